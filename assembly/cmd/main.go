@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os/signal"
 	"syscall"
 
 	"github.com/space-wanderer/microservices/assembly/internal/app"
@@ -29,7 +30,8 @@ func main() {
 
 	closer.Configure(syscall.SIGINT, syscall.SIGTERM)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	// Сигнал останавливает идущие сборки, чтобы они освободили задания до выхода
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
 	// Запускаем приложение
@@ -0,0 +1,16 @@
+package v1
+
+import (
+	"github.com/space-wanderer/microservices/assembly/internal/service"
+	assemblyV1 "github.com/space-wanderer/microservices/shared/pkg/proto/assembly/v1"
+)
+
+type api struct {
+	assemblyV1.UnimplementedAssemblyServiceServer
+
+	assemblyService service.AssemblyService
+}
+
+func NewAPI(assemblyService service.AssemblyService) *api {
+	return &api{assemblyService: assemblyService}
+}
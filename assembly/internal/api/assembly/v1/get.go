@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/assembly/internal/converter"
+	assemblyV1 "github.com/space-wanderer/microservices/shared/pkg/proto/assembly/v1"
+)
+
+func (a *api) GetAssemblyStatus(ctx context.Context, req *assemblyV1.GetAssemblyStatusRequest) (*assemblyV1.GetAssemblyStatusResponse, error) {
+	job, err := a.assemblyService.GetAssembly(ctx, req.GetOrderUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	return &assemblyV1.GetAssemblyStatusResponse{
+		Assembly: converter.ConvertJobToGRPC(job),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/assembly/internal/converter"
+	assemblyV1 "github.com/space-wanderer/microservices/shared/pkg/proto/assembly/v1"
+)
+
+func (a *api) ListAssemblies(ctx context.Context, req *assemblyV1.ListAssembliesRequest) (*assemblyV1.ListAssembliesResponse, error) {
+	filter := converter.ConvertFilterFromGRPC(req.GetFilter())
+	page := converter.ConvertPageRequestFromGRPC(req)
+	jobs, nextPageToken, err := a.assemblyService.ListAssemblies(ctx, filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	assemblies := make([]*assemblyV1.Assembly, len(jobs))
+	for i, job := range jobs {
+		assemblies[i] = converter.ConvertJobToGRPC(job)
+	}
+
+	return &assemblyV1.ListAssembliesResponse{
+		Assemblies:    assemblies,
+		NextPageToken: nextPageToken,
+	}, nil
+}
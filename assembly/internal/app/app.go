@@ -2,17 +2,44 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
 
+	assemblyV1API "github.com/space-wanderer/microservices/assembly/internal/api/assembly/v1"
 	"github.com/space-wanderer/microservices/assembly/internal/config"
 	"github.com/space-wanderer/microservices/platform/pkg/closer"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/health"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/mtls"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	kafkaMiddleware "github.com/space-wanderer/microservices/platform/pkg/middleware/kafka"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
+	"github.com/space-wanderer/microservices/shared/pkg/interceptors"
+	assemblyV1 "github.com/space-wanderer/microservices/shared/pkg/proto/assembly/v1"
 )
 
+// grpcAllowList ограничивает вызовы RPC при включенном mTLS: состояние сборок смотрит только инструмент поддержки
+func grpcAllowList() mtls.AllowList {
+	cfg := config.AppConfig().GRPCAllowList
+
+	return mtls.AllowList{
+		assemblyV1.AssemblyService_GetAssemblyStatus_FullMethodName: cfg.SupportClients(),
+		assemblyV1.AssemblyService_ListAssemblies_FullMethodName:    cfg.SupportClients(),
+	}
+}
+
 type App struct {
 	diContainer *diContainer
+	grpcServer  *grpc.Server
+	listener    net.Listener
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -35,6 +62,16 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	a.runJobResumer(ctx)
+	a.runInboxCleanup(ctx)
+
+	go func() {
+		logger.Info(ctx, fmt.Sprintf("gRPC assembly server listening on %s", config.AppConfig().AssemblyGRPC.Address()))
+		if err := a.grpcServer.Serve(a.listener); err != nil {
+			logger.Error(ctx, "gRPC server error", zap.Error(err))
+		}
+	}()
+
 	// Ждем завершения контекста
 	<-ctx.Done()
 
@@ -52,6 +89,12 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDi,
 		a.initLogger,
 		a.initCloser,
+		a.initTracing,
+		a.initMetrics,
+		a.initHealth,
+		a.initListener,
+		a.initMigrations,
+		a.initGRPCServer,
 	}
 	for _, f := range inits {
 		if err := f(ctx); err != nil {
@@ -82,3 +125,174 @@ func (a *App) initCloser(ctx context.Context) error {
 	closer.SetLogger(logger.Logger())
 	return nil
 }
+
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx,
+		config.AppConfig().Tracing.ServiceName(),
+		config.AppConfig().Tracing.CollectorEndpoint(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
+
+	closer.AddNamed("Tracer provider", shutdown)
+
+	return nil
+}
+
+func (a *App) initMetrics(ctx context.Context) error {
+	listener, err := net.Listen("tcp", config.AppConfig().Metrics.Address())
+	if err != nil {
+		return fmt.Errorf("failed to listen metrics address: %w", err)
+	}
+
+	server := metrics.NewServer(config.AppConfig().Metrics.Address())
+	go func() {
+		logger.Info(ctx, fmt.Sprintf("Metrics server listening on %s", config.AppConfig().Metrics.Address()))
+		if serveErr := server.Serve(listener); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			logger.Error(ctx, "Metrics server error", zap.Error(serveErr))
+		}
+	}()
+
+	closer.AddNamed("Metrics server", server.Shutdown)
+
+	return nil
+}
+
+func (a *App) initHealth(ctx context.Context) error {
+	registry := a.diContainer.HealthRegistry(ctx)
+
+	healthCtx, cancel := context.WithCancel(ctx)
+	go registry.Run(healthCtx)
+
+	// Статус NOT_SERVING выставляется до остановки серверов, чтобы клиенты успели переключиться
+	closer.AddBeforeShutdown("Health status", registry.Shutdown)
+	closer.AddNamed("Health checks", func(context.Context) error {
+		cancel()
+		return nil
+	})
+
+	return nil
+}
+
+func (a *App) initListener(_ context.Context) error {
+	listener, err := net.Listen("tcp", config.AppConfig().AssemblyGRPC.Address())
+	if err != nil {
+		return err
+	}
+	closer.AddNamed("TCP Listener", func(ctx context.Context) error {
+		lerr := listener.Close()
+
+		if lerr != nil && !errors.Is(lerr, net.ErrClosed) {
+			return lerr
+		}
+
+		return nil
+	})
+
+	a.listener = listener
+
+	return nil
+}
+
+func (a *App) initMigrations(ctx context.Context) error {
+	migrator := a.diContainer.PGMigrator(ctx)
+	if migrator == nil {
+		return fmt.Errorf("failed to create migrator")
+	}
+
+	err := migrator.Up()
+	if err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return nil
+}
+
+func (a *App) initGRPCServer(ctx context.Context) error {
+	creds := insecure.NewCredentials()
+	unary := []grpc.UnaryServerInterceptor{
+		interceptors.UnaryTracingServerInterceptor(),
+		metrics.UnaryServerInterceptor(),
+	}
+
+	if config.AppConfig().GRPCTLS.Enabled() {
+		reloader := a.diContainer.TLSReloader(ctx)
+		if reloader == nil {
+			return fmt.Errorf("failed to load gRPC TLS certificates")
+		}
+
+		creds = reloader.ServerCredentials()
+		unary = append(unary, mtls.UnaryAllowListInterceptor(grpcAllowList()))
+	}
+
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(append(unary, interceptors.UnaryErrorInterceptor())...),
+	)
+	closer.AddNamed("GRPC Server", func(ctx context.Context) error {
+		a.grpcServer.GracefulStop()
+		return nil
+	})
+
+	reflection.Register(a.grpcServer)
+
+	health.RegisterServer(a.grpcServer, a.diContainer.HealthRegistry(ctx))
+
+	api := assemblyV1API.NewAPI(a.diContainer.AssemblyService(ctx))
+	assemblyV1.RegisterAssemblyServiceServer(a.grpcServer, api)
+
+	return nil
+}
+
+// runJobResumer запускает продолжение брошенных сборок в горутине. При закрытии приложения
+// дожидается, пока сборки экземпляра остановятся и освободят задания для следующего запуска
+func (a *App) runJobResumer(ctx context.Context) {
+	resumerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		if err := a.diContainer.AssemblyService(ctx).RunResumer(resumerCtx); err != nil {
+			logger.Error(ctx, "Failed to run assembly job resumer", zap.Error(err))
+		}
+	}()
+
+	closer.AddNamed("Assembly job resumer", func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// runInboxCleanup запускает удаление устаревших отметок об обработанных событиях Kafka в горутине
+// и дожидается его остановки при закрытии приложения
+func (a *App) runInboxCleanup(ctx context.Context) {
+	cleanupCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		cfg := config.AppConfig().Inbox
+		if err := kafkaMiddleware.RunInboxCleanup(cleanupCtx, a.diContainer.InboxStore(ctx),
+			cfg.Retention(), cfg.CleanupInterval(), cfg.CleanupBatchSize(), logger.Logger()); err != nil {
+			logger.Error(ctx, "Failed to run Kafka inbox cleanup", zap.Error(err))
+		}
+	}()
+
+	closer.AddNamed("Kafka inbox cleanup", func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
@@ -5,25 +5,60 @@ import (
 	"log"
 
 	"github.com/IBM/sarama"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 
+	grpcClient "github.com/space-wanderer/microservices/assembly/internal/client/grpc"
 	"github.com/space-wanderer/microservices/assembly/internal/config"
 	"github.com/space-wanderer/microservices/assembly/internal/converter/kafka"
 	"github.com/space-wanderer/microservices/assembly/internal/converter/kafka/decoder"
+	"github.com/space-wanderer/microservices/assembly/internal/repository"
+	jobRepository "github.com/space-wanderer/microservices/assembly/internal/repository/job"
 	"github.com/space-wanderer/microservices/assembly/internal/service"
+	assemblyService "github.com/space-wanderer/microservices/assembly/internal/service/assembly"
 	consumerService "github.com/space-wanderer/microservices/assembly/internal/service/consumer/order_consumer"
 	producerService "github.com/space-wanderer/microservices/assembly/internal/service/producer/order_producer"
+	"github.com/space-wanderer/microservices/platform/pkg/closer"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/mtls"
+	platformHealth "github.com/space-wanderer/microservices/platform/pkg/health"
 	platformKafka "github.com/space-wanderer/microservices/platform/pkg/kafka"
 	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
 	"github.com/space-wanderer/microservices/platform/pkg/kafka/producer"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	kafkaMiddleware "github.com/space-wanderer/microservices/platform/pkg/middleware/kafka"
+	migrator "github.com/space-wanderer/microservices/platform/pkg/migrator/pg"
+	"github.com/space-wanderer/microservices/shared/pkg/interceptors"
+	assemblyV1 "github.com/space-wanderer/microservices/shared/pkg/proto/assembly/v1"
+	inventory_v1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
 )
 
 type diContainer struct {
 	consumerService service.ConsumerService
 	producerService service.ProducerService
+	assemblyService service.AssemblyService
 
-	orderPaidConsumer      platformKafka.Consumer
-	orderAssembledProducer platformKafka.Producer
+	jobRepository repository.JobRepository
+	inboxStore    *kafkaMiddleware.PostgresInbox
+
+	pgPool     *pgxpool.Pool
+	pgMigrator *migrator.Migrator
+
+	healthRegistry *platformHealth.Registry
+
+	inventoryGRPCClient grpcClient.InventoryClient
+	inventoryClient     inventory_v1.InventoryServiceClient
+	inventoryConn       *grpc.ClientConn
+	tlsReloader         *mtls.Reloader
+
+	orderPaidConsumer          platformKafka.Consumer
+	orderAssembledProducer     platformKafka.Producer
+	assemblyProgressProducer   platformKafka.Producer
+	shipAssemblyFailedProducer platformKafka.Producer
+	consumerRetryProducer      platformKafka.TopicProducer
 
 	orderPaidDecoder kafka.AssemblyRecodedDecoder
 }
@@ -34,18 +69,168 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) ConsumerService(ctx context.Context) service.ConsumerService {
 	if d.consumerService == nil {
-		d.consumerService = consumerService.NewService(d.OrderPaidConsumer(ctx), d.OrderPaidDecoder(ctx), d.ProducerService(ctx))
+		d.consumerService = consumerService.NewService(d.OrderPaidConsumer(ctx), d.OrderPaidDecoder(ctx), d.AssemblyService(ctx))
 	}
 	return d.consumerService
 }
 
 func (d *diContainer) ProducerService(ctx context.Context) service.ProducerService {
 	if d.producerService == nil {
-		d.producerService = producerService.NewService(d.OrderAssembledProducer(ctx))
+		d.producerService = producerService.NewService(d.OrderAssembledProducer(ctx), d.AssemblyProgressProducer(ctx), d.ShipAssemblyFailedProducer(ctx))
 	}
 	return d.producerService
 }
 
+func (d *diContainer) AssemblyService(ctx context.Context) service.AssemblyService {
+	if d.assemblyService == nil {
+		cfg := config.AppConfig()
+		d.assemblyService = assemblyService.NewService(
+			d.JobRepository(ctx),
+			d.InventoryGRPCClient(ctx),
+			d.ProducerService(ctx),
+			cfg.AssemblyEngine.TimeScale(),
+			cfg.AssemblyEngine.FailureRate(),
+			cfg.AssemblyJobs.LeaseTTL(),
+			cfg.AssemblyJobs.ResumeInterval(),
+			cfg.OrderPaidConsumer.Workers(),
+		)
+	}
+	return d.assemblyService
+}
+
+func (d *diContainer) JobRepository(ctx context.Context) repository.JobRepository {
+	if d.jobRepository == nil {
+		d.jobRepository = jobRepository.NewRepository(d.PGPool(ctx))
+	}
+	return d.jobRepository
+}
+
+// InboxStore создает хранилище обработанных событий Kafka, общее для всех consumer-ов сервиса
+func (d *diContainer) InboxStore(ctx context.Context) *kafkaMiddleware.PostgresInbox {
+	if d.inboxStore == nil {
+		d.inboxStore = kafkaMiddleware.NewPostgresInbox(d.PGPool(ctx))
+	}
+	return d.inboxStore
+}
+
+func (d *diContainer) PGPool(ctx context.Context) *pgxpool.Pool {
+	if d.pgPool == nil {
+		pgPool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
+		if err != nil {
+			log.Printf("❌ Ошибка подключения к PostgreSQL: %v", err)
+			return nil
+		}
+		if err = metrics.RegisterPgxPool(pgPool); err != nil {
+			log.Printf("❌ Ошибка регистрации метрик пула PostgreSQL: %v", err)
+		}
+		d.pgPool = pgPool
+	}
+	return d.pgPool
+}
+
+func (d *diContainer) PGMigrator(ctx context.Context) *migrator.Migrator {
+	if d.pgMigrator == nil {
+		db := stdlib.OpenDBFromPool(d.PGPool(ctx))
+		d.pgMigrator = migrator.NewMigrator(db, config.AppConfig().Postgres.MigrationDir())
+	}
+	return d.pgMigrator
+}
+
+// HealthRegistry создает реестр health-проверок зависимостей сервиса
+func (d *diContainer) HealthRegistry(ctx context.Context) *platformHealth.Registry {
+	if d.healthRegistry == nil {
+		cfg := config.AppConfig().Health
+		registry := platformHealth.NewRegistry(cfg.CheckInterval(), cfg.CheckTimeout())
+		registry.Register("postgres", platformHealth.PgxPoolChecker(d.PGPool(ctx)))
+		registry.RegisterService(assemblyV1.AssemblyService_ServiceDesc.ServiceName, "postgres")
+		d.healthRegistry = registry
+	}
+	return d.healthRegistry
+}
+
+func (d *diContainer) InventoryGRPCClient(ctx context.Context) grpcClient.InventoryClient {
+	if d.inventoryGRPCClient == nil {
+		d.inventoryGRPCClient = grpcClient.NewInventoryClient(d.InventoryClient(ctx))
+	}
+	return d.inventoryGRPCClient
+}
+
+func (d *diContainer) InventoryClient(ctx context.Context) inventory_v1.InventoryServiceClient {
+	if d.inventoryClient == nil {
+		conn := d.InventoryConn(ctx)
+		if conn == nil {
+			return nil
+		}
+		d.inventoryClient = inventory_v1.NewInventoryServiceClient(conn)
+	}
+	return d.inventoryClient
+}
+
+func (d *diContainer) InventoryConn(ctx context.Context) *grpc.ClientConn {
+	if d.inventoryConn == nil {
+		creds := d.GRPCTransportCredentials(ctx)
+		if creds == nil {
+			return nil
+		}
+
+		conn, err := grpc.NewClient(
+			config.AppConfig().InventoryGRPC.Address(),
+			grpc.WithTransportCredentials(creds),
+			grpc.WithChainUnaryInterceptor(
+				interceptors.UnaryTracingClientInterceptor(),
+				metrics.UnaryClientInterceptor(),
+			),
+		)
+		if err != nil {
+			log.Printf("❌ Ошибка подключения к inventory service: %v", err)
+			return nil
+		}
+
+		closer.AddNamed("Inventory gRPC connection", func(context.Context) error {
+			return conn.Close()
+		})
+
+		d.inventoryConn = conn
+	}
+	return d.inventoryConn
+}
+
+// TLSReloader загружает сертификаты mTLS и перечитывает их при изменении файлов
+func (d *diContainer) TLSReloader(ctx context.Context) *mtls.Reloader {
+	if d.tlsReloader == nil {
+		cfg := config.AppConfig().GRPCTLS
+		reloader, err := mtls.NewReloader(cfg.CertFile(), cfg.KeyFile(), cfg.CAFile())
+		if err != nil {
+			log.Printf("❌ Ошибка загрузки TLS-сертификатов: %v", err)
+			return nil
+		}
+
+		reloadCtx, cancel := context.WithCancel(ctx)
+		go reloader.Run(reloadCtx, cfg.ReloadInterval())
+		closer.AddNamed("TLS reloader", func(context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.tlsReloader = reloader
+	}
+	return d.tlsReloader
+}
+
+// GRPCTransportCredentials возвращает креды клиента inventory: mTLS, если он настроен, иначе plaintext
+func (d *diContainer) GRPCTransportCredentials(ctx context.Context) credentials.TransportCredentials {
+	if !config.AppConfig().GRPCTLS.Enabled() {
+		return insecure.NewCredentials()
+	}
+
+	reloader := d.TLSReloader(ctx)
+	if reloader == nil {
+		return nil
+	}
+
+	return reloader.ClientCredentials()
+}
+
 func (d *diContainer) OrderPaidConsumer(ctx context.Context) platformKafka.Consumer {
 	if d.orderPaidConsumer == nil {
 		cfg := config.AppConfig()
@@ -62,12 +247,52 @@ func (d *diContainer) OrderPaidConsumer(ctx context.Context) platformKafka.Consu
 			return nil
 		}
 
+		// Необработанные сообщения уходят в топики повторов, которые читает тот же consumer, а затем в DLQ
+		retryPolicy := consumerRetryPolicy(cfg.OrderPaidConsumer.TopicName(), cfg.OrderPaidConsumer.ConsumerGroupID())
+		topics := append([]string{cfg.OrderPaidConsumer.TopicName()}, retryPolicy.Topics()...)
+
 		// Создаем consumer
-		d.orderPaidConsumer = consumer.NewConsumer(group, []string{cfg.OrderPaidConsumer.TopicName()}, logger.Logger())
+		// Повторно доставленные события пропускаются по event_uuid, чтобы корабль не собирался дважды
+		orderPaidEventID := func(msg consumer.Message) string {
+			return d.OrderPaidDecoder(ctx).Decode(msg.Value).EventUUID
+		}
+
+		// Заказы собираются параллельно; события одного заказа обрабатываются по порядку, так как ключ — UUID заказа
+		d.orderPaidConsumer = consumer.NewPooledConsumer(group, topics, cfg.OrderPaidConsumer.Workers(), logger.Logger(),
+			consumer.Retry(retryPolicy, d.ConsumerRetryProducer(ctx), logger.Logger()),
+			kafkaMiddleware.Inbox(cfg.OrderPaidConsumer.ConsumerGroupID(), d.InboxStore(ctx), orderPaidEventID, logger.Logger()),
+		)
 	}
 	return d.orderPaidConsumer
 }
 
+// ConsumerRetryProducer создает producer для переноса необработанных сообщений в топики повторов и DLQ
+func (d *diContainer) ConsumerRetryProducer(ctx context.Context) platformKafka.TopicProducer {
+	if d.consumerRetryProducer == nil {
+		cfg := config.AppConfig()
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Producer.Return.Successes = true
+		saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+		saramaConfig.Producer.Retry.Max = 3
+
+		syncProducer, err := sarama.NewSyncProducer(cfg.Kafka.Brokers(), saramaConfig)
+		if err != nil {
+			log.Printf("❌ Ошибка создания Kafka producer: %v", err)
+			return nil
+		}
+
+		d.consumerRetryProducer = producer.NewTopicProducer(syncProducer, logger.Logger())
+	}
+	return d.consumerRetryProducer
+}
+
+// consumerRetryPolicy возвращает политику повторов consumer group groupID для топика topic
+func consumerRetryPolicy(topic, groupID string) consumer.RetryPolicy {
+	cfg := config.AppConfig().KafkaConsumerRetry
+	return consumer.NewRetryPolicy(topic, groupID, cfg.MaxAttempts(), cfg.Backoff(), cfg.MaxBackoff(), cfg.Delays())
+}
+
 func (d *diContainer) OrderAssembledProducer(ctx context.Context) platformKafka.Producer {
 	if d.orderAssembledProducer == nil {
 		cfg := config.AppConfig()
@@ -90,6 +315,46 @@ func (d *diContainer) OrderAssembledProducer(ctx context.Context) platformKafka.
 	return d.orderAssembledProducer
 }
 
+// AssemblyProgressProducer создает producer событий о завершении этапов сборки
+func (d *diContainer) AssemblyProgressProducer(ctx context.Context) platformKafka.Producer {
+	if d.assemblyProgressProducer == nil {
+		cfg := config.AppConfig()
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Producer.Return.Successes = true
+		saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+
+		syncProducer, err := sarama.NewSyncProducer(cfg.Kafka.Brokers(), saramaConfig)
+		if err != nil {
+			log.Printf("❌ Ошибка создания Kafka producer: %v", err)
+			return nil
+		}
+
+		d.assemblyProgressProducer = producer.NewProducer(syncProducer, cfg.AssemblyProgressProducer.TopicName(), logger.Logger())
+	}
+	return d.assemblyProgressProducer
+}
+
+// ShipAssemblyFailedProducer создает producer событий о сбое сборки
+func (d *diContainer) ShipAssemblyFailedProducer(ctx context.Context) platformKafka.Producer {
+	if d.shipAssemblyFailedProducer == nil {
+		cfg := config.AppConfig()
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Producer.Return.Successes = true
+		saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+
+		syncProducer, err := sarama.NewSyncProducer(cfg.Kafka.Brokers(), saramaConfig)
+		if err != nil {
+			log.Printf("❌ Ошибка создания Kafka producer: %v", err)
+			return nil
+		}
+
+		d.shipAssemblyFailedProducer = producer.NewProducer(syncProducer, cfg.OrderAssemblyFailedProducer.TopicName(), logger.Logger())
+	}
+	return d.shipAssemblyFailedProducer
+}
+
 func (d *diContainer) OrderPaidDecoder(ctx context.Context) kafka.AssemblyRecodedDecoder {
 	if d.orderPaidDecoder == nil {
 		d.orderPaidDecoder = decoder.NewOrderPaidDecoder()
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	generatedInventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func PartProtoToModel(protoPart *generatedInventoryV1.Part) model.Part {
+	dimensions := protoPart.GetDimensions()
+
+	return model.Part{
+		UUID:     protoPart.GetUuid(),
+		Name:     protoPart.GetName(),
+		Category: categoryProtoToModel(protoPart.GetCategory()),
+		Dimensions: model.Dimensions{
+			Length: dimensions.GetLength(),
+			Width:  dimensions.GetWidth(),
+			Height: dimensions.GetHeight(),
+			Weight: dimensions.GetWeight(),
+		},
+	}
+}
+
+func categoryProtoToModel(category generatedInventoryV1.Category) model.Category {
+	switch category {
+	case generatedInventoryV1.Category_CATEGORY_ENGINE:
+		return model.CategoryEngine
+	case generatedInventoryV1.Category_CATEGORY_FUEL:
+		return model.CategoryFuel
+	case generatedInventoryV1.Category_CATEGORY_PORTHOLE:
+		return model.CategoryPorthole
+	case generatedInventoryV1.Category_CATEGORY_WING:
+		return model.CategoryWing
+	default:
+		return model.CategoryUnknown
+	}
+}
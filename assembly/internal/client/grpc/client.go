@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"context"
+
+	inventoryV1 "github.com/space-wanderer/microservices/assembly/internal/client/grpc/inventory/v1"
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	generatedInventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+type InventoryClient interface {
+	// ListParts возвращает детали с указанными UUID; отсутствующие на складе детали в ответ не попадают
+	ListParts(ctx context.Context, uuids []string) ([]model.Part, error)
+}
+
+func NewInventoryClient(generatedClient generatedInventoryV1.InventoryServiceClient) InventoryClient {
+	return inventoryV1.NewClient(generatedClient)
+}
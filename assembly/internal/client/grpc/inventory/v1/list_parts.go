@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/assembly/internal/client/converter"
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	generatedInventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+// ListParts возвращает детали по UUID, проходя по страницам inventory
+func (c *client) ListParts(ctx context.Context, uuids []string) ([]model.Part, error) {
+	req := &generatedInventoryV1.ListPartsRequest{
+		Filter: &generatedInventoryV1.PartsFilter{Uuids: uuids},
+	}
+
+	parts := make([]model.Part, 0, len(uuids))
+	for {
+		resp, err := c.generatedClient.ListParts(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, part := range resp.GetParts() {
+			parts = append(parts, converter.PartProtoToModel(part))
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return parts, nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
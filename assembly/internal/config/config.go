@@ -11,10 +11,24 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger                 LoggerConfig
-	Kafka                  KafkaConfig
-	OrderPaidConsumer      OrderPaidConsumerConfig
-	OrderAssembledProducer OrderAssembledProducerConfig
+	Logger                      LoggerConfig
+	Tracing                     TracingConfig
+	Metrics                     MetricsConfig
+	Kafka                       KafkaConfig
+	KafkaConsumerRetry          KafkaConsumerRetryConfig
+	OrderPaidConsumer           OrderPaidConsumerConfig
+	OrderAssembledProducer      OrderAssembledProducerConfig
+	AssemblyProgressProducer    AssemblyProgressProducerConfig
+	OrderAssemblyFailedProducer OrderAssemblyFailedProducerConfig
+	AssemblyEngine              AssemblyEngineConfig
+	InventoryGRPC               InventoryGRPCConfig
+	GRPCTLS                     GRPCTLSConfig
+	GRPCAllowList               GRPCAllowListConfig
+	AssemblyJobs                AssemblyJobsConfig
+	AssemblyGRPC                AssemblyGRPCConfig
+	Postgres                    PostgresConfig
+	Inbox                       InboxConfig
+	Health                      HealthConfig
 }
 
 func Load(path ...string) error {
@@ -28,11 +42,26 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
+	metricsCfg, err := env.NewMetricsConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaCfg, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
 	}
 
+	kafkaConsumerRetryCfg, err := env.NewKafkaConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidConsumerCfg, err := env.NewOrderPaidConsumerConfig()
 	if err != nil {
 		return err
@@ -43,11 +72,80 @@ func Load(path ...string) error {
 		return err
 	}
 
+	assemblyProgressProducerCfg, err := env.NewAssemblyProgressProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderAssemblyFailedProducerCfg, err := env.NewOrderAssemblyFailedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyEngineCfg, err := env.NewAssemblyEngineConfig()
+	if err != nil {
+		return err
+	}
+
+	inventoryGRPCCfg, err := env.NewInventoryGRPCConfig()
+	if err != nil {
+		return err
+	}
+
+	grpcTLSCfg, err := env.NewGRPCTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	grpcAllowListCfg, err := env.NewGRPCAllowListConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyJobsCfg, err := env.NewAssemblyJobsConfig()
+	if err != nil {
+		return err
+	}
+
+	assemblyGRPCCfg, err := env.NewAssemblyGRPCConfig()
+	if err != nil {
+		return err
+	}
+
+	postgresCfg, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+
+	inboxCfg, err := env.NewInboxConfig()
+	if err != nil {
+		return err
+	}
+
+	healthCfg, err := env.NewHealthConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:                 loggerCfg,
-		Kafka:                  kafkaCfg,
-		OrderPaidConsumer:      orderPaidConsumerCfg,
-		OrderAssembledProducer: orderAssembledProducerCfg,
+		Logger:                      loggerCfg,
+		Tracing:                     tracingCfg,
+		Metrics:                     metricsCfg,
+		Kafka:                       kafkaCfg,
+		KafkaConsumerRetry:          kafkaConsumerRetryCfg,
+		OrderPaidConsumer:           orderPaidConsumerCfg,
+		OrderAssembledProducer:      orderAssembledProducerCfg,
+		AssemblyProgressProducer:    assemblyProgressProducerCfg,
+		OrderAssemblyFailedProducer: orderAssemblyFailedProducerCfg,
+		AssemblyEngine:              assemblyEngineCfg,
+		InventoryGRPC:               inventoryGRPCCfg,
+		GRPCTLS:                     grpcTLSCfg,
+		GRPCAllowList:               grpcAllowListCfg,
+		AssemblyJobs:                assemblyJobsCfg,
+		AssemblyGRPC:                assemblyGRPCCfg,
+		Postgres:                    postgresCfg,
+		Inbox:                       inboxCfg,
+		Health:                      healthCfg,
 	}
 
 	return nil
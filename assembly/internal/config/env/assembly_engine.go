@@ -0,0 +1,43 @@
+package env
+
+import (
+	"errors"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyEngineEnvConfig struct {
+	TimeScale   float64 `env:"ASSEMBLY_TIME_SCALE,required"`
+	FailureRate float64 `env:"ASSEMBLY_FAILURE_RATE,required"`
+}
+
+type assemblyEngineConfig struct {
+	raw assemblyEngineEnvConfig
+}
+
+func NewAssemblyEngineConfig() (*assemblyEngineConfig, error) {
+	var raw assemblyEngineEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.TimeScale <= 0 {
+		return nil, errors.New("ASSEMBLY_TIME_SCALE must be positive")
+	}
+
+	if raw.FailureRate < 0 || raw.FailureRate > 1 {
+		return nil, errors.New("ASSEMBLY_FAILURE_RATE must be between 0 and 1")
+	}
+
+	return &assemblyEngineConfig{raw: raw}, nil
+}
+
+// TimeScale возвращает множитель длительности этапов сборки
+func (cfg *assemblyEngineConfig) TimeScale() float64 {
+	return cfg.raw.TimeScale
+}
+
+// FailureRate возвращает вероятность имитируемого сбоя сборки
+func (cfg *assemblyEngineConfig) FailureRate() float64 {
+	return cfg.raw.FailureRate
+}
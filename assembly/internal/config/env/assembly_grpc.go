@@ -0,0 +1,28 @@
+package env
+
+import (
+	"net"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyGRPCEnvConfig struct {
+	Host string `env:"GRPC_HOST,required"`
+	Port string `env:"GRPC_PORT,required"`
+}
+
+type assemblyGRPCConfig struct {
+	raw assemblyGRPCEnvConfig
+}
+
+func NewAssemblyGRPCConfig() (*assemblyGRPCConfig, error) {
+	var raw assemblyGRPCEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &assemblyGRPCConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyGRPCConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
@@ -0,0 +1,45 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type assemblyJobsEnvConfig struct {
+	LeaseTTL       time.Duration `env:"ASSEMBLY_JOB_LEASE_TTL,required"`
+	ResumeInterval time.Duration `env:"ASSEMBLY_JOB_RESUME_INTERVAL,required"`
+}
+
+type assemblyJobsConfig struct {
+	raw assemblyJobsEnvConfig
+}
+
+func NewAssemblyJobsConfig() (*assemblyJobsConfig, error) {
+	var raw assemblyJobsEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	// Аренда продлевается трижды за срок, поэтому слишком короткий срок не имеет смысла
+	if raw.LeaseTTL < time.Second {
+		return nil, errors.New("ASSEMBLY_JOB_LEASE_TTL must be at least 1s")
+	}
+
+	if raw.ResumeInterval <= 0 {
+		return nil, errors.New("ASSEMBLY_JOB_RESUME_INTERVAL must be positive")
+	}
+
+	return &assemblyJobsConfig{raw: raw}, nil
+}
+
+// LeaseTTL возвращает срок аренды задания: если экземпляр не продлил ее, задание подхватит другой
+func (cfg *assemblyJobsConfig) LeaseTTL() time.Duration {
+	return cfg.raw.LeaseTTL
+}
+
+// ResumeInterval возвращает период поиска брошенных заданий
+func (cfg *assemblyJobsConfig) ResumeInterval() time.Duration {
+	return cfg.raw.ResumeInterval
+}
@@ -0,0 +1,24 @@
+package env
+
+import "github.com/caarlos0/env/v11"
+
+type assemblyProgressProducerEnvConfig struct {
+	TopicName string `env:"ASSEMBLY_PROGRESS_TOPIC_NAME,required"`
+}
+
+type assemblyProgressProducerConfig struct {
+	raw assemblyProgressProducerEnvConfig
+}
+
+func NewAssemblyProgressProducerConfig() (*assemblyProgressProducerConfig, error) {
+	var raw assemblyProgressProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &assemblyProgressProducerConfig{raw: raw}, nil
+}
+
+func (cfg *assemblyProgressProducerConfig) TopicName() string {
+	return cfg.raw.TopicName
+}
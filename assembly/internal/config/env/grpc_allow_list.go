@@ -0,0 +1,33 @@
+package env
+
+import (
+	"errors"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type grpcAllowListEnvConfig struct {
+	SupportClients []string `env:"GRPC_ALLOW_SUPPORT_CLIENTS,required" envSeparator:","`
+}
+
+type grpcAllowListConfig struct {
+	raw grpcAllowListEnvConfig
+}
+
+func NewGRPCAllowListConfig() (*grpcAllowListConfig, error) {
+	var raw grpcAllowListEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if len(raw.SupportClients) == 0 {
+		return nil, errors.New("GRPC_ALLOW_SUPPORT_CLIENTS must not be empty")
+	}
+
+	return &grpcAllowListConfig{raw: raw}, nil
+}
+
+// SupportClients возвращает идентичности клиентов, которым разрешено смотреть состояние сборок
+func (cfg *grpcAllowListConfig) SupportClients() []string {
+	return cfg.raw.SupportClients
+}
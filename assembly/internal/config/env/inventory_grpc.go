@@ -0,0 +1,29 @@
+package env
+
+import (
+	"net"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type inventoryGRPCEnvConfig struct {
+	Host string `env:"INVENTORY_GRPC_HOST,required"`
+	Port string `env:"INVENTORY_GRPC_PORT,required"`
+}
+
+type inventoryGRPCConfig struct {
+	raw inventoryGRPCEnvConfig
+}
+
+func NewInventoryGRPCConfig() (*inventoryGRPCConfig, error) {
+	var raw inventoryGRPCEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &inventoryGRPCConfig{raw: raw}, nil
+}
+
+func (cfg *inventoryGRPCConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
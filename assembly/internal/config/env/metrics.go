@@ -0,0 +1,30 @@
+package env
+
+import (
+	"net"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type metricsEnvConfig struct {
+	Host string `env:"METRICS_HOST,required"`
+	Port string `env:"METRICS_PORT,required"`
+}
+
+type metricsConfig struct {
+	raw metricsEnvConfig
+}
+
+func NewMetricsConfig() (*metricsConfig, error) {
+	var raw metricsEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &metricsConfig{raw: raw}, nil
+}
+
+// Address возвращает адрес HTTP-сервера, отдающего /metrics
+func (cfg *metricsConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
@@ -1,10 +1,15 @@
 package env
 
-import "github.com/caarlos0/env/v11"
+import (
+	"errors"
+
+	"github.com/caarlos0/env/v11"
+)
 
 type orderPaidConsumerEnvConfig struct {
 	TopicName       string `env:"ORDER_PAID_TOPIC_NAME,required"`
 	ConsumerGroupID string `env:"ORDER_PAID_CONSUMER_GROUP_ID,required"`
+	Workers         int    `env:"ORDER_PAID_CONSUMER_WORKERS,required"`
 }
 
 type orderPaidConsumerConfig struct {
@@ -17,6 +22,10 @@ func NewOrderPaidConsumerConfig() (*orderPaidConsumerConfig, error) {
 		return nil, err
 	}
 
+	if raw.Workers <= 0 {
+		return nil, errors.New("ORDER_PAID_CONSUMER_WORKERS must be positive")
+	}
+
 	return &orderPaidConsumerConfig{raw: raw}, nil
 }
 
@@ -27,3 +36,8 @@ func (cfg *orderPaidConsumerConfig) TopicName() string {
 func (cfg *orderPaidConsumerConfig) ConsumerGroupID() string {
 	return cfg.raw.ConsumerGroupID
 }
+
+// Workers возвращает число заказов, которые собираются одновременно
+func (cfg *orderPaidConsumerConfig) Workers() int {
+	return cfg.raw.Workers
+}
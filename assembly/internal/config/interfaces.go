@@ -1,19 +1,102 @@
 package config
 
+import "time"
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+}
+
+type MetricsConfig interface {
+	Address() string
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
 
+// KafkaConsumerRetryConfig - политика повторной обработки сообщений Kafka и DLQ
+type KafkaConsumerRetryConfig interface {
+	MaxAttempts() int
+	Backoff() time.Duration
+	MaxBackoff() time.Duration
+	Delays() []time.Duration
+}
+
 type OrderPaidConsumerConfig interface {
 	TopicName() string
 	ConsumerGroupID() string
+	Workers() int
 }
 
 type OrderAssembledProducerConfig interface {
 	TopicName() string
 }
+
+// AssemblyProgressProducerConfig - топик событий о ходе сборки
+type AssemblyProgressProducerConfig interface {
+	TopicName() string
+}
+
+// OrderAssemblyFailedProducerConfig - топик событий о сбое сборки
+type OrderAssemblyFailedProducerConfig interface {
+	TopicName() string
+}
+
+// AssemblyEngineConfig - параметры имитации сборки: масштаб времени этапов и вероятность сбоя
+type AssemblyEngineConfig interface {
+	TimeScale() float64
+	FailureRate() float64
+}
+
+type InventoryGRPCConfig interface {
+	Address() string
+}
+
+// GRPCTLSConfig - mTLS для gRPC-сервера и клиента inventory; без файлов используется plaintext
+type GRPCTLSConfig interface {
+	Enabled() bool
+	CertFile() string
+	KeyFile() string
+	CAFile() string
+	ReloadInterval() time.Duration
+}
+
+// GRPCAllowListConfig - клиенты, которым при включенном mTLS разрешены RPC с ограниченным доступом
+type GRPCAllowListConfig interface {
+	SupportClients() []string
+}
+
+// AssemblyJobsConfig - аренда заданий на сборку и поиск брошенных заданий
+type AssemblyJobsConfig interface {
+	LeaseTTL() time.Duration
+	ResumeInterval() time.Duration
+}
+
+type AssemblyGRPCConfig interface {
+	Address() string
+}
+
+type PostgresConfig interface {
+	URI() string
+	Database() string
+	MigrationDir() string
+}
+
+// HealthConfig - периодические проверки зависимостей для gRPC health
+type HealthConfig interface {
+	CheckInterval() time.Duration
+	CheckTimeout() time.Duration
+}
+
+// InboxConfig - хранение отметок об обработанных событиях Kafka
+type InboxConfig interface {
+	Retention() time.Duration
+	CleanupInterval() time.Duration
+	CleanupBatchSize() int
+}
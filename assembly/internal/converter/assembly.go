@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	assemblyV1 "github.com/space-wanderer/microservices/shared/pkg/proto/assembly/v1"
+)
+
+// ConvertJobToGRPC конвертирует задание на сборку в gRPC модель
+func ConvertJobToGRPC(job *model.Job) *assemblyV1.Assembly {
+	stages := make([]*assemblyV1.AssemblyStage, 0, len(job.Plan.Stages))
+	for _, stage := range job.Plan.Stages {
+		stages = append(stages, &assemblyV1.AssemblyStage{
+			Stage:      string(stage.Stage),
+			DurationMs: stage.Duration.Milliseconds(),
+		})
+	}
+
+	result := &assemblyV1.Assembly{
+		OrderUuid:       job.OrderUUID,
+		UserUuid:        job.UserUUID,
+		Status:          convertStatusToGRPC(job.Status),
+		Stages:          stages,
+		CompletedStages: int32(job.CompletedStages),
+		ElapsedMs:       job.Elapsed.Milliseconds(),
+		FailedStage:     string(job.FailedStage),
+		FailureReason:   job.FailureReason,
+		CreatedAt:       timestamppb.New(job.CreatedAt),
+		UpdatedAt:       timestamppb.New(job.UpdatedAt),
+	}
+	if job.FinishedAt != nil {
+		result.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+
+	return result
+}
+
+// ConvertFilterFromGRPC конвертирует фильтр списка сборок из gRPC запроса в модель
+func ConvertFilterFromGRPC(filter *assemblyV1.AssembliesFilter) model.JobsFilter {
+	statuses := make([]model.JobStatus, 0, len(filter.GetStatuses()))
+	for _, status := range filter.GetStatuses() {
+		statuses = append(statuses, convertStatusFromGRPC(status))
+	}
+
+	return model.JobsFilter{
+		Statuses: statuses,
+		UserUUID: filter.GetUserUuid(),
+	}
+}
+
+// ConvertPageRequestFromGRPC конвертирует параметры страницы из gRPC запроса в модель
+func ConvertPageRequestFromGRPC(req *assemblyV1.ListAssembliesRequest) model.JobsPageRequest {
+	return model.JobsPageRequest{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	}
+}
+
+func convertStatusToGRPC(status model.JobStatus) assemblyV1.AssemblyStatus {
+	switch status {
+	case model.JobStatusInProgress:
+		return assemblyV1.AssemblyStatus_ASSEMBLY_STATUS_IN_PROGRESS
+	case model.JobStatusCompleted:
+		return assemblyV1.AssemblyStatus_ASSEMBLY_STATUS_COMPLETED
+	case model.JobStatusFailed:
+		return assemblyV1.AssemblyStatus_ASSEMBLY_STATUS_FAILED
+	default:
+		return assemblyV1.AssemblyStatus_ASSEMBLY_STATUS_UNSPECIFIED
+	}
+}
+
+// convertStatusFromGRPC переводит статус из запроса; неизвестный статус отклоняется при проверке фильтра
+func convertStatusFromGRPC(status assemblyV1.AssemblyStatus) model.JobStatus {
+	switch status {
+	case assemblyV1.AssemblyStatus_ASSEMBLY_STATUS_IN_PROGRESS:
+		return model.JobStatusInProgress
+	case assemblyV1.AssemblyStatus_ASSEMBLY_STATUS_COMPLETED:
+		return model.JobStatusCompleted
+	case assemblyV1.AssemblyStatus_ASSEMBLY_STATUS_FAILED:
+		return model.JobStatusFailed
+	default:
+		return model.JobStatus(status.String())
+	}
+}
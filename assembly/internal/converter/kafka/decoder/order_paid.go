@@ -20,11 +20,20 @@ func (d *decoder) Decode(data []byte) model.OrderPaidEvent {
 		return model.OrderPaidEvent{}
 	}
 
+	items := make([]model.OrderItem, 0, len(pb.Items))
+	for _, item := range pb.Items {
+		items = append(items, model.OrderItem{
+			PartUUID: item.PartUuid,
+			Quantity: item.Quantity,
+		})
+	}
+
 	return model.OrderPaidEvent{
 		EventUUID:       pb.EventUuid,
 		OrderUUID:       pb.OrderUuid,
 		UserUUID:        pb.UserUuid,
 		PaymentMethod:   pb.PaymentMethod,
 		TransactionUUID: pb.TransactionUuid,
+		Items:           items,
 	}
 }
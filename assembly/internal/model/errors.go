@@ -0,0 +1,18 @@
+package model
+
+import (
+	"errors"
+
+	sharedErrors "github.com/space-wanderer/microservices/shared/pkg/errors"
+)
+
+var (
+	ErrInvalidOrderUUID = sharedErrors.NewInvalidArgumentError(errors.New("invalid order uuid"))
+	ErrInvalidUserUUID  = sharedErrors.NewInvalidArgumentError(errors.New("invalid user uuid"))
+	ErrInvalidPageSize  = sharedErrors.NewInvalidArgumentError(errors.New("page size must not be negative"))
+	ErrInvalidPageToken = sharedErrors.NewInvalidArgumentError(errors.New("invalid page token"))
+	ErrInvalidStatus    = sharedErrors.NewInvalidArgumentError(errors.New("invalid assembly status"))
+	ErrJobNotFound      = sharedErrors.NewNotFoundError(errors.New("assembly not found"))
+	ErrJobAlreadyExists = sharedErrors.NewFailedPreconditionError(errors.New("assembly for the order already exists"))
+	ErrJobLeaseLost     = sharedErrors.NewFailedPreconditionError(errors.New("assembly is claimed by another instance"))
+)
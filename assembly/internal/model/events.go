@@ -1,11 +1,18 @@
 package model
 
 type OrderPaidEvent struct {
-	EventUUID       string `json:"event_uuid"`
-	OrderUUID       string `json:"order_uuid"`
-	UserUUID        string `json:"user_uuid"`
-	PaymentMethod   string `json:"payment_method"`
-	TransactionUUID string `json:"transaction_uuid"`
+	EventUUID       string      `json:"event_uuid"`
+	OrderUUID       string      `json:"order_uuid"`
+	UserUUID        string      `json:"user_uuid"`
+	PaymentMethod   string      `json:"payment_method"`
+	TransactionUUID string      `json:"transaction_uuid"`
+	Items           []OrderItem `json:"items"`
+}
+
+// OrderItem - деталь заказа и ее количество
+type OrderItem struct {
+	PartUUID string `json:"part_uuid"`
+	Quantity int64  `json:"quantity"`
 }
 
 type ShipAssembledEvent struct {
@@ -14,3 +21,22 @@ type ShipAssembledEvent struct {
 	UserUUID     string `json:"user_uuid"`
 	BuildTimeSec int64  `json:"build_time_sec"`
 }
+
+type AssemblyProgressEvent struct {
+	EventUUID       string `json:"event_uuid"`
+	OrderUUID       string `json:"order_uuid"`
+	UserUUID        string `json:"user_uuid"`
+	Stage           Stage  `json:"stage"`
+	StageNumber     int    `json:"stage_number"`
+	StageCount      int    `json:"stage_count"`
+	StageDurationMs int64  `json:"stage_duration_ms"`
+	ElapsedMs       int64  `json:"elapsed_ms"`
+}
+
+type ShipAssemblyFailedEvent struct {
+	EventUUID string `json:"event_uuid"`
+	OrderUUID string `json:"order_uuid"`
+	UserUUID  string `json:"user_uuid"`
+	Stage     Stage  `json:"stage"`
+	Reason    string `json:"reason"`
+}
@@ -0,0 +1,64 @@
+package model
+
+import "time"
+
+// JobStatus - статус задания на сборку
+type JobStatus string
+
+const (
+	JobStatusInProgress JobStatus = "IN_PROGRESS"
+	JobStatusCompleted  JobStatus = "COMPLETED"
+	JobStatusFailed     JobStatus = "FAILED"
+)
+
+// Job - задание на сборку корабля по заказу. На заказ приходится одно задание,
+// его состояние сохраняется после каждого этапа, чтобы после перезапуска сборка продолжилась
+type Job struct {
+	OrderUUID       string
+	UserUUID        string
+	Status          JobStatus
+	Plan            BuildPlan
+	FailAt          int // Индекс этапа с имитируемым сбоем, -1 — сборка пройдет успешно
+	CompletedStages int
+	Elapsed         time.Duration // Время, затраченное на завершенные этапы
+	FailedStage     Stage
+	FailureReason   string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	FinishedAt      *time.Time
+	// OutcomePublishedAt - время отправки итогового события; пока оно не отправлено, задание переотправляет итог
+	OutcomePublishedAt *time.Time
+}
+
+// Finished сообщает, что сборка завершена успешно или со сбоем
+func (j *Job) Finished() bool {
+	return j.Status != JobStatusInProgress
+}
+
+// OutcomePending сообщает, что итог сборки сохранен, но событие о нем еще не отправлено
+func (j *Job) OutcomePending() bool {
+	return j.Finished() && j.OutcomePublishedAt == nil
+}
+
+// RejectedBeforeStart сообщает, что сборка не начиналась: детали заказа не нашлись в inventory
+func (j *Job) RejectedBeforeStart() bool {
+	return j.Status == JobStatusFailed && j.FailedStage == ""
+}
+
+// JobsFilter - фильтр списка заданий; пустые поля не ограничивают выдачу
+type JobsFilter struct {
+	Statuses []JobStatus
+	UserUUID string
+}
+
+// JobsPageRequest - параметры страницы списка заданий
+type JobsPageRequest struct {
+	Size  int32
+	Token string
+}
+
+// JobsCursor - ключ последнего задания предыдущей страницы
+type JobsCursor struct {
+	CreatedAt time.Time
+	OrderUUID string
+}
@@ -0,0 +1,32 @@
+package model
+
+// Part - сведения о детали, нужные для планирования сборки
+type Part struct {
+	UUID       string
+	Name       string
+	Category   Category
+	Dimensions Dimensions
+}
+
+type Category string
+
+const (
+	CategoryUnknown  Category = "UNKNOWN"
+	CategoryEngine   Category = "ENGINE"
+	CategoryFuel     Category = "FUEL"
+	CategoryPorthole Category = "PORTHOLE"
+	CategoryWing     Category = "WING"
+)
+
+// Dimensions - размеры детали в сантиметрах и вес в килограммах
+type Dimensions struct {
+	Length float64
+	Width  float64
+	Height float64
+	Weight float64
+}
+
+// VolumeLiters возвращает объем детали в литрах
+func (d Dimensions) VolumeLiters() float64 {
+	return d.Length * d.Width * d.Height / 1000
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// Stage - этап сборки корабля
+type Stage string
+
+const (
+	StageHull      Stage = "HULL"
+	StageEngines   Stage = "ENGINES"
+	StageWings     Stage = "WINGS"
+	StagePortholes Stage = "PORTHOLES"
+	StageFuel      Stage = "FUEL"
+)
+
+// BuildStage - этап плана сборки и его длительность
+type BuildStage struct {
+	Stage    Stage
+	Duration time.Duration
+}
+
+// BuildPlan - этапы сборки корабля в порядке выполнения
+type BuildPlan struct {
+	Stages []BuildStage
+}
+
+// Duration возвращает общую длительность сборки
+func (p BuildPlan) Duration() time.Duration {
+	var total time.Duration
+	for _, stage := range p.Stages {
+		total += stage.Duration
+	}
+	return total
+}
@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	repoModel "github.com/space-wanderer/microservices/assembly/internal/repository/model"
+)
+
+// ConvertModelJobToRepoJob конвертирует Job из service model в repository model
+func ConvertModelJobToRepoJob(job *model.Job) *repoModel.Job {
+	if job == nil {
+		return nil
+	}
+
+	plan := make([]repoModel.Stage, 0, len(job.Plan.Stages))
+	for _, stage := range job.Plan.Stages {
+		plan = append(plan, repoModel.Stage{
+			Stage:      string(stage.Stage),
+			DurationMs: stage.Duration.Milliseconds(),
+		})
+	}
+
+	return &repoModel.Job{
+		OrderUUID:       job.OrderUUID,
+		UserUUID:        job.UserUUID,
+		Status:          string(job.Status),
+		Plan:            plan,
+		FailAt:          job.FailAt,
+		CompletedStages: job.CompletedStages,
+		ElapsedMs:       job.Elapsed.Milliseconds(),
+		FailedStage:     optionalString(string(job.FailedStage)),
+		FailureReason:   optionalString(job.FailureReason),
+		CreatedAt:       job.CreatedAt,
+		UpdatedAt:       job.UpdatedAt,
+		FinishedAt:      job.FinishedAt,
+
+		OutcomePublishedAt: job.OutcomePublishedAt,
+	}
+}
+
+// ConvertRepoJobToModelJob конвертирует Job из repository model в service model
+func ConvertRepoJobToModelJob(job *repoModel.Job) *model.Job {
+	if job == nil {
+		return nil
+	}
+
+	stages := make([]model.BuildStage, 0, len(job.Plan))
+	for _, stage := range job.Plan {
+		stages = append(stages, model.BuildStage{
+			Stage:    model.Stage(stage.Stage),
+			Duration: time.Duration(stage.DurationMs) * time.Millisecond,
+		})
+	}
+
+	result := &model.Job{
+		OrderUUID:       job.OrderUUID,
+		UserUUID:        job.UserUUID,
+		Status:          model.JobStatus(job.Status),
+		Plan:            model.BuildPlan{Stages: stages},
+		FailAt:          job.FailAt,
+		CompletedStages: job.CompletedStages,
+		Elapsed:         time.Duration(job.ElapsedMs) * time.Millisecond,
+		CreatedAt:       job.CreatedAt,
+		UpdatedAt:       job.UpdatedAt,
+		FinishedAt:      job.FinishedAt,
+
+		OutcomePublishedAt: job.OutcomePublishedAt,
+	}
+	if job.FailedStage != nil {
+		result.FailedStage = model.Stage(*job.FailedStage)
+	}
+	if job.FailureReason != nil {
+		result.FailureReason = *job.FailureReason
+	}
+
+	return result
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
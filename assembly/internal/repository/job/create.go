@@ -0,0 +1,37 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	"github.com/space-wanderer/microservices/assembly/internal/repository/converter"
+)
+
+// CreateJob сохраняет новое задание. Первичный ключ по заказу гарантирует одно задание на заказ
+func (r *repository) CreateJob(ctx context.Context, job *model.Job) error {
+	repoJob := converter.ConvertModelJobToRepoJob(job)
+
+	plan, err := json.Marshal(repoJob.Plan)
+	if err != nil {
+		return fmt.Errorf("failed to encode assembly plan: %w", err)
+	}
+
+	tag, err := r.db.Exec(ctx, `
+		INSERT INTO assembly_jobs (order_uuid, user_uuid, status, plan, fail_at, completed_stages, elapsed_ms,
+			failed_stage, failure_reason, created_at, updated_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (order_uuid) DO NOTHING
+	`, repoJob.OrderUUID, repoJob.UserUUID, repoJob.Status, plan, repoJob.FailAt, repoJob.CompletedStages, repoJob.ElapsedMs,
+		repoJob.FailedStage, repoJob.FailureReason, repoJob.CreatedAt, repoJob.UpdatedAt, repoJob.FinishedAt)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrJobAlreadyExists
+	}
+
+	return nil
+}
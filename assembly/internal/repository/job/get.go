@@ -0,0 +1,75 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	"github.com/space-wanderer/microservices/assembly/internal/repository/converter"
+	repoModel "github.com/space-wanderer/microservices/assembly/internal/repository/model"
+)
+
+const jobColumns = `order_uuid, user_uuid, status, plan, fail_at, completed_stages, elapsed_ms,
+	failed_stage, failure_reason, created_at, updated_at, finished_at, outcome_published_at`
+
+func (r *repository) GetJob(ctx context.Context, orderUUID string) (*model.Job, error) {
+	job, err := scanJob(r.db.QueryRow(ctx, `SELECT `+jobColumns+` FROM assembly_jobs WHERE order_uuid = $1`, orderUUID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrJobNotFound
+		}
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func scanJob(row pgx.Row) (*model.Job, error) {
+	var (
+		job  repoModel.Job
+		plan []byte
+	)
+	err := row.Scan(
+		&job.OrderUUID,
+		&job.UserUUID,
+		&job.Status,
+		&plan,
+		&job.FailAt,
+		&job.CompletedStages,
+		&job.ElapsedMs,
+		&job.FailedStage,
+		&job.FailureReason,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&job.FinishedAt,
+		&job.OutcomePublishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(plan, &job.Plan); err != nil {
+		return nil, fmt.Errorf("failed to decode assembly plan: %w", err)
+	}
+
+	return converter.ConvertRepoJobToModelJob(&job), nil
+}
+
+func scanJobs(rows pgx.Rows) ([]*model.Job, error) {
+	defer rows.Close()
+
+	var jobs []*model.Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, rows.Err()
+}
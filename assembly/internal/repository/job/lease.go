@@ -0,0 +1,51 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+// ClaimJob захватывает аренду, если она свободна, истекла или уже принадлежит owner.
+// Проверка и захват выполняются одним UPDATE, поэтому два экземпляра не получат одно задание
+func (r *repository) ClaimJob(ctx context.Context, orderUUID, owner string, ttl time.Duration) (bool, error) {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE assembly_jobs
+		SET lease_owner = $2, lease_expires_at = NOW() + $3::bigint * INTERVAL '1 millisecond', updated_at = NOW()
+		WHERE order_uuid = $1 AND status = $4
+			AND (lease_owner IS NULL OR lease_owner = $2 OR lease_expires_at < NOW())
+	`, orderUUID, owner, ttl.Milliseconds(), model.JobStatusInProgress)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *repository) RenewLease(ctx context.Context, orderUUID, owner string, ttl time.Duration) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE assembly_jobs
+		SET lease_expires_at = NOW() + $3::bigint * INTERVAL '1 millisecond'
+		WHERE order_uuid = $1 AND lease_owner = $2 AND status = $4
+	`, orderUUID, owner, ttl.Milliseconds(), model.JobStatusInProgress)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrJobLeaseLost
+	}
+
+	return nil
+}
+
+func (r *repository) ReleaseJob(ctx context.Context, orderUUID, owner string) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE assembly_jobs
+		SET lease_owner = NULL, lease_expires_at = NULL
+		WHERE order_uuid = $1 AND lease_owner = $2
+	`, orderUUID, owner)
+
+	return err
+}
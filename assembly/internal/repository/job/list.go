@@ -0,0 +1,86 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+// ListJobs возвращает задания от новых к старым. Курсор — ключ сортировки последнего задания
+// предыдущей страницы, поэтому выдача не сдвигается при появлении новых заданий
+func (r *repository) ListJobs(ctx context.Context, filter model.JobsFilter, after *model.JobsCursor, limit int) ([]*model.Job, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		conditions = append(conditions, "status = ANY("+arg(statuses)+")")
+	}
+
+	if filter.UserUUID != "" {
+		conditions = append(conditions, "user_uuid = "+arg(filter.UserUUID))
+	}
+
+	if after != nil {
+		conditions = append(conditions, "(created_at, order_uuid) < ("+arg(after.CreatedAt)+", "+arg(after.OrderUUID)+")")
+	}
+
+	query := `SELECT ` + jobColumns + ` FROM assembly_jobs`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY created_at DESC, order_uuid DESC LIMIT ` + arg(limit)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(rows)
+}
+
+// ListOrphanedJobs возвращает незавершенные задания, аренда которых истекла или освобождена:
+// их экземпляр остановился, не доведя сборку до конца
+func (r *repository) ListOrphanedJobs(ctx context.Context, limit int) ([]*model.Job, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+jobColumns+`
+		FROM assembly_jobs
+		WHERE status = $1 AND (lease_expires_at IS NULL OR lease_expires_at < NOW())
+		ORDER BY created_at
+		LIMIT $2
+	`, model.JobStatusInProgress, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(rows)
+}
+
+// ListUnpublishedJobs возвращает завершенные задания, итоговое событие которых не удалось отправить
+// после сохранения итога. Недавно завершенные задания пропускаются: их итог, скорее всего, еще отправляется
+func (r *repository) ListUnpublishedJobs(ctx context.Context, finishedBefore time.Time, limit int) ([]*model.Job, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+jobColumns+`
+		FROM assembly_jobs
+		WHERE finished_at IS NOT NULL AND outcome_published_at IS NULL AND finished_at < $1
+		ORDER BY finished_at
+		LIMIT $2
+	`, finishedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(rows)
+}
@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	"github.com/space-wanderer/microservices/assembly/internal/repository/converter"
+)
+
+// CompleteStage сохраняет прогресс сборки, если аренда все еще принадлежит owner
+func (r *repository) CompleteStage(ctx context.Context, orderUUID, owner string, completedStages int, elapsed time.Duration) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE assembly_jobs
+		SET completed_stages = $3, elapsed_ms = $4, updated_at = NOW()
+		WHERE order_uuid = $1 AND lease_owner = $2 AND status = $5
+	`, orderUUID, owner, completedStages, elapsed.Milliseconds(), model.JobStatusInProgress)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrJobLeaseLost
+	}
+
+	return nil
+}
+
+// FinishJob сохраняет итог сборки, если аренда все еще принадлежит owner, и снимает ее
+func (r *repository) FinishJob(ctx context.Context, job *model.Job, owner string) error {
+	repoJob := converter.ConvertModelJobToRepoJob(job)
+
+	tag, err := r.db.Exec(ctx, `
+		UPDATE assembly_jobs
+		SET status = $3, completed_stages = $4, elapsed_ms = $5, failed_stage = $6, failure_reason = $7,
+			updated_at = $8, finished_at = $9, lease_owner = NULL, lease_expires_at = NULL
+		WHERE order_uuid = $1 AND lease_owner = $2 AND status = $10
+	`, repoJob.OrderUUID, owner, repoJob.Status, repoJob.CompletedStages, repoJob.ElapsedMs, repoJob.FailedStage,
+		repoJob.FailureReason, repoJob.UpdatedAt, repoJob.FinishedAt, model.JobStatusInProgress)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrJobLeaseLost
+	}
+
+	return nil
+}
+
+// MarkOutcomePublished отмечает, что итоговое событие сборки отправлено
+func (r *repository) MarkOutcomePublished(ctx context.Context, orderUUID string) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE assembly_jobs
+		SET outcome_published_at = NOW()
+		WHERE order_uuid = $1 AND finished_at IS NOT NULL AND outcome_published_at IS NULL
+	`, orderUUID)
+
+	return err
+}
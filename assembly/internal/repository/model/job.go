@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type Job struct {
+	OrderUUID       string
+	UserUUID        string
+	Status          string
+	Plan            []Stage
+	FailAt          int
+	CompletedStages int
+	ElapsedMs       int64
+	FailedStage     *string
+	FailureReason   *string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	FinishedAt      *time.Time
+
+	OutcomePublishedAt *time.Time
+}
+
+// Stage - этап плана сборки в колонке plan
+type Stage struct {
+	Stage      string `json:"stage"`
+	DurationMs int64  `json:"duration_ms"`
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+// JobRepository хранит задания на сборку. Сборку ведет экземпляр сервиса, захвативший аренду задания:
+// аренда продлевается, пока сборка идет, и освобождается при остановке, чтобы задание подхватил другой экземпляр
+type JobRepository interface {
+	// CreateJob сохраняет новое задание; если у заказа уже есть задание, возвращает model.ErrJobAlreadyExists
+	CreateJob(ctx context.Context, job *model.Job) error
+	GetJob(ctx context.Context, orderUUID string) (*model.Job, error)
+	// ListJobs возвращает до limit заданий от новых к старым, начиная после курсора after
+	ListJobs(ctx context.Context, filter model.JobsFilter, after *model.JobsCursor, limit int) ([]*model.Job, error)
+	// ListOrphanedJobs возвращает до limit незавершенных заданий без действующей аренды
+	ListOrphanedJobs(ctx context.Context, limit int) ([]*model.Job, error)
+	// ListUnpublishedJobs возвращает до limit заданий, завершенных раньше finishedBefore, итог которых не отправлен
+	ListUnpublishedJobs(ctx context.Context, finishedBefore time.Time, limit int) ([]*model.Job, error)
+
+	// ClaimJob захватывает аренду незавершенного задания для owner на ttl.
+	// Возвращает false, если задание завершено или его ведет другой экземпляр
+	ClaimJob(ctx context.Context, orderUUID, owner string, ttl time.Duration) (bool, error)
+	// RenewLease продлевает аренду; если она перешла к другому экземпляру, возвращает model.ErrJobLeaseLost
+	RenewLease(ctx context.Context, orderUUID, owner string, ttl time.Duration) error
+	// ReleaseJob освобождает аренду незавершенного задания, чтобы его сразу мог подхватить другой экземпляр
+	ReleaseJob(ctx context.Context, orderUUID, owner string) error
+
+	// CompleteStage сохраняет число завершенных этапов и затраченное время
+	CompleteStage(ctx context.Context, orderUUID, owner string, completedStages int, elapsed time.Duration) error
+	// FinishJob сохраняет итог сборки и снимает аренду
+	FinishJob(ctx context.Context, job *model.Job, owner string) error
+	// MarkOutcomePublished отмечает, что итоговое событие сборки отправлено
+	MarkOutcomePublished(ctx context.Context, orderUUID string) error
+}
@@ -0,0 +1,135 @@
+package assembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// Assemble собирает корабль по оплаченному заказу. При первой доставке события создается задание
+// с планом по деталям из inventory; повторная доставка продолжает незавершенное задание и не трогает завершенное.
+// Ошибка возвращается только для сбоев, которые имеет смысл повторить: недоступность inventory, базы или Kafka
+func (s *service) Assemble(ctx context.Context, event model.OrderPaidEvent) error {
+	job, err := s.jobRepository.GetJob(ctx, event.OrderUUID)
+	if errors.Is(err, model.ErrJobNotFound) {
+		job, err = s.createJob(ctx, event)
+	} else if err == nil && job.OutcomePending() {
+		// Итог сборки сохраняется до отправки события, поэтому при прошлой доставке
+		// событие могло не уйти: повторная доставка отправляет его еще раз
+		err = s.publishOutcome(ctx, job)
+	}
+	if err != nil {
+		logger.Error(ctx, "❌ Не удалось получить задание на сборку", zap.String("order_uuid", event.OrderUUID), zap.Error(err))
+		return err
+	}
+
+	if job.Finished() {
+		logger.Info(ctx, "Сборка по заказу уже завершена",
+			zap.String("order_uuid", job.OrderUUID),
+			zap.String("status", string(job.Status)),
+		)
+		return nil
+	}
+
+	return s.run(ctx, job)
+}
+
+// createJob рассчитывает план сборки и сохраняет задание. Если детали заказа не найдены,
+// сборка не начинается: сохраняется завершенное задание, и затем публикуется ShipAssemblyFailed.
+// Событие отправляет только экземпляр, создавший задание
+func (s *service) createJob(ctx context.Context, event model.OrderPaidEvent) (*model.Job, error) {
+	parts, err := s.orderParts(ctx, event.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	job := &model.Job{
+		OrderUUID: event.OrderUUID,
+		UserUUID:  event.UserUUID,
+		Status:    model.JobStatusInProgress,
+		FailAt:    -1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if missing := missingParts(event.Items, parts); len(missing) > 0 {
+		job.Status = model.JobStatusFailed
+		job.FailureReason = "parts not found in inventory: " + strings.Join(missing, ", ")
+		job.FinishedAt = &now
+	} else {
+		job.Plan = s.buildPlan(event.Items, parts)
+
+		// Этап имитируемого сбоя выбирается заранее и сохраняется, чтобы продолжение после перезапуска его не меняло
+		if s.failureRate > 0 && rand.Float64() < s.failureRate {
+			job.FailAt = rand.IntN(len(job.Plan.Stages))
+		}
+	}
+
+	err = s.jobRepository.CreateJob(ctx, job)
+	if errors.Is(err, model.ErrJobAlreadyExists) {
+		// Задание успел создать другой экземпляр, обработавший то же событие
+		return s.jobRepository.GetJob(ctx, event.OrderUUID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "📝 Задание на сборку создано",
+		zap.String("order_uuid", job.OrderUUID),
+		zap.String("status", string(job.Status)),
+		zap.Int("stages", len(job.Plan.Stages)),
+		zap.Duration("planned_duration", job.Plan.Duration()),
+	)
+
+	if job.RejectedBeforeStart() {
+		recordOutcome(ctx, job)
+		if err := s.publishOutcome(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+
+	return job, nil
+}
+
+// orderParts загружает из inventory детали позиций заказа, индексируя их по UUID
+func (s *service) orderParts(ctx context.Context, items []model.OrderItem) (map[string]model.Part, error) {
+	parts := make(map[string]model.Part, len(items))
+	if len(items) == 0 {
+		return parts, nil
+	}
+
+	uuids := make([]string, 0, len(items))
+	for _, item := range items {
+		uuids = append(uuids, item.PartUUID)
+	}
+
+	list, err := s.inventoryClient.ListParts(ctx, uuids)
+	if err != nil {
+		return nil, fmt.Errorf("list parts: %w", err)
+	}
+
+	for _, part := range list {
+		parts[part.UUID] = part
+	}
+
+	return parts, nil
+}
+
+func missingParts(items []model.OrderItem, parts map[string]model.Part) []string {
+	var missing []string
+	for _, item := range items {
+		if _, ok := parts[item.PartUUID]; !ok {
+			missing = append(missing, item.PartUUID)
+		}
+	}
+	return missing
+}
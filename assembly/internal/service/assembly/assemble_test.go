@@ -0,0 +1,142 @@
+package assembly
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+func missingPartsEvent() model.OrderPaidEvent {
+	return model.OrderPaidEvent{
+		OrderUUID: testOrderUUID,
+		UserUUID:  testUserUUID,
+		Items:     []model.OrderItem{{PartUUID: testPartUUID, Quantity: 1}},
+	}
+}
+
+func rejectedJob() *model.Job {
+	return &model.Job{
+		OrderUUID:     testOrderUUID,
+		UserUUID:      testUserUUID,
+		Status:        model.JobStatusFailed,
+		FailAt:        -1,
+		FailureReason: "parts not found in inventory: " + testPartUUID,
+	}
+}
+
+func matchFailedEvent() interface{} {
+	return mock.MatchedBy(func(event model.ShipAssemblyFailedEvent) bool {
+		return event.EventUUID == jobEventUUID(testOrderUUID, "failed") &&
+			event.OrderUUID == testOrderUUID && event.Stage == "" && event.Reason == "parts not found in inventory: "+testPartUUID
+	})
+}
+
+func (s *ServiceSuite) TestAssemble_MissingPartsSavesJobBeforePublishing() {
+	ctx := context.Background()
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(nil, model.ErrJobNotFound).Once()
+	s.inventoryClient.EXPECT().ListParts(ctx, []string{testPartUUID}).Return(nil, nil).Once()
+	create := s.jobRepository.EXPECT().CreateJob(ctx, mock.MatchedBy(func(job *model.Job) bool {
+		return job.RejectedBeforeStart() && job.FinishedAt != nil
+	})).Return(nil).Once()
+	publish := s.producerService.EXPECT().ProduceShipAssemblyFailedEvent(ctx, matchFailedEvent()).Return(nil).Once()
+	mark := s.jobRepository.EXPECT().MarkOutcomePublished(ctx, testOrderUUID).Return(nil).Once()
+	mock.InOrder(create, publish, mark)
+
+	err := s.service.Assemble(ctx, missingPartsEvent())
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestAssemble_MissingPartsCreateJobError() {
+	ctx := context.Background()
+	dbErr := errors.New("database unavailable")
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(nil, model.ErrJobNotFound).Once()
+	s.inventoryClient.EXPECT().ListParts(ctx, []string{testPartUUID}).Return(nil, nil).Once()
+	s.jobRepository.EXPECT().CreateJob(ctx, mock.Anything).Return(dbErr).Once()
+
+	err := s.service.Assemble(ctx, missingPartsEvent())
+
+	// Событие не отправляется, пока задание не сохранено: повторная доставка создаст его заново
+	s.ErrorIs(err, dbErr)
+	s.producerService.AssertNotCalled(s.T(), "ProduceShipAssemblyFailedEvent", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAssemble_MissingPartsJobCreatedByAnotherInstance() {
+	ctx := context.Background()
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(nil, model.ErrJobNotFound).Once()
+	s.inventoryClient.EXPECT().ListParts(ctx, []string{testPartUUID}).Return(nil, nil).Once()
+	s.jobRepository.EXPECT().CreateJob(ctx, mock.Anything).Return(model.ErrJobAlreadyExists).Once()
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(rejectedJob(), nil).Once()
+
+	err := s.service.Assemble(ctx, missingPartsEvent())
+
+	// Событие отправляет экземпляр, создавший задание
+	s.NoError(err)
+	s.producerService.AssertNotCalled(s.T(), "ProduceShipAssemblyFailedEvent", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAssemble_MissingPartsPublishError() {
+	ctx := context.Background()
+	kafkaErr := errors.New("kafka unavailable")
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(nil, model.ErrJobNotFound).Once()
+	s.inventoryClient.EXPECT().ListParts(ctx, []string{testPartUUID}).Return(nil, nil).Once()
+	s.jobRepository.EXPECT().CreateJob(ctx, mock.Anything).Return(nil).Once()
+	s.producerService.EXPECT().ProduceShipAssemblyFailedEvent(ctx, matchFailedEvent()).Return(kafkaErr).Once()
+
+	err := s.service.Assemble(ctx, missingPartsEvent())
+
+	s.ErrorIs(err, kafkaErr)
+	s.jobRepository.AssertNotCalled(s.T(), "MarkOutcomePublished", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAssemble_RedeliveryRepublishesRejection() {
+	ctx := context.Background()
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(rejectedJob(), nil).Once()
+	s.producerService.EXPECT().ProduceShipAssemblyFailedEvent(ctx, matchFailedEvent()).Return(nil).Once()
+	s.jobRepository.EXPECT().MarkOutcomePublished(ctx, testOrderUUID).Return(nil).Once()
+
+	err := s.service.Assemble(ctx, missingPartsEvent())
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestAssemble_RedeliveryRepublishesAssembled() {
+	ctx := context.Background()
+	job := &model.Job{OrderUUID: testOrderUUID, UserUUID: testUserUUID, Status: model.JobStatusCompleted, Elapsed: 3 * time.Second}
+
+	// Прошлая доставка сохранила итог, но не успела отправить событие: оно уходит с тем же EventUUID
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(job, nil).Once()
+	s.producerService.EXPECT().ProduceShipAssembledEvent(ctx, model.ShipAssembledEvent{
+		EventUUID:    jobEventUUID(testOrderUUID, "assembled"),
+		OrderUUID:    testOrderUUID,
+		UserUUID:     testUserUUID,
+		BuildTimeSec: 3,
+	}).Return(nil).Once()
+	s.jobRepository.EXPECT().MarkOutcomePublished(ctx, testOrderUUID).Return(nil).Once()
+
+	err := s.service.Assemble(ctx, missingPartsEvent())
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestAssemble_FinishedJobIsSkipped() {
+	ctx := context.Background()
+	publishedAt := time.Now()
+	job := &model.Job{OrderUUID: testOrderUUID, UserUUID: testUserUUID, Status: model.JobStatusCompleted, OutcomePublishedAt: &publishedAt}
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(job, nil).Once()
+
+	err := s.service.Assemble(ctx, missingPartsEvent())
+
+	s.NoError(err)
+	s.producerService.AssertNotCalled(s.T(), "ProduceShipAssembledEvent", mock.Anything, mock.Anything)
+}
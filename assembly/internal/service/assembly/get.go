@@ -0,0 +1,17 @@
+package assembly
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+func (s *service) GetAssembly(ctx context.Context, orderUUID string) (*model.Job, error) {
+	if _, err := uuid.Parse(orderUUID); err != nil {
+		return nil, model.ErrInvalidOrderUUID
+	}
+
+	return s.jobRepository.GetJob(ctx, orderUUID)
+}
@@ -0,0 +1,80 @@
+package assembly
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// ListAssemblies возвращает страницу заданий от новых к старым и токен следующей страницы
+func (s *service) ListAssemblies(ctx context.Context, filter model.JobsFilter, page model.JobsPageRequest) ([]*model.Job, string, error) {
+	if err := validateFilter(filter); err != nil {
+		return nil, "", err
+	}
+
+	size, err := pageSize(page.Size)
+	if err != nil {
+		return nil, "", err
+	}
+
+	after, err := decodePageToken(filter, page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Лишнее задание показывает, есть ли следующая страница
+	jobs, err := s.jobRepository.ListJobs(ctx, filter, after, size+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(jobs) <= size {
+		return jobs, "", nil
+	}
+
+	jobs = jobs[:size]
+	nextPageToken, err := encodePageToken(filter, jobs[len(jobs)-1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return jobs, nextPageToken, nil
+}
+
+func validateFilter(filter model.JobsFilter) error {
+	for _, status := range filter.Statuses {
+		switch status {
+		case model.JobStatusInProgress, model.JobStatusCompleted, model.JobStatusFailed:
+		default:
+			return model.ErrInvalidStatus
+		}
+	}
+
+	if filter.UserUUID != "" {
+		if _, err := uuid.Parse(filter.UserUUID); err != nil {
+			return model.ErrInvalidUserUUID
+		}
+	}
+
+	return nil
+}
+
+func pageSize(size int32) (int, error) {
+	switch {
+	case size < 0:
+		return 0, model.ErrInvalidPageSize
+	case size == 0:
+		return defaultPageSize, nil
+	case size > maxPageSize:
+		return maxPageSize, nil
+	default:
+		return int(size), nil
+	}
+}
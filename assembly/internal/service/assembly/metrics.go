@@ -0,0 +1,52 @@
+package assembly
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	shipsAssembled = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "assembly",
+		Subsystem: "ships",
+		Name:      "assembled_total",
+		Help:      "Количество собранных кораблей",
+	})
+
+	shipsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "assembly",
+		Subsystem: "ships",
+		Name:      "failed_total",
+		Help:      "Количество неудачных сборок по этапам",
+	}, []string{"stage"})
+
+	assemblyDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "assembly",
+		Subsystem: "ships",
+		Name:      "assembly_duration_seconds",
+		Help:      "Длительность сборки корабля",
+		Buckets:   prometheus.LinearBuckets(5, 5, 12),
+	})
+
+	jobsResumed = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "assembly",
+		Subsystem: "jobs",
+		Name:      "resumed_total",
+		Help:      "Количество подхваченных брошенных заданий на сборку",
+	})
+
+	outcomesRepublished = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "assembly",
+		Subsystem: "jobs",
+		Name:      "outcomes_republished_total",
+		Help:      "Количество итогов сборки, отправленных повторно после сбоя отправки",
+	})
+
+	stageDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "assembly",
+		Subsystem: "stages",
+		Name:      "duration_seconds",
+		Help:      "Длительность этапа сборки",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 8),
+	}, []string{"stage"})
+)
@@ -0,0 +1,48 @@
+package assembly
+
+import (
+	"errors"
+	"time"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/pagetoken"
+)
+
+// pageCursor - ключ последнего задания страницы, сохраняемый в токене.
+// Токен выдается для фильтра, и продолжение с другим фильтром отклоняется
+type pageCursor struct {
+	OrderUUID string `json:"u"`
+	CreatedAt int64  `json:"c"`
+}
+
+// encodePageToken строит токен страницы, следующей за заданием last
+func encodePageToken(filter model.JobsFilter, last *model.Job) (string, error) {
+	return pagetoken.Encode(filter, pageCursor{
+		OrderUUID: last.OrderUUID,
+		CreatedAt: last.CreatedAt.UnixMicro(),
+	})
+}
+
+// decodePageToken разбирает токен продолжения; пустой токен — первая страница
+func decodePageToken(filter model.JobsFilter, raw string) (*model.JobsCursor, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var cursor pageCursor
+	err := pagetoken.Decode(raw, filter, &cursor)
+	if errors.Is(err, pagetoken.ErrInvalidToken) {
+		return nil, model.ErrInvalidPageToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cursor.OrderUUID == "" {
+		return nil, model.ErrInvalidPageToken
+	}
+
+	return &model.JobsCursor{
+		CreatedAt: time.UnixMicro(cursor.CreatedAt).UTC(),
+		OrderUUID: cursor.OrderUUID,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package assembly
+
+import (
+	"time"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+// Базовые длительности этапов; к ним добавляется время, зависящее от размеров деталей
+const (
+	hullBaseDuration     = 2 * time.Second
+	engineBaseDuration   = 1500 * time.Millisecond
+	wingBaseDuration     = time.Second
+	portholeBaseDuration = 500 * time.Millisecond
+	fuelBaseDuration     = 500 * time.Millisecond
+)
+
+// stageOrder - порядок этапов: корпус собирается первым, топливо заливается последним
+var stageOrder = []model.Stage{
+	model.StageHull,
+	model.StageEngines,
+	model.StageWings,
+	model.StagePortholes,
+	model.StageFuel,
+}
+
+// buildPlan рассчитывает план сборки по позициям заказа и характеристикам деталей.
+// Корпус есть в любом плане, остальные этапы — только при наличии деталей их категории
+func (s *service) buildPlan(items []model.OrderItem, parts map[string]model.Part) model.BuildPlan {
+	durations := make(map[model.Stage]time.Duration, len(stageOrder))
+
+	var totalWeight float64
+	for _, item := range items {
+		part := parts[item.PartUUID]
+		quantity := time.Duration(item.Quantity)
+		totalWeight += part.Dimensions.Weight * float64(item.Quantity)
+
+		switch part.Category {
+		case model.CategoryEngine:
+			// Двигатель монтируется тем дольше, чем он тяжелее: +1 с на каждые 200 кг
+			durations[model.StageEngines] += quantity * (engineBaseDuration + seconds(part.Dimensions.Weight/200))
+		case model.CategoryWing:
+			// Крыло: +1 с на каждые 250 см длины
+			durations[model.StageWings] += quantity * (wingBaseDuration + seconds(part.Dimensions.Length/250))
+		case model.CategoryPorthole:
+			// Иллюминатор: +1 с на каждый квадратный метр
+			durations[model.StagePortholes] += quantity * (portholeBaseDuration + seconds(part.Dimensions.Width*part.Dimensions.Height/10000))
+		case model.CategoryFuel:
+			// Бак: +1 с на каждые 200 литров объема
+			durations[model.StageFuel] += quantity * (fuelBaseDuration + seconds(part.Dimensions.VolumeLiters()/200))
+		}
+	}
+
+	// Корпус: +0.5 с на каждые 100 кг общего веса корабля
+	durations[model.StageHull] = hullBaseDuration + seconds(totalWeight/200)
+
+	plan := model.BuildPlan{Stages: make([]model.BuildStage, 0, len(stageOrder))}
+	for _, stage := range stageOrder {
+		duration, ok := durations[stage]
+		if !ok {
+			continue
+		}
+		plan.Stages = append(plan.Stages, model.BuildStage{
+			Stage:    stage,
+			Duration: time.Duration(float64(duration) * s.timeScale),
+		})
+	}
+
+	return plan
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
@@ -0,0 +1,93 @@
+package assembly
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// resumeBatchSize - сколько брошенных заданий подхватывается за один проход
+const resumeBatchSize = 100
+
+// RunResumer при старте и затем раз в resumeInterval подхватывает незавершенные задания,
+// которые никто не ведет: прерванные остановкой этого или другого экземпляра, и переотправляет
+// неотправленные итоги завершенных заданий.
+// Число одновременно продолжаемых сборок ограничено, как и у consumer-а OrderPaid.
+// После отмены контекста дожидается остановки всех сборок экземпляра
+func (s *service) RunResumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting assembly job resumer",
+		zap.String("instance_id", s.instanceID),
+		zap.Duration("lease_ttl", s.leaseTTL),
+		zap.Duration("resume_interval", s.resumeInterval))
+
+	ticker := time.NewTicker(s.resumeInterval)
+	defer ticker.Stop()
+
+	for {
+		s.resumeOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.waitJobs()
+			logger.Info(ctx, "🛑 Assembly job resumer stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) resumeOnce(ctx context.Context) {
+	s.republishOutcomes(ctx)
+
+	jobs, err := s.jobRepository.ListOrphanedJobs(ctx, resumeBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error(ctx, "❌ Failed to list orphaned assembly jobs", zap.Error(err))
+		}
+		return
+	}
+
+	for _, job := range jobs {
+		select {
+		case s.resumeSlots <- struct{}{}:
+		default:
+			// Все слоты заняты: оставшиеся задания подхватит следующий проход
+			return
+		}
+
+		jobsResumed.Inc()
+		go func() {
+			defer func() { <-s.resumeSlots }()
+
+			if err := s.run(ctx, job); err != nil && ctx.Err() == nil {
+				logger.Error(ctx, "❌ Failed to resume assembly job", zap.String("order_uuid", job.OrderUUID), zap.Error(err))
+			}
+		}()
+	}
+}
+
+// republishOutcomes отправляет итоги сборок, сохраненные без отправки события: экземпляр
+// остановился или Kafka была недоступна сразу после сохранения итога. Итог, сохраненный
+// меньше аренды назад, пропускается, пока его отправляет экземпляр, завершивший сборку
+func (s *service) republishOutcomes(ctx context.Context) {
+	jobs, err := s.jobRepository.ListUnpublishedJobs(ctx, time.Now().Add(-s.leaseTTL), resumeBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error(ctx, "❌ Failed to list assembly jobs with unpublished outcome", zap.Error(err))
+		}
+		return
+	}
+
+	for _, job := range jobs {
+		if err := s.publishOutcome(ctx, job); err != nil {
+			if ctx.Err() == nil {
+				logger.Error(ctx, "❌ Failed to republish assembly outcome", zap.String("order_uuid", job.OrderUUID), zap.Error(err))
+			}
+			return
+		}
+		outcomesRepublished.Inc()
+	}
+}
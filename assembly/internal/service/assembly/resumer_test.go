@@ -0,0 +1,88 @@
+package assembly
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+func (s *ServiceSuite) TestResumeOnce_BoundedByWorkers() {
+	ctx := context.Background()
+	s.service.resumeSlots = make(chan struct{}, 1)
+
+	first := inProgressJob(1)
+	second := inProgressJob(1)
+	second.OrderUUID = "550e8400-e29b-41d4-a716-446655440003"
+
+	claimed := make(chan struct{})
+	release := make(chan struct{})
+	s.jobRepository.EXPECT().ListUnpublishedJobs(ctx, mock.Anything, resumeBatchSize).Return(nil, nil).Once()
+	s.jobRepository.EXPECT().ListOrphanedJobs(ctx, resumeBatchSize).Return([]*model.Job{first, second}, nil).Once()
+	s.jobRepository.EXPECT().ClaimJob(ctx, first.OrderUUID, s.service.instanceID, s.service.leaseTTL).
+		Run(func(context.Context, string, string, time.Duration) {
+			close(claimed)
+			<-release
+		}).
+		Return(false, nil).Once()
+
+	s.service.resumeOnce(ctx)
+	<-claimed
+
+	// Единственный слот занят первым заданием, второе остается следующему проходу
+	s.Len(s.service.resumeSlots, 1)
+	s.jobRepository.AssertNotCalled(s.T(), "ClaimJob", ctx, second.OrderUUID, s.service.instanceID, s.service.leaseTTL)
+
+	close(release)
+	s.service.waitJobs()
+	s.Eventually(func() bool { return len(s.service.resumeSlots) == 0 }, time.Second, time.Millisecond)
+}
+
+func (s *ServiceSuite) TestResumeOnce_ListError() {
+	ctx := context.Background()
+
+	s.jobRepository.EXPECT().ListUnpublishedJobs(ctx, mock.Anything, resumeBatchSize).Return(nil, nil).Once()
+	s.jobRepository.EXPECT().ListOrphanedJobs(ctx, resumeBatchSize).Return(nil, context.DeadlineExceeded).Once()
+
+	s.service.resumeOnce(ctx)
+
+	s.Empty(s.service.resumeSlots)
+}
+
+func (s *ServiceSuite) TestResumeOnce_RepublishesOutcomes() {
+	ctx := context.Background()
+	failed := rejectedJob()
+	assembled := &model.Job{OrderUUID: "550e8400-e29b-41d4-a716-446655440003", UserUUID: testUserUUID, Status: model.JobStatusCompleted}
+
+	// Итоги, сохраненные меньше аренды назад, еще отправляет экземпляр, завершивший сборку
+	s.jobRepository.EXPECT().ListUnpublishedJobs(ctx, mock.MatchedBy(func(finishedBefore time.Time) bool {
+		return finishedBefore.Before(time.Now().Add(-s.service.leaseTTL + time.Second))
+	}), resumeBatchSize).Return([]*model.Job{failed, assembled}, nil).Once()
+	s.producerService.EXPECT().ProduceShipAssemblyFailedEvent(ctx, matchFailedEvent()).Return(nil).Once()
+	s.jobRepository.EXPECT().MarkOutcomePublished(ctx, failed.OrderUUID).Return(nil).Once()
+	s.producerService.EXPECT().ProduceShipAssembledEvent(ctx, mock.MatchedBy(func(event model.ShipAssembledEvent) bool {
+		return event.EventUUID == jobEventUUID(assembled.OrderUUID, "assembled")
+	})).Return(nil).Once()
+	s.jobRepository.EXPECT().MarkOutcomePublished(ctx, assembled.OrderUUID).Return(nil).Once()
+	s.jobRepository.EXPECT().ListOrphanedJobs(ctx, resumeBatchSize).Return(nil, nil).Once()
+
+	s.service.resumeOnce(ctx)
+}
+
+func (s *ServiceSuite) TestResumeOnce_RepublishErrorStopsPass() {
+	ctx := context.Background()
+	second := rejectedJob()
+	second.OrderUUID = "550e8400-e29b-41d4-a716-446655440003"
+
+	s.jobRepository.EXPECT().ListUnpublishedJobs(ctx, mock.Anything, resumeBatchSize).Return([]*model.Job{rejectedJob(), second}, nil).Once()
+	s.producerService.EXPECT().ProduceShipAssemblyFailedEvent(ctx, mock.Anything).Return(errors.New("kafka unavailable")).Once()
+	s.jobRepository.EXPECT().ListOrphanedJobs(ctx, resumeBatchSize).Return(nil, nil).Once()
+
+	s.service.resumeOnce(ctx)
+
+	// Остальные итоги переотправит следующий проход, брошенные задания подхватываются как обычно
+	s.jobRepository.AssertNotCalled(s.T(), "MarkOutcomePublished", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,265 @@
+package assembly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// run ведет сборку с первого незавершенного этапа, пока этот экземпляр держит аренду задания.
+// При остановке сервиса аренда освобождается, и задание продолжает следующий запуск или другой экземпляр
+func (s *service) run(ctx context.Context, job *model.Job) error {
+	if !s.track(job.OrderUUID) {
+		logger.Info(ctx, "Сборка по заказу уже идет", zap.String("order_uuid", job.OrderUUID))
+		return nil
+	}
+	defer s.untrack(job.OrderUUID)
+
+	claimed, err := s.jobRepository.ClaimJob(ctx, job.OrderUUID, s.instanceID, s.leaseTTL)
+	if err != nil {
+		logger.Error(ctx, "❌ Не удалось захватить задание на сборку", zap.String("order_uuid", job.OrderUUID), zap.Error(err))
+		return err
+	}
+	if !claimed {
+		logger.Info(ctx, "Сборку по заказу ведет другой экземпляр", zap.String("order_uuid", job.OrderUUID))
+		return nil
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go s.keepLease(runCtx, cancel, job.OrderUUID)
+
+	defer func() {
+		// Контекст запроса к этому моменту может быть отменен остановкой сервиса
+		if err := s.jobRepository.ReleaseJob(context.WithoutCancel(ctx), job.OrderUUID, s.instanceID); err != nil {
+			logger.Error(ctx, "❌ Не удалось освободить задание на сборку", zap.String("order_uuid", job.OrderUUID), zap.Error(err))
+		}
+	}()
+
+	err = s.runStages(runCtx, job)
+	if ctx.Err() == nil && (errors.Is(err, model.ErrJobLeaseLost) || runCtx.Err() != nil) {
+		// Аренду перехватил другой экземпляр: сборку продолжит он
+		logger.Warn(ctx, "Аренда задания на сборку потеряна", zap.String("order_uuid", job.OrderUUID))
+		return nil
+	}
+
+	return err
+}
+
+// keepLease продлевает аренду задания, пока идет сборка, и отменяет сборку, если аренда потеряна
+func (s *service) keepLease(ctx context.Context, cancel context.CancelFunc, orderUUID string) {
+	ticker := time.NewTicker(s.leaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := s.jobRepository.RenewLease(ctx, orderUUID, s.instanceID, s.leaseTTL)
+		if errors.Is(err, model.ErrJobLeaseLost) {
+			cancel()
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			// Аренда еще действует: попробуем продлить ее на следующем тике
+			logger.Error(ctx, "❌ Не удалось продлить аренду задания на сборку", zap.String("order_uuid", orderUUID), zap.Error(err))
+		}
+	}
+}
+
+func (s *service) runStages(ctx context.Context, job *model.Job) error {
+	if job.CompletedStages > 0 {
+		logger.Info(ctx, "🔁 Продолжаем сборку корабля",
+			zap.String("order_uuid", job.OrderUUID),
+			zap.Int("completed_stages", job.CompletedStages),
+			zap.Int("stages", len(job.Plan.Stages)),
+		)
+	} else {
+		logger.Info(ctx, "🚀 Начинаем сборку корабля",
+			zap.String("order_uuid", job.OrderUUID),
+			zap.Int("stages", len(job.Plan.Stages)),
+			zap.Duration("planned_duration", job.Plan.Duration()),
+		)
+	}
+
+	for i := job.CompletedStages; i < len(job.Plan.Stages); i++ {
+		stage := job.Plan.Stages[i]
+		startedAt := time.Now()
+
+		if i == job.FailAt {
+			// Сбой случается в середине этапа
+			if err := wait(ctx, stage.Duration/2); err != nil {
+				logger.Error(ctx, "❌ Сборка корабля прервана", zap.String("order_uuid", job.OrderUUID))
+				return err
+			}
+
+			job.Elapsed += time.Since(startedAt)
+			return s.finish(ctx, job, model.JobStatusFailed, stage.Stage, "simulated assembly failure")
+		}
+
+		if err := wait(ctx, stage.Duration); err != nil {
+			logger.Error(ctx, "❌ Сборка корабля прервана", zap.String("order_uuid", job.OrderUUID))
+			return err
+		}
+		job.Elapsed += time.Since(startedAt)
+		job.CompletedStages = i + 1
+		stageDuration.WithLabelValues(string(stage.Stage)).Observe(stage.Duration.Seconds())
+
+		progressEvent := model.AssemblyProgressEvent{
+			EventUUID:       jobEventUUID(job.OrderUUID, fmt.Sprintf("progress:%d", job.CompletedStages)),
+			OrderUUID:       job.OrderUUID,
+			UserUUID:        job.UserUUID,
+			Stage:           stage.Stage,
+			StageNumber:     job.CompletedStages,
+			StageCount:      len(job.Plan.Stages),
+			StageDurationMs: stage.Duration.Milliseconds(),
+			ElapsedMs:       job.Elapsed.Milliseconds(),
+		}
+		if err := s.producerService.ProduceAssemblyProgressEvent(ctx, progressEvent); err != nil {
+			logger.Error(ctx, "❌ Ошибка отправки события AssemblyProgress", zap.Error(err))
+			return err
+		}
+
+		if err := s.jobRepository.CompleteStage(ctx, job.OrderUUID, s.instanceID, job.CompletedStages, job.Elapsed); err != nil {
+			logger.Error(ctx, "❌ Не удалось сохранить прогресс сборки", zap.String("order_uuid", job.OrderUUID), zap.Error(err))
+			return err
+		}
+
+		logger.Info(ctx, "🔧 Этап сборки завершен",
+			zap.String("order_uuid", job.OrderUUID),
+			zap.String("stage", string(stage.Stage)),
+			zap.Int("stage_number", progressEvent.StageNumber),
+			zap.Int("stage_count", progressEvent.StageCount),
+		)
+	}
+
+	return s.finish(ctx, job, model.JobStatusCompleted, "", "")
+}
+
+// finish сохраняет итог сборки в задании и затем публикует событие о нем, как и при отказе
+// в сборке из-за ненайденных деталей. Если событие не ушло, его переотправит повторная доставка
+// OrderPaid или резюмер с тем же EventUUID, поэтому получатели отбросят дубликаты
+func (s *service) finish(ctx context.Context, job *model.Job, status model.JobStatus, failedStage model.Stage, reason string) error {
+	now := time.Now()
+	job.Status = status
+	job.FailedStage = failedStage
+	job.FailureReason = reason
+	job.UpdatedAt = now
+	job.FinishedAt = &now
+
+	if err := s.jobRepository.FinishJob(ctx, job, s.instanceID); err != nil {
+		return err
+	}
+	recordOutcome(ctx, job)
+
+	return s.publishOutcome(ctx, job)
+}
+
+// recordOutcome учитывает сохраненный итог сборки в метриках
+func recordOutcome(ctx context.Context, job *model.Job) {
+	if job.Status == model.JobStatusFailed {
+		shipsFailed.WithLabelValues(string(job.FailedStage)).Inc()
+		logger.Warn(ctx, "💥 Сборка корабля не удалась",
+			zap.String("order_uuid", job.OrderUUID),
+			zap.String("stage", string(job.FailedStage)),
+			zap.String("reason", job.FailureReason),
+		)
+		return
+	}
+
+	assemblyDuration.Observe(job.Elapsed.Seconds())
+	shipsAssembled.Inc()
+	logger.Info(ctx, "✅ Корабль собран",
+		zap.String("order_uuid", job.OrderUUID),
+		zap.Duration("build_time", job.Elapsed),
+	)
+}
+
+// publishOutcome публикует ShipAssembled или ShipAssemblyFailed по сохраненному итогу сборки
+// и отмечает, что событие отправлено
+func (s *service) publishOutcome(ctx context.Context, job *model.Job) error {
+	var err error
+	if job.Status == model.JobStatusFailed {
+		err = s.publishFailure(ctx, job)
+	} else {
+		err = s.publishAssembled(ctx, job)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = s.jobRepository.MarkOutcomePublished(ctx, job.OrderUUID); err != nil {
+		// Событие уже отправлено: повторная отправка уйдет с тем же EventUUID
+		logger.Error(ctx, "❌ Не удалось отметить отправку итога сборки", zap.String("order_uuid", job.OrderUUID), zap.Error(err))
+		return nil
+	}
+
+	now := time.Now()
+	job.OutcomePublishedAt = &now
+
+	return nil
+}
+
+func (s *service) publishAssembled(ctx context.Context, job *model.Job) error {
+	shipAssembledEvent := model.ShipAssembledEvent{
+		EventUUID:    jobEventUUID(job.OrderUUID, "assembled"),
+		OrderUUID:    job.OrderUUID,
+		UserUUID:     job.UserUUID,
+		BuildTimeSec: int64(math.Ceil(job.Elapsed.Seconds())),
+	}
+
+	if err := s.producerService.ProduceShipAssembledEvent(ctx, shipAssembledEvent); err != nil {
+		logger.Error(ctx, "❌ Ошибка отправки события ShipAssembled", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// publishFailure публикует ShipAssemblyFailed; этап пуст, если сборка не началась
+func (s *service) publishFailure(ctx context.Context, job *model.Job) error {
+	failedEvent := model.ShipAssemblyFailedEvent{
+		EventUUID: jobEventUUID(job.OrderUUID, "failed"),
+		OrderUUID: job.OrderUUID,
+		UserUUID:  job.UserUUID,
+		Stage:     job.FailedStage,
+		Reason:    job.FailureReason,
+	}
+
+	if err := s.producerService.ProduceShipAssemblyFailedEvent(ctx, failedEvent); err != nil {
+		logger.Error(ctx, "❌ Ошибка отправки события ShipAssemblyFailed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// jobEventUUID возвращает EventUUID события сборки заказа. Он выводится из заказа и вида события,
+// поэтому повторная отправка того же события после сбоя получает тот же EventUUID
+func jobEventUUID(orderUUID, event string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("assembly:"+orderUUID+":"+event)).String()
+}
+
+// wait ждет окончания этапа или отмены контекста
+func wait(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,149 @@
+package assembly
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/assembly/internal/model"
+)
+
+// inProgressJob возвращает незавершенное задание из трех коротких этапов
+func inProgressJob(completedStages int) *model.Job {
+	return &model.Job{
+		OrderUUID: testOrderUUID,
+		UserUUID:  testUserUUID,
+		Status:    model.JobStatusInProgress,
+		Plan: model.BuildPlan{Stages: []model.BuildStage{
+			{Stage: model.StageHull, Duration: time.Millisecond},
+			{Stage: model.StageEngines, Duration: time.Millisecond},
+			{Stage: model.StageFuel, Duration: time.Millisecond},
+		}},
+		FailAt:          -1,
+		CompletedStages: completedStages,
+		Elapsed:         2 * time.Second,
+	}
+}
+
+func (s *ServiceSuite) TestAssemble_ResumesFromCompletedStages() {
+	ctx := context.Background()
+	job := inProgressJob(2)
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(job, nil).Once()
+	s.jobRepository.EXPECT().ClaimJob(ctx, testOrderUUID, s.service.instanceID, s.service.leaseTTL).Return(true, nil).Once()
+	// Завершенные до перезапуска этапы не повторяются: событие приходит только о третьем
+	s.producerService.EXPECT().ProduceAssemblyProgressEvent(mock.Anything, mock.MatchedBy(func(event model.AssemblyProgressEvent) bool {
+		return event.Stage == model.StageFuel && event.StageNumber == 3 && event.StageCount == 3 && event.ElapsedMs >= 2000
+	})).Return(nil).Once()
+	s.jobRepository.EXPECT().CompleteStage(mock.Anything, testOrderUUID, s.service.instanceID, 3, mock.Anything).Return(nil).Once()
+	// Итог сохраняется до отправки ShipAssembled
+	finish := s.jobRepository.EXPECT().FinishJob(mock.Anything, mock.MatchedBy(func(job *model.Job) bool {
+		return job.Status == model.JobStatusCompleted && job.CompletedStages == 3
+	}), s.service.instanceID).Return(nil).Once()
+	publish := s.producerService.EXPECT().ProduceShipAssembledEvent(mock.Anything, mock.MatchedBy(func(event model.ShipAssembledEvent) bool {
+		return event.EventUUID == jobEventUUID(testOrderUUID, "assembled") && event.OrderUUID == testOrderUUID && event.BuildTimeSec >= 2
+	})).Return(nil).Once()
+	mark := s.jobRepository.EXPECT().MarkOutcomePublished(mock.Anything, testOrderUUID).Return(nil).Once()
+	mock.InOrder(finish, publish, mark)
+	s.jobRepository.EXPECT().ReleaseJob(mock.Anything, testOrderUUID, s.service.instanceID).Return(nil).Once()
+
+	err := s.service.Assemble(ctx, model.OrderPaidEvent{OrderUUID: testOrderUUID, UserUUID: testUserUUID})
+
+	s.NoError(err)
+}
+
+func (s *ServiceSuite) TestAssemble_LeaseLostStopsAssembly() {
+	ctx := context.Background()
+	// Аренда продлевается каждые leaseTTL/3, а этап длится дольше, поэтому потеря аренды
+	// обнаруживается посреди этапа
+	s.service.leaseTTL = 30 * time.Millisecond
+	job := inProgressJob(0)
+	job.Plan.Stages[0].Duration = time.Minute
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(job, nil).Once()
+	s.jobRepository.EXPECT().ClaimJob(ctx, testOrderUUID, s.service.instanceID, s.service.leaseTTL).Return(true, nil).Once()
+	s.jobRepository.EXPECT().RenewLease(mock.Anything, testOrderUUID, s.service.instanceID, s.service.leaseTTL).Return(model.ErrJobLeaseLost).Once()
+	s.jobRepository.EXPECT().ReleaseJob(mock.Anything, testOrderUUID, s.service.instanceID).Return(nil).Once()
+
+	err := s.service.Assemble(ctx, model.OrderPaidEvent{OrderUUID: testOrderUUID, UserUUID: testUserUUID})
+
+	// Сборку продолжит экземпляр, перехвативший аренду: этот не публикует событий и не завершает задание
+	s.NoError(err)
+	s.producerService.AssertNotCalled(s.T(), "ProduceAssemblyProgressEvent", mock.Anything, mock.Anything)
+	s.jobRepository.AssertNotCalled(s.T(), "FinishJob", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAssemble_JobClaimedByAnotherInstance() {
+	ctx := context.Background()
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(inProgressJob(1), nil).Once()
+	s.jobRepository.EXPECT().ClaimJob(ctx, testOrderUUID, s.service.instanceID, s.service.leaseTTL).Return(false, nil).Once()
+
+	err := s.service.Assemble(ctx, model.OrderPaidEvent{OrderUUID: testOrderUUID, UserUUID: testUserUUID})
+
+	s.NoError(err)
+	s.jobRepository.AssertNotCalled(s.T(), "ReleaseJob", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAssemble_SingleRunPerOrderOnInstance() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	job := inProgressJob(0)
+	job.Plan.Stages[0].Duration = time.Minute
+
+	claimed := make(chan struct{})
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(job, nil).Twice()
+	s.jobRepository.EXPECT().ClaimJob(ctx, testOrderUUID, s.service.instanceID, s.service.leaseTTL).
+		Run(func(context.Context, string, string, time.Duration) { close(claimed) }).
+		Return(true, nil).Once()
+	s.jobRepository.EXPECT().ReleaseJob(mock.Anything, testOrderUUID, s.service.instanceID).Return(nil).Once()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.service.Assemble(ctx, model.OrderPaidEvent{OrderUUID: testOrderUUID, UserUUID: testUserUUID})
+	}()
+	<-claimed
+
+	// Повторная доставка того же события, пока идет сборка, не запускает вторую
+	err := s.service.Assemble(ctx, model.OrderPaidEvent{OrderUUID: testOrderUUID, UserUUID: testUserUUID})
+	s.NoError(err)
+
+	// Остановка сервиса прерывает сборку и освобождает аренду
+	cancel()
+	s.ErrorIs(<-done, context.Canceled)
+}
+
+func (s *ServiceSuite) TestAssemble_FinishSaveErrorPublishesNothing() {
+	ctx := context.Background()
+	dbErr := errors.New("database unavailable")
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(inProgressJob(3), nil).Once()
+	s.jobRepository.EXPECT().ClaimJob(ctx, testOrderUUID, s.service.instanceID, s.service.leaseTTL).Return(true, nil).Once()
+	s.jobRepository.EXPECT().FinishJob(mock.Anything, mock.Anything, s.service.instanceID).Return(dbErr).Once()
+	s.jobRepository.EXPECT().ReleaseJob(mock.Anything, testOrderUUID, s.service.instanceID).Return(nil).Once()
+
+	err := s.service.Assemble(ctx, model.OrderPaidEvent{OrderUUID: testOrderUUID, UserUUID: testUserUUID})
+
+	// Несохраненный итог не публикуется: повторная доставка завершит сборку заново
+	s.ErrorIs(err, dbErr)
+	s.producerService.AssertNotCalled(s.T(), "ProduceShipAssembledEvent", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAssemble_FinishPublishErrorKeepsOutcomePending() {
+	ctx := context.Background()
+	kafkaErr := errors.New("kafka unavailable")
+
+	s.jobRepository.EXPECT().GetJob(ctx, testOrderUUID).Return(inProgressJob(3), nil).Once()
+	s.jobRepository.EXPECT().ClaimJob(ctx, testOrderUUID, s.service.instanceID, s.service.leaseTTL).Return(true, nil).Once()
+	s.jobRepository.EXPECT().FinishJob(mock.Anything, mock.Anything, s.service.instanceID).Return(nil).Once()
+	s.producerService.EXPECT().ProduceShipAssembledEvent(mock.Anything, mock.Anything).Return(kafkaErr).Once()
+	s.jobRepository.EXPECT().ReleaseJob(mock.Anything, testOrderUUID, s.service.instanceID).Return(nil).Once()
+
+	err := s.service.Assemble(ctx, model.OrderPaidEvent{OrderUUID: testOrderUUID, UserUUID: testUserUUID})
+
+	// Итог остается неотправленным: его переотправит повторная доставка или резюмер
+	s.ErrorIs(err, kafkaErr)
+	s.jobRepository.AssertNotCalled(s.T(), "MarkOutcomePublished", mock.Anything, mock.Anything)
+}
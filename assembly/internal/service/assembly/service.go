@@ -0,0 +1,96 @@
+package assembly
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	grpcClient "github.com/space-wanderer/microservices/assembly/internal/client/grpc"
+	"github.com/space-wanderer/microservices/assembly/internal/repository"
+	assemblyService "github.com/space-wanderer/microservices/assembly/internal/service"
+)
+
+type service struct {
+	jobRepository   repository.JobRepository
+	inventoryClient grpcClient.InventoryClient
+	producerService assemblyService.ProducerService
+
+	timeScale      float64
+	failureRate    float64
+	leaseTTL       time.Duration
+	resumeInterval time.Duration
+
+	// instanceID - владелец аренды заданий, уникальный для каждого запуска сервиса
+	instanceID string
+
+	// resumeSlots ограничивает число сборок, которые одновременно продолжает резюмер
+	resumeSlots chan struct{}
+
+	// running - задания, которые ведет этот экземпляр; stopped сигналит о завершении каждого из них
+	mu      sync.Mutex
+	running map[string]struct{}
+	stopped *sync.Cond
+}
+
+// NewService создает движок сборки: длительности этапов умножаются на timeScale,
+// а с вероятностью failureRate сборка прерывается на случайном этапе.
+// Аренда задания действует leaseTTL, брошенные задания ищутся раз в resumeInterval,
+// и одновременно продолжается не больше resumeWorkers из них
+func NewService(
+	jobRepository repository.JobRepository,
+	inventoryClient grpcClient.InventoryClient,
+	producerService assemblyService.ProducerService,
+	timeScale float64,
+	failureRate float64,
+	leaseTTL time.Duration,
+	resumeInterval time.Duration,
+	resumeWorkers int,
+) *service {
+	s := &service{
+		jobRepository:   jobRepository,
+		inventoryClient: inventoryClient,
+		producerService: producerService,
+		timeScale:       timeScale,
+		failureRate:     failureRate,
+		leaseTTL:        leaseTTL,
+		resumeInterval:  resumeInterval,
+		resumeSlots:     make(chan struct{}, resumeWorkers),
+		instanceID:      uuid.New().String(),
+		running:         make(map[string]struct{}),
+	}
+	s.stopped = sync.NewCond(&s.mu)
+
+	return s
+}
+
+// track отмечает, что этот экземпляр ведет сборку заказа; false — сборка уже идет
+func (s *service) track(orderUUID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.running[orderUUID]; ok {
+		return false
+	}
+	s.running[orderUUID] = struct{}{}
+
+	return true
+}
+
+func (s *service) untrack(orderUUID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.running, orderUUID)
+	s.stopped.Broadcast()
+}
+
+// waitJobs дожидается остановки всех сборок, которые ведет экземпляр
+func (s *service) waitJobs() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for len(s.running) > 0 {
+		s.stopped.Wait()
+	}
+}
@@ -0,0 +1,44 @@
+package assembly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	grpcMocks "github.com/space-wanderer/microservices/assembly/internal/client/grpc/mocks"
+	repoMocks "github.com/space-wanderer/microservices/assembly/internal/repository/mocks"
+	serviceMocks "github.com/space-wanderer/microservices/assembly/internal/service/mocks"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+const (
+	testOrderUUID = "550e8400-e29b-41d4-a716-446655440000"
+	testUserUUID  = "550e8400-e29b-41d4-a716-446655440001"
+	testPartUUID  = "550e8400-e29b-41d4-a716-446655440002"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	jobRepository   *repoMocks.JobRepository
+	inventoryClient *grpcMocks.InventoryClient
+	producerService *serviceMocks.ProducerService
+	service         *service
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	logger.SetNopLogger()
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.jobRepository = repoMocks.NewJobRepository(s.T())
+	s.inventoryClient = grpcMocks.NewInventoryClient(s.T())
+	s.producerService = serviceMocks.NewProducerService(s.T())
+
+	// Длительности этапов сжаты, чтобы сборка в тестах шла миллисекунды
+	s.service = NewService(s.jobRepository, s.inventoryClient, s.producerService, 0.0001, 0, time.Minute, time.Minute, 2)
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -14,14 +14,14 @@ import (
 type service struct {
 	assemblyRecodeConsumer kafka.Consumer
 	assemblyRecodedDecoder kafkaConverter.AssemblyRecodedDecoder
-	producerService        assemblyService.ProducerService
+	assemblyService        assemblyService.AssemblyService
 }
 
-func NewService(assemblyRecodeConsumer kafka.Consumer, assemblyRecodedDecoder kafkaConverter.AssemblyRecodedDecoder, producerService assemblyService.ProducerService) *service {
+func NewService(assemblyRecodeConsumer kafka.Consumer, assemblyRecodedDecoder kafkaConverter.AssemblyRecodedDecoder, assemblyService assemblyService.AssemblyService) *service {
 	return &service{
 		assemblyRecodeConsumer: assemblyRecodeConsumer,
 		assemblyRecodedDecoder: assemblyRecodedDecoder,
-		producerService:        producerService,
+		assemblyService:        assemblyService,
 	}
 }
 
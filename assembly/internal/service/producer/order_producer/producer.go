@@ -13,12 +13,16 @@ import (
 )
 
 type service struct {
-	assemblyRecodedProducer kafka.Producer
+	assemblyRecodedProducer    kafka.Producer
+	assemblyProgressProducer   kafka.Producer
+	shipAssemblyFailedProducer kafka.Producer
 }
 
-func NewService(assemblyRecodedProducer kafka.Producer) *service {
+func NewService(assemblyRecodedProducer, assemblyProgressProducer, shipAssemblyFailedProducer kafka.Producer) *service {
 	return &service{
-		assemblyRecodedProducer: assemblyRecodedProducer,
+		assemblyRecodedProducer:    assemblyRecodedProducer,
+		assemblyProgressProducer:   assemblyProgressProducer,
+		shipAssemblyFailedProducer: shipAssemblyFailedProducer,
 	}
 }
 
@@ -44,3 +48,56 @@ func (s *service) ProduceShipAssembledEvent(ctx context.Context, event model.Shi
 
 	return nil
 }
+
+// ProduceAssemblyProgressEvent отправляет событие о завершении этапа сборки.
+// Ключ — UUID заказа, чтобы этапы одного заказа читались в порядке отправки
+func (s *service) ProduceAssemblyProgressEvent(ctx context.Context, event model.AssemblyProgressEvent) error {
+	msg := &eventsV1.AssemblyProgressEvent{
+		EventUuid:       event.EventUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		Stage:           string(event.Stage),
+		StageNumber:     int32(event.StageNumber),
+		StageCount:      int32(event.StageCount),
+		StageDurationMs: event.StageDurationMs,
+		ElapsedMs:       event.ElapsedMs,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal assembly progress event", zap.Error(err))
+		return err
+	}
+
+	err = s.assemblyProgressProducer.Send(ctx, []byte(event.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to send assembly progress event", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) ProduceShipAssemblyFailedEvent(ctx context.Context, event model.ShipAssemblyFailedEvent) error {
+	msg := &eventsV1.ShipAssemblyFailedEvent{
+		EventUuid: event.EventUUID,
+		OrderUuid: event.OrderUUID,
+		UserUuid:  event.UserUUID,
+		Stage:     string(event.Stage),
+		Reason:    event.Reason,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal ship assembly failed event", zap.Error(err))
+		return err
+	}
+
+	err = s.shipAssemblyFailedProducer.Send(ctx, []byte(event.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to send ship assembly failed event", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
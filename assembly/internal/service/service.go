@@ -12,4 +12,15 @@ type ConsumerService interface {
 
 type ProducerService interface {
 	ProduceShipAssembledEvent(ctx context.Context, event model.ShipAssembledEvent) error
+	ProduceAssemblyProgressEvent(ctx context.Context, event model.AssemblyProgressEvent) error
+	ProduceShipAssemblyFailedEvent(ctx context.Context, event model.ShipAssemblyFailedEvent) error
+}
+
+// AssemblyService собирает корабли по оплаченным заказам и отдает состояние сборок
+type AssemblyService interface {
+	Assemble(ctx context.Context, event model.OrderPaidEvent) error
+	// RunResumer продолжает сборки, прерванные остановкой экземпляров сервиса
+	RunResumer(ctx context.Context) error
+	GetAssembly(ctx context.Context, orderUUID string) (*model.Job, error)
+	ListAssemblies(ctx context.Context, filter model.JobsFilter, page model.JobsPageRequest) ([]*model.Job, string, error)
 }
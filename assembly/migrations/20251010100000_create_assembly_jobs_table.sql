@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE assembly_jobs (
+    order_uuid VARCHAR(36) PRIMARY KEY, -- на заказ приходится одно задание на сборку
+    user_uuid VARCHAR(36) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('IN_PROGRESS', 'COMPLETED', 'FAILED')),
+    plan JSONB NOT NULL, -- этапы сборки и их плановая длительность
+    fail_at INTEGER NOT NULL DEFAULT -1, -- индекс этапа с имитируемым сбоем, -1 — без сбоя
+    completed_stages INTEGER NOT NULL DEFAULT 0,
+    elapsed_ms BIGINT NOT NULL DEFAULT 0,
+    failed_stage VARCHAR(20),
+    failure_reason TEXT,
+    lease_owner VARCHAR(36), -- экземпляр сервиса, который сейчас ведет сборку
+    lease_expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP
+);
+
+CREATE INDEX idx_assembly_jobs_created_at ON assembly_jobs(created_at DESC, order_uuid DESC);
+CREATE INDEX idx_assembly_jobs_user_uuid ON assembly_jobs(user_uuid);
+-- Незавершенные задания перебираются при поиске брошенных сборок
+CREATE INDEX idx_assembly_jobs_in_progress_lease ON assembly_jobs(lease_expires_at) WHERE status = 'IN_PROGRESS';
+
+-- +goose Down
+DROP TABLE assembly_jobs;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Время отправки итогового события сборки. Итог сохраняется до отправки события,
+-- и задания с неотправленным итогом переотправляются, пока отправка не удастся
+ALTER TABLE assembly_jobs ADD COLUMN outcome_published_at TIMESTAMP;
+
+-- До этой миграции итог сохранялся только после отправки события
+UPDATE assembly_jobs SET outcome_published_at = finished_at WHERE finished_at IS NOT NULL;
+
+CREATE INDEX idx_assembly_jobs_unpublished_outcome ON assembly_jobs(finished_at)
+    WHERE finished_at IS NOT NULL AND outcome_published_at IS NULL;
+
+-- +goose Down
+DROP INDEX idx_assembly_jobs_unpublished_outcome;
+ALTER TABLE assembly_jobs DROP COLUMN outcome_published_at;
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CommitReservation(ctx context.Context, req *inventoryV1.CommitReservationRequest) (*inventoryV1.CommitReservationResponse, error) {
+	reservation, err := a.inventoryService.CommitReservation(ctx, req.GetOrderUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryV1.CommitReservationResponse{
+		Reservation: converter.ConvertReservationToGRPC(reservation),
+	}, nil
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreatePart(ctx context.Context, req *inventoryV1.CreatePartRequest) (*inventoryV1.CreatePartResponse, error) {
+	part, err := a.inventoryService.CreatePart(ctx, converter.ConvertPartInfoFromGRPC(req.GetInfo()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryV1.CreatePartResponse{
+		Part: converter.ConvertPartToGRPC(part),
+	}, nil
+}
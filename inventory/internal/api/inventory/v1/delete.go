@@ -0,0 +1,16 @@
+package v1
+
+import (
+	"context"
+
+	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeletePart(ctx context.Context, req *inventoryV1.DeletePartRequest) (*inventoryV1.DeletePartResponse, error) {
+	err := a.inventoryService.DeletePart(ctx, req.GetUuid(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryV1.DeletePartResponse{}, nil
+}
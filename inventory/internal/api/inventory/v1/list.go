@@ -9,7 +9,8 @@ import (
 
 func (a *api) ListParts(ctx context.Context, req *inventoryV1.ListPartsRequest) (*inventoryV1.ListPartsResponse, error) {
 	filter := converter.ConvertFilterFromGRPC(req.GetFilter())
-	parts, err := a.inventoryService.ListParts(ctx, filter)
+	page := converter.ConvertPageRequestFromGRPC(req)
+	parts, nextPageToken, err := a.inventoryService.ListParts(ctx, filter, page)
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +21,7 @@ func (a *api) ListParts(ctx context.Context, req *inventoryV1.ListPartsRequest)
 	}
 
 	return &inventoryV1.ListPartsResponse{
-		Parts: grpcParts,
+		Parts:         grpcParts,
+		NextPageToken: nextPageToken,
 	}, nil
 }
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReleaseReservation(ctx context.Context, req *inventoryV1.ReleaseReservationRequest) (*inventoryV1.ReleaseReservationResponse, error) {
+	reservation, err := a.inventoryService.ReleaseReservation(ctx, req.GetOrderUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryV1.ReleaseReservationResponse{
+		Reservation: converter.ConvertReservationToGRPC(reservation),
+	}, nil
+}
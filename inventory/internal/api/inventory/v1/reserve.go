@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReserveParts(ctx context.Context, req *inventoryV1.ReservePartsRequest) (*inventoryV1.ReservePartsResponse, error) {
+	items := converter.ConvertReservationItemsFromGRPC(req.GetItems())
+	reservation, err := a.inventoryService.ReserveParts(ctx, req.GetOrderUuid(), items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryV1.ReservePartsResponse{
+		Reservation: converter.ConvertReservationToGRPC(reservation),
+	}, nil
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) UpdatePart(ctx context.Context, req *inventoryV1.UpdatePartRequest) (*inventoryV1.UpdatePartResponse, error) {
+	part, err := a.inventoryService.UpdatePart(ctx, req.GetUuid(), req.GetVersion(), converter.ConvertPartInfoFromGRPC(req.GetInfo()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryV1.UpdatePartResponse{
+		Part: converter.ConvertPartToGRPC(part),
+	}, nil
+}
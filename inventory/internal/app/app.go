@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -14,10 +16,29 @@ import (
 	"github.com/space-wanderer/microservices/inventory/internal/config"
 	"github.com/space-wanderer/microservices/platform/pkg/closer"
 	"github.com/space-wanderer/microservices/platform/pkg/grpc/health"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/mtls"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
+	"github.com/space-wanderer/microservices/shared/pkg/interceptors"
 	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
 )
 
+// grpcAllowList ограничивает вызовы RPC при включенном mTLS: резервировать детали может только order,
+// а изменять каталог - только операторы. Чтение каталога доступно любому клиенту с валидным сертификатом
+func grpcAllowList() mtls.AllowList {
+	cfg := config.AppConfig().GRPCAllowList
+
+	return mtls.AllowList{
+		inventoryV1.InventoryService_ReserveParts_FullMethodName:       cfg.ReservationClients(),
+		inventoryV1.InventoryService_CommitReservation_FullMethodName:  cfg.ReservationClients(),
+		inventoryV1.InventoryService_ReleaseReservation_FullMethodName: cfg.ReservationClients(),
+		inventoryV1.InventoryService_CreatePart_FullMethodName:         cfg.CatalogClients(),
+		inventoryV1.InventoryService_UpdatePart_FullMethodName:         cfg.CatalogClients(),
+		inventoryV1.InventoryService_DeletePart_FullMethodName:         cfg.CatalogClients(),
+	}
+}
+
 type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
@@ -36,6 +57,8 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
+	a.runReservationSweeper(ctx)
+
 	return a.runGRPCServer(ctx)
 }
 
@@ -44,6 +67,9 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDi,
 		a.initLogger,
 		a.initCloser,
+		a.initTracing,
+		a.initMetrics,
+		a.initHealth,
 		a.initListener,
 		a.initGRPCServer,
 	}
@@ -75,6 +101,55 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx,
+		config.AppConfig().Tracing.ServiceName(),
+		config.AppConfig().Tracing.CollectorEndpoint(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
+
+	closer.AddNamed("Tracer provider", shutdown)
+
+	return nil
+}
+
+func (a *App) initMetrics(ctx context.Context) error {
+	listener, err := net.Listen("tcp", config.AppConfig().Metrics.Address())
+	if err != nil {
+		return fmt.Errorf("failed to listen metrics address: %w", err)
+	}
+
+	server := metrics.NewServer(config.AppConfig().Metrics.Address())
+	go func() {
+		logger.Info(ctx, fmt.Sprintf("Metrics server listening on %s", config.AppConfig().Metrics.Address()))
+		if serveErr := server.Serve(listener); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			logger.Error(ctx, "Metrics server error", zap.Error(serveErr))
+		}
+	}()
+
+	closer.AddNamed("Metrics server", server.Shutdown)
+
+	return nil
+}
+
+func (a *App) initHealth(ctx context.Context) error {
+	registry := a.diContainer.HealthRegistry(ctx)
+
+	healthCtx, cancel := context.WithCancel(ctx)
+	go registry.Run(healthCtx)
+
+	// Статус NOT_SERVING выставляется до остановки серверов, чтобы клиенты успели переключиться
+	closer.AddBeforeShutdown("Health status", registry.Shutdown)
+	closer.AddNamed("Health checks", func(context.Context) error {
+		cancel()
+		return nil
+	})
+
+	return nil
+}
+
 func (a *App) initListener(_ context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().InventoryGRPC.Address())
 	if err != nil {
@@ -96,14 +171,33 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	creds := insecure.NewCredentials()
+	unary := []grpc.UnaryServerInterceptor{
+		interceptors.UnaryTracingServerInterceptor(),
+		metrics.UnaryServerInterceptor(),
+	}
+
+	if config.AppConfig().GRPCTLS.Enabled() {
+		reloader := a.diContainer.TLSReloader(ctx)
+		if reloader == nil {
+			return fmt.Errorf("failed to load gRPC TLS certificates")
+		}
+
+		creds = reloader.ServerCredentials()
+		unary = append(unary, mtls.UnaryAllowListInterceptor(grpcAllowList()))
+	}
+
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(append(unary, interceptors.UnaryErrorInterceptor())...),
+	)
 	closer.AddNamed("GRPC Server", func(ctx context.Context) error {
 		a.grpcServer.GracefulStop()
 		return nil
 	})
 
 	reflection.Register(a.grpcServer)
-	health.RegisterServer(a.grpcServer)
+	health.RegisterServer(a.grpcServer, a.diContainer.HealthRegistry(ctx))
 
 	api := inventoryV1API.NewAPI(a.diContainer.InventoryService(ctx))
 	inventoryV1.RegisterInventoryServiceServer(a.grpcServer, api)
@@ -111,6 +205,31 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	return nil
 }
 
+// runReservationSweeper запускает откат зависших и снятие просроченных резервов.
+// При остановке сервиса текущий проход дорабатывает до конца
+func (a *App) runReservationSweeper(ctx context.Context) {
+	sweeperCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		if err := a.diContainer.ReservationSweeperService(ctx).RunSweeper(sweeperCtx); err != nil {
+			logger.Error(ctx, "Failed to run reservation sweeper", zap.Error(err))
+		}
+	}()
+
+	closer.AddNamed("Reservation sweeper", func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
 func (a *App) runGRPCServer(ctx context.Context) error {
 	logger.Info(ctx, fmt.Sprintf("gRPC inventory server listening on %s", config.AppConfig().InventoryGRPC.Address()))
 
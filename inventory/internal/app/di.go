@@ -14,7 +14,11 @@ import (
 	partRepository "github.com/space-wanderer/microservices/inventory/internal/repository/part"
 	"github.com/space-wanderer/microservices/inventory/internal/service"
 	partService "github.com/space-wanderer/microservices/inventory/internal/service/part"
+	reservationService "github.com/space-wanderer/microservices/inventory/internal/service/reservation"
 	"github.com/space-wanderer/microservices/platform/pkg/closer"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/mtls"
+	platformHealth "github.com/space-wanderer/microservices/platform/pkg/health"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
 	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
 )
 
@@ -23,10 +27,16 @@ type diContainer struct {
 
 	inventoryService service.InventoryService
 
+	reservationSweeperService service.ReservationSweeperService
+
 	inventoryRepository repository.InventoryRepository
 
 	mongoDBClient *mongo.Client
 	mongoDBHandle *mongo.Database
+
+	healthRegistry *platformHealth.Registry
+
+	tlsReloader *mtls.Reloader
 }
 
 func NewDiContainer() *diContainer {
@@ -47,6 +57,22 @@ func (d *diContainer) InventoryService(ctx context.Context) service.InventorySer
 	return d.inventoryService
 }
 
+// ReservationSweeperService создает сервис, откатывающий зависшие и снимающий просроченные резервы
+func (d *diContainer) ReservationSweeperService(ctx context.Context) service.ReservationSweeperService {
+	if d.reservationSweeperService == nil {
+		cfg := config.AppConfig().ReservationSweeper
+
+		d.reservationSweeperService = reservationService.NewService(
+			d.InventoryRepository(ctx),
+			cfg.PendingTimeout(),
+			cfg.TTL(),
+			cfg.SweepInterval(),
+			cfg.BatchSize(),
+		)
+	}
+	return d.reservationSweeperService
+}
+
 func (d *diContainer) InventoryRepository(ctx context.Context) repository.InventoryRepository {
 	if d.inventoryRepository == nil {
 		d.inventoryRepository = partRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
@@ -56,7 +82,10 @@ func (d *diContainer) InventoryRepository(ctx context.Context) repository.Invent
 
 func (d *diContainer) MongoDBClient(ctx context.Context) *mongo.Client {
 	if d.mongoDBClient == nil {
-		client, err := mongo.Connect(ctx, options.Client().ApplyURI(config.AppConfig().Mongo.URI()))
+		client, err := mongo.Connect(ctx, options.Client().
+			ApplyURI(config.AppConfig().Mongo.URI()).
+			SetPoolMonitor(metrics.MongoPoolMonitor()).
+			SetMonitor(metrics.MongoCommandMonitor()))
 		if err != nil {
 			panic(fmt.Sprintf("failed to connect to MongoDB: %s\n", err.Error()))
 		}
@@ -78,3 +107,36 @@ func (d *diContainer) MongoDBHandle(ctx context.Context) *mongo.Database {
 	}
 	return d.mongoDBHandle
 }
+
+// HealthRegistry создает реестр health-проверок зависимостей сервиса
+func (d *diContainer) HealthRegistry(ctx context.Context) *platformHealth.Registry {
+	if d.healthRegistry == nil {
+		cfg := config.AppConfig().Health
+		registry := platformHealth.NewRegistry(cfg.CheckInterval(), cfg.CheckTimeout())
+		registry.Register("mongo", platformHealth.MongoChecker(d.MongoDBClient(ctx)))
+		registry.RegisterService(inventoryV1.InventoryService_ServiceDesc.ServiceName, "mongo")
+		d.healthRegistry = registry
+	}
+	return d.healthRegistry
+}
+
+// TLSReloader загружает сертификаты mTLS и перечитывает их при изменении файлов
+func (d *diContainer) TLSReloader(ctx context.Context) *mtls.Reloader {
+	if d.tlsReloader == nil {
+		cfg := config.AppConfig().GRPCTLS
+		reloader, err := mtls.NewReloader(cfg.CertFile(), cfg.KeyFile(), cfg.CAFile())
+		if err != nil {
+			panic(fmt.Sprintf("failed to load TLS certificates: %s\n", err.Error()))
+		}
+
+		reloadCtx, cancel := context.WithCancel(ctx)
+		go reloader.Run(reloadCtx, cfg.ReloadInterval())
+		closer.AddNamed("TLS reloader", func(context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.tlsReloader = reloader
+	}
+	return d.tlsReloader
+}
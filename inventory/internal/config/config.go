@@ -12,8 +12,15 @@ var appConfig *config
 
 type config struct {
 	Logger        LoggerConfig
+	Tracing       TracingConfig
+	Metrics       MetricsConfig
+	Health        HealthConfig
+	GRPCTLS       GRPCTLSConfig
+	GRPCAllowList GRPCAllowListConfig
 	InventoryGRPC InventoryGRPCConfig
 	Mongo         MongoConfig
+
+	ReservationSweeper ReservationSweeperConfig
 }
 
 func Load(path ...string) error {
@@ -27,6 +34,31 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
+	metricsCfg, err := env.NewMetricsConfig()
+	if err != nil {
+		return err
+	}
+
+	healthCfg, err := env.NewHealthConfig()
+	if err != nil {
+		return err
+	}
+
+	grpcTLSCfg, err := env.NewGRPCTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	grpcAllowListCfg, err := env.NewGRPCAllowListConfig()
+	if err != nil {
+		return err
+	}
+
 	inventoryGRPCCfg, err := env.NewInventoryGRPCConfig()
 	if err != nil {
 		return err
@@ -37,10 +69,22 @@ func Load(path ...string) error {
 		return err
 	}
 
+	reservationSweeperCfg, err := env.NewReservationSweeperConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:        loggerCfg,
+		Tracing:       tracingCfg,
+		Metrics:       metricsCfg,
+		Health:        healthCfg,
+		GRPCTLS:       grpcTLSCfg,
+		GRPCAllowList: grpcAllowListCfg,
 		InventoryGRPC: inventoryGRPCCfg,
 		Mongo:         mongoCfg,
+
+		ReservationSweeper: reservationSweeperCfg,
 	}
 
 	return nil
@@ -0,0 +1,42 @@
+package env
+
+import (
+	"errors"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type grpcAllowListEnvConfig struct {
+	ReservationClients []string `env:"GRPC_ALLOW_RESERVATION_CLIENTS,required" envSeparator:","`
+	CatalogClients     []string `env:"GRPC_ALLOW_CATALOG_CLIENTS,required" envSeparator:","`
+}
+
+type grpcAllowListConfig struct {
+	raw grpcAllowListEnvConfig
+}
+
+func NewGRPCAllowListConfig() (*grpcAllowListConfig, error) {
+	var raw grpcAllowListEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if len(raw.ReservationClients) == 0 {
+		return nil, errors.New("GRPC_ALLOW_RESERVATION_CLIENTS must not be empty")
+	}
+	if len(raw.CatalogClients) == 0 {
+		return nil, errors.New("GRPC_ALLOW_CATALOG_CLIENTS must not be empty")
+	}
+
+	return &grpcAllowListConfig{raw: raw}, nil
+}
+
+// ReservationClients возвращает идентичности клиентов, которым разрешено резервировать детали
+func (cfg *grpcAllowListConfig) ReservationClients() []string {
+	return cfg.raw.ReservationClients
+}
+
+// CatalogClients возвращает идентичности клиентов, которым разрешено создавать, изменять и удалять детали каталога
+func (cfg *grpcAllowListConfig) CatalogClients() []string {
+	return cfg.raw.CatalogClients
+}
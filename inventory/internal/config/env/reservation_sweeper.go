@@ -0,0 +1,60 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type reservationSweeperEnvConfig struct {
+	PendingTimeout time.Duration `env:"RESERVATION_PENDING_TIMEOUT,required"`
+	TTL            time.Duration `env:"RESERVATION_TTL,required"`
+	SweepInterval  time.Duration `env:"RESERVATION_SWEEP_INTERVAL,required"`
+	BatchSize      int           `env:"RESERVATION_SWEEP_BATCH_SIZE,required"`
+}
+
+type reservationSweeperConfig struct {
+	raw reservationSweeperEnvConfig
+}
+
+func NewReservationSweeperConfig() (*reservationSweeperConfig, error) {
+	var raw reservationSweeperEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.PendingTimeout <= 0 {
+		return nil, errors.New("RESERVATION_PENDING_TIMEOUT must be positive")
+	}
+	if raw.TTL <= 0 {
+		return nil, errors.New("RESERVATION_TTL must be positive")
+	}
+	if raw.SweepInterval <= 0 {
+		return nil, errors.New("RESERVATION_SWEEP_INTERVAL must be positive")
+	}
+	if raw.BatchSize <= 0 {
+		return nil, errors.New("RESERVATION_SWEEP_BATCH_SIZE must be positive")
+	}
+
+	return &reservationSweeperConfig{raw: raw}, nil
+}
+
+// PendingTimeout - время, после которого незавершенный резерв считается зависшим и откатывается
+func (cfg *reservationSweeperConfig) PendingTimeout() time.Duration {
+	return cfg.raw.PendingTimeout
+}
+
+// TTL - срок жизни неподтвержденного резерва. Должен быть больше срока оплаты заказа,
+// иначе резерв снимется раньше, чем сервис заказов успеет его подтвердить или снять
+func (cfg *reservationSweeperConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
+
+func (cfg *reservationSweeperConfig) SweepInterval() time.Duration {
+	return cfg.raw.SweepInterval
+}
+
+func (cfg *reservationSweeperConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
@@ -1,10 +1,40 @@
 package config
 
+import "time"
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+}
+
+type MetricsConfig interface {
+	Address() string
+}
+
+type HealthConfig interface {
+	CheckInterval() time.Duration
+	CheckTimeout() time.Duration
+}
+
+type GRPCTLSConfig interface {
+	Enabled() bool
+	CertFile() string
+	KeyFile() string
+	CAFile() string
+	ReloadInterval() time.Duration
+}
+
+// GRPCAllowListConfig - клиенты, которым при включенном mTLS разрешены RPC с ограниченным доступом
+type GRPCAllowListConfig interface {
+	ReservationClients() []string
+	CatalogClients() []string
+}
+
 type InventoryGRPCConfig interface {
 	Address() string
 }
@@ -13,3 +43,10 @@ type MongoConfig interface {
 	URI() string
 	Database() string
 }
+
+type ReservationSweeperConfig interface {
+	PendingTimeout() time.Duration
+	TTL() time.Duration
+	SweepInterval() time.Duration
+	BatchSize() int
+}
@@ -0,0 +1,133 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+// convertModelFloatRangeToRepo конвертирует диапазон из service model в repository model
+func convertModelFloatRangeToRepo(r *model.FloatRange) *repoModel.FloatRange {
+	if r == nil {
+		return nil
+	}
+	return &repoModel.FloatRange{Min: r.Min, Max: r.Max}
+}
+
+// convertModelIntRangeToRepo конвертирует диапазон из service model в repository model
+func convertModelIntRangeToRepo(r *model.IntRange) *repoModel.IntRange {
+	if r == nil {
+		return nil
+	}
+	return &repoModel.IntRange{Min: r.Min, Max: r.Max}
+}
+
+// convertModelDimensionsFilterToRepo конвертирует фильтр размеров из service model в repository model
+func convertModelDimensionsFilterToRepo(f *model.DimensionsFilter) *repoModel.DimensionsFilter {
+	if f == nil {
+		return nil
+	}
+	return &repoModel.DimensionsFilter{
+		Length: convertModelFloatRangeToRepo(f.Length),
+		Width:  convertModelFloatRangeToRepo(f.Width),
+		Height: convertModelFloatRangeToRepo(f.Height),
+		Weight: convertModelFloatRangeToRepo(f.Weight),
+	}
+}
+
+// convertModelMetadataPredicatesToRepo конвертирует условия на метаданные из service model в repository model
+func convertModelMetadataPredicatesToRepo(predicates []model.MetadataPredicate) []repoModel.MetadataPredicate {
+	if predicates == nil {
+		return nil
+	}
+
+	repoPredicates := make([]repoModel.MetadataPredicate, len(predicates))
+	for i, predicate := range predicates {
+		repoPredicates[i] = repoModel.MetadataPredicate{
+			Key:      predicate.Key,
+			Operator: repoModel.ComparisonOperator(predicate.Operator),
+			Value:    convertModelValueToRepoValue(predicate.Value),
+		}
+	}
+	return repoPredicates
+}
+
+// convertGRPCTagsMatchToModel конвертирует gRPC режим сравнения тегов в модель
+func convertGRPCTagsMatchToModel(tagsMatch inventoryV1.TagsMatch) model.TagsMatch {
+	if tagsMatch == inventoryV1.TagsMatch_TAGS_MATCH_ALL {
+		return model.TagsMatchAll
+	}
+	return model.TagsMatchAny
+}
+
+// convertGRPCDoubleRangeToModel конвертирует gRPC диапазон в модель
+func convertGRPCDoubleRangeToModel(r *inventoryV1.DoubleRange) *model.FloatRange {
+	if r == nil {
+		return nil
+	}
+	return &model.FloatRange{Min: r.Min, Max: r.Max}
+}
+
+// convertGRPCInt64RangeToModel конвертирует gRPC диапазон в модель
+func convertGRPCInt64RangeToModel(r *inventoryV1.Int64Range) *model.IntRange {
+	if r == nil {
+		return nil
+	}
+	return &model.IntRange{Min: r.Min, Max: r.Max}
+}
+
+// convertGRPCDimensionsFilterToModel конвертирует gRPC фильтр размеров в модель
+func convertGRPCDimensionsFilterToModel(f *inventoryV1.DimensionsFilter) *model.DimensionsFilter {
+	if f == nil {
+		return nil
+	}
+	return &model.DimensionsFilter{
+		Length: convertGRPCDoubleRangeToModel(f.GetLength()),
+		Width:  convertGRPCDoubleRangeToModel(f.GetWidth()),
+		Height: convertGRPCDoubleRangeToModel(f.GetHeight()),
+		Weight: convertGRPCDoubleRangeToModel(f.GetWeight()),
+	}
+}
+
+// convertGRPCMetadataPredicatesToModel конвертирует gRPC условия на метаданные в модель.
+// Значение без заполненного oneof остается nil, чтобы условие отклонила валидация
+func convertGRPCMetadataPredicatesToModel(predicates []*inventoryV1.MetadataPredicate) []model.MetadataPredicate {
+	if predicates == nil {
+		return nil
+	}
+
+	modelPredicates := make([]model.MetadataPredicate, len(predicates))
+	for i, predicate := range predicates {
+		var value model.Value
+		if v := convertGRPCValueToModelValue(predicate.GetValue()); v != nil {
+			value = *v
+		}
+
+		modelPredicates[i] = model.MetadataPredicate{
+			Key:      predicate.GetKey(),
+			Operator: convertGRPCComparisonOperatorToModel(predicate.GetOperator()),
+			Value:    value,
+		}
+	}
+	return modelPredicates
+}
+
+// convertGRPCComparisonOperatorToModel конвертирует gRPC оператор сравнения в модель
+func convertGRPCComparisonOperatorToModel(operator inventoryV1.ComparisonOperator) model.ComparisonOperator {
+	switch operator {
+	case inventoryV1.ComparisonOperator_COMPARISON_OPERATOR_EQ:
+		return model.ComparisonOperatorEQ
+	case inventoryV1.ComparisonOperator_COMPARISON_OPERATOR_NE:
+		return model.ComparisonOperatorNE
+	case inventoryV1.ComparisonOperator_COMPARISON_OPERATOR_GT:
+		return model.ComparisonOperatorGT
+	case inventoryV1.ComparisonOperator_COMPARISON_OPERATOR_GTE:
+		return model.ComparisonOperatorGTE
+	case inventoryV1.ComparisonOperator_COMPARISON_OPERATOR_LT:
+		return model.ComparisonOperatorLT
+	case inventoryV1.ComparisonOperator_COMPARISON_OPERATOR_LTE:
+		return model.ComparisonOperatorLTE
+	default:
+		return ""
+	}
+}
@@ -46,6 +46,7 @@ func ConvertRepoPartToModelPart(repoPart *repoModel.Part) *model.Part {
 		Metadata:      convertRepoMetadataToModelMetadata(repoPart.Metadata),
 		CreatedAt:     repoPart.CreatedAt.Time(),
 		UpdatedAt:     repoPart.UpdatedAt.Time(),
+		Version:       repoPart.Version,
 	}
 }
 
@@ -69,15 +70,88 @@ func convertRepoValueToModelValue(repoValue *repoModel.Value) model.Value {
 		return nil
 	}
 
-	switch v := (*repoValue).(type) {
-	case *repoModel.StringValue:
-		return &model.StringValue{StringValue: v.StringValue}
-	case *repoModel.Int64Value:
-		return &model.Int64Value{Int64Value: v.Int64Value}
-	case *repoModel.DoubleValue:
-		return &model.DoubleValue{DoubleValue: v.DoubleValue}
-	case *repoModel.BoolValue:
-		return &model.BoolValue{BoolValue: v.BoolValue}
+	switch {
+	case repoValue.StringValue != nil:
+		return &model.StringValue{StringValue: *repoValue.StringValue}
+	case repoValue.Int64Value != nil:
+		return &model.Int64Value{Int64Value: *repoValue.Int64Value}
+	case repoValue.DoubleValue != nil:
+		return &model.DoubleValue{DoubleValue: *repoValue.DoubleValue}
+	case repoValue.BoolValue != nil:
+		return &model.BoolValue{BoolValue: *repoValue.BoolValue}
+	default:
+		return nil
+	}
+}
+
+// ConvertModelPartToRepoPart конвертирует Part из service model в repository model.
+// Служебные поля (версия, даты, резерв) заполняет репозиторий
+func ConvertModelPartToRepoPart(part *model.Part) *repoModel.Part {
+	if part == nil {
+		return nil
+	}
+
+	var dimensions *repoModel.Dimensions
+	if part.Dimensions != nil {
+		dimensions = &repoModel.Dimensions{
+			Length: part.Dimensions.Length,
+			Width:  part.Dimensions.Width,
+			Height: part.Dimensions.Height,
+			Weight: part.Dimensions.Weight,
+		}
+	}
+
+	var manufacturer *repoModel.Manufacturer
+	if part.Manufacturer != nil {
+		manufacturer = &repoModel.Manufacturer{
+			Name:    part.Manufacturer.Name,
+			Country: part.Manufacturer.Country,
+			Website: part.Manufacturer.Website,
+		}
+	}
+
+	return &repoModel.Part{
+		UUID:          part.UUID,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Category:      repoModel.Category(part.Category),
+		Dimensions:    dimensions,
+		Manufacturer:  manufacturer,
+		Tags:          part.Tags,
+		Metadata:      convertModelMetadataToRepoMetadata(part.Metadata),
+	}
+}
+
+func convertModelMetadataToRepoMetadata(modelMetadata map[string]*model.Value) map[string]*repoModel.Value {
+	if modelMetadata == nil {
+		return nil
+	}
+
+	repoMetadata := make(map[string]*repoModel.Value, len(modelMetadata))
+	for key, modelValue := range modelMetadata {
+		if modelValue == nil {
+			continue
+		}
+
+		if repoValue := convertModelValueToRepoValue(*modelValue); repoValue != nil {
+			repoMetadata[key] = repoValue
+		}
+	}
+	return repoMetadata
+}
+
+func convertModelValueToRepoValue(modelValue model.Value) *repoModel.Value {
+	switch v := modelValue.(type) {
+	case *model.StringValue:
+		return &repoModel.Value{StringValue: &v.StringValue}
+	case *model.Int64Value:
+		return &repoModel.Value{Int64Value: &v.Int64Value}
+	case *model.DoubleValue:
+		return &repoModel.Value{DoubleValue: &v.DoubleValue}
+	case *model.BoolValue:
+		return &repoModel.Value{BoolValue: &v.BoolValue}
 	default:
 		return nil
 	}
@@ -95,6 +169,12 @@ func ConvertModelPartsFilterToRepoPartsFilter(modelFilter *model.PartsFilter) *r
 		Categories:            convertModelCategoriesToRepoCategories(modelFilter.Categories),
 		ManufacturerCountries: modelFilter.ManufacturerCountries,
 		Tags:                  modelFilter.Tags,
+		TagsMatch:             repoModel.TagsMatch(modelFilter.TagsMatch),
+		Price:                 convertModelFloatRangeToRepo(modelFilter.Price),
+		StockQuantity:         convertModelIntRangeToRepo(modelFilter.StockQuantity),
+		Dimensions:            convertModelDimensionsFilterToRepo(modelFilter.Dimensions),
+		Metadata:              convertModelMetadataPredicatesToRepo(modelFilter.Metadata),
+		Text:                  modelFilter.Text,
 	}
 }
 
@@ -133,8 +213,10 @@ func ConvertPartToGRPC(part *model.Part) *inventoryV1.Part {
 		StockQuantity: part.StockQuantity,
 		Category:      convertCategoryToGRPC(part.Category),
 		Tags:          part.Tags,
+		Metadata:      convertMetadataToGRPC(part.Metadata),
 		CreatedAt:     timestamppb.New(part.CreatedAt),
 		UpdatedAt:     timestamppb.New(part.UpdatedAt),
+		Version:       part.Version,
 	}
 
 	if part.Dimensions != nil {
@@ -157,6 +239,32 @@ func ConvertPartToGRPC(part *model.Part) *inventoryV1.Part {
 	return grpcPart
 }
 
+// convertMetadataToGRPC конвертирует метаданные детали в gRPC модель
+func convertMetadataToGRPC(metadata map[string]*model.Value) map[string]*inventoryV1.Value {
+	if metadata == nil {
+		return nil
+	}
+
+	grpcMetadata := make(map[string]*inventoryV1.Value, len(metadata))
+	for key, value := range metadata {
+		if value == nil {
+			continue
+		}
+
+		switch v := (*value).(type) {
+		case *model.StringValue:
+			grpcMetadata[key] = &inventoryV1.Value{Value: &inventoryV1.Value_StringValue{StringValue: v.StringValue}}
+		case *model.Int64Value:
+			grpcMetadata[key] = &inventoryV1.Value{Value: &inventoryV1.Value_Int64Value{Int64Value: v.Int64Value}}
+		case *model.DoubleValue:
+			grpcMetadata[key] = &inventoryV1.Value{Value: &inventoryV1.Value_DoubleValue{DoubleValue: v.DoubleValue}}
+		case *model.BoolValue:
+			grpcMetadata[key] = &inventoryV1.Value{Value: &inventoryV1.Value_BoolValue{BoolValue: v.BoolValue}}
+		}
+	}
+	return grpcMetadata
+}
+
 // convertCategoryToGRPC конвертирует внутреннюю категорию в gRPC категорию
 func convertCategoryToGRPC(category model.Category) inventoryV1.Category {
 	switch category {
@@ -184,6 +292,12 @@ func ConvertFilterFromGRPC(grpcFilter *inventoryV1.PartsFilter) *model.PartsFilt
 		Categories:            convertGRPCCategoriesToModelCategories(grpcFilter.GetCategories()),
 		ManufacturerCountries: grpcFilter.GetManufacturerCountries(),
 		Tags:                  grpcFilter.GetTags(),
+		TagsMatch:             convertGRPCTagsMatchToModel(grpcFilter.GetTagsMatch()),
+		Price:                 convertGRPCDoubleRangeToModel(grpcFilter.GetPrice()),
+		StockQuantity:         convertGRPCInt64RangeToModel(grpcFilter.GetStockQuantity()),
+		Dimensions:            convertGRPCDimensionsFilterToModel(grpcFilter.GetDimensions()),
+		Metadata:              convertGRPCMetadataPredicatesToModel(grpcFilter.GetMetadata()),
+		Text:                  grpcFilter.GetText(),
 	}
 }
 
@@ -215,3 +329,82 @@ func convertGRPCCategoryToModelCategory(grpcCategory inventoryV1.Category) model
 		return model.CategoryUnknown
 	}
 }
+
+// ConvertPartInfoFromGRPC конвертирует редактируемые поля детали из gRPC модели.
+// Значение метаданных без заполненного oneof сохраняется как nil, чтобы его отклонила валидация
+func ConvertPartInfoFromGRPC(info *inventoryV1.PartInfo) *model.Part {
+	part := &model.Part{
+		Name:          info.GetName(),
+		Description:   info.GetDescription(),
+		Price:         info.GetPrice(),
+		StockQuantity: info.GetStockQuantity(),
+		Category:      convertGRPCCategoryToModelCategory(info.GetCategory()),
+		Tags:          info.GetTags(),
+	}
+
+	if dimensions := info.GetDimensions(); dimensions != nil {
+		part.Dimensions = &model.Dimensions{
+			Length: dimensions.GetLength(),
+			Width:  dimensions.GetWidth(),
+			Height: dimensions.GetHeight(),
+			Weight: dimensions.GetWeight(),
+		}
+	}
+
+	if manufacturer := info.GetManufacturer(); manufacturer != nil {
+		part.Manufacturer = &model.Manufacturer{
+			Name:    manufacturer.GetName(),
+			Country: manufacturer.GetCountry(),
+			Website: manufacturer.GetWebsite(),
+		}
+	}
+
+	if metadata := info.GetMetadata(); metadata != nil {
+		part.Metadata = make(map[string]*model.Value, len(metadata))
+		for key, grpcValue := range metadata {
+			part.Metadata[key] = convertGRPCValueToModelValue(grpcValue)
+		}
+	}
+
+	return part
+}
+
+// convertGRPCValueToModelValue конвертирует gRPC значение метаданных в модель
+func convertGRPCValueToModelValue(grpcValue *inventoryV1.Value) *model.Value {
+	var value model.Value
+	switch v := grpcValue.GetValue().(type) {
+	case *inventoryV1.Value_StringValue:
+		value = &model.StringValue{StringValue: v.StringValue}
+	case *inventoryV1.Value_Int64Value:
+		value = &model.Int64Value{Int64Value: v.Int64Value}
+	case *inventoryV1.Value_DoubleValue:
+		value = &model.DoubleValue{DoubleValue: v.DoubleValue}
+	case *inventoryV1.Value_BoolValue:
+		value = &model.BoolValue{BoolValue: v.BoolValue}
+	default:
+		return nil
+	}
+	return &value
+}
+
+// ConvertPageRequestFromGRPC конвертирует параметры страницы из gRPC запроса в модель
+func ConvertPageRequestFromGRPC(req *inventoryV1.ListPartsRequest) model.PartsPageRequest {
+	return model.PartsPageRequest{
+		Size:       req.GetPageSize(),
+		Token:      req.GetPageToken(),
+		OrderBy:    convertGRPCOrderByToModelOrderBy(req.GetOrderBy()),
+		Descending: req.GetDescending(),
+	}
+}
+
+// convertGRPCOrderByToModelOrderBy конвертирует gRPC поле сортировки в модель
+func convertGRPCOrderByToModelOrderBy(orderBy inventoryV1.PartsOrderBy) model.PartsOrderBy {
+	switch orderBy {
+	case inventoryV1.PartsOrderBy_PARTS_ORDER_BY_PRICE:
+		return model.PartsOrderByPrice
+	case inventoryV1.PartsOrderBy_PARTS_ORDER_BY_NAME:
+		return model.PartsOrderByName
+	default:
+		return model.PartsOrderByCreatedAt
+	}
+}
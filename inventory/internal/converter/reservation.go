@@ -0,0 +1,98 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+// ConvertModelReservationToRepoReservation конвертирует Reservation из service model в repository model
+func ConvertModelReservationToRepoReservation(reservation *model.Reservation) *repoModel.Reservation {
+	if reservation == nil {
+		return nil
+	}
+
+	items := make([]repoModel.ReservationItem, len(reservation.Items))
+	for i, item := range reservation.Items {
+		items[i] = repoModel.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return &repoModel.Reservation{
+		OrderUUID: reservation.OrderUUID,
+		Items:     items,
+		Status:    repoModel.ReservationStatus(reservation.Status),
+	}
+}
+
+// ConvertRepoReservationToModelReservation конвертирует Reservation из repository model в service model
+func ConvertRepoReservationToModelReservation(reservation *repoModel.Reservation) *model.Reservation {
+	if reservation == nil {
+		return nil
+	}
+
+	items := make([]model.ReservationItem, len(reservation.Items))
+	for i, item := range reservation.Items {
+		items[i] = model.ReservationItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return &model.Reservation{
+		OrderUUID: reservation.OrderUUID,
+		Items:     items,
+		Status:    model.ReservationStatus(reservation.Status),
+		CreatedAt: reservation.CreatedAt.Time(),
+		UpdatedAt: reservation.UpdatedAt.Time(),
+	}
+}
+
+// ConvertReservationItemsFromGRPC конвертирует gRPC позиции резерва в модель
+func ConvertReservationItemsFromGRPC(grpcItems []*inventoryV1.ReservationItem) []model.ReservationItem {
+	items := make([]model.ReservationItem, 0, len(grpcItems))
+	for _, item := range grpcItems {
+		items = append(items, model.ReservationItem{
+			PartUUID: item.GetPartUuid(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+	return items
+}
+
+// ConvertReservationToGRPC конвертирует внутреннюю модель Reservation в gRPC модель
+func ConvertReservationToGRPC(reservation *model.Reservation) *inventoryV1.Reservation {
+	items := make([]*inventoryV1.ReservationItem, len(reservation.Items))
+	for i, item := range reservation.Items {
+		items[i] = &inventoryV1.ReservationItem{
+			PartUuid: item.PartUUID,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return &inventoryV1.Reservation{
+		OrderUuid: reservation.OrderUUID,
+		Items:     items,
+		Status:    convertReservationStatusToGRPC(reservation.Status),
+		CreatedAt: timestamppb.New(reservation.CreatedAt),
+		UpdatedAt: timestamppb.New(reservation.UpdatedAt),
+	}
+}
+
+// convertReservationStatusToGRPC конвертирует внутренний статус резерва в gRPC статус
+func convertReservationStatusToGRPC(status model.ReservationStatus) inventoryV1.ReservationStatus {
+	switch status {
+	case model.ReservationStatusReserved:
+		return inventoryV1.ReservationStatus_RESERVATION_STATUS_RESERVED
+	case model.ReservationStatusCommitted:
+		return inventoryV1.ReservationStatus_RESERVATION_STATUS_COMMITTED
+	case model.ReservationStatusReleased:
+		return inventoryV1.ReservationStatus_RESERVATION_STATUS_RELEASED
+	default:
+		return inventoryV1.ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+	}
+}
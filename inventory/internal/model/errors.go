@@ -9,4 +9,26 @@ import (
 var (
 	ErrPartNotFound = sharedErrors.NewNotFoundError(errors.New("part not found"))
 	ErrInvalidUUID  = sharedErrors.NewInvalidArgumentError(errors.New("invalid uuid"))
+
+	ErrInvalidPageSize  = sharedErrors.NewInvalidArgumentError(errors.New("page size must not be negative"))
+	ErrInvalidPageToken = sharedErrors.NewInvalidArgumentError(errors.New("invalid page token"))
+
+	ErrInvalidRange             = sharedErrors.NewInvalidArgumentError(errors.New("range min must not exceed max"))
+	ErrInvalidMetadataPredicate = sharedErrors.NewInvalidArgumentError(errors.New("metadata predicate must have a valid key, a supported operator and a value"))
+
+	ErrPartVersionConflict  = sharedErrors.NewFailedPreconditionError(errors.New("part version conflict"))
+	ErrInvalidPartName      = sharedErrors.NewInvalidArgumentError(errors.New("part name is required"))
+	ErrInvalidPartPrice     = sharedErrors.NewInvalidArgumentError(errors.New("part price must be positive"))
+	ErrInvalidStockQuantity = sharedErrors.NewInvalidArgumentError(errors.New("part stock quantity must not be negative"))
+	ErrInvalidCategory      = sharedErrors.NewInvalidArgumentError(errors.New("part category must be specified"))
+	ErrInvalidDimensions    = sharedErrors.NewInvalidArgumentError(errors.New("part dimensions must be positive"))
+	ErrInvalidMetadata      = sharedErrors.NewInvalidArgumentError(errors.New("part metadata keys must be non-empty, without dots or a leading $, and values must be set"))
+
+	ErrInsufficientStock    = sharedErrors.NewFailedPreconditionError(errors.New("insufficient stock"))
+	ErrInvalidReservation   = sharedErrors.NewInvalidArgumentError(errors.New("reservation must contain parts with positive quantity"))
+	ErrReservationNotFound  = sharedErrors.NewNotFoundError(errors.New("reservation not found"))
+	ErrReservationCommitted = sharedErrors.NewFailedPreconditionError(errors.New("reservation already committed"))
+	ErrReservationReleased  = sharedErrors.NewFailedPreconditionError(errors.New("reservation already released"))
+	// ErrReservationInProgress - резерв заказа еще создается; запрос можно повторить
+	ErrReservationInProgress = sharedErrors.NewUnavailableError(errors.New("reservation is in progress"))
 )
@@ -0,0 +1,18 @@
+package model
+
+// PartsOrderBy - поле сортировки списка деталей
+type PartsOrderBy string
+
+const (
+	PartsOrderByCreatedAt PartsOrderBy = "created_at"
+	PartsOrderByPrice     PartsOrderBy = "price"
+	PartsOrderByName      PartsOrderBy = "name"
+)
+
+// PartsPageRequest - параметры страницы списка деталей
+type PartsPageRequest struct {
+	Size       int32
+	Token      string
+	OrderBy    PartsOrderBy
+	Descending bool
+}
@@ -1,6 +1,9 @@
 package model
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type Part struct {
 	UUID          string
@@ -15,6 +18,7 @@ type Part struct {
 	Metadata      map[string]*Value
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	Version       int64
 }
 
 type Category string
@@ -68,4 +72,60 @@ type PartsFilter struct {
 	Categories            []Category
 	ManufacturerCountries []string
 	Tags                  []string
+	TagsMatch             TagsMatch
+	Price                 *FloatRange
+	StockQuantity         *IntRange
+	Dimensions            *DimensionsFilter
+	Metadata              []MetadataPredicate
+	Text                  string
+}
+
+// FloatRange - диапазон с включенными границами, nil-граница не ограничивает диапазон
+type FloatRange struct {
+	Min *float64
+	Max *float64
+}
+
+// IntRange - диапазон с включенными границами, nil-граница не ограничивает диапазон
+type IntRange struct {
+	Min *int64
+	Max *int64
 }
+
+type DimensionsFilter struct {
+	Length *FloatRange
+	Width  *FloatRange
+	Height *FloatRange
+	Weight *FloatRange
+}
+
+type TagsMatch string
+
+const (
+	TagsMatchAny TagsMatch = "ANY"
+	TagsMatchAll TagsMatch = "ALL"
+)
+
+// MetadataPredicate - условие на значение метаданных по ключу
+type MetadataPredicate struct {
+	Key      string
+	Operator ComparisonOperator
+	Value    Value
+}
+
+// ValidMetadataKey сообщает, можно ли использовать key как ключ метаданных. Ключ становится
+// частью пути поля в MongoDB, поэтому он не может быть пустым, содержать точку или начинаться с $
+func ValidMetadataKey(key string) bool {
+	return strings.TrimSpace(key) != "" && !strings.Contains(key, ".") && !strings.HasPrefix(key, "$")
+}
+
+type ComparisonOperator string
+
+const (
+	ComparisonOperatorEQ  ComparisonOperator = "EQ"
+	ComparisonOperatorNE  ComparisonOperator = "NE"
+	ComparisonOperatorGT  ComparisonOperator = "GT"
+	ComparisonOperatorGTE ComparisonOperator = "GTE"
+	ComparisonOperatorLT  ComparisonOperator = "LT"
+	ComparisonOperatorLTE ComparisonOperator = "LTE"
+)
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type Reservation struct {
+	OrderUUID string
+	Items     []ReservationItem
+	Status    ReservationStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ReservationItem struct {
+	PartUUID string
+	Quantity int64
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusReserved  ReservationStatus = "RESERVED"
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+	ReservationStatusReleased  ReservationStatus = "RELEASED"
+)
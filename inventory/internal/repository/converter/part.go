@@ -89,6 +89,7 @@ func ConvertRepoPartToServicePart(repoPart *repoModel.Part) *serviceModel.Part {
 		Metadata:      convertRepoMetadataToServiceMetadata(repoPart.Metadata),
 		CreatedAt:     convertPrimitiveDateTimeToServiceTime(repoPart.CreatedAt),
 		UpdatedAt:     convertPrimitiveDateTimeToServiceTime(repoPart.UpdatedAt),
+		Version:       repoPart.Version,
 	}
 }
 
@@ -181,20 +182,18 @@ func convertServiceValueToRepoValue(serviceValue *serviceModel.Value) *repoModel
 		return nil
 	}
 
-	var value repoModel.Value
 	switch v := (*serviceValue).(type) {
 	case *serviceModel.StringValue:
-		value = &repoModel.StringValue{StringValue: v.StringValue}
+		return &repoModel.Value{StringValue: &v.StringValue}
 	case *serviceModel.Int64Value:
-		value = &repoModel.Int64Value{Int64Value: v.Int64Value}
+		return &repoModel.Value{Int64Value: &v.Int64Value}
 	case *serviceModel.DoubleValue:
-		value = &repoModel.DoubleValue{DoubleValue: v.DoubleValue}
+		return &repoModel.Value{DoubleValue: &v.DoubleValue}
 	case *serviceModel.BoolValue:
-		value = &repoModel.BoolValue{BoolValue: v.BoolValue}
+		return &repoModel.Value{BoolValue: &v.BoolValue}
 	default:
 		return nil
 	}
-	return &value
 }
 
 func convertRepoValueToServiceValue(repoValue *repoModel.Value) *serviceModel.Value {
@@ -203,15 +202,15 @@ func convertRepoValueToServiceValue(repoValue *repoModel.Value) *serviceModel.Va
 	}
 
 	var value serviceModel.Value
-	switch v := (*repoValue).(type) {
-	case *repoModel.StringValue:
-		value = &serviceModel.StringValue{StringValue: v.StringValue}
-	case *repoModel.Int64Value:
-		value = &serviceModel.Int64Value{Int64Value: v.Int64Value}
-	case *repoModel.DoubleValue:
-		value = &serviceModel.DoubleValue{DoubleValue: v.DoubleValue}
-	case *repoModel.BoolValue:
-		value = &serviceModel.BoolValue{BoolValue: v.BoolValue}
+	switch {
+	case repoValue.StringValue != nil:
+		value = &serviceModel.StringValue{StringValue: *repoValue.StringValue}
+	case repoValue.Int64Value != nil:
+		value = &serviceModel.Int64Value{Int64Value: *repoValue.Int64Value}
+	case repoValue.DoubleValue != nil:
+		value = &serviceModel.DoubleValue{DoubleValue: *repoValue.DoubleValue}
+	case repoValue.BoolValue != nil:
+		value = &serviceModel.BoolValue{BoolValue: *repoValue.BoolValue}
 	default:
 		return nil
 	}
@@ -7,6 +7,7 @@ import (
 
 	model "github.com/space-wanderer/microservices/inventory/internal/repository/model"
 	mock "github.com/stretchr/testify/mock"
+	time "time"
 )
 
 // InventoryRepository is an autogenerated mock type for the InventoryRepository type
@@ -22,6 +23,230 @@ func (_m *InventoryRepository) EXPECT() *InventoryRepository_Expecter {
 	return &InventoryRepository_Expecter{mock: &_m.Mock}
 }
 
+// AbandonStaleReservations provides a mock function with given fields: ctx, createdBefore, limit
+func (_m *InventoryRepository) AbandonStaleReservations(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	ret := _m.Called(ctx, createdBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbandonStaleReservations")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return rf(ctx, createdBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = rf(ctx, createdBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_AbandonStaleReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbandonStaleReservations'
+type InventoryRepository_AbandonStaleReservations_Call struct {
+	*mock.Call
+}
+
+// AbandonStaleReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+func (_e *InventoryRepository_Expecter) AbandonStaleReservations(ctx interface{}, createdBefore interface{}, limit interface{}) *InventoryRepository_AbandonStaleReservations_Call {
+	return &InventoryRepository_AbandonStaleReservations_Call{Call: _e.mock.On("AbandonStaleReservations", ctx, createdBefore, limit)}
+}
+
+func (_c *InventoryRepository_AbandonStaleReservations_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *InventoryRepository_AbandonStaleReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_AbandonStaleReservations_Call) Return(_a0 int, _a1 error) *InventoryRepository_AbandonStaleReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_AbandonStaleReservations_Call) RunAndReturn(run func(context.Context, time.Time, int) (int, error)) *InventoryRepository_AbandonStaleReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryRepository) CommitReservation(ctx context.Context, orderUUID string) (*model.Reservation, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Reservation, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Reservation); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryRepository_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryRepository_Expecter) CommitReservation(ctx interface{}, orderUUID interface{}) *InventoryRepository_CommitReservation_Call {
+	return &InventoryRepository_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) Return(_a0 *model.Reservation, _a1 error) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_CommitReservation_Call) RunAndReturn(run func(context.Context, string) (*model.Reservation, error)) *InventoryRepository_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *InventoryRepository) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) (*model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) *model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type InventoryRepository_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *InventoryRepository_Expecter) CreatePart(ctx interface{}, part interface{}) *InventoryRepository_CreatePart_Call {
+	return &InventoryRepository_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *InventoryRepository_CreatePart_Call) Run(run func(ctx context.Context, part *model.Part)) *InventoryRepository_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CreatePart_Call) Return(_a0 *model.Part, _a1 error) *InventoryRepository_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_CreatePart_Call) RunAndReturn(run func(context.Context, *model.Part) (*model.Part, error)) *InventoryRepository_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, uuid, version
+func (_m *InventoryRepository) DeletePart(ctx context.Context, uuid string, version int64) error {
+	ret := _m.Called(ctx, uuid, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, uuid, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type InventoryRepository_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - version int64
+func (_e *InventoryRepository_Expecter) DeletePart(ctx interface{}, uuid interface{}, version interface{}) *InventoryRepository_DeletePart_Call {
+	return &InventoryRepository_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, uuid, version)}
+}
+
+func (_c *InventoryRepository_DeletePart_Call) Run(run func(ctx context.Context, uuid string, version int64)) *InventoryRepository_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_DeletePart_Call) Return(_a0 error) *InventoryRepository_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_DeletePart_Call) RunAndReturn(run func(context.Context, string, int64) error) *InventoryRepository_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *InventoryRepository) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -81,75 +306,154 @@ func (_c *InventoryRepository_GetPart_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// InitSampleData provides a mock function with given fields: ctx
-func (_m *InventoryRepository) InitSampleData(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// ListParts provides a mock function with given fields: ctx, filter, page
+func (_m *InventoryRepository) ListParts(ctx context.Context, filter *model.PartsFilter, page *model.PartsPage) ([]*model.Part, bool, error) {
+	ret := _m.Called(ctx, filter, page)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InitSampleData")
+		panic("no return value specified for ListParts")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	var r0 []*model.Part
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter, *model.PartsPage) ([]*model.Part, bool, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter, *model.PartsPage) []*model.Part); ok {
+		r0 = rf(ctx, filter, page)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Part)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter, *model.PartsPage) bool); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.PartsFilter, *model.PartsPage) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// InventoryRepository_InitSampleData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitSampleData'
-type InventoryRepository_InitSampleData_Call struct {
+// InventoryRepository_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
+type InventoryRepository_ListParts_Call struct {
 	*mock.Call
 }
 
-// InitSampleData is a helper method to define mock.On call
+// ListParts is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *InventoryRepository_Expecter) InitSampleData(ctx interface{}) *InventoryRepository_InitSampleData_Call {
-	return &InventoryRepository_InitSampleData_Call{Call: _e.mock.On("InitSampleData", ctx)}
+//   - filter *model.PartsFilter
+//   - page *model.PartsPage
+func (_e *InventoryRepository_Expecter) ListParts(ctx interface{}, filter interface{}, page interface{}) *InventoryRepository_ListParts_Call {
+	return &InventoryRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter, page)}
 }
 
-func (_c *InventoryRepository_InitSampleData_Call) Run(run func(ctx context.Context)) *InventoryRepository_InitSampleData_Call {
+func (_c *InventoryRepository_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter, page *model.PartsPage)) *InventoryRepository_ListParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*model.PartsFilter), args[2].(*model.PartsPage))
 	})
 	return _c
 }
 
-func (_c *InventoryRepository_InitSampleData_Call) Return(_a0 error) *InventoryRepository_InitSampleData_Call {
-	_c.Call.Return(_a0)
+func (_c *InventoryRepository_ListParts_Call) Return(_a0 []*model.Part, _a1 bool, _a2 error) *InventoryRepository_ListParts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *InventoryRepository_InitSampleData_Call) RunAndReturn(run func(context.Context) error) *InventoryRepository_InitSampleData_Call {
+func (_c *InventoryRepository_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter, *model.PartsPage) ([]*model.Part, bool, error)) *InventoryRepository_ListParts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *InventoryRepository) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
-	ret := _m.Called(ctx, filter)
+// ReleaseExpiredReservations provides a mock function with given fields: ctx, createdBefore, limit
+func (_m *InventoryRepository) ReleaseExpiredReservations(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	ret := _m.Called(ctx, createdBefore, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListParts")
+		panic("no return value specified for ReleaseExpiredReservations")
 	}
 
-	var r0 []*model.Part
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return rf(ctx, createdBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = rf(ctx, createdBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ReleaseExpiredReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseExpiredReservations'
+type InventoryRepository_ReleaseExpiredReservations_Call struct {
+	*mock.Call
+}
+
+// ReleaseExpiredReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+func (_e *InventoryRepository_Expecter) ReleaseExpiredReservations(ctx interface{}, createdBefore interface{}, limit interface{}) *InventoryRepository_ReleaseExpiredReservations_Call {
+	return &InventoryRepository_ReleaseExpiredReservations_Call{Call: _e.mock.On("ReleaseExpiredReservations", ctx, createdBefore, limit)}
+}
+
+func (_c *InventoryRepository_ReleaseExpiredReservations_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *InventoryRepository_ReleaseExpiredReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseExpiredReservations_Call) Return(_a0 int, _a1 error) *InventoryRepository_ReleaseExpiredReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseExpiredReservations_Call) RunAndReturn(run func(context.Context, time.Time, int) (int, error)) *InventoryRepository_ReleaseExpiredReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryRepository) ReleaseReservation(ctx context.Context, orderUUID string) (*model.Reservation, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 *model.Reservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) ([]*model.Part, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Reservation, error)); ok {
+		return rf(ctx, orderUUID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) []*model.Part); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Reservation); ok {
+		r0 = rf(ctx, orderUUID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Part)
+			r0 = ret.Get(0).(*model.Reservation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -157,31 +461,151 @@ func (_m *InventoryRepository) ListParts(ctx context.Context, filter *model.Part
 	return r0, r1
 }
 
-// InventoryRepository_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
-type InventoryRepository_ListParts_Call struct {
+// InventoryRepository_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryRepository_ReleaseReservation_Call struct {
 	*mock.Call
 }
 
-// ListParts is a helper method to define mock.On call
+// ReleaseReservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter *model.PartsFilter
-func (_e *InventoryRepository_Expecter) ListParts(ctx interface{}, filter interface{}) *InventoryRepository_ListParts_Call {
-	return &InventoryRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - orderUUID string
+func (_e *InventoryRepository_Expecter) ReleaseReservation(ctx interface{}, orderUUID interface{}) *InventoryRepository_ReleaseReservation_Call {
+	return &InventoryRepository_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) Return(_a0 *model.Reservation, _a1 error) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) (*model.Reservation, error)) *InventoryRepository_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, reservation
+func (_m *InventoryRepository) ReserveParts(ctx context.Context, reservation *model.Reservation) (*model.Reservation, error) {
+	ret := _m.Called(ctx, reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Reservation) (*model.Reservation, error)); ok {
+		return rf(ctx, reservation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Reservation) *model.Reservation); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Reservation) error); ok {
+		r1 = rf(ctx, reservation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryRepository_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservation *model.Reservation
+func (_e *InventoryRepository_Expecter) ReserveParts(ctx interface{}, reservation interface{}) *InventoryRepository_ReserveParts_Call {
+	return &InventoryRepository_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, reservation)}
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) Run(run func(ctx context.Context, reservation *model.Reservation)) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Reservation))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) Return(_a0 *model.Reservation, _a1 error) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ReserveParts_Call) RunAndReturn(run func(context.Context, *model.Reservation) (*model.Reservation, error)) *InventoryRepository_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, uuid, version, part
+func (_m *InventoryRepository) UpdatePart(ctx context.Context, uuid string, version int64, part *model.Part) (*model.Part, error) {
+	ret := _m.Called(ctx, uuid, version, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, *model.Part) (*model.Part, error)); ok {
+		return rf(ctx, uuid, version, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, *model.Part) *model.Part); ok {
+		r0 = rf(ctx, uuid, version, part)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, *model.Part) error); ok {
+		r1 = rf(ctx, uuid, version, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type InventoryRepository_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - version int64
+//   - part *model.Part
+func (_e *InventoryRepository_Expecter) UpdatePart(ctx interface{}, uuid interface{}, version interface{}, part interface{}) *InventoryRepository_UpdatePart_Call {
+	return &InventoryRepository_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, uuid, version, part)}
 }
 
-func (_c *InventoryRepository_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter)) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_UpdatePart_Call) Run(run func(ctx context.Context, uuid string, version int64, part *model.Part)) *InventoryRepository_UpdatePart_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.PartsFilter))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(*model.Part))
 	})
 	return _c
 }
 
-func (_c *InventoryRepository_ListParts_Call) Return(_a0 []*model.Part, _a1 error) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_UpdatePart_Call) Return(_a0 *model.Part, _a1 error) *InventoryRepository_UpdatePart_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InventoryRepository_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter) ([]*model.Part, error)) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_UpdatePart_Call) RunAndReturn(run func(context.Context, string, int64, *model.Part) (*model.Part, error)) *InventoryRepository_UpdatePart_Call {
 	_c.Call.Return(run)
 	return _c
 }
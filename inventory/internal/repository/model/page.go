@@ -0,0 +1,29 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// PartsOrderBy - поле документа, по которому сортируется список деталей
+type PartsOrderBy string
+
+const (
+	PartsOrderByCreatedAt PartsOrderBy = "created_at"
+	PartsOrderByPrice     PartsOrderBy = "price"
+	PartsOrderByName      PartsOrderBy = "name"
+)
+
+// PartsPage - параметры keyset-страницы: выдача продолжается строго после After
+// в порядке (OrderBy, uuid)
+type PartsPage struct {
+	Size       int64
+	OrderBy    PartsOrderBy
+	Descending bool
+	After      *PartsCursor
+}
+
+// PartsCursor - ключ последней детали предыдущей страницы
+type PartsCursor struct {
+	UUID      string
+	Price     float64
+	Name      string
+	CreatedAt primitive.DateTime
+}
@@ -5,19 +5,22 @@ import (
 )
 
 type Part struct {
-	ID            primitive.ObjectID `bson:"_id,omitempty" json:"-"`
-	UUID          string             `bson:"uuid" json:"uuid"`
-	Name          string             `bson:"name" json:"name"`
-	Description   string             `bson:"description" json:"description"`
-	Price         float64            `bson:"price" json:"price"`
-	StockQuantity int64              `bson:"stock_quantity" json:"stock_quantity"`
-	Category      Category           `bson:"category" json:"category"`
-	Dimensions    *Dimensions        `bson:"dimensions" json:"dimensions"`
-	Manufacturer  *Manufacturer      `bson:"manufacturer" json:"manufacturer"`
-	Tags          []string           `bson:"tags" json:"tags"`
-	Metadata      map[string]*Value  `bson:"metadata" json:"metadata"`
-	CreatedAt     primitive.DateTime `bson:"created_at" json:"created_at"`
-	UpdatedAt     primitive.DateTime `bson:"updated_at" json:"updated_at"`
+	ID               primitive.ObjectID  `bson:"_id,omitempty" json:"-"`
+	UUID             string              `bson:"uuid" json:"uuid"`
+	Name             string              `bson:"name" json:"name"`
+	Description      string              `bson:"description" json:"description"`
+	Price            float64             `bson:"price" json:"price"`
+	StockQuantity    int64               `bson:"stock_quantity" json:"stock_quantity"`
+	ReservedQuantity int64               `bson:"reserved_quantity" json:"reserved_quantity"`
+	Category         Category            `bson:"category" json:"category"`
+	Dimensions       *Dimensions         `bson:"dimensions" json:"dimensions"`
+	Manufacturer     *Manufacturer       `bson:"manufacturer" json:"manufacturer"`
+	Tags             []string            `bson:"tags" json:"tags"`
+	Metadata         map[string]*Value   `bson:"metadata" json:"metadata"`
+	CreatedAt        primitive.DateTime  `bson:"created_at" json:"created_at"`
+	UpdatedAt        primitive.DateTime  `bson:"updated_at" json:"updated_at"`
+	Version          int64               `bson:"version" json:"version"`
+	DeletedAt        *primitive.DateTime `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
 }
 
 type Category string
@@ -43,32 +46,69 @@ type Manufacturer struct {
 	Website string
 }
 
-type Value interface {
-	isValue()
+// Value - значение метаданных, заполнено ровно одно из полей.
+// Хранится как документ, потому что интерфейс нельзя прочитать из BSON обратно
+type Value struct {
+	StringValue *string  `bson:"string_value,omitempty" json:"string_value,omitempty"`
+	Int64Value  *int64   `bson:"int64_value,omitempty" json:"int64_value,omitempty"`
+	DoubleValue *float64 `bson:"double_value,omitempty" json:"double_value,omitempty"`
+	BoolValue   *bool    `bson:"bool_value,omitempty" json:"bool_value,omitempty"`
 }
 
-type StringValue struct {
-	StringValue string
-}
-type Int64Value struct {
-	Int64Value int64
-}
-type DoubleValue struct {
-	DoubleValue float64
-}
-type BoolValue struct {
-	BoolValue bool
-}
-
-func (v StringValue) isValue() {}
-func (v Int64Value) isValue()  {}
-func (v DoubleValue) isValue() {}
-func (v BoolValue) isValue()   {}
-
 type PartsFilter struct {
 	Uuids                 []string
 	Names                 []string
 	Categories            []Category
 	ManufacturerCountries []string
 	Tags                  []string
+	TagsMatch             TagsMatch
+	Price                 *FloatRange
+	StockQuantity         *IntRange
+	Dimensions            *DimensionsFilter
+	Metadata              []MetadataPredicate
+	Text                  string
+}
+
+// FloatRange - диапазон с включенными границами, nil-граница не ограничивает диапазон
+type FloatRange struct {
+	Min *float64
+	Max *float64
+}
+
+// IntRange - диапазон с включенными границами, nil-граница не ограничивает диапазон
+type IntRange struct {
+	Min *int64
+	Max *int64
+}
+
+type DimensionsFilter struct {
+	Length *FloatRange
+	Width  *FloatRange
+	Height *FloatRange
+	Weight *FloatRange
 }
+
+type TagsMatch string
+
+const (
+	TagsMatchAny TagsMatch = "ANY"
+	TagsMatchAll TagsMatch = "ALL"
+)
+
+// MetadataPredicate - условие на значение метаданных по ключу
+type MetadataPredicate struct {
+	Key      string
+	Operator ComparisonOperator
+	Value    *Value
+}
+
+type ComparisonOperator string
+
+const (
+	ComparisonOperatorEQ  ComparisonOperator = "EQ"
+	ComparisonOperatorNE  ComparisonOperator = "NE"
+	ComparisonOperatorGT  ComparisonOperator = "GT"
+	ComparisonOperatorGTE ComparisonOperator = "GTE"
+	ComparisonOperatorLT  ComparisonOperator = "LT"
+	ComparisonOperatorLTE ComparisonOperator = "LTE"
+)
@@ -0,0 +1,28 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type Reservation struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"-"`
+	OrderUUID string             `bson:"order_uuid" json:"order_uuid"`
+	Items     []ReservationItem  `bson:"items" json:"items"`
+	Status    ReservationStatus  `bson:"status" json:"status"`
+	CreatedAt primitive.DateTime `bson:"created_at" json:"created_at"`
+	UpdatedAt primitive.DateTime `bson:"updated_at" json:"updated_at"`
+}
+
+type ReservationItem struct {
+	PartUUID string `bson:"part_uuid" json:"part_uuid"`
+	Quantity int64  `bson:"quantity" json:"quantity"`
+}
+
+type ReservationStatus string
+
+const (
+	// ReservationStatusPending - резерв создан, но списаны еще не все позиции. Такой резерв
+	// не возвращается клиенту: он либо становится RESERVED, либо откатывается
+	ReservationStatusPending   ReservationStatus = "PENDING"
+	ReservationStatusReserved  ReservationStatus = "RESERVED"
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+	ReservationStatusReleased  ReservationStatus = "RELEASED"
+)
@@ -0,0 +1,25 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+func (r *repository) CreatePart(ctx context.Context, part *repoModel.Part) (*repoModel.Part, error) {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	part.CreatedAt = now
+	part.UpdatedAt = now
+	part.Version = 1
+
+	_, err := r.collection.InsertOne(ctx, part)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create part: %w", err)
+	}
+
+	return part, nil
+}
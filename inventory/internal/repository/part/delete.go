@@ -0,0 +1,31 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DeletePart помечает деталь удаленной, если ее текущая версия равна version.
+// Документ остается в коллекции, чтобы резервы и история заказов продолжали на него ссылаться
+func (r *repository) DeletePart(ctx context.Context, uuid string, version int64) error {
+	filter := activePartFilter(uuid)
+	filter["version"] = versionFilter(version)
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{"deleted_at": now, "updated_at": now},
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete part: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return r.writeConflictError(ctx, uuid)
+	}
+
+	return nil
+}
@@ -15,7 +15,7 @@ import (
 func (r *repository) GetPart(ctx context.Context, uuid string) (*repoModel.Part, error) {
 	var part repoModel.Part
 
-	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&part)
+	err := r.collection.FindOne(ctx, activePartFilter(uuid)).Decode(&part)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, model.ErrPartNotFound
@@ -25,3 +25,8 @@ func (r *repository) GetPart(ctx context.Context, uuid string) (*repoModel.Part,
 
 	return &part, nil
 }
+
+// activePartFilter выбирает деталь по UUID, если она не удалена
+func activePartFilter(uuid string) bson.M {
+	return bson.M{"uuid": uuid, "deleted_at": bson.M{"$exists": false}}
+}
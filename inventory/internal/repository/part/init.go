@@ -5,28 +5,72 @@ import (
 	"log"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
 )
 
 type repository struct {
-	collection *mongo.Collection
+	collection   *mongo.Collection
+	reservations *mongo.Collection
 }
 
 func NewRepository(ctx context.Context, db *mongo.Database) *repository {
 	collection := db.Collection("parts")
+	if err := initPartIndexes(ctx, collection); err != nil {
+		log.Printf("warning: failed to create part indexes: %v", err)
+	}
 	if err := InitSampleData(collection, ctx); err != nil {
 		// Логируем ошибку, но не прерываем работу
 		log.Printf("warning: failed to init sample data: %v", err)
 	}
 
+	reservations := db.Collection("reservations")
+	if err := initReservationIndexes(ctx, reservations); err != nil {
+		log.Printf("warning: failed to create reservation indexes: %v", err)
+	}
+
 	return &repository{
-		collection: collection,
+		collection:   collection,
+		reservations: reservations,
 	}
 }
 
+// initPartIndexes гарантирует уникальность UUID детали и создает индексы
+// под каждую сортировку keyset-пагинации и полнотекстовый индекс по названию и описанию
+func initPartIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "created_at", Value: 1}, {Key: "uuid", Value: 1}}},
+		{Keys: bson.D{{Key: "price", Value: 1}, {Key: "uuid", Value: 1}}},
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "uuid", Value: 1}}},
+		{
+			Keys:    bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().SetDefaultLanguage("russian"),
+		},
+	})
+	return err
+}
+
+// initReservationIndexes гарантирует не более одного резерва на заказ
+// и создает индекс для выборки зависших и просроченных резервов
+func initReservationIndexes(ctx context.Context, reservations *mongo.Collection) error {
+	_, err := reservations.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "order_uuid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}}},
+	})
+	return err
+}
+
 // InitSampleData инициализирует коллекцию с тестовыми данными
 func InitSampleData(collection *mongo.Collection, ctx context.Context) error {
 	// Проверяем, есть ли уже данные в коллекции
@@ -46,6 +90,7 @@ func InitSampleData(collection *mongo.Collection, ctx context.Context) error {
 	// Конвертируем map в slice для вставки
 	var documents []interface{}
 	for _, part := range sampleParts {
+		part.Version = 1
 		documents = append(documents, part)
 	}
 
@@ -5,18 +5,47 @@ import (
 	"log"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/space-wanderer/microservices/inventory/internal/model"
 	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
 )
 
-func (r *repository) ListParts(ctx context.Context, filter *repoModel.PartsFilter) ([]*repoModel.Part, error) {
-	// Строим фильтр для MongoDB
+// ListParts возвращает одну страницу деталей и признак того, что за ней есть еще детали.
+// Страница выбирается по ключу (поле сортировки, uuid), поэтому стоимость запроса
+// не зависит от глубины пролистывания
+func (r *repository) ListParts(ctx context.Context, filter *repoModel.PartsFilter, page *repoModel.PartsPage) ([]*repoModel.Part, bool, error) {
+	// Ключ метаданных подставляется в путь поля, поэтому недопустимый ключ не доходит до запроса
+	if filter != nil {
+		for _, predicate := range filter.Metadata {
+			if !model.ValidMetadataKey(predicate.Key) {
+				return nil, false, model.ErrInvalidMetadataPredicate
+			}
+		}
+	}
+
+	// Строим фильтр для MongoDB, удаленные детали в выдачу не попадают
 	mongoFilter := buildMongoFilter(filter)
+	mongoFilter["deleted_at"] = bson.M{"$exists": false}
+	if page.After != nil {
+		and, _ := mongoFilter["$and"].(bson.A)
+		mongoFilter["$and"] = append(and, buildKeysetFilter(page))
+	}
+
+	direction := 1
+	if page.Descending {
+		direction = -1
+	}
+
+	// Запрашиваем на одну деталь больше, чтобы узнать, есть ли следующая страница
+	opts := options.Find().
+		SetSort(bson.D{{Key: string(page.OrderBy), Value: direction}, {Key: "uuid", Value: direction}}).
+		SetLimit(page.Size + 1)
 
 	// Выполняем запрос
-	cursor, err := r.collection.Find(ctx, mongoFilter)
+	cursor, err := r.collection.Find(ctx, mongoFilter, opts)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer func() {
 		if err := cursor.Close(ctx); err != nil {
@@ -27,19 +56,47 @@ func (r *repository) ListParts(ctx context.Context, filter *repoModel.PartsFilte
 	// Читаем результаты
 	var parts []*repoModel.Part
 	if err = cursor.All(ctx, &parts); err != nil {
-		return nil, err
+		return nil, false, err
+	}
+
+	hasMore := int64(len(parts)) > page.Size
+	if hasMore {
+		parts = parts[:page.Size]
 	}
 
-	return parts, nil
+	return parts, hasMore, nil
 }
 
-// buildMongoFilter строит фильтр для MongoDB на основе PartsFilter
-func buildMongoFilter(filter *repoModel.PartsFilter) bson.M {
-	if filter == nil || isEmptyFilter(filter) {
-		return bson.M{}
+// buildKeysetFilter выбирает детали, которые в порядке сортировки идут после курсора
+func buildKeysetFilter(page *repoModel.PartsPage) bson.M {
+	op := "$gt"
+	if page.Descending {
+		op = "$lt"
+	}
+
+	var value interface{}
+	switch page.OrderBy {
+	case repoModel.PartsOrderByPrice:
+		value = page.After.Price
+	case repoModel.PartsOrderByName:
+		value = page.After.Name
+	default:
+		value = page.After.CreatedAt
 	}
 
+	field := string(page.OrderBy)
+	return bson.M{"$or": bson.A{
+		bson.M{field: bson.M{op: value}},
+		bson.M{field: value, "uuid": bson.M{op: page.After.UUID}},
+	}}
+}
+
+// buildMongoFilter строит фильтр для MongoDB на основе PartsFilter
+func buildMongoFilter(filter *repoModel.PartsFilter) bson.M {
 	mongoFilter := bson.M{}
+	if filter == nil {
+		return mongoFilter
+	}
 
 	// Фильтр по UUID
 	if len(filter.Uuids) > 0 {
@@ -61,19 +118,119 @@ func buildMongoFilter(filter *repoModel.PartsFilter) bson.M {
 		mongoFilter["manufacturer.country"] = bson.M{"$in": filter.ManufacturerCountries}
 	}
 
-	// Фильтр по тегам
+	// Фильтр по тегам: любой из тегов или все теги сразу
 	if len(filter.Tags) > 0 {
-		mongoFilter["tags"] = bson.M{"$in": filter.Tags}
+		op := "$in"
+		if filter.TagsMatch == repoModel.TagsMatchAll {
+			op = "$all"
+		}
+		mongoFilter["tags"] = bson.M{op: filter.Tags}
+	}
+
+	// Фильтры по диапазонам
+	addRange(mongoFilter, "price", floatRangeBounds(filter.Price))
+	addRange(mongoFilter, "stock_quantity", intRangeBounds(filter.StockQuantity))
+	if filter.Dimensions != nil {
+		addRange(mongoFilter, "dimensions.length", floatRangeBounds(filter.Dimensions.Length))
+		addRange(mongoFilter, "dimensions.width", floatRangeBounds(filter.Dimensions.Width))
+		addRange(mongoFilter, "dimensions.height", floatRangeBounds(filter.Dimensions.Height))
+		addRange(mongoFilter, "dimensions.weight", floatRangeBounds(filter.Dimensions.Weight))
+	}
+
+	// Условия на метаданные
+	if len(filter.Metadata) > 0 {
+		and := make(bson.A, 0, len(filter.Metadata))
+		for _, predicate := range filter.Metadata {
+			and = append(and, buildMetadataClause(predicate))
+		}
+		mongoFilter["$and"] = and
+	}
+
+	// Полнотекстовый поиск по названию и описанию
+	if filter.Text != "" {
+		mongoFilter["$text"] = bson.M{"$search": filter.Text}
 	}
 
 	return mongoFilter
 }
 
-// isEmptyFilter проверяет, пуст ли фильтр
-func isEmptyFilter(filter *repoModel.PartsFilter) bool {
-	return len(filter.Uuids) == 0 &&
-		len(filter.Names) == 0 &&
-		len(filter.Categories) == 0 &&
-		len(filter.ManufacturerCountries) == 0 &&
-		len(filter.Tags) == 0
+// addRange добавляет в фильтр условие на диапазон, если задана хотя бы одна граница
+func addRange(mongoFilter bson.M, field string, bounds bson.M) {
+	if len(bounds) > 0 {
+		mongoFilter[field] = bounds
+	}
+}
+
+func floatRangeBounds(r *repoModel.FloatRange) bson.M {
+	bounds := bson.M{}
+	if r == nil {
+		return bounds
+	}
+	if r.Min != nil {
+		bounds["$gte"] = *r.Min
+	}
+	if r.Max != nil {
+		bounds["$lte"] = *r.Max
+	}
+	return bounds
+}
+
+func intRangeBounds(r *repoModel.IntRange) bson.M {
+	bounds := bson.M{}
+	if r == nil {
+		return bounds
+	}
+	if r.Min != nil {
+		bounds["$gte"] = *r.Min
+	}
+	if r.Max != nil {
+		bounds["$lte"] = *r.Max
+	}
+	return bounds
+}
+
+// comparisonOperators сопоставляет операторы сравнения с операторами MongoDB
+var comparisonOperators = map[repoModel.ComparisonOperator]string{
+	repoModel.ComparisonOperatorEQ:  "$eq",
+	repoModel.ComparisonOperatorGT:  "$gt",
+	repoModel.ComparisonOperatorGTE: "$gte",
+	repoModel.ComparisonOperatorLT:  "$lt",
+	repoModel.ComparisonOperatorLTE: "$lte",
+}
+
+// buildMetadataClause строит условие на одно значение метаданных.
+// Целые и дробные числа сравниваются между собой, поэтому числовое условие
+// проверяет оба поля значения. NE строится как отрицание равенства
+func buildMetadataClause(predicate repoModel.MetadataPredicate) bson.M {
+	op, ok := comparisonOperators[predicate.Operator]
+	if predicate.Operator == repoModel.ComparisonOperatorNE || !ok {
+		op = "$eq"
+	}
+
+	prefix := "metadata." + predicate.Key + "."
+	var clause bson.M
+	switch v := predicate.Value; {
+	case v == nil:
+		clause = bson.M{prefix + "string_value": bson.M{op: nil}}
+	case v.StringValue != nil:
+		clause = bson.M{prefix + "string_value": bson.M{op: *v.StringValue}}
+	case v.BoolValue != nil:
+		clause = bson.M{prefix + "bool_value": bson.M{op: *v.BoolValue}}
+	default:
+		var number interface{}
+		if v.Int64Value != nil {
+			number = *v.Int64Value
+		} else if v.DoubleValue != nil {
+			number = *v.DoubleValue
+		}
+		clause = bson.M{"$or": bson.A{
+			bson.M{prefix + "int64_value": bson.M{op: number}},
+			bson.M{prefix + "double_value": bson.M{op: number}},
+		}}
+	}
+
+	if predicate.Operator == repoModel.ComparisonOperatorNE {
+		return bson.M{"$nor": bson.A{clause}}
+	}
+	return clause
 }
@@ -7,7 +7,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 
+	"github.com/space-wanderer/microservices/inventory/internal/model"
 	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
 	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
 )
@@ -81,15 +83,18 @@ func (s *ListPartsTestSuite) TestListParts_WithMockRepository() {
 		},
 	}
 
-	s.mockRepository.On("ListParts", ctx, filter).Return(expectedParts, nil).Once()
+	page := &repoModel.PartsPage{Size: 50, OrderBy: repoModel.PartsOrderByCreatedAt}
+
+	s.mockRepository.On("ListParts", ctx, filter, page).Return(expectedParts, false, nil).Once()
 
 	// Act
-	result, err := s.mockRepository.ListParts(ctx, filter)
+	result, hasMore, err := s.mockRepository.ListParts(ctx, filter, page)
 
 	// Assert
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
 	assert.Len(s.T(), result, 2)
+	assert.False(s.T(), hasMore)
 	assert.Equal(s.T(), "test-uuid-1", result[0].UUID)
 	assert.Equal(s.T(), "test-uuid-2", result[1].UUID)
 }
@@ -100,15 +105,97 @@ func (s *ListPartsTestSuite) TestListParts_MockRepositoryError() {
 	filter := &repoModel.PartsFilter{
 		Categories: []repoModel.Category{repoModel.CategoryEngine},
 	}
+	page := &repoModel.PartsPage{Size: 50, OrderBy: repoModel.PartsOrderByCreatedAt}
 	expectedError := errors.New("database error")
 
-	s.mockRepository.On("ListParts", ctx, filter).Return(nil, expectedError).Once()
+	s.mockRepository.On("ListParts", ctx, filter, page).Return(nil, false, expectedError).Once()
 
 	// Act
-	result, err := s.mockRepository.ListParts(ctx, filter)
+	result, _, err := s.mockRepository.ListParts(ctx, filter, page)
 
 	// Assert
 	assert.Error(s.T(), err)
 	assert.Nil(s.T(), result)
 	assert.Equal(s.T(), expectedError, err)
 }
+
+func TestBuildMongoFilter(t *testing.T) {
+	minPrice, maxStock := 100.0, int64(5)
+	power, certified := int64(100), true
+
+	tests := []struct {
+		name     string
+		filter   *repoModel.PartsFilter
+		expected bson.M
+	}{
+		{
+			name:     "Пустой фильтр",
+			filter:   nil,
+			expected: bson.M{},
+		},
+		{
+			name: "Все теги и диапазоны",
+			filter: &repoModel.PartsFilter{
+				Tags:          []string{"a", "b"},
+				TagsMatch:     repoModel.TagsMatchAll,
+				Price:         &repoModel.FloatRange{Min: &minPrice},
+				StockQuantity: &repoModel.IntRange{Max: &maxStock},
+				Dimensions:    &repoModel.DimensionsFilter{Length: &repoModel.FloatRange{Min: &minPrice}},
+			},
+			expected: bson.M{
+				"tags":              bson.M{"$all": []string{"a", "b"}},
+				"price":             bson.M{"$gte": minPrice},
+				"stock_quantity":    bson.M{"$lte": maxStock},
+				"dimensions.length": bson.M{"$gte": minPrice},
+			},
+		},
+		{
+			name: "Метаданные и полнотекстовый поиск",
+			filter: &repoModel.PartsFilter{
+				Metadata: []repoModel.MetadataPredicate{
+					{Key: "power", Operator: repoModel.ComparisonOperatorGT, Value: &repoModel.Value{Int64Value: &power}},
+					{Key: "certified", Operator: repoModel.ComparisonOperatorNE, Value: &repoModel.Value{BoolValue: &certified}},
+				},
+				Text: "двигатель",
+			},
+			expected: bson.M{
+				"$and": bson.A{
+					bson.M{"$or": bson.A{
+						bson.M{"metadata.power.int64_value": bson.M{"$gt": power}},
+						bson.M{"metadata.power.double_value": bson.M{"$gt": power}},
+					}},
+					bson.M{"$nor": bson.A{
+						bson.M{"metadata.certified.bool_value": bson.M{"$eq": certified}},
+					}},
+				},
+				"$text": bson.M{"$search": "двигатель"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildMongoFilter(tt.filter))
+		})
+	}
+}
+
+func TestListParts_InvalidMetadataKey(t *testing.T) {
+	power := int64(100)
+
+	for _, key := range []string{"", "power.max", "$where"} {
+		t.Run(key, func(t *testing.T) {
+			// Коллекция не нужна: запрос с недопустимым ключом не должен дойти до MongoDB
+			repo := &repository{}
+			filter := &repoModel.PartsFilter{Metadata: []repoModel.MetadataPredicate{
+				{Key: key, Operator: repoModel.ComparisonOperatorEQ, Value: &repoModel.Value{Int64Value: &power}},
+			}}
+
+			parts, hasMore, err := repo.ListParts(context.Background(), filter, &repoModel.PartsPage{})
+
+			assert.ErrorIs(t, err, model.ErrInvalidMetadataPredicate)
+			assert.Nil(t, parts)
+			assert.False(t, hasMore)
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+// reservationHoldsField - поле детали с ID незавершенных резервов, уже списавших ее остаток.
+// Метка ставится тем же обновлением, что и списание, поэтому по ней откат резерва
+// возвращает на склад ровно те позиции, которые успел списать
+const reservationHoldsField = "reservation_holds"
+
+// reservationStatusAbandoned - незавершенный резерв, который откатывается. Статус внутренний:
+// после возврата списанных позиций резерв удаляется, и заказ можно зарезервировать заново
+const reservationStatusAbandoned repoModel.ReservationStatus = "ABANDONED"
+
+// ReserveParts резервирует детали под заказ. Резерв создается в статусе PENDING, затем остаток
+// каждой детали уменьшается атомарно и только если его хватает, и лишь после списания всех позиций
+// резерв становится RESERVED. При нехватке уже списанные позиции возвращаются на склад, а если процесс
+// прервался, незавершенный резерв откатит AbandonStaleReservations.
+// Изменение остатка повышает версию детали, чтобы UpdatePart не перезаписал его устаревшим значением.
+func (r *repository) ReserveParts(ctx context.Context, reservation *repoModel.Reservation) (*repoModel.Reservation, error) {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	reservation.Status = repoModel.ReservationStatusPending
+	reservation.CreatedAt = now
+	reservation.UpdatedAt = now
+
+	result, err := r.reservations.InsertOne(ctx, reservation)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			// Повторный запрос по тому же заказу
+			return r.existingReservation(ctx, reservation.OrderUUID)
+		}
+		return nil, fmt.Errorf("failed to create reservation: %w", err)
+	}
+	reservation.ID = result.InsertedID.(primitive.ObjectID)
+
+	for _, item := range reservation.Items {
+		updated, err := r.collection.UpdateOne(ctx,
+			bson.M{
+				"uuid":                item.PartUUID,
+				"deleted_at":          bson.M{"$exists": false},
+				"stock_quantity":      bson.M{"$gte": item.Quantity},
+				reservationHoldsField: bson.M{"$ne": reservation.ID},
+			},
+			bson.M{
+				"$inc":      bson.M{"stock_quantity": -item.Quantity, "reserved_quantity": item.Quantity, "version": 1},
+				"$set":      bson.M{"updated_at": now},
+				"$addToSet": bson.M{reservationHoldsField: reservation.ID},
+			},
+		)
+		if err == nil && updated.MatchedCount == 1 {
+			continue
+		}
+
+		if abandonErr := r.abandonReservation(ctx, reservation); abandonErr != nil {
+			log.Printf("warning: failed to roll back reservation of order %s: %v", reservation.OrderUUID, abandonErr)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve part %s: %w", item.PartUUID, err)
+		}
+
+		count, err := r.collection.CountDocuments(ctx, activePartFilter(item.PartUUID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to check part %s: %w", item.PartUUID, err)
+		}
+		if count == 0 {
+			return nil, model.ErrPartNotFound
+		}
+
+		return nil, model.ErrInsufficientStock
+	}
+
+	// Резерв начинает действовать, только если его не успели признать зависшим и откатить
+	updated, err := r.reservations.UpdateOne(ctx,
+		bson.M{"_id": reservation.ID, "status": repoModel.ReservationStatusPending},
+		bson.M{"$set": bson.M{"status": repoModel.ReservationStatusReserved, "updated_at": now}},
+	)
+	if err != nil {
+		// Резерв остается PENDING и будет откачен AbandonStaleReservations
+		return nil, fmt.Errorf("failed to complete reservation: %w", err)
+	}
+	if updated.MatchedCount == 0 {
+		return nil, model.ErrReservationInProgress
+	}
+	reservation.Status = repoModel.ReservationStatusReserved
+
+	r.clearHolds(ctx, reservation)
+
+	return reservation, nil
+}
+
+// CommitReservation подтверждает резерв: детали окончательно списываются со склада
+func (r *repository) CommitReservation(ctx context.Context, orderUUID string) (*repoModel.Reservation, error) {
+	reservation, from, err := r.switchReservationStatus(ctx, orderUUID, repoModel.ReservationStatusCommitted, repoModel.ReservationStatusReserved)
+	if err != nil {
+		return nil, err
+	}
+	if from == "" {
+		return reservation, nil
+	}
+
+	for _, item := range reservation.Items {
+		_, err = r.collection.UpdateOne(ctx,
+			bson.M{"uuid": item.PartUUID},
+			bson.M{
+				"$inc":  bson.M{"reserved_quantity": -item.Quantity},
+				"$set":  bson.M{"updated_at": reservation.UpdatedAt},
+				"$pull": bson.M{reservationHoldsField: reservation.ID},
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to commit part %s: %w", item.PartUUID, err)
+		}
+	}
+
+	return reservation, nil
+}
+
+// ReleaseReservation снимает резерв и возвращает детали на склад. Подтвержденный резерв
+// тоже снимается: так на склад возвращаются детали оплаченного заказа, отмененного с возвратом средств
+func (r *repository) ReleaseReservation(ctx context.Context, orderUUID string) (*repoModel.Reservation, error) {
+	reservation, from, err := r.switchReservationStatus(ctx, orderUUID, repoModel.ReservationStatusReleased,
+		repoModel.ReservationStatusReserved, repoModel.ReservationStatusCommitted)
+	if err != nil {
+		return nil, err
+	}
+	if from == "" {
+		return reservation, nil
+	}
+
+	for _, item := range reservation.Items {
+		inc := bson.M{"stock_quantity": item.Quantity, "version": 1}
+		// Подтвержденные детали уже сняты с reserved_quantity при подтверждении
+		if from == repoModel.ReservationStatusReserved {
+			inc["reserved_quantity"] = -item.Quantity
+		}
+
+		_, err = r.collection.UpdateOne(ctx,
+			bson.M{"uuid": item.PartUUID},
+			bson.M{
+				"$inc":  inc,
+				"$set":  bson.M{"updated_at": reservation.UpdatedAt},
+				"$pull": bson.M{reservationHoldsField: reservation.ID},
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to release part %s: %w", item.PartUUID, err)
+		}
+	}
+
+	return reservation, nil
+}
+
+// switchReservationStatus атомарно переводит резерв в статус to из одного из статусов from
+// и возвращает статус, из которого он переведен. Если резерв уже находится в целевом статусе,
+// он возвращается с пустым статусом, чтобы повторный запрос не изменил складские остатки второй раз.
+func (r *repository) switchReservationStatus(ctx context.Context, orderUUID string, to repoModel.ReservationStatus, from ...repoModel.ReservationStatus) (*repoModel.Reservation, repoModel.ReservationStatus, error) {
+	now := primitive.NewDateTimeFromTime(time.Now())
+
+	var previous repoModel.Reservation
+	err := r.reservations.FindOneAndUpdate(ctx,
+		bson.M{"order_uuid": orderUUID, "status": bson.M{"$in": from}},
+		bson.M{"$set": bson.M{"status": to, "updated_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.Before),
+	).Decode(&previous)
+	if err == nil {
+		switched := previous
+		switched.Status = to
+		switched.UpdatedAt = now
+		return &switched, previous.Status, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, "", fmt.Errorf("failed to update reservation: %w", err)
+	}
+
+	existing, err := r.findReservation(ctx, orderUUID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch existing.Status {
+	case to:
+		return existing, "", nil
+	case repoModel.ReservationStatusCommitted:
+		return nil, "", model.ErrReservationCommitted
+	case repoModel.ReservationStatusReleased:
+		return nil, "", model.ErrReservationReleased
+	case repoModel.ReservationStatusPending, reservationStatusAbandoned:
+		return nil, "", model.ErrReservationInProgress
+	default:
+		return nil, "", fmt.Errorf("unexpected reservation status %s", existing.Status)
+	}
+}
+
+// existingReservation возвращает ранее созданный резерв заказа, если он уже действует
+func (r *repository) existingReservation(ctx context.Context, orderUUID string) (*repoModel.Reservation, error) {
+	existing, err := r.findReservation(ctx, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch existing.Status {
+	case repoModel.ReservationStatusReserved:
+		return existing, nil
+	case repoModel.ReservationStatusCommitted:
+		return nil, model.ErrReservationCommitted
+	case repoModel.ReservationStatusReleased:
+		return nil, model.ErrReservationReleased
+	default:
+		// Первый запрос еще списывает позиции или резерв откатывается
+		return nil, model.ErrReservationInProgress
+	}
+}
+
+func (r *repository) findReservation(ctx context.Context, orderUUID string) (*repoModel.Reservation, error) {
+	var reservation repoModel.Reservation
+
+	err := r.reservations.FindOne(ctx, bson.M{"order_uuid": orderUUID}).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrReservationNotFound
+		}
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+
+	return &reservation, nil
+}
+
+// abandonReservation откатывает незавершенный резерв: переводит его в ABANDONED, чтобы он уже
+// не мог стать RESERVED, возвращает на склад позиции с меткой резерва и удаляет его.
+// Каждый шаг можно повторить, поэтому прерванный откат завершит следующий проход AbandonStaleReservations
+func (r *repository) abandonReservation(ctx context.Context, reservation *repoModel.Reservation) error {
+	claimed, err := r.reservations.UpdateOne(ctx,
+		bson.M{"_id": reservation.ID, "status": bson.M{"$in": bson.A{repoModel.ReservationStatusPending, reservationStatusAbandoned}}},
+		bson.M{"$set": bson.M{"status": reservationStatusAbandoned, "updated_at": primitive.NewDateTimeFromTime(time.Now())}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to abandon reservation: %w", err)
+	}
+	if claimed.MatchedCount == 0 {
+		// Резерв успел стать действующим или его уже откатили
+		return nil
+	}
+
+	for _, item := range reservation.Items {
+		_, err = r.collection.UpdateOne(ctx,
+			bson.M{"uuid": item.PartUUID, reservationHoldsField: reservation.ID},
+			bson.M{
+				"$inc":  bson.M{"stock_quantity": item.Quantity, "reserved_quantity": -item.Quantity, "version": 1},
+				"$pull": bson.M{reservationHoldsField: reservation.ID},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to return part %s: %w", item.PartUUID, err)
+		}
+	}
+
+	if _, err = r.reservations.DeleteOne(ctx, bson.M{"_id": reservation.ID, "status": reservationStatusAbandoned}); err != nil {
+		return fmt.Errorf("failed to delete reservation: %w", err)
+	}
+
+	return nil
+}
+
+// clearHolds снимает метки действующего резерва с деталей. Оставшаяся метка ни на что не влияет:
+// откатывается только незавершенный резерв, а подтверждение и снятие резерва снимают ее сами
+func (r *repository) clearHolds(ctx context.Context, reservation *repoModel.Reservation) {
+	partUUIDs := make([]string, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		partUUIDs = append(partUUIDs, item.PartUUID)
+	}
+
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"uuid": bson.M{"$in": partUUIDs}},
+		bson.M{"$pull": bson.M{reservationHoldsField: reservation.ID}},
+	)
+	if err != nil {
+		log.Printf("warning: failed to clear holds of reservation %s: %v", reservation.OrderUUID, err)
+	}
+}
@@ -0,0 +1,79 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+// AbandonStaleReservations откатывает до limit незавершенных резервов, созданных раньше createdBefore:
+// резервирование прервалось, не дойдя до RESERVED, или был прерван его откат
+func (r *repository) AbandonStaleReservations(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	reservations, err := r.findReservations(ctx, bson.M{
+		"status":     bson.M{"$in": bson.A{repoModel.ReservationStatusPending, reservationStatusAbandoned}},
+		"created_at": bson.M{"$lt": primitive.NewDateTimeFromTime(createdBefore)},
+	}, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	abandoned := 0
+	for _, reservation := range reservations {
+		if err = r.abandonReservation(ctx, reservation); err != nil {
+			log.Printf("warning: failed to roll back stale reservation of order %s: %v", reservation.OrderUUID, err)
+			continue
+		}
+		abandoned++
+	}
+
+	return abandoned, nil
+}
+
+// ReleaseExpiredReservations снимает до limit действующих резервов, созданных раньше createdBefore.
+// Резерв заказа, который не оплатили и не сняли в срок, например потому что сервис заказов не успел
+// сохранить заказ, иначе держал бы детали на складе бессрочно
+func (r *repository) ReleaseExpiredReservations(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	reservations, err := r.findReservations(ctx, bson.M{
+		"status":     repoModel.ReservationStatusReserved,
+		"created_at": bson.M{"$lt": primitive.NewDateTimeFromTime(createdBefore)},
+	}, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, reservation := range reservations {
+		// Резерв могли подтвердить или снять после выборки: тогда он пропускается
+		if _, err = r.ReleaseReservation(ctx, reservation.OrderUUID); err != nil {
+			log.Printf("warning: failed to release expired reservation of order %s: %v", reservation.OrderUUID, err)
+			continue
+		}
+		released++
+	}
+
+	return released, nil
+}
+
+// findReservations возвращает до limit самых старых резервов, подходящих под filter
+func (r *repository) findReservations(ctx context.Context, filter bson.M, limit int) ([]*repoModel.Reservation, error) {
+	cursor, err := r.reservations.Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reservations: %w", err)
+	}
+
+	var reservations []*repoModel.Reservation
+	if err = cursor.All(ctx, &reservations); err != nil {
+		return nil, fmt.Errorf("failed to decode reservations: %w", err)
+	}
+
+	return reservations, nil
+}
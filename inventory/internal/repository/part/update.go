@@ -0,0 +1,72 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+// UpdatePart заменяет редактируемые поля детали, если ее текущая версия равна version
+func (r *repository) UpdatePart(ctx context.Context, uuid string, version int64, part *repoModel.Part) (*repoModel.Part, error) {
+	filter := activePartFilter(uuid)
+	filter["version"] = versionFilter(version)
+
+	var updated repoModel.Part
+	err := r.collection.FindOneAndUpdate(ctx, filter,
+		bson.M{
+			"$set": bson.M{
+				"name":           part.Name,
+				"description":    part.Description,
+				"price":          part.Price,
+				"stock_quantity": part.StockQuantity,
+				"category":       part.Category,
+				"dimensions":     part.Dimensions,
+				"manufacturer":   part.Manufacturer,
+				"tags":           part.Tags,
+				"metadata":       part.Metadata,
+				"updated_at":     primitive.NewDateTimeFromTime(time.Now()),
+			},
+			"$inc": bson.M{"version": 1},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, r.writeConflictError(ctx, uuid)
+		}
+		return nil, fmt.Errorf("failed to update part: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// versionFilter сопоставляет ожидаемую версию детали.
+// У деталей, созданных до появления версий, поле отсутствует и считается нулевым
+func versionFilter(version int64) interface{} {
+	if version == 0 {
+		return bson.M{"$in": bson.A{int64(0), nil}}
+	}
+	return version
+}
+
+// writeConflictError объясняет, почему условная запись не нашла деталь:
+// деталь удалена или отсутствует, либо ее версия уже изменилась
+func (r *repository) writeConflictError(ctx context.Context, uuid string) error {
+	count, err := r.collection.CountDocuments(ctx, activePartFilter(uuid))
+	if err != nil {
+		return fmt.Errorf("failed to check part: %w", err)
+	}
+	if count == 0 {
+		return model.ErrPartNotFound
+	}
+	return model.ErrPartVersionConflict
+}
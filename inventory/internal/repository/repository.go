@@ -2,11 +2,22 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/space-wanderer/microservices/inventory/internal/repository/model"
 )
 
 type InventoryRepository interface {
 	GetPart(ctx context.Context, uuid string) (*model.Part, error)
-	ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error)
+	ListParts(ctx context.Context, filter *model.PartsFilter, page *model.PartsPage) ([]*model.Part, bool, error)
+	CreatePart(ctx context.Context, part *model.Part) (*model.Part, error)
+	UpdatePart(ctx context.Context, uuid string, version int64, part *model.Part) (*model.Part, error)
+	DeletePart(ctx context.Context, uuid string, version int64) error
+	ReserveParts(ctx context.Context, reservation *model.Reservation) (*model.Reservation, error)
+	CommitReservation(ctx context.Context, orderUUID string) (*model.Reservation, error)
+	ReleaseReservation(ctx context.Context, orderUUID string) (*model.Reservation, error)
+	// AbandonStaleReservations откатывает незавершенные резервы, созданные раньше createdBefore
+	AbandonStaleReservations(ctx context.Context, createdBefore time.Time, limit int) (int, error)
+	// ReleaseExpiredReservations снимает действующие резервы, созданные раньше createdBefore
+	ReleaseExpiredReservations(ctx context.Context, createdBefore time.Time, limit int) (int, error)
 }
@@ -22,6 +22,172 @@ func (_m *InventoryService) EXPECT() *InventoryService_Expecter {
 	return &InventoryService_Expecter{mock: &_m.Mock}
 }
 
+// CommitReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryService) CommitReservation(ctx context.Context, orderUUID string) (*model.Reservation, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Reservation, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Reservation); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryService_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryService_Expecter) CommitReservation(ctx interface{}, orderUUID interface{}) *InventoryService_CommitReservation_Call {
+	return &InventoryService_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryService_CommitReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryService_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CommitReservation_Call) Return(_a0 *model.Reservation, _a1 error) *InventoryService_CommitReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_CommitReservation_Call) RunAndReturn(run func(context.Context, string) (*model.Reservation, error)) *InventoryService_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *InventoryService) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) (*model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) *model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type InventoryService_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *InventoryService_Expecter) CreatePart(ctx interface{}, part interface{}) *InventoryService_CreatePart_Call {
+	return &InventoryService_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *InventoryService_CreatePart_Call) Run(run func(ctx context.Context, part *model.Part)) *InventoryService_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CreatePart_Call) Return(_a0 *model.Part, _a1 error) *InventoryService_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_CreatePart_Call) RunAndReturn(run func(context.Context, *model.Part) (*model.Part, error)) *InventoryService_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, uuid, version
+func (_m *InventoryService) DeletePart(ctx context.Context, uuid string, version int64) error {
+	ret := _m.Called(ctx, uuid, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, uuid, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type InventoryService_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - version int64
+func (_e *InventoryService_Expecter) DeletePart(ctx interface{}, uuid interface{}, version interface{}) *InventoryService_DeletePart_Call {
+	return &InventoryService_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, uuid, version)}
+}
+
+func (_c *InventoryService_DeletePart_Call) Run(run func(ctx context.Context, uuid string, version int64)) *InventoryService_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *InventoryService_DeletePart_Call) Return(_a0 error) *InventoryService_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_DeletePart_Call) RunAndReturn(run func(context.Context, string, int64) error) *InventoryService_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *InventoryService) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -81,34 +247,41 @@ func (_c *InventoryService_GetPart_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *InventoryService) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
-	ret := _m.Called(ctx, filter)
+// ListParts provides a mock function with given fields: ctx, filter, page
+func (_m *InventoryService) ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPageRequest) ([]*model.Part, string, error) {
+	ret := _m.Called(ctx, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListParts")
 	}
 
 	var r0 []*model.Part
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) ([]*model.Part, error)); ok {
-		return rf(ctx, filter)
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter, model.PartsPageRequest) ([]*model.Part, string, error)); ok {
+		return rf(ctx, filter, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) []*model.Part); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter, model.PartsPageRequest) []*model.Part); ok {
+		r0 = rf(ctx, filter, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Part)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter, model.PartsPageRequest) string); ok {
+		r1 = rf(ctx, filter, page)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *model.PartsFilter, model.PartsPageRequest) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // InventoryService_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
@@ -119,23 +292,204 @@ type InventoryService_ListParts_Call struct {
 // ListParts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - filter *model.PartsFilter
-func (_e *InventoryService_Expecter) ListParts(ctx interface{}, filter interface{}) *InventoryService_ListParts_Call {
-	return &InventoryService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - page model.PartsPageRequest
+func (_e *InventoryService_Expecter) ListParts(ctx interface{}, filter interface{}, page interface{}) *InventoryService_ListParts_Call {
+	return &InventoryService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter, page)}
+}
+
+func (_c *InventoryService_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter, page model.PartsPageRequest)) *InventoryService_ListParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartsFilter), args[2].(model.PartsPageRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListParts_Call) Return(_a0 []*model.Part, _a1 string, _a2 error) *InventoryService_ListParts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *InventoryService_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter, model.PartsPageRequest) ([]*model.Part, string, error)) *InventoryService_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryService) ReleaseReservation(ctx context.Context, orderUUID string) (*model.Reservation, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Reservation, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Reservation); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryService_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryService_Expecter) ReleaseReservation(ctx interface{}, orderUUID interface{}) *InventoryService_ReleaseReservation_Call {
+	return &InventoryService_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderUUID)}
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) Return(_a0 *model.Reservation, _a1 error) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) (*model.Reservation, error)) *InventoryService_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, orderUUID, items
+func (_m *InventoryService) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) (*model.Reservation, error) {
+	ret := _m.Called(ctx, orderUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem) (*model.Reservation, error)); ok {
+		return rf(ctx, orderUUID, items)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem) *model.Reservation); ok {
+		r0 = rf(ctx, orderUUID, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.ReservationItem) error); ok {
+		r1 = rf(ctx, orderUUID, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryService_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - items []model.ReservationItem
+func (_e *InventoryService_Expecter) ReserveParts(ctx interface{}, orderUUID interface{}, items interface{}) *InventoryService_ReserveParts_Call {
+	return &InventoryService_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, orderUUID, items)}
+}
+
+func (_c *InventoryService_ReserveParts_Call) Run(run func(ctx context.Context, orderUUID string, items []model.ReservationItem)) *InventoryService_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.ReservationItem))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ReserveParts_Call) Return(_a0 *model.Reservation, _a1 error) *InventoryService_ReserveParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ReserveParts_Call) RunAndReturn(run func(context.Context, string, []model.ReservationItem) (*model.Reservation, error)) *InventoryService_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, uuid, version, part
+func (_m *InventoryService) UpdatePart(ctx context.Context, uuid string, version int64, part *model.Part) (*model.Part, error) {
+	ret := _m.Called(ctx, uuid, version, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, *model.Part) (*model.Part, error)); ok {
+		return rf(ctx, uuid, version, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, *model.Part) *model.Part); ok {
+		r0 = rf(ctx, uuid, version, part)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, *model.Part) error); ok {
+		r1 = rf(ctx, uuid, version, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type InventoryService_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - version int64
+//   - part *model.Part
+func (_e *InventoryService_Expecter) UpdatePart(ctx interface{}, uuid interface{}, version interface{}, part interface{}) *InventoryService_UpdatePart_Call {
+	return &InventoryService_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, uuid, version, part)}
 }
 
-func (_c *InventoryService_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter)) *InventoryService_ListParts_Call {
+func (_c *InventoryService_UpdatePart_Call) Run(run func(ctx context.Context, uuid string, version int64, part *model.Part)) *InventoryService_UpdatePart_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.PartsFilter))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(*model.Part))
 	})
 	return _c
 }
 
-func (_c *InventoryService_ListParts_Call) Return(_a0 []*model.Part, _a1 error) *InventoryService_ListParts_Call {
+func (_c *InventoryService_UpdatePart_Call) Return(_a0 *model.Part, _a1 error) *InventoryService_UpdatePart_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InventoryService_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter) ([]*model.Part, error)) *InventoryService_ListParts_Call {
+func (_c *InventoryService_UpdatePart_Call) RunAndReturn(run func(context.Context, string, int64, *model.Part) (*model.Part, error)) *InventoryService_UpdatePart_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,23 @@
+package part
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+)
+
+func (s *Service) CommitReservation(ctx context.Context, orderUUID string) (*model.Reservation, error) {
+	if _, err := uuid.Parse(orderUUID); err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+
+	reservation, err := s.inventoryRepository.CommitReservation(ctx, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertRepoReservationToModelReservation(reservation), nil
+}
@@ -0,0 +1,85 @@
+package part
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+func TestService_CommitReservation(t *testing.T) {
+	tests := []struct {
+		name          string
+		orderUUID     string
+		setupMock     func(*mocks.InventoryRepository)
+		expectedError error
+	}{
+		{
+			name:      "Успешная операция",
+			orderUUID: testOrderUUID,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					CommitReservation(mock.Anything, testOrderUUID).
+					Return(&repoModel.Reservation{
+						OrderUUID: testOrderUUID,
+						Items:     []repoModel.ReservationItem{{PartUUID: testPartUUID1, Quantity: 2}},
+						Status:    repoModel.ReservationStatusCommitted,
+					}, nil).
+					Once()
+			},
+		},
+		{
+			name:          "Невалидный UUID заказа",
+			orderUUID:     "not-a-uuid",
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidUUID,
+		},
+		{
+			name:      "Резерв не найден",
+			orderUUID: testOrderUUID,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					CommitReservation(mock.Anything, testOrderUUID).
+					Return(nil, model.ErrReservationNotFound).
+					Once()
+			},
+			expectedError: model.ErrReservationNotFound,
+		},
+		{
+			name:      "Резерв уже снят",
+			orderUUID: testOrderUUID,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					CommitReservation(mock.Anything, testOrderUUID).
+					Return(nil, model.ErrReservationReleased).
+					Once()
+			},
+			expectedError: model.ErrReservationReleased,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newInventoryRepository(t)
+			tt.setupMock(mockRepo)
+
+			service := NewService(mockRepo)
+
+			result, err := service.CommitReservation(context.Background(), tt.orderUUID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.orderUUID, result.OrderUUID)
+				assert.Equal(t, model.ReservationStatusCommitted, result.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package part
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+)
+
+func (s *Service) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	if err := validatePart(part); err != nil {
+		return nil, err
+	}
+
+	repoPart := converter.ConvertModelPartToRepoPart(part)
+	repoPart.UUID = uuid.New().String()
+
+	created, err := s.inventoryRepository.CreatePart(ctx, repoPart)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertRepoPartToModelPart(created), nil
+}
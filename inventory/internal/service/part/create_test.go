@@ -0,0 +1,200 @@
+package part
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+func newValidPart() *model.Part {
+	var material model.Value = &model.StringValue{StringValue: "титан"}
+	return &model.Part{
+		Name:          "Ионный двигатель X-2000",
+		Description:   "Высокоэффективный ионный двигатель",
+		Price:         150000.0,
+		StockQuantity: 5,
+		Category:      model.CategoryEngine,
+		Dimensions: &model.Dimensions{
+			Length: 120.0,
+			Width:  80.0,
+			Height: 60.0,
+			Weight: 250.0,
+		},
+		Manufacturer: &model.Manufacturer{
+			Name:    "КосмоТех",
+			Country: "Россия",
+			Website: "https://cosmotech.ru",
+		},
+		Tags:     []string{"ионный", "двигатель"},
+		Metadata: map[string]*model.Value{"material": &material},
+	}
+}
+
+func TestService_CreatePart(t *testing.T) {
+	tests := []struct {
+		name          string
+		part          func() *model.Part
+		setupMock     func(*mocks.InventoryRepository)
+		expectedError error
+	}{
+		{
+			name: "Успешное создание детали",
+			part: newValidPart,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					CreatePart(mock.Anything, mock.MatchedBy(func(part *repoModel.Part) bool {
+						return part.UUID != "" &&
+							part.Name == "Ионный двигатель X-2000" &&
+							part.Category == repoModel.CategoryEngine &&
+							*part.Metadata["material"].StringValue == "титан"
+					})).
+					RunAndReturn(func(_ context.Context, part *repoModel.Part) (*repoModel.Part, error) {
+						part.Version = 1
+						return part, nil
+					}).
+					Once()
+			},
+		},
+		{
+			name: "Пустое название",
+			part: func() *model.Part {
+				part := newValidPart()
+				part.Name = "  "
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidPartName,
+		},
+		{
+			name: "Неположительная цена",
+			part: func() *model.Part {
+				part := newValidPart()
+				part.Price = 0
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidPartPrice,
+		},
+		{
+			name: "Отрицательный остаток",
+			part: func() *model.Part {
+				part := newValidPart()
+				part.StockQuantity = -1
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidStockQuantity,
+		},
+		{
+			name: "Неизвестная категория",
+			part: func() *model.Part {
+				part := newValidPart()
+				part.Category = model.CategoryUnknown
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidCategory,
+		},
+		{
+			name: "Размеры не указаны",
+			part: func() *model.Part {
+				part := newValidPart()
+				part.Dimensions = nil
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidDimensions,
+		},
+		{
+			name: "Нулевой вес",
+			part: func() *model.Part {
+				part := newValidPart()
+				part.Dimensions.Weight = 0
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidDimensions,
+		},
+		{
+			name: "Значение метаданных без типа",
+			part: func() *model.Part {
+				part := newValidPart()
+				part.Metadata["empty"] = nil
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidMetadata,
+		},
+		{
+			name: "Пустой ключ метаданных",
+			part: func() *model.Part {
+				part := newValidPart()
+				var value model.Value = &model.BoolValue{BoolValue: true}
+				part.Metadata[""] = &value
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidMetadata,
+		},
+		{
+			name: "Ключ метаданных с точкой",
+			part: func() *model.Part {
+				part := newValidPart()
+				var value model.Value = &model.BoolValue{BoolValue: true}
+				part.Metadata["engine.certified"] = &value
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidMetadata,
+		},
+		{
+			name: "Ключ метаданных с оператором MongoDB",
+			part: func() *model.Part {
+				part := newValidPart()
+				var value model.Value = &model.BoolValue{BoolValue: true}
+				part.Metadata["$set"] = &value
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidMetadata,
+		},
+		{
+			name: "Ошибка репозитория",
+			part: newValidPart,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					CreatePart(mock.Anything, mock.Anything).
+					Return(nil, assert.AnError).
+					Once()
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newInventoryRepository(t)
+			tt.setupMock(mockRepo)
+
+			service := NewService(mockRepo)
+
+			result, err := service.CreatePart(context.Background(), tt.part())
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, result.UUID)
+				assert.Equal(t, int64(1), result.Version)
+				assert.Equal(t, newValidPart().Metadata, result.Metadata)
+			}
+		})
+	}
+}
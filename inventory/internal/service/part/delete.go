@@ -0,0 +1,17 @@
+package part
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+)
+
+func (s *Service) DeletePart(ctx context.Context, partUUID string, version int64) error {
+	if _, err := uuid.Parse(partUUID); err != nil {
+		return model.ErrInvalidUUID
+	}
+
+	return s.inventoryRepository.DeletePart(ctx, partUUID, version)
+}
@@ -0,0 +1,70 @@
+package part
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
+)
+
+func TestService_DeletePart(t *testing.T) {
+	tests := []struct {
+		name          string
+		uuid          string
+		version       int64
+		setupMock     func(*mocks.InventoryRepository)
+		expectedError error
+	}{
+		{
+			name:    "Успешное удаление детали",
+			uuid:    testPartUUID1,
+			version: 2,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					DeletePart(mock.Anything, testPartUUID1, int64(2)).
+					Return(nil).
+					Once()
+			},
+		},
+		{
+			name:          "Невалидный UUID детали",
+			uuid:          "not-a-uuid",
+			version:       1,
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidUUID,
+		},
+		{
+			name:    "Устаревшая версия",
+			uuid:    testPartUUID1,
+			version: 1,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					DeletePart(mock.Anything, testPartUUID1, int64(1)).
+					Return(model.ErrPartVersionConflict).
+					Once()
+			},
+			expectedError: model.ErrPartVersionConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newInventoryRepository(t)
+			tt.setupMock(mockRepo)
+
+			service := NewService(mockRepo)
+
+			err := service.DeletePart(context.Background(), tt.uuid, tt.version)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package part
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+func TestService_ListParts_InvalidFilter(t *testing.T) {
+	minPrice, maxPrice, nan := 500.0, 100.0, math.NaN()
+	minStock, maxStock := int64(10), int64(1)
+
+	tests := []struct {
+		name          string
+		filter        *model.PartsFilter
+		expectedError error
+	}{
+		{
+			name:          "Минимальная цена больше максимальной",
+			filter:        &model.PartsFilter{Price: &model.FloatRange{Min: &minPrice, Max: &maxPrice}},
+			expectedError: model.ErrInvalidRange,
+		},
+		{
+			name:          "Граница цены NaN",
+			filter:        &model.PartsFilter{Price: &model.FloatRange{Min: &nan}},
+			expectedError: model.ErrInvalidRange,
+		},
+		{
+			name:          "Минимальный остаток больше максимального",
+			filter:        &model.PartsFilter{StockQuantity: &model.IntRange{Min: &minStock, Max: &maxStock}},
+			expectedError: model.ErrInvalidRange,
+		},
+		{
+			name: "Неверный диапазон размеров",
+			filter: &model.PartsFilter{Dimensions: &model.DimensionsFilter{
+				Weight: &model.FloatRange{Min: &minPrice, Max: &maxPrice},
+			}},
+			expectedError: model.ErrInvalidRange,
+		},
+		{
+			name: "Условие на метаданные без ключа",
+			filter: &model.PartsFilter{Metadata: []model.MetadataPredicate{
+				{Operator: model.ComparisonOperatorEQ, Value: &model.StringValue{StringValue: "x"}},
+			}},
+			expectedError: model.ErrInvalidMetadataPredicate,
+		},
+		{
+			name: "Ключ метаданных с точкой",
+			filter: &model.PartsFilter{Metadata: []model.MetadataPredicate{
+				{Key: "power.max", Operator: model.ComparisonOperatorEQ, Value: &model.Int64Value{Int64Value: 1}},
+			}},
+			expectedError: model.ErrInvalidMetadataPredicate,
+		},
+		{
+			name: "Ключ метаданных с оператором MongoDB",
+			filter: &model.PartsFilter{Metadata: []model.MetadataPredicate{
+				{Key: "$where", Operator: model.ComparisonOperatorEQ, Value: &model.StringValue{StringValue: "1"}},
+			}},
+			expectedError: model.ErrInvalidMetadataPredicate,
+		},
+		{
+			name: "Условие на метаданные без значения",
+			filter: &model.PartsFilter{Metadata: []model.MetadataPredicate{
+				{Key: "power", Operator: model.ComparisonOperatorEQ},
+			}},
+			expectedError: model.ErrInvalidMetadataPredicate,
+		},
+		{
+			name: "Неизвестный оператор",
+			filter: &model.PartsFilter{Metadata: []model.MetadataPredicate{
+				{Key: "power", Value: &model.Int64Value{Int64Value: 1}},
+			}},
+			expectedError: model.ErrInvalidMetadataPredicate,
+		},
+		{
+			name: "Сравнение булевого значения на больше",
+			filter: &model.PartsFilter{Metadata: []model.MetadataPredicate{
+				{Key: "certified", Operator: model.ComparisonOperatorGT, Value: &model.BoolValue{BoolValue: true}},
+			}},
+			expectedError: model.ErrInvalidMetadataPredicate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewInventoryRepository(t)
+			service := NewService(mockRepo)
+
+			result, _, err := service.ListParts(context.Background(), tt.filter, model.PartsPageRequest{})
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestService_ListParts_PassesRichFilter(t *testing.T) {
+	mockRepo := mocks.NewInventoryRepository(t)
+	service := NewService(mockRepo)
+
+	minPrice, maxPrice := 100.0, 500.0
+	maxStock := int64(10)
+	filter := &model.PartsFilter{
+		Tags:          []string{"ионный", "двигатель"},
+		TagsMatch:     model.TagsMatchAll,
+		Price:         &model.FloatRange{Min: &minPrice, Max: &maxPrice},
+		StockQuantity: &model.IntRange{Max: &maxStock},
+		Dimensions:    &model.DimensionsFilter{Weight: &model.FloatRange{Max: &maxPrice}},
+		Metadata: []model.MetadataPredicate{
+			{Key: "power", Operator: model.ComparisonOperatorGTE, Value: &model.Int64Value{Int64Value: 100}},
+			{Key: "certified", Operator: model.ComparisonOperatorNE, Value: &model.BoolValue{BoolValue: false}},
+		},
+		Text: "плазменный",
+	}
+
+	power, certified := int64(100), false
+	expectedFilter := &repoModel.PartsFilter{
+		Tags:          []string{"ионный", "двигатель"},
+		TagsMatch:     repoModel.TagsMatchAll,
+		Price:         &repoModel.FloatRange{Min: &minPrice, Max: &maxPrice},
+		StockQuantity: &repoModel.IntRange{Max: &maxStock},
+		Dimensions:    &repoModel.DimensionsFilter{Weight: &repoModel.FloatRange{Max: &maxPrice}},
+		Metadata: []repoModel.MetadataPredicate{
+			{Key: "power", Operator: repoModel.ComparisonOperatorGTE, Value: &repoModel.Value{Int64Value: &power}},
+			{Key: "certified", Operator: repoModel.ComparisonOperatorNE, Value: &repoModel.Value{BoolValue: &certified}},
+		},
+		Text: "плазменный",
+	}
+
+	mockRepo.EXPECT().
+		ListParts(mock.Anything, expectedFilter, mock.AnythingOfType("*model.PartsPage")).
+		Return([]*repoModel.Part{}, false, nil).
+		Once()
+
+	result, _, err := service.ListParts(context.Background(), filter, model.PartsPageRequest{})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+}
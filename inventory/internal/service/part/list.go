@@ -7,12 +7,35 @@ import (
 	"github.com/space-wanderer/microservices/inventory/internal/model"
 )
 
-func (s *Service) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+func (s *Service) ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPageRequest) ([]*model.Part, string, error) {
+	if err := validatePartsFilter(filter); err != nil {
+		return nil, "", err
+	}
+
 	repoFilter := converter.ConvertModelPartsFilterToRepoPartsFilter(filter)
-	parts, err := s.inventoryRepository.ListParts(ctx, repoFilter)
+
+	repoPage, err := newRepoPage(repoFilter, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	parts, hasMore, err := s.inventoryRepository.ListParts(ctx, repoFilter, repoPage)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if hasMore && len(parts) > 0 {
+		nextPageToken, err = encodePageToken(repoFilter, repoPage, parts[len(parts)-1])
+		if err != nil {
+			return nil, "", err
+		}
 	}
 
-	return converter.ConvertRepoPartsToModelParts(parts), nil
+	return converter.ConvertRepoPartsToModelParts(parts), nextPageToken, nil
 }
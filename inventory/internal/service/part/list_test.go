@@ -78,8 +78,8 @@ func TestService_ListParts(t *testing.T) {
 				}
 
 				mockRepo.EXPECT().
-					ListParts(mock.Anything, expectedFilter).
-					Return(expectedParts, nil).
+					ListParts(mock.Anything, expectedFilter, mock.AnythingOfType("*model.PartsPage")).
+					Return(expectedParts, false, nil).
 					Once()
 			},
 			expectedResult: []*model.Part{
@@ -113,8 +113,8 @@ func TestService_ListParts(t *testing.T) {
 				}
 
 				mockRepo.EXPECT().
-					ListParts(mock.Anything, expectedFilter).
-					Return([]*repoModel.Part{}, nil).
+					ListParts(mock.Anything, expectedFilter, mock.AnythingOfType("*model.PartsPage")).
+					Return([]*repoModel.Part{}, false, nil).
 					Once()
 			},
 			expectedResult: []*model.Part{},
@@ -131,8 +131,8 @@ func TestService_ListParts(t *testing.T) {
 				}
 
 				mockRepo.EXPECT().
-					ListParts(mock.Anything, expectedFilter).
-					Return(nil, assert.AnError).
+					ListParts(mock.Anything, expectedFilter, mock.AnythingOfType("*model.PartsPage")).
+					Return(nil, false, assert.AnError).
 					Once()
 			},
 			expectedResult: nil,
@@ -145,8 +145,8 @@ func TestService_ListParts(t *testing.T) {
 				expectedFilter := &repoModel.PartsFilter{}
 
 				mockRepo.EXPECT().
-					ListParts(mock.Anything, expectedFilter).
-					Return([]*repoModel.Part{}, nil).
+					ListParts(mock.Anything, expectedFilter, mock.AnythingOfType("*model.PartsPage")).
+					Return([]*repoModel.Part{}, false, nil).
 					Once()
 			},
 			expectedResult: []*model.Part{},
@@ -166,7 +166,7 @@ func TestService_ListParts(t *testing.T) {
 			service := NewService(mockRepo)
 
 			// Выполняем тест
-			result, err := service.ListParts(context.Background(), tt.filter)
+			result, _, err := service.ListParts(context.Background(), tt.filter, model.PartsPageRequest{})
 
 			// Проверяем результаты
 			if tt.expectedError {
@@ -209,17 +209,18 @@ func TestService_ListParts_WithMock(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().
-		ListParts(mock.Anything, mock.Anything).
-		Return(expectedParts, nil).
+		ListParts(mock.Anything, mock.Anything, mock.AnythingOfType("*model.PartsPage")).
+		Return(expectedParts, false, nil).
 		Once()
 
 	// Выполняем тест
-	result, err := service.ListParts(context.Background(), filter)
+	result, nextPageToken, err := service.ListParts(context.Background(), filter, model.PartsPageRequest{})
 
 	// Проверяем результаты
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Len(t, result, 2)
+	assert.Empty(t, nextPageToken)
 	assert.Equal(t, "test-uuid-1", result[0].UUID)
 	assert.Equal(t, "test-uuid-2", result[1].UUID)
 }
@@ -0,0 +1,101 @@
+package part
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+	"github.com/space-wanderer/microservices/platform/pkg/pagetoken"
+)
+
+// pageQuery - параметры запроса, от которых зависит порядок выдачи. Токен страницы
+// выдается для них, и продолжение с другими параметрами отклоняется
+type pageQuery struct {
+	Filter     *repoModel.PartsFilter `json:"f"`
+	OrderBy    repoModel.PartsOrderBy `json:"o"`
+	Descending bool                   `json:"d,omitempty"`
+}
+
+// pageCursor - ключ последней детали страницы, сохраняемый в токене
+type pageCursor struct {
+	UUID      string  `json:"u"`
+	Price     float64 `json:"p,omitempty"`
+	Name      string  `json:"n,omitempty"`
+	CreatedAt int64   `json:"c,omitempty"`
+}
+
+// newRepoPage проверяет параметры страницы и разбирает токен продолжения
+func newRepoPage(filter *repoModel.PartsFilter, page model.PartsPageRequest) (*repoModel.PartsPage, error) {
+	if page.Size < 0 {
+		return nil, model.ErrInvalidPageSize
+	}
+
+	size := int64(page.Size)
+	switch {
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	repoPage := &repoModel.PartsPage{
+		Size:       size,
+		OrderBy:    convertOrderBy(page.OrderBy),
+		Descending: page.Descending,
+	}
+
+	if page.Token == "" {
+		return repoPage, nil
+	}
+
+	var cursor pageCursor
+	err := pagetoken.Decode(page.Token, pageQuery{Filter: filter, OrderBy: repoPage.OrderBy, Descending: repoPage.Descending}, &cursor)
+	if errors.Is(err, pagetoken.ErrInvalidToken) {
+		return nil, model.ErrInvalidPageToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cursor.UUID == "" {
+		return nil, model.ErrInvalidPageToken
+	}
+
+	repoPage.After = &repoModel.PartsCursor{
+		UUID:      cursor.UUID,
+		Price:     cursor.Price,
+		Name:      cursor.Name,
+		CreatedAt: primitive.DateTime(cursor.CreatedAt),
+	}
+
+	return repoPage, nil
+}
+
+// encodePageToken строит токен страницы, следующей за деталью last
+func encodePageToken(filter *repoModel.PartsFilter, page *repoModel.PartsPage, last *repoModel.Part) (string, error) {
+	cursor := pageCursor{UUID: last.UUID}
+
+	// В токен попадает только значение поля сортировки
+	switch page.OrderBy {
+	case repoModel.PartsOrderByPrice:
+		cursor.Price = last.Price
+	case repoModel.PartsOrderByName:
+		cursor.Name = last.Name
+	default:
+		cursor.CreatedAt = int64(last.CreatedAt)
+	}
+
+	return pagetoken.Encode(pageQuery{Filter: filter, OrderBy: page.OrderBy, Descending: page.Descending}, cursor)
+}
+
+func convertOrderBy(orderBy model.PartsOrderBy) repoModel.PartsOrderBy {
+	switch orderBy {
+	case model.PartsOrderByPrice:
+		return repoModel.PartsOrderByPrice
+	case model.PartsOrderByName:
+		return repoModel.PartsOrderByName
+	default:
+		return repoModel.PartsOrderByCreatedAt
+	}
+}
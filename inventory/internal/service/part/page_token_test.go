@@ -0,0 +1,141 @@
+package part
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+func TestService_ListParts_Pagination(t *testing.T) {
+	mockRepo := newInventoryRepository(t)
+	service := NewService(mockRepo)
+
+	filter := &model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+	firstPage := []*repoModel.Part{
+		{UUID: testPartUUID1, Name: "Engine A", Price: 100},
+		{UUID: testPartUUID2, Name: "Engine B", Price: 200},
+	}
+
+	mockRepo.EXPECT().
+		ListParts(mock.Anything, mock.Anything, &repoModel.PartsPage{
+			Size:    2,
+			OrderBy: repoModel.PartsOrderByPrice,
+		}).
+		Return(firstPage, true, nil).
+		Once()
+
+	parts, nextPageToken, err := service.ListParts(context.Background(), filter, model.PartsPageRequest{
+		Size:    2,
+		OrderBy: model.PartsOrderByPrice,
+	})
+	require.NoError(t, err)
+	require.Len(t, parts, 2)
+	require.NotEmpty(t, nextPageToken)
+
+	// Вторая страница продолжается строго после последней детали первой
+	mockRepo.EXPECT().
+		ListParts(mock.Anything, mock.Anything, &repoModel.PartsPage{
+			Size:    2,
+			OrderBy: repoModel.PartsOrderByPrice,
+			After:   &repoModel.PartsCursor{UUID: testPartUUID2, Price: 200},
+		}).
+		Return([]*repoModel.Part{{UUID: testOrderUUID, Price: 300}}, false, nil).
+		Once()
+
+	parts, nextPageToken, err = service.ListParts(context.Background(), filter, model.PartsPageRequest{
+		Size:    2,
+		Token:   nextPageToken,
+		OrderBy: model.PartsOrderByPrice,
+	})
+	require.NoError(t, err)
+	assert.Len(t, parts, 1)
+	assert.Empty(t, nextPageToken)
+}
+
+func TestService_ListParts_PageSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		size         int32
+		expectedSize int64
+	}{
+		{name: "Размер по умолчанию", size: 0, expectedSize: defaultPageSize},
+		{name: "Размер из запроса", size: 10, expectedSize: 10},
+		{name: "Размер ограничен максимумом", size: 10000, expectedSize: maxPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newInventoryRepository(t)
+			mockRepo.EXPECT().
+				ListParts(mock.Anything, mock.Anything, mock.MatchedBy(func(page *repoModel.PartsPage) bool {
+					return page.Size == tt.expectedSize && page.OrderBy == repoModel.PartsOrderByCreatedAt
+				})).
+				Return([]*repoModel.Part{}, false, nil).
+				Once()
+
+			_, _, err := NewService(mockRepo).ListParts(context.Background(), &model.PartsFilter{}, model.PartsPageRequest{Size: tt.size})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_ListParts_InvalidPage(t *testing.T) {
+	filter := &model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+
+	token, err := encodePageToken(
+		&repoModel.PartsFilter{Categories: []repoModel.Category{repoModel.CategoryEngine}},
+		&repoModel.PartsPage{OrderBy: repoModel.PartsOrderByName},
+		&repoModel.Part{UUID: testPartUUID1, Name: "Engine A"},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		filter        *model.PartsFilter
+		page          model.PartsPageRequest
+		expectedError error
+	}{
+		{
+			name:          "Отрицательный размер страницы",
+			filter:        filter,
+			page:          model.PartsPageRequest{Size: -1},
+			expectedError: model.ErrInvalidPageSize,
+		},
+		{
+			name:          "Поврежденный токен",
+			filter:        filter,
+			page:          model.PartsPageRequest{Token: "not-a-token", OrderBy: model.PartsOrderByName},
+			expectedError: model.ErrInvalidPageToken,
+		},
+		{
+			name:          "Токен от другой сортировки",
+			filter:        filter,
+			page:          model.PartsPageRequest{Token: token, OrderBy: model.PartsOrderByPrice},
+			expectedError: model.ErrInvalidPageToken,
+		},
+		{
+			name:          "Токен от другого фильтра",
+			filter:        &model.PartsFilter{Categories: []model.Category{model.CategoryWing}},
+			page:          model.PartsPageRequest{Token: token, OrderBy: model.PartsOrderByName},
+			expectedError: model.ErrInvalidPageToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newInventoryRepository(t)
+
+			parts, nextPageToken, err := NewService(mockRepo).ListParts(context.Background(), tt.filter, tt.page)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Nil(t, parts)
+			assert.Empty(t, nextPageToken)
+		})
+	}
+}
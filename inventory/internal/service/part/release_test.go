@@ -0,0 +1,85 @@
+package part
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+func TestService_ReleaseReservation(t *testing.T) {
+	tests := []struct {
+		name          string
+		orderUUID     string
+		setupMock     func(*mocks.InventoryRepository)
+		expectedError error
+	}{
+		{
+			name:      "Успешная операция",
+			orderUUID: testOrderUUID,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					ReleaseReservation(mock.Anything, testOrderUUID).
+					Return(&repoModel.Reservation{
+						OrderUUID: testOrderUUID,
+						Items:     []repoModel.ReservationItem{{PartUUID: testPartUUID1, Quantity: 2}},
+						Status:    repoModel.ReservationStatusReleased,
+					}, nil).
+					Once()
+			},
+		},
+		{
+			name:          "Невалидный UUID заказа",
+			orderUUID:     "not-a-uuid",
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidUUID,
+		},
+		{
+			name:      "Резерв не найден",
+			orderUUID: testOrderUUID,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					ReleaseReservation(mock.Anything, testOrderUUID).
+					Return(nil, model.ErrReservationNotFound).
+					Once()
+			},
+			expectedError: model.ErrReservationNotFound,
+		},
+		{
+			name:      "Резерв уже подтвержден",
+			orderUUID: testOrderUUID,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					ReleaseReservation(mock.Anything, testOrderUUID).
+					Return(nil, model.ErrReservationCommitted).
+					Once()
+			},
+			expectedError: model.ErrReservationCommitted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newInventoryRepository(t)
+			tt.setupMock(mockRepo)
+
+			service := NewService(mockRepo)
+
+			result, err := service.ReleaseReservation(context.Background(), tt.orderUUID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.orderUUID, result.OrderUUID)
+				assert.Equal(t, model.ReservationStatusReleased, result.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package part
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+)
+
+func (s *Service) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) (*model.Reservation, error) {
+	if _, err := uuid.Parse(orderUUID); err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+
+	merged, err := mergeReservationItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation, err := s.inventoryRepository.ReserveParts(ctx, converter.ConvertModelReservationToRepoReservation(&model.Reservation{
+		OrderUUID: orderUUID,
+		Items:     merged,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertRepoReservationToModelReservation(reservation), nil
+}
+
+// mergeReservationItems проверяет позиции резерва и объединяет повторяющиеся детали,
+// сохраняя порядок их первого появления
+func mergeReservationItems(items []model.ReservationItem) ([]model.ReservationItem, error) {
+	if len(items) == 0 {
+		return nil, model.ErrInvalidReservation
+	}
+
+	merged := make([]model.ReservationItem, 0, len(items))
+	index := make(map[string]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, model.ErrInvalidReservation
+		}
+		if _, err := uuid.Parse(item.PartUUID); err != nil {
+			return nil, model.ErrInvalidUUID
+		}
+
+		if i, ok := index[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,131 @@
+package part
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+const (
+	testOrderUUID = "8f1c2d3e-4b5a-4c6d-8e7f-901a2b3c4d5e"
+	testPartUUID1 = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	testPartUUID2 = "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+)
+
+func TestService_ReserveParts(t *testing.T) {
+	tests := []struct {
+		name          string
+		orderUUID     string
+		items         []model.ReservationItem
+		setupMock     func(*mocks.InventoryRepository)
+		expectedItems []model.ReservationItem
+		expectedError error
+	}{
+		{
+			name:      "Успешное резервирование с объединением повторяющихся деталей",
+			orderUUID: testOrderUUID,
+			items: []model.ReservationItem{
+				{PartUUID: testPartUUID1, Quantity: 1},
+				{PartUUID: testPartUUID2, Quantity: 3},
+				{PartUUID: testPartUUID1, Quantity: 2},
+			},
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					ReserveParts(mock.Anything, &repoModel.Reservation{
+						OrderUUID: testOrderUUID,
+						Items: []repoModel.ReservationItem{
+							{PartUUID: testPartUUID1, Quantity: 3},
+							{PartUUID: testPartUUID2, Quantity: 3},
+						},
+					}).
+					RunAndReturn(func(_ context.Context, reservation *repoModel.Reservation) (*repoModel.Reservation, error) {
+						reservation.Status = repoModel.ReservationStatusReserved
+						return reservation, nil
+					}).
+					Once()
+			},
+			expectedItems: []model.ReservationItem{
+				{PartUUID: testPartUUID1, Quantity: 3},
+				{PartUUID: testPartUUID2, Quantity: 3},
+			},
+		},
+		{
+			name:          "Невалидный UUID заказа",
+			orderUUID:     "not-a-uuid",
+			items:         []model.ReservationItem{{PartUUID: testPartUUID1, Quantity: 1}},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidUUID,
+		},
+		{
+			name:          "Пустой список деталей",
+			orderUUID:     testOrderUUID,
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidReservation,
+		},
+		{
+			name:          "Неположительное количество",
+			orderUUID:     testOrderUUID,
+			items:         []model.ReservationItem{{PartUUID: testPartUUID1, Quantity: 0}},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidReservation,
+		},
+		{
+			name:          "Невалидный UUID детали",
+			orderUUID:     testOrderUUID,
+			items:         []model.ReservationItem{{PartUUID: "bad", Quantity: 1}},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidUUID,
+		},
+		{
+			name:      "Недостаточно деталей на складе",
+			orderUUID: testOrderUUID,
+			items:     []model.ReservationItem{{PartUUID: testPartUUID1, Quantity: 100}},
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					ReserveParts(mock.Anything, mock.Anything).
+					Return(nil, model.ErrInsufficientStock).
+					Once()
+			},
+			expectedError: model.ErrInsufficientStock,
+		},
+		{
+			name:      "Резерв заказа еще создается",
+			orderUUID: testOrderUUID,
+			items:     []model.ReservationItem{{PartUUID: testPartUUID1, Quantity: 1}},
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					ReserveParts(mock.Anything, mock.Anything).
+					Return(nil, model.ErrReservationInProgress).
+					Once()
+			},
+			expectedError: model.ErrReservationInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newInventoryRepository(t)
+			tt.setupMock(mockRepo)
+
+			service := NewService(mockRepo)
+
+			result, err := service.ReserveParts(context.Background(), tt.orderUUID, tt.items)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.orderUUID, result.OrderUUID)
+				assert.Equal(t, model.ReservationStatusReserved, result.Status)
+				assert.Equal(t, tt.expectedItems, result.Items)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package part
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/inventory/internal/converter"
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+)
+
+func (s *Service) UpdatePart(ctx context.Context, partUUID string, version int64, part *model.Part) (*model.Part, error) {
+	if _, err := uuid.Parse(partUUID); err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+
+	if err := validatePart(part); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.inventoryRepository.UpdatePart(ctx, partUUID, version, converter.ConvertModelPartToRepoPart(part))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertRepoPartToModelPart(updated), nil
+}
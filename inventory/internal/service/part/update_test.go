@@ -0,0 +1,107 @@
+package part
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/inventory/internal/repository/model"
+)
+
+func TestService_UpdatePart(t *testing.T) {
+	tests := []struct {
+		name          string
+		uuid          string
+		version       int64
+		part          func() *model.Part
+		setupMock     func(*mocks.InventoryRepository)
+		expectedError error
+	}{
+		{
+			name:    "Успешное обновление детали",
+			uuid:    testPartUUID1,
+			version: 3,
+			part:    newValidPart,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					UpdatePart(mock.Anything, testPartUUID1, int64(3), mock.AnythingOfType("*model.Part")).
+					RunAndReturn(func(_ context.Context, uuid string, version int64, part *repoModel.Part) (*repoModel.Part, error) {
+						part.UUID = uuid
+						part.Version = version + 1
+						return part, nil
+					}).
+					Once()
+			},
+		},
+		{
+			name:          "Невалидный UUID детали",
+			uuid:          "not-a-uuid",
+			version:       1,
+			part:          newValidPart,
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidUUID,
+		},
+		{
+			name:    "Невалидная деталь",
+			uuid:    testPartUUID1,
+			version: 1,
+			part: func() *model.Part {
+				part := newValidPart()
+				part.Category = model.CategoryUnknown
+				return part
+			},
+			setupMock:     func(*mocks.InventoryRepository) {},
+			expectedError: model.ErrInvalidCategory,
+		},
+		{
+			name:    "Устаревшая версия",
+			uuid:    testPartUUID1,
+			version: 1,
+			part:    newValidPart,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					UpdatePart(mock.Anything, testPartUUID1, int64(1), mock.Anything).
+					Return(nil, model.ErrPartVersionConflict).
+					Once()
+			},
+			expectedError: model.ErrPartVersionConflict,
+		},
+		{
+			name:    "Деталь удалена",
+			uuid:    testPartUUID1,
+			version: 1,
+			part:    newValidPart,
+			setupMock: func(mockRepo *mocks.InventoryRepository) {
+				mockRepo.EXPECT().
+					UpdatePart(mock.Anything, testPartUUID1, int64(1), mock.Anything).
+					Return(nil, model.ErrPartNotFound).
+					Once()
+			},
+			expectedError: model.ErrPartNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newInventoryRepository(t)
+			tt.setupMock(mockRepo)
+
+			service := NewService(mockRepo)
+
+			result, err := service.UpdatePart(context.Background(), tt.uuid, tt.version, tt.part())
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.uuid, result.UUID)
+				assert.Equal(t, tt.version+1, result.Version)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package part
+
+import (
+	"math"
+	"strings"
+
+	"github.com/space-wanderer/microservices/inventory/internal/model"
+)
+
+// validatePart проверяет редактируемые поля детали перед записью в каталог
+func validatePart(part *model.Part) error {
+	if strings.TrimSpace(part.Name) == "" {
+		return model.ErrInvalidPartName
+	}
+
+	if part.Price <= 0 || math.IsInf(part.Price, 0) || math.IsNaN(part.Price) {
+		return model.ErrInvalidPartPrice
+	}
+
+	if part.StockQuantity < 0 {
+		return model.ErrInvalidStockQuantity
+	}
+
+	switch part.Category {
+	case model.CategoryEngine, model.CategoryFuel, model.CategoryPorthole, model.CategoryWing:
+	default:
+		return model.ErrInvalidCategory
+	}
+
+	if !validDimensions(part.Dimensions) {
+		return model.ErrInvalidDimensions
+	}
+
+	for key, value := range part.Metadata {
+		if !model.ValidMetadataKey(key) || value == nil || *value == nil {
+			return model.ErrInvalidMetadata
+		}
+	}
+
+	return nil
+}
+
+func validDimensions(dimensions *model.Dimensions) bool {
+	if dimensions == nil {
+		return false
+	}
+
+	for _, v := range []float64{dimensions.Length, dimensions.Width, dimensions.Height, dimensions.Weight} {
+		if v <= 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// validatePartsFilter проверяет диапазоны и условия на метаданные в фильтре деталей
+func validatePartsFilter(filter *model.PartsFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	if !validFloatRange(filter.Price) || !validIntRange(filter.StockQuantity) {
+		return model.ErrInvalidRange
+	}
+
+	if d := filter.Dimensions; d != nil {
+		for _, r := range []*model.FloatRange{d.Length, d.Width, d.Height, d.Weight} {
+			if !validFloatRange(r) {
+				return model.ErrInvalidRange
+			}
+		}
+	}
+
+	for _, predicate := range filter.Metadata {
+		if !validMetadataPredicate(predicate) {
+			return model.ErrInvalidMetadataPredicate
+		}
+	}
+
+	return nil
+}
+
+func validFloatRange(r *model.FloatRange) bool {
+	if r == nil {
+		return true
+	}
+	for _, bound := range []*float64{r.Min, r.Max} {
+		if bound != nil && math.IsNaN(*bound) {
+			return false
+		}
+	}
+	return r.Min == nil || r.Max == nil || *r.Min <= *r.Max
+}
+
+func validIntRange(r *model.IntRange) bool {
+	return r == nil || r.Min == nil || r.Max == nil || *r.Min <= *r.Max
+}
+
+// validMetadataPredicate допускает для булевых значений только проверку на равенство
+func validMetadataPredicate(predicate model.MetadataPredicate) bool {
+	if !model.ValidMetadataKey(predicate.Key) || predicate.Value == nil {
+		return false
+	}
+
+	switch predicate.Operator {
+	case model.ComparisonOperatorEQ, model.ComparisonOperatorNE:
+		return true
+	case model.ComparisonOperatorGT, model.ComparisonOperatorGTE, model.ComparisonOperatorLT, model.ComparisonOperatorLTE:
+		_, isBool := predicate.Value.(*model.BoolValue)
+		return !isBool
+	default:
+		return false
+	}
+}
@@ -0,0 +1,22 @@
+package reservation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	reservationsAbandoned = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "inventory",
+		Subsystem: "reservations",
+		Name:      "abandoned_total",
+		Help:      "Количество откаченных незавершенных резервов",
+	})
+
+	reservationsExpired = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "inventory",
+		Subsystem: "reservations",
+		Name:      "expired_total",
+		Help:      "Количество резервов, снятых по истечении срока жизни",
+	})
+)
@@ -0,0 +1,32 @@
+package reservation
+
+import (
+	"time"
+
+	"github.com/space-wanderer/microservices/inventory/internal/service"
+)
+
+type Service struct {
+	inventoryRepository service.PartService
+
+	pendingTimeout time.Duration
+	reservationTTL time.Duration
+	sweepInterval  time.Duration
+	batchSize      int
+}
+
+func NewService(
+	inventoryRepository service.PartService,
+	pendingTimeout time.Duration,
+	reservationTTL time.Duration,
+	sweepInterval time.Duration,
+	batchSize int,
+) *Service {
+	return &Service{
+		inventoryRepository: inventoryRepository,
+		pendingTimeout:      pendingTimeout,
+		reservationTTL:      reservationTTL,
+		sweepInterval:       sweepInterval,
+		batchSize:           batchSize,
+	}
+}
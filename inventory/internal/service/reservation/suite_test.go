@@ -0,0 +1,40 @@
+package reservation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/space-wanderer/microservices/inventory/internal/repository/mocks"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	inventoryRepository *mocks.InventoryRepository
+	service             *Service
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.Require().NoError(logger.Init("error", true))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.inventoryRepository = mocks.NewInventoryRepository(s.T())
+	s.service = NewService(
+		s.inventoryRepository,
+		time.Minute,
+		24*time.Hour,
+		10*time.Millisecond,
+		10,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.inventoryRepository.AssertExpectations(s.T())
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
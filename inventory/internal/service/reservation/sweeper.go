@@ -0,0 +1,65 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// RunSweeper периодически откатывает зависшие незавершенные резервы и снимает просроченные
+// до отмены контекста
+func (s *Service) RunSweeper(ctx context.Context) error {
+	logger.Info(ctx, "Starting reservation sweeper",
+		zap.Duration("pending_timeout", s.pendingTimeout),
+		zap.Duration("reservation_ttl", s.reservationTTL),
+		zap.Duration("sweep_interval", s.sweepInterval),
+		zap.Int("batch_size", s.batchSize))
+
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		s.sweepOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "🛑 Reservation sweeper stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) sweepOnce(ctx context.Context) {
+	now := time.Now()
+
+	// Обрабатываем пачки подряд, пока зависшие и просроченные резервы не закончатся
+	for ctx.Err() == nil {
+		abandoned, err := s.inventoryRepository.AbandonStaleReservations(ctx, now.Add(-s.pendingTimeout), s.batchSize)
+		if err != nil {
+			logger.Error(ctx, "❌ Failed to roll back stale reservations", zap.Error(err))
+			break
+		}
+
+		reservationsAbandoned.Add(float64(abandoned))
+		if abandoned < s.batchSize {
+			break
+		}
+	}
+
+	for ctx.Err() == nil {
+		released, err := s.inventoryRepository.ReleaseExpiredReservations(ctx, now.Add(-s.reservationTTL), s.batchSize)
+		if err != nil {
+			logger.Error(ctx, "❌ Failed to release expired reservations", zap.Error(err))
+			return
+		}
+
+		reservationsExpired.Add(float64(released))
+		if released < s.batchSize {
+			return
+		}
+	}
+}
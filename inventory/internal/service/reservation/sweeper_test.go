@@ -0,0 +1,88 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ServiceSuite) TestRunSweeper_StopsOnContextCancel() {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	s.inventoryRepository.EXPECT().AbandonStaleReservations(mock.Anything, mock.AnythingOfType("time.Time"), 10).Return(0, nil)
+	s.inventoryRepository.EXPECT().ReleaseExpiredReservations(mock.Anything, mock.AnythingOfType("time.Time"), 10).Return(0, nil)
+
+	done := make(chan error)
+	go func() {
+		done <- s.service.RunSweeper(ctx)
+	}()
+
+	// Act
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+
+	// Assert
+	select {
+	case err := <-done:
+		s.NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("sweeper did not stop after context cancel")
+	}
+}
+
+func (s *ServiceSuite) TestSweepOnce_UsesTimeouts() {
+	// Arrange
+	ctx := context.Background()
+	within := func(age time.Duration) func(time.Time) bool {
+		return func(createdBefore time.Time) bool {
+			cutoff := time.Since(createdBefore)
+			return cutoff >= age && cutoff < age+time.Second
+		}
+	}
+	s.inventoryRepository.EXPECT().AbandonStaleReservations(ctx, mock.MatchedBy(within(time.Minute)), 10).Return(0, nil).Once()
+	s.inventoryRepository.EXPECT().ReleaseExpiredReservations(ctx, mock.MatchedBy(within(24*time.Hour)), 10).Return(0, nil).Once()
+
+	// Act
+	s.service.sweepOnce(ctx)
+}
+
+func (s *ServiceSuite) TestSweepOnce_DrainsFullBatches() {
+	// Arrange
+	ctx := context.Background()
+	s.inventoryRepository.EXPECT().AbandonStaleReservations(ctx, mock.AnythingOfType("time.Time"), 10).Return(10, nil).Once()
+	s.inventoryRepository.EXPECT().AbandonStaleReservations(ctx, mock.AnythingOfType("time.Time"), 10).Return(2, nil).Once()
+	s.inventoryRepository.EXPECT().ReleaseExpiredReservations(ctx, mock.AnythingOfType("time.Time"), 10).Return(10, nil).Twice()
+	s.inventoryRepository.EXPECT().ReleaseExpiredReservations(ctx, mock.AnythingOfType("time.Time"), 10).Return(0, nil).Once()
+
+	// Act
+	s.service.sweepOnce(ctx)
+
+	// Assert
+	s.inventoryRepository.AssertNumberOfCalls(s.T(), "AbandonStaleReservations", 2)
+	s.inventoryRepository.AssertNumberOfCalls(s.T(), "ReleaseExpiredReservations", 3)
+}
+
+func (s *ServiceSuite) TestSweepOnce_AbandonErrorStillReleasesExpired() {
+	// Arrange
+	ctx := context.Background()
+	s.inventoryRepository.EXPECT().AbandonStaleReservations(ctx, mock.AnythingOfType("time.Time"), 10).Return(0, errors.New("database error")).Once()
+	s.inventoryRepository.EXPECT().ReleaseExpiredReservations(ctx, mock.AnythingOfType("time.Time"), 10).Return(1, nil).Once()
+
+	// Act
+	s.service.sweepOnce(ctx)
+}
+
+func (s *ServiceSuite) TestSweepOnce_ReleaseError() {
+	// Arrange
+	ctx := context.Background()
+	s.inventoryRepository.EXPECT().AbandonStaleReservations(ctx, mock.AnythingOfType("time.Time"), 10).Return(0, nil).Once()
+	s.inventoryRepository.EXPECT().ReleaseExpiredReservations(ctx, mock.AnythingOfType("time.Time"), 10).Return(0, errors.New("database error")).Once()
+
+	// Act
+	s.service.sweepOnce(ctx)
+
+	// Assert
+	s.inventoryRepository.AssertNumberOfCalls(s.T(), "ReleaseExpiredReservations", 1)
+}
@@ -8,5 +8,16 @@ import (
 
 type InventoryService interface {
 	GetPart(ctx context.Context, uuid string) (*model.Part, error)
-	ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error)
+	ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPageRequest) ([]*model.Part, string, error)
+	CreatePart(ctx context.Context, part *model.Part) (*model.Part, error)
+	UpdatePart(ctx context.Context, uuid string, version int64, part *model.Part) (*model.Part, error)
+	DeletePart(ctx context.Context, uuid string, version int64) error
+	ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) (*model.Reservation, error)
+	CommitReservation(ctx context.Context, orderUUID string) (*model.Reservation, error)
+	ReleaseReservation(ctx context.Context, orderUUID string) (*model.Reservation, error)
+}
+
+// ReservationSweeperService откатывает зависшие и снимает просроченные резервы деталей
+type ReservationSweeperService interface {
+	RunSweeper(ctx context.Context) error
 }
@@ -6,8 +6,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/mtls"
 	inventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
 )
 
@@ -21,10 +21,15 @@ var _ = Describe("InventoryService", func() {
 	BeforeEach(func() {
 		ctx, cancel = context.WithCancel(suiteCtx)
 
-		// Создаём gRPC клиент
+		// Создаём gRPC клиент с клиентским сертификатом; authority совпадает с именем в серверном сертификате
+		clientPair := env.Certs.Clients[testClientIdentity]
+		reloader, err := mtls.NewReloader(clientPair.CertFile, clientPair.KeyFile, env.Certs.CAFile)
+		Expect(err).ToNot(HaveOccurred(), "ожидали успешную загрузку клиентского сертификата")
+
 		conn, err := grpc.NewClient(
 			env.App.Address(),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithTransportCredentials(reloader.ClientCredentials()),
+			grpc.WithAuthority(inventoryAppName),
 		)
 		Expect(err).ToNot(HaveOccurred(), "ожидали успешное подключение к gRPC приложению")
 
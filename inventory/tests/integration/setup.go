@@ -12,6 +12,7 @@ import (
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
 	"github.com/space-wanderer/microservices/platform/pkg/testcontainers"
 	"github.com/space-wanderer/microservices/platform/pkg/testcontainers/app"
+	"github.com/space-wanderer/microservices/platform/pkg/testcontainers/certs"
 	"github.com/space-wanderer/microservices/platform/pkg/testcontainers/mongo"
 	"github.com/space-wanderer/microservices/platform/pkg/testcontainers/network"
 	"github.com/space-wanderer/microservices/platform/pkg/testcontainers/path"
@@ -23,7 +24,16 @@ const (
 	inventoryDockerfile = "deploy/docker/inventory/Dockerfile"
 
 	// Переменные окружения приложения
-	grpcPortKey = "GRPC_PORT"
+	grpcPortKey        = "GRPC_PORT"
+	grpcTLSCertFileKey = "GRPC_TLS_CERT_FILE"
+	grpcTLSKeyFileKey  = "GRPC_TLS_KEY_FILE"
+	grpcTLSCAFileKey   = "GRPC_TLS_CA_FILE"
+
+	// Пути к одноразовым сертификатам внутри контейнера приложения
+	containerCertDir = "/tmp/certs"
+
+	// Идентичность, с которой тесты вызывают inventory — как order в проде
+	testClientIdentity = "order"
 
 	// Значения переменных окружения
 	loggerLevelValue = "debug"
@@ -35,14 +45,30 @@ type TestEnvironment struct {
 	Network *network.Network
 	Mongo   *mongo.Container
 	App     *app.Container
+	Certs   *certs.Bundle
+	CertDir string
 }
 
 // setupTestEnvironment — подготавливает тестовое окружение: сеть, контейнеры и возвращает структуру с ресурсами
 func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 	logger.Info(ctx, "Подготавливаем тестовое окружение")
 
+	// Шаг 1: Выпускаем одноразовые сертификаты для mTLS между тестами и приложением
+	certDir, err := os.MkdirTemp("", projectName+"-certs-")
+	if err != nil {
+		logger.Fatal(ctx, "Не удалось создать директорию для сертификатов", zap.Error(err))
+	}
+
+	bundle, err := certs.Generate(certDir, []string{inventoryAppName}, testClientIdentity)
+	if err != nil {
+		cleanupTestEnvironment(ctx, &TestEnvironment{CertDir: certDir})
+		logger.Fatal(ctx, "Не удалось выпустить сертификаты", zap.Error(err))
+	}
+	logger.Info(ctx, "Сертификаты для mTLS выпущены")
+
 	generatedNetwork, err := network.NewNetwork(ctx, projectName)
 	if err != nil {
+		cleanupTestEnvironment(ctx, &TestEnvironment{CertDir: certDir})
 		logger.Fatal(ctx, "Не удалось создать сеть", zap.Error(err))
 	}
 	logger.Info(ctx, "Сеть успешно создана")
@@ -65,7 +91,7 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		mongo.WithLogger(logger.Logger()),
 	)
 	if err != nil {
-		cleanupTestEnvironment(ctx, &TestEnvironment{Network: generatedNetwork})
+		cleanupTestEnvironment(ctx, &TestEnvironment{Network: generatedNetwork, CertDir: certDir})
 		logger.Fatal(ctx, "Не удалось запустить контейнер с MongoDB", zap.Error(err))
 	}
 
@@ -77,6 +103,10 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 	appEnv := map[string]string{
 		// Переопределяем хост MongoDB для подключения к контейнеру из testcontainers
 		testcontainers.MongoHostKey: generatedMongo.Config().ContainerName,
+		// Включаем mTLS на сертификатах, скопированных в контейнер
+		grpcTLSCertFileKey: containerCertDir + "/server.pem",
+		grpcTLSKeyFileKey:  containerCertDir + "/server-key.pem",
+		grpcTLSCAFileKey:   containerCertDir + "/ca.pem",
 	}
 
 	// Создаем настраиваемую стратегию ожидания с увеличенным таймаутом
@@ -89,12 +119,15 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		app.WithDockerfile(projectRoot, inventoryDockerfile),
 		app.WithNetwork(generatedNetwork.Name()),
 		app.WithEnv(appEnv),
+		app.WithFile(bundle.Server.CertFile, containerCertDir+"/server.pem"),
+		app.WithFile(bundle.Server.KeyFile, containerCertDir+"/server-key.pem"),
+		app.WithFile(bundle.CAFile, containerCertDir+"/ca.pem"),
 		app.WithLogOutput(os.Stdout),
 		app.WithStartupWait(waitStrategy),
 		app.WithLogger(logger.Logger()),
 	)
 	if err != nil {
-		cleanupTestEnvironment(ctx, &TestEnvironment{Network: generatedNetwork, Mongo: generatedMongo})
+		cleanupTestEnvironment(ctx, &TestEnvironment{Network: generatedNetwork, Mongo: generatedMongo, CertDir: certDir})
 		logger.Fatal(ctx, "Не удалось запустить контейнер с приложением", zap.Error(err))
 	}
 
@@ -105,6 +138,8 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		Network: generatedNetwork,
 		Mongo:   generatedMongo,
 		App:     appContainer,
+		Certs:   bundle,
+		CertDir: certDir,
 	}
 }
 
@@ -2,6 +2,7 @@ package integration
 
 import (
 	"context"
+	"os"
 
 	"go.uber.org/zap"
 
@@ -42,4 +43,10 @@ func cleanupTestEnvironment(ctx context.Context, env *TestEnvironment) {
 			logger.Info(ctx, "Сеть удалена")
 		}
 	}
+
+	if env.CertDir != "" {
+		if err := os.RemoveAll(env.CertDir); err != nil {
+			logger.Error(ctx, "не удалось удалить сертификаты", zap.Error(err))
+		}
+	}
 }
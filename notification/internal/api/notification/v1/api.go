@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/service"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	notificationV1 "github.com/space-wanderer/microservices/shared/pkg/proto/notification/v1"
+)
+
+type api struct {
+	notificationV1.UnimplementedNotificationServiceServer
+
+	subscriptionService service.SubscriptionService
+}
+
+func NewAPI(subscriptionService service.SubscriptionService) *api {
+	return &api{subscriptionService: subscriptionService}
+}
+
+// currentUserUUID возвращает UUID пользователя, прошедшего аутентификацию
+func currentUserUUID(ctx context.Context) (string, error) {
+	userUUID, ok := logger.UserIDFromContext(ctx)
+	if !ok {
+		return "", model.ErrUnauthenticated
+	}
+
+	return userUUID, nil
+}
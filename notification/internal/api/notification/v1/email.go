@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/converter"
+	notificationV1 "github.com/space-wanderer/microservices/shared/pkg/proto/notification/v1"
+)
+
+func (a *api) CreateEmailSubscription(ctx context.Context, req *notificationV1.CreateEmailSubscriptionRequest) (*notificationV1.CreateEmailSubscriptionResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := a.subscriptionService.CreateEmailSubscription(ctx, userUUID, req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertEmailLinkTokenToGRPC(token), nil
+}
+
+func (a *api) ConfirmEmailSubscription(ctx context.Context, req *notificationV1.ConfirmEmailSubscriptionRequest) (*notificationV1.ConfirmEmailSubscriptionResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := a.subscriptionService.ConfirmEmailSubscription(ctx, userUUID, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &notificationV1.ConfirmEmailSubscriptionResponse{
+		Subscription: converter.ConvertSubscriptionToGRPC(subscription),
+	}, nil
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/converter"
+	notificationV1 "github.com/space-wanderer/microservices/shared/pkg/proto/notification/v1"
+)
+
+func (a *api) CreateTelegramLinkToken(ctx context.Context, _ *notificationV1.CreateTelegramLinkTokenRequest) (*notificationV1.CreateTelegramLinkTokenResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := a.subscriptionService.CreateTelegramLinkToken(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertLinkTokenToGRPC(token), nil
+}
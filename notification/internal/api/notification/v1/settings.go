@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/converter"
+	notificationV1 "github.com/space-wanderer/microservices/shared/pkg/proto/notification/v1"
+)
+
+func (a *api) GetNotificationSettings(ctx context.Context, _ *notificationV1.GetNotificationSettingsRequest) (*notificationV1.GetNotificationSettingsResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := a.subscriptionService.GetSettings(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertSettingsToGRPC(settings), nil
+}
+
+func (a *api) DeleteSubscription(ctx context.Context, req *notificationV1.DeleteSubscriptionRequest) (*notificationV1.DeleteSubscriptionResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.subscriptionService.DeleteSubscription(ctx, userUUID, req.GetSubscriptionUuid()); err != nil {
+		return nil, err
+	}
+
+	return &notificationV1.DeleteSubscriptionResponse{}, nil
+}
+
+func (a *api) SetEventEnabled(ctx context.Context, req *notificationV1.SetEventEnabledRequest) (*notificationV1.SetEventEnabledResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := converter.ConvertEventTypeFromGRPC(req.GetEventType())
+	if err = a.subscriptionService.SetEventEnabled(ctx, userUUID, eventType, req.GetEnabled()); err != nil {
+		return nil, err
+	}
+
+	return &notificationV1.SetEventEnabledResponse{}, nil
+}
+
+func (a *api) SetQuietHours(ctx context.Context, req *notificationV1.SetQuietHoursRequest) (*notificationV1.SetQuietHoursResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quietHours := converter.ConvertQuietHoursFromGRPC(req.GetQuietHours())
+	if err = a.subscriptionService.SetQuietHours(ctx, userUUID, quietHours); err != nil {
+		return nil, err
+	}
+
+	return &notificationV1.SetQuietHoursResponse{}, nil
+}
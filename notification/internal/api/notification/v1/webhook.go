@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/converter"
+	notificationV1 "github.com/space-wanderer/microservices/shared/pkg/proto/notification/v1"
+)
+
+func (a *api) CreateWebhookSubscription(ctx context.Context, req *notificationV1.CreateWebhookSubscriptionRequest) (*notificationV1.CreateWebhookSubscriptionResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := a.subscriptionService.CreateWebhookSubscription(ctx, userUUID, req.GetUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertWebhookSubscriptionToGRPC(subscription), nil
+}
+
+func (a *api) ListWebhookDeliveryLogs(ctx context.Context, req *notificationV1.ListWebhookDeliveryLogsRequest) (*notificationV1.ListWebhookDeliveryLogsResponse, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := a.subscriptionService.ListWebhookLogs(ctx, userUUID, req.GetSubscriptionUuid(), int(req.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertWebhookLogsToGRPC(logs), nil
+}
@@ -0,0 +1,69 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/notification/internal/client/http"
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/service"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// StartCommand - команда бота, которой пользователь передает токен привязки: /start <token>
+const StartCommand = "/start"
+
+const (
+	linkedReply       = "✅ Чат привязан, сюда будут приходить уведомления о ваших заказах."
+	tokenMissingReply = "Чтобы получать уведомления, откройте ссылку привязки из настроек уведомлений."
+	tokenExpiredReply = "⚠️ Ссылка привязки недействительна или устарела. Получите новую в настройках уведомлений."
+	linkFailedReply   = "❌ Не удалось привязать чат, попробуйте позже."
+)
+
+type handler struct {
+	subscriptionService service.SubscriptionService
+	telegramClient      http.TelegramClient
+}
+
+func NewHandler(subscriptionService service.SubscriptionService, telegramClient http.TelegramClient) *handler {
+	return &handler{
+		subscriptionService: subscriptionService,
+		telegramClient:      telegramClient,
+	}
+}
+
+// HandleStart привязывает чат, из которого пришла команда /start <token>, к владельцу токена
+func (h *handler) HandleStart(ctx context.Context, _ *bot.Bot, update *models.Update) {
+	if update.Message == nil {
+		return
+	}
+	chatID := update.Message.Chat.ID
+
+	token := strings.TrimSpace(strings.TrimPrefix(update.Message.Text, StartCommand))
+	if token == "" {
+		h.reply(ctx, chatID, tokenMissingReply)
+		return
+	}
+
+	_, err := h.subscriptionService.LinkTelegramChat(ctx, token, chatID)
+	switch {
+	case err == nil:
+		h.reply(ctx, chatID, linkedReply)
+	case errors.Is(err, model.ErrLinkTokenNotFound):
+		h.reply(ctx, chatID, tokenExpiredReply)
+	default:
+		logger.Error(ctx, "❌ Failed to link Telegram chat", zap.Error(err))
+		h.reply(ctx, chatID, linkFailedReply)
+	}
+}
+
+func (h *handler) reply(ctx context.Context, chatID int64, text string) {
+	if err := h.telegramClient.SendMessage(ctx, chatID, text); err != nil {
+		logger.Warn(ctx, "Failed to reply to Telegram command", zap.Error(err))
+	}
+}
@@ -2,12 +2,30 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
 	"time"
 
+	"github.com/go-telegram/bot"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
+	notificationV1API "github.com/space-wanderer/microservices/notification/internal/api/notification/v1"
+	telegramAPI "github.com/space-wanderer/microservices/notification/internal/api/telegram"
+	"github.com/space-wanderer/microservices/notification/internal/config"
+	"github.com/space-wanderer/microservices/platform/pkg/auth"
 	"github.com/space-wanderer/microservices/platform/pkg/closer"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/health"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	kafkaMiddleware "github.com/space-wanderer/microservices/platform/pkg/middleware/kafka"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
+	"github.com/space-wanderer/microservices/shared/pkg/interceptors"
+	notificationV1 "github.com/space-wanderer/microservices/shared/pkg/proto/notification/v1"
 )
 
 type App struct {
@@ -25,6 +43,31 @@ func NewApp() *App {
 func (app *App) Run(ctx context.Context) error {
 	logger.Info(ctx, "🚀 Запуск Notification Service")
 
+	if err := app.initTracing(ctx); err != nil {
+		return err
+	}
+
+	if err := app.initMetrics(ctx); err != nil {
+		return err
+	}
+
+	if err := app.initMigrations(ctx); err != nil {
+		return err
+	}
+
+	app.initHealth(ctx)
+
+	if err := app.initGRPCServer(ctx); err != nil {
+		return err
+	}
+
+	if err := app.runTelegramBot(ctx); err != nil {
+		return err
+	}
+
+	app.runDeliveryDispatcher(ctx)
+	app.runInboxCleanup(ctx)
+
 	// Запускаем OrderPaid consumer
 	orderPaidConsumerService := app.diContainer.OrderPaidConsumerService(ctx)
 	go func() {
@@ -45,7 +88,7 @@ func (app *App) Run(ctx context.Context) error {
 
 	logger.Info(ctx, "🛑 Получен сигнал завершения, начинаем graceful shutdown")
 
-	shutdownCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
 	defer cancel()
 
 	if err := app.closer.CloseAll(shutdownCtx); err != nil {
@@ -55,3 +98,167 @@ func (app *App) Run(ctx context.Context) error {
 	logger.Info(ctx, "✅ Notification Service завершен")
 	return nil
 }
+
+func (app *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx,
+		config.AppConfig().Tracing.ServiceName(),
+		config.AppConfig().Tracing.CollectorEndpoint(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
+
+	app.closer.AddNamed("Tracer provider", shutdown)
+
+	return nil
+}
+
+func (app *App) initMetrics(ctx context.Context) error {
+	listener, err := net.Listen("tcp", config.AppConfig().Metrics.Address())
+	if err != nil {
+		return fmt.Errorf("failed to listen metrics address: %w", err)
+	}
+
+	server := metrics.NewServer(config.AppConfig().Metrics.Address())
+	go func() {
+		logger.Info(ctx, fmt.Sprintf("Metrics server listening on %s", config.AppConfig().Metrics.Address()))
+		if serveErr := server.Serve(listener); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			logger.Error(ctx, "Metrics server error", zap.Error(serveErr))
+		}
+	}()
+
+	app.closer.AddNamed("Metrics server", server.Shutdown)
+
+	return nil
+}
+
+func (app *App) initMigrations(ctx context.Context) error {
+	migrator := app.diContainer.PGMigrator(ctx)
+	if migrator == nil {
+		return fmt.Errorf("failed to create migrator")
+	}
+
+	if err := migrator.Up(); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return nil
+}
+
+func (app *App) initHealth(ctx context.Context) {
+	registry := app.diContainer.HealthRegistry(ctx)
+
+	healthCtx, cancel := context.WithCancel(ctx)
+	go registry.Run(healthCtx)
+
+	// Статус NOT_SERVING выставляется до остановки серверов, чтобы клиенты успели переключиться
+	app.closer.AddBeforeShutdown("Health status", registry.Shutdown)
+	app.closer.AddNamed("Health checks", func(context.Context) error {
+		cancel()
+		return nil
+	})
+}
+
+// initGRPCServer запускает gRPC API настроек уведомлений. Пользователь определяется по JWT
+func (app *App) initGRPCServer(ctx context.Context) error {
+	verifier := app.diContainer.AuthVerifier(ctx)
+	if verifier == nil {
+		return fmt.Errorf("failed to create auth verifier")
+	}
+
+	listener, err := net.Listen("tcp", config.AppConfig().NotificationGRPC.Address())
+	if err != nil {
+		return fmt.Errorf("failed to listen gRPC address: %w", err)
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.UnaryTracingServerInterceptor(),
+			metrics.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(verifier, grpc_health_v1.Health_Check_FullMethodName),
+			interceptors.UnaryErrorInterceptor(),
+		),
+	)
+
+	reflection.Register(grpcServer)
+	health.RegisterServer(grpcServer, app.diContainer.HealthRegistry(ctx))
+
+	api := notificationV1API.NewAPI(app.diContainer.SubscriptionService(ctx))
+	notificationV1.RegisterNotificationServiceServer(grpcServer, api)
+
+	go func() {
+		logger.Info(ctx, fmt.Sprintf("gRPC notification server listening on %s", config.AppConfig().NotificationGRPC.Address()))
+		if serveErr := grpcServer.Serve(listener); serveErr != nil {
+			logger.Error(ctx, "gRPC server error", zap.Error(serveErr))
+		}
+	}()
+
+	app.closer.AddNamed("GRPC Server", func(context.Context) error {
+		grpcServer.GracefulStop()
+		return nil
+	})
+
+	return nil
+}
+
+// runTelegramBot запускает получение обновлений бота, чтобы принимать команду привязки чата
+func (app *App) runTelegramBot(ctx context.Context) error {
+	telegramBot := app.diContainer.TelegramBot(ctx)
+	if telegramBot == nil {
+		return fmt.Errorf("failed to create telegram bot")
+	}
+
+	handler := telegramAPI.NewHandler(app.diContainer.SubscriptionService(ctx), app.diContainer.TelegramClient(ctx))
+	telegramBot.RegisterHandler(bot.HandlerTypeMessageText, telegramAPI.StartCommand, bot.MatchTypePrefix, handler.HandleStart)
+
+	app.runUntilClosed(ctx, "Telegram bot", func(ctx context.Context) {
+		telegramBot.Start(ctx)
+	})
+
+	return nil
+}
+
+// runDeliveryDispatcher запускает отправку отложенных уведомлений
+func (app *App) runDeliveryDispatcher(ctx context.Context) {
+	notificationService := app.diContainer.NotificationService(ctx)
+
+	app.runUntilClosed(ctx, "Notification delivery dispatcher", func(ctx context.Context) {
+		if err := notificationService.RunDeliveryDispatcher(ctx); err != nil {
+			logger.Error(ctx, "Notification delivery dispatcher error", zap.Error(err))
+		}
+	})
+}
+
+// runInboxCleanup запускает удаление устаревших отметок об обработанных событиях Kafka
+func (app *App) runInboxCleanup(ctx context.Context) {
+	cfg := config.AppConfig().Inbox
+	inboxStore := app.diContainer.InboxStore(ctx)
+
+	app.runUntilClosed(ctx, "Kafka inbox cleanup", func(ctx context.Context) {
+		if err := kafkaMiddleware.RunInboxCleanup(ctx, inboxStore, cfg.Retention(), cfg.CleanupInterval(), cfg.CleanupBatchSize(), logger.Logger()); err != nil {
+			logger.Error(ctx, "Kafka inbox cleanup error", zap.Error(err))
+		}
+	})
+}
+
+// runUntilClosed запускает run в горутине и при закрытии приложения останавливает его, дожидаясь завершения
+func (app *App) runUntilClosed(ctx context.Context, name string, run func(ctx context.Context)) {
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		run(runCtx)
+	}()
+
+	app.closer.AddNamed(name, func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
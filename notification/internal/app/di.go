@@ -6,31 +6,67 @@ import (
 
 	"github.com/IBM/sarama"
 	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 
 	"github.com/space-wanderer/microservices/notification/internal/client/http"
 	"github.com/space-wanderer/microservices/notification/internal/client/http/telegram"
 	"github.com/space-wanderer/microservices/notification/internal/config"
 	"github.com/space-wanderer/microservices/notification/internal/converter/kafka"
 	"github.com/space-wanderer/microservices/notification/internal/converter/kafka/decoder"
+	"github.com/space-wanderer/microservices/notification/internal/repository"
+	deliveryRepository "github.com/space-wanderer/microservices/notification/internal/repository/delivery"
+	subscriptionRepository "github.com/space-wanderer/microservices/notification/internal/repository/subscription"
+	webhookLogRepository "github.com/space-wanderer/microservices/notification/internal/repository/webhooklog"
 	"github.com/space-wanderer/microservices/notification/internal/service"
+	emailChannel "github.com/space-wanderer/microservices/notification/internal/service/channel/email"
+	telegramChannel "github.com/space-wanderer/microservices/notification/internal/service/channel/telegram"
+	webhookChannel "github.com/space-wanderer/microservices/notification/internal/service/channel/webhook"
 	consumerAssembledService "github.com/space-wanderer/microservices/notification/internal/service/consumer/order_assembled_consumer"
 	consumerPaidService "github.com/space-wanderer/microservices/notification/internal/service/consumer/order_paid_consumer"
-	telegramService "github.com/space-wanderer/microservices/notification/internal/service/telegram"
+	notificationService "github.com/space-wanderer/microservices/notification/internal/service/notification"
+	subscriptionService "github.com/space-wanderer/microservices/notification/internal/service/subscription"
+	"github.com/space-wanderer/microservices/platform/pkg/auth"
+	platformHealth "github.com/space-wanderer/microservices/platform/pkg/health"
 	platformKafka "github.com/space-wanderer/microservices/platform/pkg/kafka"
 	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/producer"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	kafkaMiddleware "github.com/space-wanderer/microservices/platform/pkg/middleware/kafka"
+	migrator "github.com/space-wanderer/microservices/platform/pkg/migrator/pg"
+	notificationV1 "github.com/space-wanderer/microservices/shared/pkg/proto/notification/v1"
 )
 
 type diContainer struct {
 	orderPaidConsumer      platformKafka.Consumer
 	orderAssembledConsumer platformKafka.Consumer
+	consumerRetryProducer  platformKafka.TopicProducer
 
 	orderPaidDecoder      kafka.OrderPaidDecoder
 	orderAssembledDecoder kafka.ShipAssembledDecoder
 
-	telegramBot     *bot.Bot
-	telegramClient  http.TelegramClient
-	telegramService service.TelegramService
+	telegramBot    *bot.Bot
+	telegramClient http.TelegramClient
+
+	telegramChannel service.Channel
+	emailChannel    service.Channel
+	webhookChannel  service.Channel
+
+	notificationService service.NotificationService
+	subscriptionService service.SubscriptionService
+
+	subscriptionRepository repository.SubscriptionRepository
+	deliveryRepository     repository.DeliveryRepository
+	webhookLogRepository   repository.WebhookLogRepository
+	inboxStore             *kafkaMiddleware.PostgresInbox
+
+	pgPool     *pgxpool.Pool
+	pgMigrator *migrator.Migrator
+
+	healthRegistry *platformHealth.Registry
+	authVerifier   *auth.Verifier
 }
 
 func NewDiContainer() *diContainer {
@@ -53,8 +89,20 @@ func (d *diContainer) OrderPaidConsumer(ctx context.Context) platformKafka.Consu
 			return nil
 		}
 
+		// Необработанные сообщения уходят в топики повторов, которые читает тот же consumer, а затем в DLQ
+		retryPolicy := consumerRetryPolicy(cfg.OrderPaidConsumer.TopicName(), cfg.OrderPaidConsumer.ConsumerGroupID())
+		topics := append([]string{cfg.OrderPaidConsumer.TopicName()}, retryPolicy.Topics()...)
+
 		// Создаем consumer
-		d.orderPaidConsumer = consumer.NewConsumer(group, []string{cfg.OrderPaidConsumer.TopicName()}, logger.Logger())
+		// Повторно доставленные события пропускаются по event_uuid, чтобы уведомление не отправлялось дважды
+		eventID := func(msg consumer.Message) string {
+			return d.OrderPaidDecoder(ctx).Decode(msg.Value).EventUUID
+		}
+
+		d.orderPaidConsumer = consumer.NewConsumer(group, topics, logger.Logger(),
+			consumer.Retry(retryPolicy, d.ConsumerRetryProducer(ctx), logger.Logger()),
+			kafkaMiddleware.Inbox(cfg.OrderPaidConsumer.ConsumerGroupID(), d.InboxStore(ctx), eventID, logger.Logger()),
+		)
 	}
 	return d.orderPaidConsumer
 }
@@ -75,12 +123,51 @@ func (d *diContainer) OrderAssembledConsumer(ctx context.Context) platformKafka.
 			return nil
 		}
 
+		// Необработанные сообщения уходят в топики повторов, которые читает тот же consumer, а затем в DLQ
+		retryPolicy := consumerRetryPolicy(cfg.OrderAssembledConsumer.TopicName(), cfg.OrderAssembledConsumer.ConsumerGroupID())
+		topics := append([]string{cfg.OrderAssembledConsumer.TopicName()}, retryPolicy.Topics()...)
+
 		// Создаем consumer
-		d.orderAssembledConsumer = consumer.NewConsumer(group, []string{cfg.OrderAssembledConsumer.TopicName()}, logger.Logger())
+		// Повторно доставленные события пропускаются по event_uuid, чтобы уведомление не отправлялось дважды
+		eventID := func(msg consumer.Message) string {
+			return d.OrderAssembledDecoder(ctx).Decode(msg.Value).EventUUID
+		}
+
+		d.orderAssembledConsumer = consumer.NewConsumer(group, topics, logger.Logger(),
+			consumer.Retry(retryPolicy, d.ConsumerRetryProducer(ctx), logger.Logger()),
+			kafkaMiddleware.Inbox(cfg.OrderAssembledConsumer.ConsumerGroupID(), d.InboxStore(ctx), eventID, logger.Logger()),
+		)
 	}
 	return d.orderAssembledConsumer
 }
 
+// ConsumerRetryProducer создает producer для переноса необработанных сообщений в топики повторов и DLQ
+func (d *diContainer) ConsumerRetryProducer(ctx context.Context) platformKafka.TopicProducer {
+	if d.consumerRetryProducer == nil {
+		cfg := config.AppConfig()
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Producer.Return.Successes = true
+		saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+		saramaConfig.Producer.Retry.Max = 3
+
+		syncProducer, err := sarama.NewSyncProducer(cfg.Kafka.Brokers(), saramaConfig)
+		if err != nil {
+			log.Printf("❌ Ошибка создания Kafka producer: %v", err)
+			return nil
+		}
+
+		d.consumerRetryProducer = producer.NewTopicProducer(syncProducer, logger.Logger())
+	}
+	return d.consumerRetryProducer
+}
+
+// consumerRetryPolicy возвращает политику повторов consumer group groupID для топика topic
+func consumerRetryPolicy(topic, groupID string) consumer.RetryPolicy {
+	cfg := config.AppConfig().KafkaConsumerRetry
+	return consumer.NewRetryPolicy(topic, groupID, cfg.MaxAttempts(), cfg.Backoff(), cfg.MaxBackoff(), cfg.Delays())
+}
+
 func (d *diContainer) OrderPaidDecoder(ctx context.Context) kafka.OrderPaidDecoder {
 	if d.orderPaidDecoder == nil {
 		d.orderPaidDecoder = decoder.NewOrderPaidDecoder()
@@ -98,7 +185,8 @@ func (d *diContainer) OrderAssembledDecoder(ctx context.Context) kafka.ShipAssem
 func (d *diContainer) TelegramBot(ctx context.Context) *bot.Bot {
 	if d.telegramBot == nil {
 		cfg := config.AppConfig()
-		b, err := bot.New(cfg.TelegramBot.Token())
+		// Бот отвечает только на зарегистрированные команды, остальные обновления игнорируются
+		b, err := bot.New(cfg.TelegramBot.Token(), bot.WithDefaultHandler(func(context.Context, *bot.Bot, *models.Update) {}))
 		if err != nil {
 			log.Printf("❌ Ошибка создания Telegram бота: %v", err)
 			return nil
@@ -115,17 +203,163 @@ func (d *diContainer) TelegramClient(ctx context.Context) http.TelegramClient {
 	return d.telegramClient
 }
 
-func (d *diContainer) TelegramService(ctx context.Context) service.TelegramService {
-	if d.telegramService == nil {
-		d.telegramService = telegramService.NewService(d.TelegramClient(ctx))
+func (d *diContainer) TelegramChannel(ctx context.Context) service.Channel {
+	if d.telegramChannel == nil {
+		d.telegramChannel = telegramChannel.NewChannel(d.TelegramClient(ctx))
+	}
+	return d.telegramChannel
+}
+
+// EmailChannel создает канал отправки писем через SMTP-сервер из конфигурации
+func (d *diContainer) EmailChannel(ctx context.Context) service.Channel {
+	if d.emailChannel == nil {
+		cfg := config.AppConfig().Email
+		d.emailChannel = emailChannel.NewChannel(
+			cfg.SMTPHost(),
+			cfg.SMTPPort(),
+			cfg.SMTPUsername(),
+			cfg.SMTPPassword(),
+			cfg.From(),
+			cfg.Timeout(),
+		)
+	}
+	return d.emailChannel
+}
+
+// WebhookChannel создает канал подписанных запросов на webhook с журналом доставки
+func (d *diContainer) WebhookChannel(ctx context.Context) service.Channel {
+	if d.webhookChannel == nil {
+		cfg := config.AppConfig().Webhook
+		d.webhookChannel = webhookChannel.NewChannel(
+			d.WebhookLogRepository(ctx),
+			cfg.Timeout(),
+			cfg.MaxAttempts(),
+			cfg.RetryBackoff(),
+		)
+	}
+	return d.webhookChannel
+}
+
+func (d *diContainer) NotificationService(ctx context.Context) service.NotificationService {
+	if d.notificationService == nil {
+		d.notificationService = notificationService.NewService(
+			d.SubscriptionRepository(ctx),
+			d.DeliveryRepository(ctx),
+			[]service.Channel{d.TelegramChannel(ctx), d.EmailChannel(ctx), d.WebhookChannel(ctx)},
+			config.AppConfig().Delivery.DispatchInterval(),
+		)
+	}
+	return d.notificationService
+}
+
+func (d *diContainer) SubscriptionService(ctx context.Context) service.SubscriptionService {
+	if d.subscriptionService == nil {
+		cfg := config.AppConfig()
+		d.subscriptionService = subscriptionService.NewService(
+			d.SubscriptionRepository(ctx),
+			d.WebhookLogRepository(ctx),
+			d.EmailChannel(ctx),
+			d.WebhookChannel(ctx),
+			cfg.TelegramBot.Username(),
+			cfg.TelegramBot.LinkTokenTTL(),
+			cfg.Email.ConfirmationTTL(),
+		)
+	}
+	return d.subscriptionService
+}
+
+func (d *diContainer) SubscriptionRepository(ctx context.Context) repository.SubscriptionRepository {
+	if d.subscriptionRepository == nil {
+		d.subscriptionRepository = subscriptionRepository.NewRepository(d.PGPool(ctx))
+	}
+	return d.subscriptionRepository
+}
+
+func (d *diContainer) DeliveryRepository(ctx context.Context) repository.DeliveryRepository {
+	if d.deliveryRepository == nil {
+		d.deliveryRepository = deliveryRepository.NewRepository(d.PGPool(ctx))
+	}
+	return d.deliveryRepository
+}
+
+func (d *diContainer) WebhookLogRepository(ctx context.Context) repository.WebhookLogRepository {
+	if d.webhookLogRepository == nil {
+		d.webhookLogRepository = webhookLogRepository.NewRepository(d.PGPool(ctx))
+	}
+	return d.webhookLogRepository
+}
+
+// InboxStore создает хранилище обработанных событий Kafka, общее для всех consumer-ов сервиса
+func (d *diContainer) InboxStore(ctx context.Context) *kafkaMiddleware.PostgresInbox {
+	if d.inboxStore == nil {
+		d.inboxStore = kafkaMiddleware.NewPostgresInbox(d.PGPool(ctx))
+	}
+	return d.inboxStore
+}
+
+func (d *diContainer) PGPool(ctx context.Context) *pgxpool.Pool {
+	if d.pgPool == nil {
+		pgPool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
+		if err != nil {
+			log.Printf("❌ Ошибка подключения к PostgreSQL: %v", err)
+			return nil
+		}
+		if err = metrics.RegisterPgxPool(pgPool); err != nil {
+			log.Printf("❌ Ошибка регистрации метрик пула PostgreSQL: %v", err)
+		}
+		d.pgPool = pgPool
+	}
+	return d.pgPool
+}
+
+func (d *diContainer) PGMigrator(ctx context.Context) *migrator.Migrator {
+	if d.pgMigrator == nil {
+		db := stdlib.OpenDBFromPool(d.PGPool(ctx))
+		d.pgMigrator = migrator.NewMigrator(db, config.AppConfig().Postgres.MigrationDir())
+	}
+	return d.pgMigrator
+}
+
+// HealthRegistry создает реестр health-проверок зависимостей сервиса
+func (d *diContainer) HealthRegistry(ctx context.Context) *platformHealth.Registry {
+	if d.healthRegistry == nil {
+		cfg := config.AppConfig().Health
+		registry := platformHealth.NewRegistry(cfg.CheckInterval(), cfg.CheckTimeout())
+		registry.Register("postgres", platformHealth.PgxPoolChecker(d.PGPool(ctx)))
+		registry.RegisterService(notificationV1.NotificationService_ServiceDesc.ServiceName, "postgres")
+		d.healthRegistry = registry
+	}
+	return d.healthRegistry
+}
+
+// AuthVerifier создает проверку JWT с ключами из JWKS-файла или статическим ключом
+func (d *diContainer) AuthVerifier(ctx context.Context) *auth.Verifier {
+	if d.authVerifier == nil {
+		cfg := config.AppConfig().Auth
+
+		var (
+			keys *auth.KeySet
+			err  error
+		)
+		if cfg.JWKSFile() != "" {
+			keys, err = auth.LoadJWKSFile(cfg.JWKSFile())
+		} else {
+			keys, err = auth.StaticKey(cfg.StaticKey())
+		}
+		if err != nil {
+			log.Printf("❌ Ошибка загрузки ключей JWT: %v", err)
+			return nil
+		}
+
+		d.authVerifier = auth.NewVerifier(keys, cfg.Issuer(), cfg.Audience())
 	}
-	return d.telegramService
+	return d.authVerifier
 }
 
 func (d *diContainer) OrderPaidConsumerService(ctx context.Context) service.ConsumerService {
-	return consumerPaidService.NewService(d.OrderPaidConsumer(ctx), d.OrderPaidDecoder(ctx), d.TelegramService(ctx))
+	return consumerPaidService.NewService(d.OrderPaidConsumer(ctx), d.OrderPaidDecoder(ctx), d.NotificationService(ctx))
 }
 
 func (d *diContainer) OrderAssembledConsumerService(ctx context.Context) service.ConsumerService {
-	return consumerAssembledService.NewService(d.OrderAssembledConsumer(ctx), d.OrderAssembledDecoder(ctx), d.TelegramService(ctx))
+	return consumerAssembledService.NewService(d.OrderAssembledConsumer(ctx), d.OrderAssembledDecoder(ctx), d.NotificationService(ctx))
 }
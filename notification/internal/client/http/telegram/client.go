@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
 )
 
 type client struct {
@@ -22,7 +23,7 @@ func (c *client) SendMessage(ctx context.Context, chatID int64, text string) err
 	_, err := c.bot.SendMessage(ctx, &bot.SendMessageParams{
 		ChatID:    chatID,
 		Text:      text,
-		ParseMode: "Markdown",
+		ParseMode: models.ParseModeHTML,
 	})
 	if err != nil {
 		return err
@@ -12,10 +12,21 @@ var appConfig *config
 
 type config struct {
 	Logger                 LoggerConfig
+	Tracing                TracingConfig
+	Metrics                MetricsConfig
 	Kafka                  KafkaConfig
+	KafkaConsumerRetry     KafkaConsumerRetryConfig
 	OrderPaidConsumer      OrderPaidConsumerConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
 	TelegramBot            TelegramBotConfig
+	Email                  EmailConfig
+	Webhook                WebhookConfig
+	Delivery               DeliveryConfig
+	NotificationGRPC       NotificationGRPCConfig
+	Postgres               PostgresConfig
+	Inbox                  InboxConfig
+	Health                 HealthConfig
+	Auth                   AuthConfig
 }
 
 func Load(path ...string) error {
@@ -29,11 +40,26 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
+	metricsCfg, err := env.NewMetricsConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaCfg, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
 	}
 
+	kafkaConsumerRetryCfg, err := env.NewKafkaConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidConsumerCfg, err := env.NewOrderPaidConsumerConfig()
 	if err != nil {
 		return err
@@ -49,12 +75,63 @@ func Load(path ...string) error {
 		return err
 	}
 
+	emailCfg, err := env.NewEmailConfig()
+	if err != nil {
+		return err
+	}
+
+	webhookCfg, err := env.NewWebhookConfig()
+	if err != nil {
+		return err
+	}
+
+	deliveryCfg, err := env.NewDeliveryConfig()
+	if err != nil {
+		return err
+	}
+
+	notificationGRPCCfg, err := env.NewNotificationGRPCConfig()
+	if err != nil {
+		return err
+	}
+
+	postgresCfg, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+
+	inboxCfg, err := env.NewInboxConfig()
+	if err != nil {
+		return err
+	}
+
+	healthCfg, err := env.NewHealthConfig()
+	if err != nil {
+		return err
+	}
+
+	authCfg, err := env.NewAuthConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:                 loggerCfg,
+		Tracing:                tracingCfg,
+		Metrics:                metricsCfg,
 		Kafka:                  kafkaCfg,
+		KafkaConsumerRetry:     kafkaConsumerRetryCfg,
 		OrderPaidConsumer:      orderPaidConsumerCfg,
 		OrderAssembledConsumer: orderAssembledConsumerCfg,
 		TelegramBot:            telegramBotCfg,
+		Email:                  emailCfg,
+		Webhook:                webhookCfg,
+		Delivery:               deliveryCfg,
+		NotificationGRPC:       notificationGRPCCfg,
+		Postgres:               postgresCfg,
+		Inbox:                  inboxCfg,
+		Health:                 healthCfg,
+		Auth:                   authCfg,
 	}
 	return nil
 }
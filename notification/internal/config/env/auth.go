@@ -0,0 +1,50 @@
+package env
+
+import (
+	"errors"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type authEnvConfig struct {
+	JWKSFile  string `env:"AUTH_JWKS_FILE"`
+	StaticKey string `env:"AUTH_STATIC_KEY"`
+	Issuer    string `env:"AUTH_ISSUER,required"`
+	Audience  string `env:"AUTH_AUDIENCE,required"`
+}
+
+type authConfig struct {
+	raw authEnvConfig
+}
+
+func NewAuthConfig() (*authConfig, error) {
+	var raw authEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	// Ключи проверки подписи задаются ровно одним способом
+	if (raw.JWKSFile == "") == (raw.StaticKey == "") {
+		return nil, errors.New("exactly one of AUTH_JWKS_FILE or AUTH_STATIC_KEY must be set")
+	}
+
+	return &authConfig{raw: raw}, nil
+}
+
+// JWKSFile возвращает путь к JWKS-файлу с открытыми ключами издателя токенов
+func (cfg *authConfig) JWKSFile() string {
+	return cfg.raw.JWKSFile
+}
+
+// StaticKey возвращает PEM открытого ключа или общий HMAC-секрет
+func (cfg *authConfig) StaticKey() string {
+	return cfg.raw.StaticKey
+}
+
+func (cfg *authConfig) Issuer() string {
+	return cfg.raw.Issuer
+}
+
+func (cfg *authConfig) Audience() string {
+	return cfg.raw.Audience
+}
@@ -0,0 +1,34 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type deliveryEnvConfig struct {
+	DispatchInterval time.Duration `env:"DELIVERY_DISPATCH_INTERVAL,required"`
+}
+
+type deliveryConfig struct {
+	raw deliveryEnvConfig
+}
+
+func NewDeliveryConfig() (*deliveryConfig, error) {
+	var raw deliveryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.DispatchInterval <= 0 {
+		return nil, errors.New("DELIVERY_DISPATCH_INTERVAL must be positive")
+	}
+
+	return &deliveryConfig{raw: raw}, nil
+}
+
+// DispatchInterval возвращает период отправки отложенных уведомлений
+func (cfg *deliveryConfig) DispatchInterval() time.Duration {
+	return cfg.raw.DispatchInterval
+}
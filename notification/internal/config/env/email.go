@@ -0,0 +1,82 @@
+package env
+
+import (
+	"errors"
+	"net/mail"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type emailEnvConfig struct {
+	SMTPHost        string        `env:"SMTP_HOST,required"`
+	SMTPPort        int           `env:"SMTP_PORT,required"`
+	SMTPUsername    string        `env:"SMTP_USERNAME"`
+	SMTPPassword    string        `env:"SMTP_PASSWORD"`
+	From            string        `env:"SMTP_FROM,required"`
+	Timeout         time.Duration `env:"SMTP_TIMEOUT,required"`
+	ConfirmationTTL time.Duration `env:"EMAIL_CONFIRMATION_TTL,required"`
+}
+
+type emailConfig struct {
+	raw  emailEnvConfig
+	from *mail.Address
+}
+
+func NewEmailConfig() (*emailConfig, error) {
+	var raw emailEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.SMTPPort <= 0 || raw.SMTPPort > 65535 {
+		return nil, errors.New("SMTP_PORT must be a valid port")
+	}
+
+	if raw.Timeout <= 0 {
+		return nil, errors.New("SMTP_TIMEOUT must be positive")
+	}
+
+	if raw.ConfirmationTTL <= 0 {
+		return nil, errors.New("EMAIL_CONFIRMATION_TTL must be positive")
+	}
+
+	from, err := mail.ParseAddress(raw.From)
+	if err != nil {
+		return nil, errors.New("SMTP_FROM must be an email address")
+	}
+
+	return &emailConfig{raw: raw, from: from}, nil
+}
+
+func (cfg *emailConfig) SMTPHost() string {
+	return cfg.raw.SMTPHost
+}
+
+func (cfg *emailConfig) SMTPPort() int {
+	return cfg.raw.SMTPPort
+}
+
+// SMTPUsername возвращает логин SMTP-сервера; пустое значение отключает аутентификацию
+func (cfg *emailConfig) SMTPUsername() string {
+	return cfg.raw.SMTPUsername
+}
+
+func (cfg *emailConfig) SMTPPassword() string {
+	return cfg.raw.SMTPPassword
+}
+
+// From возвращает отправителя писем, например "Space Wanderer <noreply@example.com>"
+func (cfg *emailConfig) From() *mail.Address {
+	return cfg.from
+}
+
+// Timeout возвращает ограничение времени на отправку одного письма
+func (cfg *emailConfig) Timeout() time.Duration {
+	return cfg.raw.Timeout
+}
+
+// ConfirmationTTL возвращает срок действия токена подтверждения адреса
+func (cfg *emailConfig) ConfirmationTTL() time.Duration {
+	return cfg.raw.ConfirmationTTL
+}
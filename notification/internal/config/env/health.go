@@ -0,0 +1,35 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type healthEnvConfig struct {
+	CheckInterval time.Duration `env:"HEALTH_CHECK_INTERVAL,required"`
+	CheckTimeout  time.Duration `env:"HEALTH_CHECK_TIMEOUT,required"`
+}
+
+type healthConfig struct {
+	raw healthEnvConfig
+}
+
+func NewHealthConfig() (*healthConfig, error) {
+	var raw healthEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &healthConfig{raw: raw}, nil
+}
+
+// CheckInterval возвращает период проверки зависимостей
+func (cfg *healthConfig) CheckInterval() time.Duration {
+	return cfg.raw.CheckInterval
+}
+
+// CheckTimeout возвращает таймаут одной проверки зависимости
+func (cfg *healthConfig) CheckTimeout() time.Duration {
+	return cfg.raw.CheckTimeout
+}
@@ -0,0 +1,46 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type kafkaConsumerRetryEnvConfig struct {
+	MaxAttempts int             `env:"KAFKA_CONSUMER_RETRY_MAX_ATTEMPTS,required"`
+	Backoff     time.Duration   `env:"KAFKA_CONSUMER_RETRY_BACKOFF,required"`
+	MaxBackoff  time.Duration   `env:"KAFKA_CONSUMER_RETRY_MAX_BACKOFF,required"`
+	Delays      []time.Duration `env:"KAFKA_CONSUMER_RETRY_DELAYS,required" envSeparator:","`
+}
+
+type kafkaConsumerRetryConfig struct {
+	raw kafkaConsumerRetryEnvConfig
+}
+
+func NewKafkaConsumerRetryConfig() (*kafkaConsumerRetryConfig, error) {
+	var raw kafkaConsumerRetryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &kafkaConsumerRetryConfig{raw: raw}, nil
+}
+
+// MaxAttempts - число попыток обработки сообщения в одном топике, включая первую
+func (cfg *kafkaConsumerRetryConfig) MaxAttempts() int {
+	return cfg.raw.MaxAttempts
+}
+
+// Backoff - пауза после первой неудачной попытки, дальше она удваивается до MaxBackoff
+func (cfg *kafkaConsumerRetryConfig) Backoff() time.Duration {
+	return cfg.raw.Backoff
+}
+
+func (cfg *kafkaConsumerRetryConfig) MaxBackoff() time.Duration {
+	return cfg.raw.MaxBackoff
+}
+
+// Delays - задержки топиков отложенных повторов, после последнего сообщение уходит в DLQ
+func (cfg *kafkaConsumerRetryConfig) Delays() []time.Duration {
+	return cfg.raw.Delays
+}
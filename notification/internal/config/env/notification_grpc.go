@@ -0,0 +1,28 @@
+package env
+
+import (
+	"net"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type notificationGRPCEnvConfig struct {
+	Host string `env:"GRPC_HOST,required"`
+	Port string `env:"GRPC_PORT,required"`
+}
+
+type notificationGRPCConfig struct {
+	raw notificationGRPCEnvConfig
+}
+
+func NewNotificationGRPCConfig() (*notificationGRPCConfig, error) {
+	var raw notificationGRPCEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &notificationGRPCConfig{raw: raw}, nil
+}
+
+func (cfg *notificationGRPCConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
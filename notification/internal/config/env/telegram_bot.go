@@ -1,9 +1,16 @@
 package env
 
-import "github.com/caarlos0/env/v11"
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
 
 type telegramBotEnvConfig struct {
-	Token string `env:"TELEGRAM_BOT_TOKEN,required"`
+	Token        string        `env:"TELEGRAM_BOT_TOKEN,required"`
+	Username     string        `env:"TELEGRAM_BOT_USERNAME,required"`
+	LinkTokenTTL time.Duration `env:"TELEGRAM_LINK_TOKEN_TTL,required"`
 }
 
 type telegramBotConfig struct {
@@ -16,9 +23,23 @@ func NewTelegramBotConfig() (*telegramBotConfig, error) {
 		return nil, err
 	}
 
+	if raw.LinkTokenTTL <= 0 {
+		return nil, errors.New("TELEGRAM_LINK_TOKEN_TTL must be positive")
+	}
+
 	return &telegramBotConfig{raw: raw}, nil
 }
 
 func (cfg *telegramBotConfig) Token() string {
 	return cfg.raw.Token
 }
+
+// Username возвращает имя бота без @, из него строится ссылка привязки чата
+func (cfg *telegramBotConfig) Username() string {
+	return cfg.raw.Username
+}
+
+// LinkTokenTTL возвращает срок действия токена привязки чата
+func (cfg *telegramBotConfig) LinkTokenTTL() time.Duration {
+	return cfg.raw.LinkTokenTTL
+}
@@ -0,0 +1,54 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type webhookEnvConfig struct {
+	Timeout      time.Duration `env:"WEBHOOK_TIMEOUT,required"`
+	MaxAttempts  int           `env:"WEBHOOK_MAX_ATTEMPTS,required"`
+	RetryBackoff time.Duration `env:"WEBHOOK_RETRY_BACKOFF,required"`
+}
+
+type webhookConfig struct {
+	raw webhookEnvConfig
+}
+
+func NewWebhookConfig() (*webhookConfig, error) {
+	var raw webhookEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.Timeout <= 0 {
+		return nil, errors.New("WEBHOOK_TIMEOUT must be positive")
+	}
+
+	if raw.MaxAttempts < 1 {
+		return nil, errors.New("WEBHOOK_MAX_ATTEMPTS must be at least 1")
+	}
+
+	if raw.RetryBackoff <= 0 {
+		return nil, errors.New("WEBHOOK_RETRY_BACKOFF must be positive")
+	}
+
+	return &webhookConfig{raw: raw}, nil
+}
+
+// Timeout возвращает ограничение времени на один запрос к webhook
+func (cfg *webhookConfig) Timeout() time.Duration {
+	return cfg.raw.Timeout
+}
+
+// MaxAttempts возвращает число попыток доставки при одной отправке
+func (cfg *webhookConfig) MaxAttempts() int {
+	return cfg.raw.MaxAttempts
+}
+
+// RetryBackoff возвращает задержку перед второй попыткой; каждая следующая задержка вдвое больше
+func (cfg *webhookConfig) RetryBackoff() time.Duration {
+	return cfg.raw.RetryBackoff
+}
@@ -1,14 +1,36 @@
 package config
 
+import (
+	"net/mail"
+	"time"
+)
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+}
+
+type MetricsConfig interface {
+	Address() string
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
 
+// KafkaConsumerRetryConfig - политика повторной обработки сообщений Kafka и DLQ
+type KafkaConsumerRetryConfig interface {
+	MaxAttempts() int
+	Backoff() time.Duration
+	MaxBackoff() time.Duration
+	Delays() []time.Duration
+}
+
 type OrderPaidConsumerConfig interface {
 	TopicName() string
 	ConsumerGroupID() string
@@ -21,4 +43,60 @@ type OrderAssembledConsumerConfig interface {
 
 type TelegramBotConfig interface {
 	Token() string
+	Username() string
+	LinkTokenTTL() time.Duration
+}
+
+// EmailConfig - отправка уведомлений по SMTP
+type EmailConfig interface {
+	SMTPHost() string
+	SMTPPort() int
+	SMTPUsername() string
+	SMTPPassword() string
+	From() *mail.Address
+	Timeout() time.Duration
+	ConfirmationTTL() time.Duration
+}
+
+// WebhookConfig - отправка уведомлений на webhook пользователей
+type WebhookConfig interface {
+	Timeout() time.Duration
+	MaxAttempts() int
+	RetryBackoff() time.Duration
+}
+
+// DeliveryConfig - отправка отложенных уведомлений
+type DeliveryConfig interface {
+	DispatchInterval() time.Duration
+}
+
+type NotificationGRPCConfig interface {
+	Address() string
+}
+
+type PostgresConfig interface {
+	URI() string
+	Database() string
+	MigrationDir() string
+}
+
+// HealthConfig - периодические проверки зависимостей для gRPC health
+type HealthConfig interface {
+	CheckInterval() time.Duration
+	CheckTimeout() time.Duration
+}
+
+// AuthConfig - проверка JWT пользователей, вызывающих gRPC API
+type AuthConfig interface {
+	JWKSFile() string
+	StaticKey() string
+	Issuer() string
+	Audience() string
+}
+
+// InboxConfig - хранение отметок об обработанных событиях Kafka
+type InboxConfig interface {
+	Retention() time.Duration
+	CleanupInterval() time.Duration
+	CleanupBatchSize() int
 }
@@ -0,0 +1,136 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	notificationV1 "github.com/space-wanderer/microservices/shared/pkg/proto/notification/v1"
+)
+
+func ConvertLinkTokenToGRPC(token *model.LinkToken) *notificationV1.CreateTelegramLinkTokenResponse {
+	return &notificationV1.CreateTelegramLinkTokenResponse{
+		Token:     token.Token,
+		Link:      token.Link,
+		ExpiresAt: timestamppb.New(token.ExpiresAt),
+	}
+}
+
+func ConvertEmailLinkTokenToGRPC(token *model.LinkToken) *notificationV1.CreateEmailSubscriptionResponse {
+	return &notificationV1.CreateEmailSubscriptionResponse{
+		ExpiresAt: timestamppb.New(token.ExpiresAt),
+	}
+}
+
+// ConvertSubscriptionToGRPC не передает секрет подписи: он возвращается только при создании webhook-подписки
+func ConvertSubscriptionToGRPC(subscription *model.Subscription) *notificationV1.Subscription {
+	return &notificationV1.Subscription{
+		SubscriptionUuid: subscription.UUID,
+		Channel:          ConvertChannelToGRPC(subscription.Channel),
+		Recipient:        subscription.Recipient,
+		CreatedAt:        timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+func ConvertWebhookSubscriptionToGRPC(subscription *model.Subscription) *notificationV1.CreateWebhookSubscriptionResponse {
+	return &notificationV1.CreateWebhookSubscriptionResponse{
+		Subscription:  ConvertSubscriptionToGRPC(subscription),
+		SigningSecret: subscription.Secret,
+	}
+}
+
+func ConvertWebhookLogsToGRPC(logs []*model.WebhookLog) *notificationV1.ListWebhookDeliveryLogsResponse {
+	converted := make([]*notificationV1.WebhookDeliveryLog, len(logs))
+	for i, log := range logs {
+		converted[i] = &notificationV1.WebhookDeliveryLog{
+			LogUuid:     log.UUID,
+			MessageUuid: log.MessageUUID,
+			EventType:   ConvertEventTypeToGRPC(log.EventType),
+			Attempt:     int32(log.Attempt),
+			StatusCode:  int32(log.StatusCode),
+			Error:       log.Error,
+			DurationMs:  log.Duration.Milliseconds(),
+			CreatedAt:   timestamppb.New(log.CreatedAt),
+		}
+	}
+
+	return &notificationV1.ListWebhookDeliveryLogsResponse{Logs: converted}
+}
+
+func ConvertSettingsToGRPC(settings *model.Settings) *notificationV1.GetNotificationSettingsResponse {
+	subscriptions := make([]*notificationV1.Subscription, len(settings.Subscriptions))
+	for i, subscription := range settings.Subscriptions {
+		subscriptions[i] = ConvertSubscriptionToGRPC(subscription)
+	}
+
+	disabledEvents := make([]notificationV1.EventType, len(settings.Preferences.DisabledEvents))
+	for i, eventType := range settings.Preferences.DisabledEvents {
+		disabledEvents[i] = ConvertEventTypeToGRPC(eventType)
+	}
+
+	return &notificationV1.GetNotificationSettingsResponse{
+		Subscriptions:  subscriptions,
+		DisabledEvents: disabledEvents,
+		QuietHours:     ConvertQuietHoursToGRPC(settings.Preferences.QuietHours),
+	}
+}
+
+func ConvertQuietHoursToGRPC(quietHours *model.QuietHours) *notificationV1.QuietHours {
+	if quietHours == nil {
+		return nil
+	}
+
+	return &notificationV1.QuietHours{
+		StartMinute: int32(quietHours.StartMinute),
+		EndMinute:   int32(quietHours.EndMinute),
+		TimeZone:    quietHours.TimeZone,
+	}
+}
+
+// ConvertQuietHoursFromGRPC возвращает nil, если тихие часы не заданы
+func ConvertQuietHoursFromGRPC(quietHours *notificationV1.QuietHours) *model.QuietHours {
+	if quietHours == nil {
+		return nil
+	}
+
+	return &model.QuietHours{
+		StartMinute: int(quietHours.GetStartMinute()),
+		EndMinute:   int(quietHours.GetEndMinute()),
+		TimeZone:    quietHours.GetTimeZone(),
+	}
+}
+
+func ConvertChannelToGRPC(channel model.Channel) notificationV1.Channel {
+	switch channel {
+	case model.ChannelTelegram:
+		return notificationV1.Channel_CHANNEL_TELEGRAM
+	case model.ChannelEmail:
+		return notificationV1.Channel_CHANNEL_EMAIL
+	case model.ChannelWebhook:
+		return notificationV1.Channel_CHANNEL_WEBHOOK
+	default:
+		return notificationV1.Channel_CHANNEL_UNSPECIFIED
+	}
+}
+
+func ConvertEventTypeToGRPC(eventType model.EventType) notificationV1.EventType {
+	switch eventType {
+	case model.EventTypeOrderPaid:
+		return notificationV1.EventType_EVENT_TYPE_ORDER_PAID
+	case model.EventTypeShipAssembled:
+		return notificationV1.EventType_EVENT_TYPE_SHIP_ASSEMBLED
+	default:
+		return notificationV1.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+// ConvertEventTypeFromGRPC возвращает пустое значение для неизвестного события, его отклоняет сервис
+func ConvertEventTypeFromGRPC(eventType notificationV1.EventType) model.EventType {
+	switch eventType {
+	case notificationV1.EventType_EVENT_TYPE_ORDER_PAID:
+		return model.EventTypeOrderPaid
+	case notificationV1.EventType_EVENT_TYPE_SHIP_ASSEMBLED:
+		return model.EventTypeShipAssembled
+	default:
+		return ""
+	}
+}
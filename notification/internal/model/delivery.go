@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// Message - уведомление, подготовленное по шаблонам канала получателя
+type Message struct {
+	// UUID одинаков у всех попыток отправки, по нему получатель отбрасывает повторы
+	UUID      string
+	EventType EventType
+	Subject   string // Тема письма, для остальных каналов пуста
+	Body      string
+}
+
+// Delivery - уведомление, отправка которого отложена: на время тихих часов
+// или после неудачной попытки. Текст формируется при получении события
+type Delivery struct {
+	UUID         string
+	UserUUID     string
+	Subscription *Subscription
+	EventType    EventType
+	Subject      string
+	Body         string
+	DeliverAt    time.Time
+	Attempts     int
+	CreatedAt    time.Time
+}
+
+// Message возвращает отложенное уведомление; его UUID совпадает с UUID первой попытки отправки
+func (d *Delivery) Message() *Message {
+	return &Message{
+		UUID:      d.UUID,
+		EventType: d.EventType,
+		Subject:   d.Subject,
+		Body:      d.Body,
+	}
+}
+
+// WebhookLog - попытка доставки уведомления на webhook
+type WebhookLog struct {
+	UUID             string
+	SubscriptionUUID string
+	MessageUUID      string
+	EventType        EventType
+	Attempt          int
+	StatusCode       int // HTTP-статус ответа, 0 — ответ не получен
+	Error            string
+	Duration         time.Duration
+	CreatedAt        time.Time
+}
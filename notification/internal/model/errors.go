@@ -0,0 +1,21 @@
+package model
+
+import (
+	"errors"
+
+	sharedErrors "github.com/space-wanderer/microservices/shared/pkg/errors"
+)
+
+var (
+	ErrUnauthenticated      = sharedErrors.NewUnauthenticatedError(errors.New("authentication required"))
+	ErrInvalidUserUUID      = sharedErrors.NewInvalidArgumentError(errors.New("invalid user uuid"))
+	ErrInvalidEventType     = sharedErrors.NewInvalidArgumentError(errors.New("invalid event type"))
+	ErrInvalidQuietHours    = sharedErrors.NewInvalidArgumentError(errors.New("quiet hours must be distinct minutes of a day in a valid time zone"))
+	ErrSubscriptionNotFound = sharedErrors.NewNotFoundError(errors.New("subscription not found"))
+	ErrLinkTokenNotFound    = sharedErrors.NewNotFoundError(errors.New("link token not found or expired"))
+	ErrUnsupportedChannel   = sharedErrors.NewInvalidArgumentError(errors.New("unsupported notification channel"))
+	ErrInvalidRecipient     = sharedErrors.NewInvalidArgumentError(errors.New("invalid recipient"))
+	ErrSubscriptionExists   = sharedErrors.NewFailedPreconditionError(errors.New("subscription already exists"))
+	ErrConfirmationNotSent  = sharedErrors.NewUnavailableError(errors.New("failed to send confirmation email"))
+	ErrInvalidPageSize      = sharedErrors.NewInvalidArgumentError(errors.New("page size must not be negative"))
+)
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+// minutesPerDay — число минут в сутках, граница значений StartMinute и EndMinute
+const minutesPerDay = 24 * 60
+
+// QuietHours - ежедневный интервал [StartMinute, EndMinute) в часовом поясе TimeZone,
+// в который уведомления откладываются до его окончания. Интервал может переходить через полночь
+type QuietHours struct {
+	StartMinute int
+	EndMinute   int
+	TimeZone    string
+}
+
+// Valid проверяет границы интервала и часовой пояс
+func (q *QuietHours) Valid() bool {
+	if q.StartMinute < 0 || q.StartMinute >= minutesPerDay || q.EndMinute < 0 || q.EndMinute >= minutesPerDay {
+		return false
+	}
+	if q.StartMinute == q.EndMinute {
+		return false
+	}
+
+	_, err := time.LoadLocation(q.TimeZone)
+	return err == nil
+}
+
+// DeferUntil возвращает окончание тихих часов, если момент t попадает в них,
+// и false, если уведомление можно отправить сразу
+func (q *QuietHours) DeferUntil(t time.Time) (time.Time, bool) {
+	location, err := time.LoadLocation(q.TimeZone)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	local := t.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+
+	switch {
+	case q.StartMinute < q.EndMinute:
+		// Интервал внутри суток, например 13:00-15:00
+		if minute < q.StartMinute || minute >= q.EndMinute {
+			return time.Time{}, false
+		}
+		return midnight.Add(time.Duration(q.EndMinute) * time.Minute), true
+	case minute >= q.StartMinute:
+		// Интервал через полночь, вечерняя часть: окончание на следующие сутки
+		return midnight.AddDate(0, 0, 1).Add(time.Duration(q.EndMinute) * time.Minute), true
+	case minute < q.EndMinute:
+		// Интервал через полночь, утренняя часть
+		return midnight.Add(time.Duration(q.EndMinute) * time.Minute), true
+	default:
+		return time.Time{}, false
+	}
+}
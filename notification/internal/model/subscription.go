@@ -0,0 +1,62 @@
+package model
+
+import "time"
+
+// Channel - канал доставки уведомлений
+type Channel string
+
+const (
+	ChannelTelegram Channel = "TELEGRAM"
+	ChannelEmail    Channel = "EMAIL"
+	ChannelWebhook  Channel = "WEBHOOK"
+)
+
+// EventType - событие, о котором отправляется уведомление
+type EventType string
+
+const (
+	EventTypeOrderPaid     EventType = "ORDER_PAID"
+	EventTypeShipAssembled EventType = "SHIP_ASSEMBLED"
+)
+
+// Subscription - получатель уведомлений пользователя в одном из каналов
+type Subscription struct {
+	UUID      string
+	UserUUID  string
+	Channel   Channel
+	Recipient string // Адрес в канале: идентификатор Telegram-чата, адрес почты или URL webhook
+	Secret    string // Секрет подписи запросов webhook, для остальных каналов пуст
+	CreatedAt time.Time
+}
+
+// LinkToken - одноразовый токен, которым пользователь подтверждает привязку получателя в канале
+type LinkToken struct {
+	Token     string
+	UserUUID  string
+	Channel   Channel
+	Recipient string // Подтверждаемый адрес; пуст, если адрес становится известен при погашении токена
+	Link      string // Ссылка, по которой пользователь передает токен в канал
+	ExpiresAt time.Time
+}
+
+// Preferences - настройки уведомлений пользователя
+type Preferences struct {
+	DisabledEvents []EventType
+	QuietHours     *QuietHours // nil — тихие часы отключены
+}
+
+// Enabled сообщает, получает ли пользователь уведомления о событии eventType
+func (p *Preferences) Enabled(eventType EventType) bool {
+	for _, disabled := range p.DisabledEvents {
+		if disabled == eventType {
+			return false
+		}
+	}
+	return true
+}
+
+// Settings - подписки и настройки уведомлений пользователя
+type Settings struct {
+	Subscriptions []*Subscription
+	Preferences   Preferences
+}
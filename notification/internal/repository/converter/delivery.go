@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	repoModel "github.com/space-wanderer/microservices/notification/internal/repository/model"
+)
+
+func ConvertModelDeliveryToRepo(delivery *model.Delivery) *repoModel.Delivery {
+	return &repoModel.Delivery{
+		UUID:             delivery.UUID,
+		UserUUID:         delivery.UserUUID,
+		SubscriptionUUID: delivery.Subscription.UUID,
+		EventType:        string(delivery.EventType),
+		Subject:          delivery.Subject,
+		Body:             delivery.Body,
+		DeliverAt:        delivery.DeliverAt.UTC(),
+		Attempts:         delivery.Attempts,
+		CreatedAt:        delivery.CreatedAt.UTC(),
+	}
+}
+
+func ConvertRepoDeliveryToModel(delivery *repoModel.Delivery, subscription *repoModel.Subscription) *model.Delivery {
+	return &model.Delivery{
+		UUID:         delivery.UUID,
+		UserUUID:     delivery.UserUUID,
+		Subscription: ConvertRepoSubscriptionToModel(subscription),
+		EventType:    model.EventType(delivery.EventType),
+		Subject:      delivery.Subject,
+		Body:         delivery.Body,
+		DeliverAt:    delivery.DeliverAt,
+		Attempts:     delivery.Attempts,
+		CreatedAt:    delivery.CreatedAt,
+	}
+}
+
+func ConvertModelWebhookLogToRepo(log *model.WebhookLog) *repoModel.WebhookLog {
+	var (
+		statusCode *int
+		logErr     *string
+	)
+	if log.StatusCode != 0 {
+		statusCode = &log.StatusCode
+	}
+	if log.Error != "" {
+		logErr = &log.Error
+	}
+
+	return &repoModel.WebhookLog{
+		UUID:             log.UUID,
+		SubscriptionUUID: log.SubscriptionUUID,
+		MessageUUID:      log.MessageUUID,
+		EventType:        string(log.EventType),
+		Attempt:          log.Attempt,
+		StatusCode:       statusCode,
+		Error:            logErr,
+		DurationMs:       log.Duration.Milliseconds(),
+		CreatedAt:        log.CreatedAt.UTC(),
+	}
+}
+
+func ConvertRepoWebhookLogToModel(log *repoModel.WebhookLog) *model.WebhookLog {
+	webhookLog := &model.WebhookLog{
+		UUID:             log.UUID,
+		SubscriptionUUID: log.SubscriptionUUID,
+		MessageUUID:      log.MessageUUID,
+		EventType:        model.EventType(log.EventType),
+		Attempt:          log.Attempt,
+		Duration:         time.Duration(log.DurationMs) * time.Millisecond,
+		CreatedAt:        log.CreatedAt,
+	}
+	if log.StatusCode != nil {
+		webhookLog.StatusCode = *log.StatusCode
+	}
+	if log.Error != nil {
+		webhookLog.Error = *log.Error
+	}
+
+	return webhookLog
+}
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	repoModel "github.com/space-wanderer/microservices/notification/internal/repository/model"
+)
+
+func ConvertRepoSubscriptionToModel(subscription *repoModel.Subscription) *model.Subscription {
+	var secret string
+	if subscription.Secret != nil {
+		secret = *subscription.Secret
+	}
+
+	return &model.Subscription{
+		UUID:      subscription.UUID,
+		UserUUID:  subscription.UserUUID,
+		Channel:   model.Channel(subscription.Channel),
+		Recipient: subscription.Recipient,
+		Secret:    secret,
+		CreatedAt: subscription.CreatedAt,
+	}
+}
+
+func ConvertModelSubscriptionToRepo(subscription *model.Subscription) *repoModel.Subscription {
+	var secret *string
+	if subscription.Secret != "" {
+		secret = &subscription.Secret
+	}
+
+	return &repoModel.Subscription{
+		UUID:      subscription.UUID,
+		UserUUID:  subscription.UserUUID,
+		Channel:   string(subscription.Channel),
+		Recipient: subscription.Recipient,
+		Secret:    secret,
+		CreatedAt: subscription.CreatedAt.UTC(),
+	}
+}
+
+// ConvertRepoQuietHoursToModel возвращает nil, если тихие часы не заданы
+func ConvertRepoQuietHoursToModel(preferences *repoModel.Preferences) *model.QuietHours {
+	if preferences.QuietStartMinute == nil || preferences.QuietEndMinute == nil || preferences.QuietTimeZone == nil {
+		return nil
+	}
+
+	return &model.QuietHours{
+		StartMinute: *preferences.QuietStartMinute,
+		EndMinute:   *preferences.QuietEndMinute,
+		TimeZone:    *preferences.QuietTimeZone,
+	}
+}
+
+func ConvertModelQuietHoursToRepo(quietHours *model.QuietHours) *repoModel.Preferences {
+	if quietHours == nil {
+		return &repoModel.Preferences{}
+	}
+
+	return &repoModel.Preferences{
+		QuietStartMinute: &quietHours.StartMinute,
+		QuietEndMinute:   &quietHours.EndMinute,
+		QuietTimeZone:    &quietHours.TimeZone,
+	}
+}
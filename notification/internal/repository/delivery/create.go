@@ -0,0 +1,26 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository/converter"
+)
+
+// CreateDeliveries сохраняет уведомления одним пакетом запросов
+func (r *repository) CreateDeliveries(ctx context.Context, deliveries []*model.Delivery) error {
+	batch := &pgx.Batch{}
+	for _, delivery := range deliveries {
+		repoDelivery := converter.ConvertModelDeliveryToRepo(delivery)
+		batch.Queue(`
+			INSERT INTO notification_deliveries (delivery_uuid, user_uuid, subscription_uuid, event_type, subject, body,
+				deliver_at, attempts, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`, repoDelivery.UUID, repoDelivery.UserUUID, repoDelivery.SubscriptionUUID, repoDelivery.EventType,
+			repoDelivery.Subject, repoDelivery.Body, repoDelivery.DeliverAt, repoDelivery.Attempts, repoDelivery.CreatedAt)
+	}
+
+	return r.db.SendBatch(ctx, batch).Close()
+}
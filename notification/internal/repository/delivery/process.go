@@ -0,0 +1,96 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	repo "github.com/space-wanderer/microservices/notification/internal/repository"
+	"github.com/space-wanderer/microservices/notification/internal/repository/converter"
+	repoModel "github.com/space-wanderer/microservices/notification/internal/repository/model"
+)
+
+// ProcessDueDeliveries блокирует пачку уведомлений, время которых наступило, и отправляет их через sender
+// по текущему адресу подписки.
+// Отправленные уведомления удаляются, неудачные переносятся на задержку sender.RetryDelay,
+// а после исчерпания попыток удаляются. Строки, заблокированные другим экземпляром, пропускаются
+func (r *repository) ProcessDueDeliveries(ctx context.Context, limit int, sender repo.DeliverySender) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			_ = rollbackErr
+		}
+	}()
+
+	rows, err := tx.Query(ctx, `
+		SELECT d.delivery_uuid, d.user_uuid, d.subscription_uuid, d.event_type, d.subject, d.body, d.deliver_at,
+			d.attempts, d.created_at, s.channel, s.recipient, s.secret, s.created_at
+		FROM notification_deliveries d
+		JOIN notification_subscriptions s ON s.subscription_uuid = d.subscription_uuid
+		WHERE d.deliver_at <= NOW()
+		ORDER BY d.deliver_at
+		LIMIT $1
+		FOR UPDATE OF d SKIP LOCKED
+	`, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var deliveries []*model.Delivery
+	for rows.Next() {
+		var (
+			delivery     repoModel.Delivery
+			subscription repoModel.Subscription
+		)
+		if err = rows.Scan(&delivery.UUID, &delivery.UserUUID, &delivery.SubscriptionUUID, &delivery.EventType,
+			&delivery.Subject, &delivery.Body, &delivery.DeliverAt, &delivery.Attempts, &delivery.CreatedAt,
+			&subscription.Channel, &subscription.Recipient, &subscription.Secret, &subscription.CreatedAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		subscription.UUID = delivery.SubscriptionUUID
+		subscription.UserUUID = delivery.UserUUID
+		deliveries = append(deliveries, converter.ConvertRepoDeliveryToModel(&delivery, &subscription))
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		deliverErr := sender.Deliver(ctx, delivery)
+		if deliverErr == nil {
+			if _, err = tx.Exec(ctx, `DELETE FROM notification_deliveries WHERE delivery_uuid = $1`, delivery.UUID); err != nil {
+				return delivered, err
+			}
+			delivered++
+			continue
+		}
+
+		delay, retry := sender.RetryDelay(delivery.Attempts + 1)
+		if !retry {
+			if _, err = tx.Exec(ctx, `DELETE FROM notification_deliveries WHERE delivery_uuid = $1`, delivery.UUID); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE notification_deliveries
+			SET attempts = attempts + 1, last_error = $2, deliver_at = NOW() + make_interval(secs => $3)
+			WHERE delivery_uuid = $1
+		`, delivery.UUID, deliverErr.Error(), delay.Seconds())
+		if err != nil {
+			return delivered, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return delivered, nil
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type Delivery struct {
+	UUID             string
+	UserUUID         string
+	SubscriptionUUID string
+	EventType        string
+	Subject          string
+	Body             string
+	DeliverAt        time.Time
+	Attempts         int
+	CreatedAt        time.Time
+}
+
+type WebhookLog struct {
+	UUID             string
+	SubscriptionUUID string
+	MessageUUID      string
+	EventType        string
+	Attempt          int
+	StatusCode       *int
+	Error            *string
+	DurationMs       int64
+	CreatedAt        time.Time
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type Subscription struct {
+	UUID      string
+	UserUUID  string
+	Channel   string
+	Recipient string
+	Secret    *string
+	CreatedAt time.Time
+}
+
+type Preferences struct {
+	QuietStartMinute *int
+	QuietEndMinute   *int
+	QuietTimeZone    *string
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+// SubscriptionRepository хранит получателей уведомлений и настройки пользователей
+type SubscriptionRepository interface {
+	// CreateLinkToken сохраняет токен привязки; сам токен не хранится, только его хеш
+	CreateLinkToken(ctx context.Context, token *model.LinkToken) error
+	// LinkRecipient погашает токен привязки канала subscription.Channel и сохраняет подписку его владельца.
+	// Пустой subscription.Recipient берется из токена, а заданный subscription.UserUUID должен совпадать
+	// с владельцем токена. Если получатель уже привязан, возвращает существующую подписку.
+	// Если токен не найден или истек, возвращает model.ErrLinkTokenNotFound
+	LinkRecipient(ctx context.Context, token string, subscription *model.Subscription) (*model.Subscription, error)
+	// CreateSubscription сохраняет подписку, не требующую подтверждения.
+	// Если получатель уже привязан, возвращает model.ErrSubscriptionExists
+	CreateSubscription(ctx context.Context, subscription *model.Subscription) error
+	// GetSubscription возвращает подписку пользователя; чужая подписка не находится
+	GetSubscription(ctx context.Context, userUUID, subscriptionUUID string) (*model.Subscription, error)
+	ListSubscriptions(ctx context.Context, userUUID string) ([]*model.Subscription, error)
+	// DeleteSubscription удаляет подписку пользователя вместе с отложенными уведомлениями и журналом доставки
+	DeleteSubscription(ctx context.Context, userUUID, subscriptionUUID string) error
+
+	GetPreferences(ctx context.Context, userUUID string) (*model.Preferences, error)
+	// SetEventEnabled включает или отключает событие; при отключении отложенные уведомления о нем отменяются
+	SetEventEnabled(ctx context.Context, userUUID string, eventType model.EventType, enabled bool) error
+	// SetQuietHours сохраняет тихие часы; nil отключает их
+	SetQuietHours(ctx context.Context, userUUID string, quietHours *model.QuietHours) error
+}
+
+// DeliveryRepository хранит отложенные уведомления
+type DeliveryRepository interface {
+	CreateDeliveries(ctx context.Context, deliveries []*model.Delivery) error
+	// ProcessDueDeliveries отправляет через sender до limit уведомлений, время которых наступило.
+	// Несколько экземпляров сервиса обрабатывают разные уведомления
+	ProcessDueDeliveries(ctx context.Context, limit int, sender DeliverySender) (int, error)
+}
+
+// WebhookLogRepository хранит журнал попыток доставки на webhook
+type WebhookLogRepository interface {
+	CreateWebhookLog(ctx context.Context, log *model.WebhookLog) error
+	// ListWebhookLogs возвращает до limit последних записей подписки, от новых к старым
+	ListWebhookLogs(ctx context.Context, subscriptionUUID string, limit int) ([]*model.WebhookLog, error)
+}
+
+// DeliverySender отправляет отложенные уведомления и определяет задержку перед повторной попыткой
+type DeliverySender interface {
+	Deliver(ctx context.Context, delivery *model.Delivery) error
+	// RetryDelay возвращает задержку перед попыткой attempts или false, если попытки исчерпаны
+	RetryDelay(attempts int) (time.Duration, bool)
+}
@@ -0,0 +1,27 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository/converter"
+)
+
+func (r *repository) CreateSubscription(ctx context.Context, subscription *model.Subscription) error {
+	stored := converter.ConvertModelSubscriptionToRepo(subscription)
+
+	tag, err := r.db.Exec(ctx, `
+		INSERT INTO notification_subscriptions (subscription_uuid, user_uuid, channel, recipient, secret, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_uuid, channel, recipient) DO NOTHING
+	`, stored.UUID, stored.UserUUID, stored.Channel, stored.Recipient, stored.Secret, stored.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrSubscriptionExists
+	}
+
+	return nil
+}
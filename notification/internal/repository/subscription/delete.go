@@ -0,0 +1,24 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+// DeleteSubscription удаляет подписку; отложенные уведомления и журнал доставки удаляются каскадно
+func (r *repository) DeleteSubscription(ctx context.Context, userUUID, subscriptionUUID string) error {
+	tag, err := r.db.Exec(ctx, `
+		DELETE FROM notification_subscriptions
+		WHERE subscription_uuid = $1 AND user_uuid = $2
+	`, subscriptionUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrSubscriptionNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository/converter"
+)
+
+// CreateLinkToken сохраняет хеш токена и заодно удаляет истекшие токены
+func (r *repository) CreateLinkToken(ctx context.Context, token *model.LinkToken) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM notification_link_tokens WHERE expires_at <= NOW()`); err != nil {
+		return err
+	}
+
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO notification_link_tokens (token_hash, user_uuid, channel, recipient, expires_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5)
+	`, hashToken(token.Token), token.UserUUID, string(token.Channel), token.Recipient, token.ExpiresAt.UTC())
+
+	return err
+}
+
+// LinkRecipient удаляет токен и сохраняет подписку в одной транзакции, поэтому токен срабатывает один раз
+func (r *repository) LinkRecipient(ctx context.Context, token string, subscription *model.Subscription) (*model.Subscription, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			_ = rollbackErr
+		}
+	}()
+
+	// Токен другого пользователя не находится и не погашается
+	var userUUID, recipient string
+	err = tx.QueryRow(ctx, `
+		DELETE FROM notification_link_tokens
+		WHERE token_hash = $1 AND channel = $2 AND expires_at > NOW() AND ($3 = '' OR user_uuid = $3)
+		RETURNING user_uuid, COALESCE(recipient, $4)
+	`, hashToken(token), string(subscription.Channel), subscription.UserUUID, subscription.Recipient).Scan(&userUUID, &recipient)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrLinkTokenNotFound
+		}
+		return nil, err
+	}
+
+	// Повторная привязка того же получателя не создает дубль: обновление без изменений возвращает строку
+	stored, err := scanSubscription(tx.QueryRow(ctx, `
+		INSERT INTO notification_subscriptions (subscription_uuid, user_uuid, channel, recipient, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_uuid, channel, recipient) DO UPDATE SET recipient = EXCLUDED.recipient
+		RETURNING `+subscriptionColumns,
+		subscription.UUID, userUUID, string(subscription.Channel), recipient, subscription.CreatedAt.UTC(),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertRepoSubscriptionToModel(stored), nil
+}
@@ -0,0 +1,66 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository/converter"
+	repoModel "github.com/space-wanderer/microservices/notification/internal/repository/model"
+)
+
+const subscriptionColumns = `subscription_uuid, user_uuid, channel, recipient, secret, created_at`
+
+// scanSubscription читает строку, выбранную по subscriptionColumns
+func scanSubscription(row pgx.Row) (*repoModel.Subscription, error) {
+	var subscription repoModel.Subscription
+	err := row.Scan(&subscription.UUID, &subscription.UserUUID, &subscription.Channel, &subscription.Recipient,
+		&subscription.Secret, &subscription.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (r *repository) GetSubscription(ctx context.Context, userUUID, subscriptionUUID string) (*model.Subscription, error) {
+	subscription, err := scanSubscription(r.db.QueryRow(ctx, `
+		SELECT `+subscriptionColumns+`
+		FROM notification_subscriptions
+		WHERE subscription_uuid = $1 AND user_uuid = $2
+	`, subscriptionUUID, userUUID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrSubscriptionNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ConvertRepoSubscriptionToModel(subscription), nil
+}
+
+func (r *repository) ListSubscriptions(ctx context.Context, userUUID string) ([]*model.Subscription, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+subscriptionColumns+`
+		FROM notification_subscriptions
+		WHERE user_uuid = $1
+		ORDER BY created_at, subscription_uuid
+	`, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []*model.Subscription
+	for rows.Next() {
+		subscription, scanErr := scanSubscription(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		subscriptions = append(subscriptions, converter.ConvertRepoSubscriptionToModel(subscription))
+	}
+
+	return subscriptions, rows.Err()
+}
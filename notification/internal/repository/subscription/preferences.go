@@ -0,0 +1,83 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository/converter"
+	repoModel "github.com/space-wanderer/microservices/notification/internal/repository/model"
+)
+
+// GetPreferences возвращает настройки пользователя; если он их не менял, все события включены, тихих часов нет
+func (r *repository) GetPreferences(ctx context.Context, userUUID string) (*model.Preferences, error) {
+	var stored repoModel.Preferences
+	err := r.db.QueryRow(ctx, `
+		SELECT quiet_start_minute, quiet_end_minute, quiet_time_zone
+		FROM notification_preferences
+		WHERE user_uuid = $1
+	`, userUUID).Scan(&stored.QuietStartMinute, &stored.QuietEndMinute, &stored.QuietTimeZone)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT event_type FROM notification_opt_outs WHERE user_uuid = $1 ORDER BY event_type
+	`, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	preferences := &model.Preferences{
+		QuietHours: converter.ConvertRepoQuietHoursToModel(&stored),
+	}
+	for rows.Next() {
+		var eventType string
+		if err = rows.Scan(&eventType); err != nil {
+			return nil, err
+		}
+		preferences.DisabledEvents = append(preferences.DisabledEvents, model.EventType(eventType))
+	}
+
+	return preferences, rows.Err()
+}
+
+func (r *repository) SetEventEnabled(ctx context.Context, userUUID string, eventType model.EventType, enabled bool) error {
+	if enabled {
+		_, err := r.db.Exec(ctx, `
+			DELETE FROM notification_opt_outs WHERE user_uuid = $1 AND event_type = $2
+		`, userUUID, string(eventType))
+		return err
+	}
+
+	// Отложенные уведомления о событии тоже отменяются
+	_, err := r.db.Exec(ctx, `
+		WITH opted_out AS (
+			INSERT INTO notification_opt_outs (user_uuid, event_type)
+			VALUES ($1, $2)
+			ON CONFLICT (user_uuid, event_type) DO NOTHING
+		)
+		DELETE FROM notification_deliveries WHERE user_uuid = $1 AND event_type = $2
+	`, userUUID, string(eventType))
+
+	return err
+}
+
+func (r *repository) SetQuietHours(ctx context.Context, userUUID string, quietHours *model.QuietHours) error {
+	stored := converter.ConvertModelQuietHoursToRepo(quietHours)
+
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO notification_preferences (user_uuid, quiet_start_minute, quiet_end_minute, quiet_time_zone, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (user_uuid) DO UPDATE SET
+			quiet_start_minute = EXCLUDED.quiet_start_minute,
+			quiet_end_minute = EXCLUDED.quiet_end_minute,
+			quiet_time_zone = EXCLUDED.quiet_time_zone,
+			updated_at = EXCLUDED.updated_at
+	`, userUUID, stored.QuietStartMinute, stored.QuietEndMinute, stored.QuietTimeZone)
+
+	return err
+}
@@ -0,0 +1,22 @@
+package subscription
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *repository {
+	return &repository{db: db}
+}
+
+// hashToken возвращает хеш токена привязки, под которым он хранится в базе
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,21 @@
+package webhooklog
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository/converter"
+)
+
+func (r *repository) CreateWebhookLog(ctx context.Context, log *model.WebhookLog) error {
+	stored := converter.ConvertModelWebhookLogToRepo(log)
+
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO notification_webhook_logs (log_uuid, subscription_uuid, message_uuid, event_type, attempt,
+			status_code, error, duration_ms, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, stored.UUID, stored.SubscriptionUUID, stored.MessageUUID, stored.EventType, stored.Attempt,
+		stored.StatusCode, stored.Error, stored.DurationMs, stored.CreatedAt)
+
+	return err
+}
@@ -0,0 +1,35 @@
+package webhooklog
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository/converter"
+	repoModel "github.com/space-wanderer/microservices/notification/internal/repository/model"
+)
+
+func (r *repository) ListWebhookLogs(ctx context.Context, subscriptionUUID string, limit int) ([]*model.WebhookLog, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT log_uuid, subscription_uuid, message_uuid, event_type, attempt, status_code, error, duration_ms, created_at
+		FROM notification_webhook_logs
+		WHERE subscription_uuid = $1
+		ORDER BY created_at DESC, log_uuid
+		LIMIT $2
+	`, subscriptionUUID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logs []*model.WebhookLog
+	for rows.Next() {
+		var log repoModel.WebhookLog
+		if err = rows.Scan(&log.UUID, &log.SubscriptionUUID, &log.MessageUUID, &log.EventType, &log.Attempt,
+			&log.StatusCode, &log.Error, &log.DurationMs, &log.CreatedAt); err != nil {
+			return nil, err
+		}
+		logs = append(logs, converter.ConvertRepoWebhookLogToModel(&log))
+	}
+
+	return logs, rows.Err()
+}
@@ -0,0 +1,119 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+type channel struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     *mail.Address
+	timeout  time.Duration
+}
+
+// NewChannel создает канал, который отправляет уведомления письмами через SMTP-сервер host:port.
+// Если username пуст, сервер используется без аутентификации
+func NewChannel(host string, port int, username, password string, from *mail.Address, timeout time.Duration) *channel {
+	return &channel{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+		timeout:  timeout,
+	}
+}
+
+func (c *channel) Type() model.Channel {
+	return model.ChannelEmail
+}
+
+// ValidateRecipient проверяет, что получатель — один адрес электронной почты без имени
+func (c *channel) ValidateRecipient(recipient string) error {
+	address, err := mail.ParseAddress(recipient)
+	if err != nil || address.Address != recipient {
+		return model.ErrInvalidRecipient
+	}
+	return nil
+}
+
+// Send отправляет письмо с темой и HTML-телом уведомления
+func (c *channel) Send(ctx context.Context, subscription *model.Subscription, message *model.Message) error {
+	to, err := mail.ParseAddress(subscription.Recipient)
+	if err != nil {
+		return model.ErrInvalidRecipient
+	}
+
+	return c.sendMail(ctx, to, buildMessage(c.from, to, message, time.Now()))
+}
+
+// sendMail проводит SMTP-сессию: STARTTLS, если сервер его поддерживает, и аутентификацию, если задан логин.
+// Вся сессия ограничена timeout
+func (c *channel) sendMail(ctx context.Context, to *mail.Address, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(c.host, strconv.Itoa(c.port)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			_ = conn.Close()
+			return err
+		}
+	}
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: c.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if c.username != "" {
+		if err = client.Auth(smtp.PlainAuth("", c.username, c.password, c.host)); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	if err = client.Mail(c.from.Address); err != nil {
+		return err
+	}
+	if err = client.Rcpt(to.Address); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = writer.Write(msg); err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,248 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+// smtpSession - то, что фейковый SMTP-сервер получил за одну сессию
+type smtpSession struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTPServer - SMTP-сервер в процессе теста. Поддерживает EHLO, AUTH PLAIN, MAIL, RCPT, DATA и QUIT,
+// на RCPT к rejectRcpt отвечает 550
+type fakeSMTPServer struct {
+	listener   net.Listener
+	rejectRcpt string
+	sessions   chan smtpSession
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeSMTPServer{listener: listener, sessions: make(chan smtpSession, 1)}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go s.serve()
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	text := textproto.NewConn(conn)
+	var session smtpSession
+	reply := func(line string) { _ = text.PrintfLine("%s", line) }
+
+	reply("220 localhost ESMTP fake")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(command) {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			_, credentials, _ := strings.Cut(arg, " ")
+			decoded, _ := base64.StdEncoding.DecodeString(credentials)
+			session.auth = string(decoded)
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			session.from = arg
+			reply("250 OK")
+		case "RCPT":
+			if s.rejectRcpt != "" && strings.Contains(arg, s.rejectRcpt) {
+				reply("550 mailbox unavailable")
+				continue
+			}
+			session.to = append(session.to, arg)
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			session.data = string(data)
+			reply("250 OK")
+		case "RSET", "NOOP":
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			s.sessions <- session
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+func testMessage() *model.Message {
+	return &model.Message{
+		UUID:      "550e8400-e29b-41d4-a716-446655440020",
+		EventType: model.EventTypeOrderPaid,
+		Subject:   "Заказ оплачен",
+		Body:      "<p>Заказ оплачен</p>",
+	}
+}
+
+func newTestChannel(server *fakeSMTPServer, username, password string) *channel {
+	return NewChannel("127.0.0.1", server.port(), username, password,
+		&mail.Address{Name: "Space Wanderer", Address: "noreply@space-wanderer.dev"}, time.Second)
+}
+
+func TestSend_DeliversMessage(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	subscription := &model.Subscription{Channel: model.ChannelEmail, Recipient: "pilot@example.com"}
+
+	err := newTestChannel(server, "", "").Send(context.Background(), subscription, testMessage())
+
+	require.NoError(t, err)
+	session := <-server.sessions
+	assert.Empty(t, session.auth)
+	assert.Equal(t, "FROM:<noreply@space-wanderer.dev>", session.from)
+	assert.Equal(t, []string{"TO:<pilot@example.com>"}, session.to)
+
+	msg, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(session.data)))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Заказ оплачен", subject)
+	assert.Equal(t, "<550e8400-e29b-41d4-a716-446655440020@space-wanderer.dev>", msg.Header.Get("Message-ID"))
+	assert.Equal(t, "base64", msg.Header.Get("Content-Transfer-Encoding"))
+
+	encoded, err := io.ReadAll(msg.Body)
+	require.NoError(t, err)
+	body, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Заказ оплачен</p>", string(body))
+}
+
+func TestSend_Authenticates(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	subscription := &model.Subscription{Channel: model.ChannelEmail, Recipient: "pilot@example.com"}
+
+	err := newTestChannel(server, "mailer", "secret").Send(context.Background(), subscription, testMessage())
+
+	require.NoError(t, err)
+	session := <-server.sessions
+	// AUTH PLAIN передает "authzid\x00username\x00password"
+	assert.Equal(t, "\x00mailer\x00secret", session.auth)
+}
+
+func TestSend_RecipientRejected(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	server.rejectRcpt = "pilot@example.com"
+	subscription := &model.Subscription{Channel: model.ChannelEmail, Recipient: "pilot@example.com"}
+
+	err := newTestChannel(server, "", "").Send(context.Background(), subscription, testMessage())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "550")
+}
+
+func TestSend_ServerUnavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	c := NewChannel("127.0.0.1", port, "", "", &mail.Address{Address: "noreply@space-wanderer.dev"}, time.Second)
+	err = c.Send(context.Background(), &model.Subscription{Recipient: "pilot@example.com"}, testMessage())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to connect to SMTP server")
+}
+
+func TestSend_InvalidRecipient(t *testing.T) {
+	c := NewChannel("127.0.0.1", 25, "", "", &mail.Address{Address: "noreply@space-wanderer.dev"}, time.Second)
+
+	err := c.Send(context.Background(), &model.Subscription{Recipient: "not an address"}, testMessage())
+
+	require.ErrorIs(t, err, model.ErrInvalidRecipient)
+}
+
+func TestValidateRecipient(t *testing.T) {
+	tests := []struct {
+		recipient string
+		valid     bool
+	}{
+		{recipient: "pilot@example.com", valid: true},
+		{recipient: "Pilot <pilot@example.com>", valid: false},
+		{recipient: "pilot@example.com, other@example.com", valid: false},
+		{recipient: "pilot", valid: false},
+		{recipient: "", valid: false},
+	}
+
+	c := NewChannel("127.0.0.1", 25, "", "", &mail.Address{Address: "noreply@space-wanderer.dev"}, time.Second)
+	for _, tt := range tests {
+		t.Run(tt.recipient, func(t *testing.T) {
+			err := c.ValidateRecipient(tt.recipient)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, model.ErrInvalidRecipient)
+			}
+		})
+	}
+}
+
+func TestBuildMessage_WrapsBody(t *testing.T) {
+	message := testMessage()
+	message.Body = strings.Repeat("Корабль собран. ", 50)
+	from := &mail.Address{Address: "noreply@space-wanderer.dev"}
+	to := &mail.Address{Address: "pilot@example.com"}
+
+	raw := string(buildMessage(from, to, message, time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)))
+
+	_, body, ok := strings.Cut(raw, "\r\n\r\n")
+	require.True(t, ok)
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), lineLength)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, message.Body, string(decoded))
+	assert.Contains(t, raw, "Date: Wed, 01 Oct 2025 12:00:00 +0000\r\n")
+}
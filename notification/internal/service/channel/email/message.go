@@ -0,0 +1,58 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+// lineLength - максимальная длина строки тела письма в base64 по RFC 2045
+const lineLength = 76
+
+// buildMessage формирует письмо в формате RFC 5322 с HTML-телом в base64.
+// Message-ID строится из UUID уведомления, поэтому повторная отправка дает то же письмо
+func buildMessage(from, to *mail.Address, message *model.Message, now time.Time) []byte {
+	var buf bytes.Buffer
+
+	writeHeader(&buf, "From", from.String())
+	writeHeader(&buf, "To", to.String())
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", message.Subject))
+	writeHeader(&buf, "Date", now.Format(time.RFC1123Z))
+	writeHeader(&buf, "Message-ID", fmt.Sprintf("<%s@%s>", message.UUID, domain(from.Address)))
+	writeHeader(&buf, "MIME-Version", "1.0")
+	writeHeader(&buf, "Content-Type", `text/html; charset="utf-8"`)
+	writeHeader(&buf, "Content-Transfer-Encoding", "base64")
+	buf.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(message.Body))
+	for len(encoded) > lineLength {
+		buf.WriteString(encoded[:lineLength])
+		buf.WriteString("\r\n")
+		encoded = encoded[lineLength:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\r\n")
+
+	return buf.Bytes()
+}
+
+func writeHeader(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(name)
+	buf.WriteString(": ")
+	buf.WriteString(value)
+	buf.WriteString("\r\n")
+}
+
+// domain возвращает домен адреса отправителя для Message-ID
+func domain(address string) string {
+	if _, host, ok := strings.Cut(address, "@"); ok && host != "" {
+		return host
+	}
+	return "localhost"
+}
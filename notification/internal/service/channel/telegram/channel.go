@@ -0,0 +1,42 @@
+package telegram
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/space-wanderer/microservices/notification/internal/client/http"
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+type channel struct {
+	telegramClient http.TelegramClient
+}
+
+// NewChannel создает канал, который отправляет уведомления в Telegram-чаты через бота
+func NewChannel(telegramClient http.TelegramClient) *channel {
+	return &channel{
+		telegramClient: telegramClient,
+	}
+}
+
+func (c *channel) Type() model.Channel {
+	return model.ChannelTelegram
+}
+
+// ValidateRecipient проверяет, что получатель — идентификатор чата
+func (c *channel) ValidateRecipient(recipient string) error {
+	if _, err := strconv.ParseInt(recipient, 10, 64); err != nil {
+		return model.ErrInvalidRecipient
+	}
+	return nil
+}
+
+// Send отправляет тело уведомления в чат получателя; тема не используется
+func (c *channel) Send(ctx context.Context, subscription *model.Subscription, message *model.Message) error {
+	chatID, err := strconv.ParseInt(subscription.Recipient, 10, 64)
+	if err != nil {
+		return model.ErrInvalidRecipient
+	}
+
+	return c.telegramClient.SendMessage(ctx, chatID, message.Body)
+}
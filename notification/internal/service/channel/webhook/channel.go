@@ -0,0 +1,177 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+const (
+	// Заголовки запроса, по которым получатель проверяет и дедуплицирует уведомление
+	eventHeader     = "X-Notification-Event"
+	deliveryHeader  = "X-Notification-Delivery"
+	timestampHeader = "X-Notification-Timestamp"
+	signatureHeader = "X-Notification-Signature"
+
+	userAgent = "space-wanderer-notification/1.0"
+
+	// maxResponseBytes - сколько байт ответа вычитывается, чтобы соединение вернулось в пул
+	maxResponseBytes = 64 << 10
+)
+
+var errInvalidSecret = errors.New("webhook subscription has no signing secret")
+
+type channel struct {
+	webhookLogRepository repository.WebhookLogRepository
+	httpClient           *http.Client
+
+	maxAttempts  int
+	retryBackoff time.Duration
+}
+
+// NewChannel создает канал, который отправляет уведомления POST-запросами с JSON, подписанными секретом подписки.
+// Каждая попытка ограничена timeout; ответы 5xx, 429 и сетевые ошибки повторяются до maxAttempts раз
+// с удваивающейся задержкой от retryBackoff. Все попытки записываются в журнал доставки.
+// Запросы во внутреннюю сеть сервиса не отправляются
+func NewChannel(
+	webhookLogRepository repository.WebhookLogRepository,
+	timeout time.Duration,
+	maxAttempts int,
+	retryBackoff time.Duration,
+) *channel {
+	return &channel{
+		webhookLogRepository: webhookLogRepository,
+		httpClient:           newHTTPClient(timeout, controlDial),
+		maxAttempts:          maxAttempts,
+		retryBackoff:         retryBackoff,
+	}
+}
+
+func (c *channel) Type() model.Channel {
+	return model.ChannelWebhook
+}
+
+// ValidateRecipient проверяет, что получатель — абсолютный URL http или https, который не указывает
+// на внутренний адрес явно
+func (c *channel) ValidateRecipient(recipient string) error {
+	u, err := url.Parse(recipient)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return model.ErrInvalidRecipient
+	}
+	if !allowedHost(u.Hostname()) {
+		return model.ErrInvalidRecipient
+	}
+	return nil
+}
+
+// Send отправляет тело уведомления на URL подписки, повторяя временные сбои.
+// Возвращает ошибку последней попытки; дальнейшие повторы выполняет очередь отложенных уведомлений
+func (c *channel) Send(ctx context.Context, subscription *model.Subscription, message *model.Message) error {
+	if subscription.Secret == "" {
+		return errInvalidSecret
+	}
+
+	for attempt := 1; ; attempt++ {
+		started := time.Now()
+		statusCode, err := c.post(ctx, subscription, message)
+		c.writeLog(ctx, subscription, message, attempt, statusCode, err, started)
+
+		if err == nil {
+			return nil
+		}
+
+		// Запрещенный адрес не станет разрешенным при повторе
+		if !retryable(statusCode) || attempt >= c.maxAttempts || errors.Is(err, errForbiddenAddress) {
+			return err
+		}
+
+		timer := time.NewTimer(c.retryBackoff << (attempt - 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// post выполняет одну попытку доставки и возвращает HTTP-статус ответа, 0 — ответ не получен
+func (c *channel) post(ctx context.Context, subscription *model.Subscription, message *model.Message) (int, error) {
+	body := []byte(message.Body)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Recipient, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(eventHeader, string(message.EventType))
+	req.Header.Set(deliveryHeader, message.UUID)
+	req.Header.Set(timestampHeader, timestamp)
+	req.Header.Set(signatureHeader, sign(subscription.Secret, timestamp, body))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// writeLog сохраняет попытку в журнал доставки; сбой журнала не влияет на отправку
+func (c *channel) writeLog(
+	ctx context.Context,
+	subscription *model.Subscription,
+	message *model.Message,
+	attempt int,
+	statusCode int,
+	sendErr error,
+	started time.Time,
+) {
+	log := &model.WebhookLog{
+		UUID:             uuid.NewString(),
+		SubscriptionUUID: subscription.UUID,
+		MessageUUID:      message.UUID,
+		EventType:        message.EventType,
+		Attempt:          attempt,
+		StatusCode:       statusCode,
+		Duration:         time.Since(started),
+		CreatedAt:        started,
+	}
+	if sendErr != nil {
+		log.Error = sendErr.Error()
+	}
+
+	if err := c.webhookLogRepository.CreateWebhookLog(context.WithoutCancel(ctx), log); err != nil {
+		logger.Warn(ctx, "Failed to write webhook delivery log",
+			zap.String("subscription_uuid", subscription.UUID),
+			zap.Error(err))
+	}
+}
+
+// retryable сообщает, имеет ли смысл повторить попытку сразу: ответ не получен, сервер перегружен или недоступен
+func retryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
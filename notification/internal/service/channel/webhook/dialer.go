@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// errForbiddenAddress - адрес webhook ведет в сеть самого сервиса, а не во внешний интернет
+var errForbiddenAddress = errors.New("webhook address is not allowed")
+
+var (
+	// metadataAddress - сервис метаданных облачных провайдеров, отдающий учетные данные инстанса
+	metadataAddress = netip.MustParseAddr("169.254.169.254")
+	// sharedAddressSpace - адреса CGNAT из RFC 6598, которые тоже не маршрутизируются в интернет
+	sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+)
+
+// allowedAddress сообщает, можно ли отправить webhook на ip. Запрещены адреса, через которые подписка
+// обратилась бы к самому сервису или внутренней сети: loopback, частные, link-local, unspecified,
+// multicast и адрес сервиса метаданных
+func allowedAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() &&
+		!ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified() &&
+		ip != metadataAddress &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// allowedHost проверяет хост URL подписки без обращения к DNS: отклоняет запрещенные IP-адреса и localhost.
+// Имена, которые разрешаются во внутренние адреса, отклоняются при подключении
+func allowedHost(host string) bool {
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return allowedAddress(ip)
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	return host != "localhost" && !strings.HasSuffix(host, ".localhost")
+}
+
+// controlDial проверяет адрес, к которому подключается клиент, уже после разрешения DNS.
+// Поэтому не помогают ни имя, указывающее на внутренний адрес, ни подмена DNS-ответа после проверки подписки
+func controlDial(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errForbiddenAddress, address)
+	}
+	if !allowedAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errForbiddenAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// newHTTPClient создает клиент webhook. control проверяет каждый адрес перед подключением;
+// прокси из окружения не используется, иначе проверялся бы адрес прокси, а не получателя
+func newHTTPClient(timeout time.Duration, control func(network, address string, conn syscall.RawConn) error) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   control,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		// Перенаправления не выполняются: подпись относится к адресу подписки
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// signaturePrefix - схема подписи в заголовке X-Notification-Signature
+const signaturePrefix = "sha256="
+
+// sign возвращает значение заголовка X-Notification-Signature: HMAC-SHA256 секретом secret
+// от строки "<timestamp>.<body>". Метка времени в подписи не дает повторить старый запрос
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
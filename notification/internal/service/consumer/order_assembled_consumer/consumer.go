@@ -6,7 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	kafkaConverter "github.com/space-wanderer/microservices/notification/internal/converter/kafka"
-	telegramService "github.com/space-wanderer/microservices/notification/internal/service"
+	notificationService "github.com/space-wanderer/microservices/notification/internal/service"
 	"github.com/space-wanderer/microservices/platform/pkg/kafka"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
 )
@@ -14,14 +14,14 @@ import (
 type service struct {
 	orderAssembledRecodeConsumer kafka.Consumer
 	orderAssembledRecodeDecoder  kafkaConverter.ShipAssembledDecoder
-	telegramService              telegramService.TelegramService
+	notificationService          notificationService.NotificationService
 }
 
-func NewService(orderAssembledRecodeConsumer kafka.Consumer, orderAssembledRecodeDecoder kafkaConverter.ShipAssembledDecoder, telegramService telegramService.TelegramService) *service {
+func NewService(orderAssembledRecodeConsumer kafka.Consumer, orderAssembledRecodeDecoder kafkaConverter.ShipAssembledDecoder, notificationService notificationService.NotificationService) *service {
 	return &service{
 		orderAssembledRecodeConsumer: orderAssembledRecodeConsumer,
 		orderAssembledRecodeDecoder:  orderAssembledRecodeDecoder,
-		telegramService:              telegramService,
+		notificationService:          notificationService,
 	}
 }
 
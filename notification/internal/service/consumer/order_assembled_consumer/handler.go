@@ -22,8 +22,8 @@ func (s *service) OrderAssembledHandler(ctx context.Context, msg consumer.Messag
 		zap.Any("build_time_sec", event.BuildTimeSec),
 	)
 
-	// Отправляем уведомление в Telegram
-	if err := s.telegramService.SendShipAssembledNotification(ctx, event.OrderUUID, event); err != nil {
+	// Отправляем уведомление получателям пользователя
+	if err := s.notificationService.SendShipAssembledNotification(ctx, event.OrderUUID, event); err != nil {
 		logger.Error(ctx, "Ошибка отправки уведомления ShipAssembled", zap.Error(err))
 		return err
 	}
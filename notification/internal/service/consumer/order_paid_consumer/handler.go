@@ -23,8 +23,8 @@ func (s *service) OrderPaidHandler(ctx context.Context, msg consumer.Message) er
 		zap.String("transaction_uuid", event.TransactionUUID),
 	)
 
-	// Отправляем уведомление в Telegram
-	if err := s.telegramService.SendOrderPaidNotification(ctx, event.OrderUUID, event); err != nil {
+	// Отправляем уведомление получателям пользователя
+	if err := s.notificationService.SendOrderPaidNotification(ctx, event.OrderUUID, event); err != nil {
 		logger.Error(ctx, "Ошибка отправки уведомления OrderPaid", zap.Error(err))
 		return err
 	}
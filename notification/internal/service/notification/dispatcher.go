@@ -0,0 +1,80 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+const (
+	// dispatchBatchSize - сколько отложенных уведомлений отправляется за один проход
+	dispatchBatchSize = 100
+	// maxDeliveryAttempts - после стольких неудачных попыток уведомление отбрасывается
+	maxDeliveryAttempts = 10
+	// retryBaseDelay и retryMaxDelay ограничивают экспоненциальную задержку между попытками
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// RunDeliveryDispatcher раз в dispatchInterval отправляет отложенные уведомления, время которых наступило
+func (s *service) RunDeliveryDispatcher(ctx context.Context) error {
+	logger.Info(ctx, "Starting notification delivery dispatcher", zap.Duration("interval", s.dispatchInterval))
+
+	ticker := time.NewTicker(s.dispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "🛑 Notification delivery dispatcher stopped")
+			return nil
+		case <-ticker.C:
+		}
+
+		if _, err := s.deliveryRepository.ProcessDueDeliveries(ctx, dispatchBatchSize, s); err != nil && ctx.Err() == nil {
+			logger.Error(ctx, "❌ Failed to process deferred notifications", zap.Error(err))
+		}
+	}
+}
+
+// Deliver отправляет отложенное уведомление; вызывается репозиторием при обработке очереди
+func (s *service) Deliver(ctx context.Context, delivery *model.Delivery) error {
+	err := s.send(ctx, delivery.Subscription, delivery.Message())
+	if err == nil {
+		return nil
+	}
+
+	if _, retry := retryDelay(delivery.Attempts + 1); !retry {
+		logger.Error(ctx, "❌ Notification dropped after all delivery attempts",
+			zap.String("delivery_uuid", delivery.UUID),
+			zap.String("user_uuid", delivery.UserUUID),
+			zap.String("subscription_uuid", delivery.Subscription.UUID),
+			zap.String("channel", string(delivery.Subscription.Channel)),
+			zap.Int("attempts", delivery.Attempts+1),
+			zap.Error(err))
+	}
+
+	return err
+}
+
+func (s *service) RetryDelay(attempts int) (time.Duration, bool) {
+	return retryDelay(attempts)
+}
+
+// retryDelay возвращает задержку перед следующей попыткой после attempts неудачных или false, если попытки исчерпаны
+func retryDelay(attempts int) (time.Duration, bool) {
+	if attempts >= maxDeliveryAttempts {
+		return 0, false
+	}
+
+	delay := retryBaseDelay << (attempts - 1)
+	if delay > retryMaxDelay || delay <= 0 {
+		delay = retryMaxDelay
+	}
+
+	return delay, true
+}
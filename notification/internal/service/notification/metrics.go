@@ -0,0 +1,36 @@
+package notification
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	notificationsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "notification",
+		Subsystem: "messages",
+		Name:      "sent_total",
+		Help:      "Количество отправленных уведомлений по каналам и событиям",
+	}, []string{"channel", "event_type"})
+
+	notificationsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "notification",
+		Subsystem: "messages",
+		Name:      "failed_total",
+		Help:      "Количество неудачных попыток отправки уведомлений по каналам и событиям",
+	}, []string{"channel", "event_type"})
+
+	notificationsDeferred = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "notification",
+		Subsystem: "messages",
+		Name:      "deferred_total",
+		Help:      "Количество уведомлений, отложенных на время тихих часов",
+	}, []string{"event_type"})
+
+	notificationsSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "notification",
+		Subsystem: "messages",
+		Name:      "opted_out_total",
+		Help:      "Количество событий, уведомления о которых пользователь отключил",
+	}, []string{"event_type"})
+)
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// notify отправляет уведомление всем получателям пользователя userUUID, если он не отключил событие.
+// Текст формируется по шаблонам канала каждого получателя. В тихие часы уведомления откладываются
+// до их окончания. Получатели, которым отправить не удалось, повторяются через очередь отложенных
+// уведомлений, чтобы повтор события не дублировал остальным
+func (s *service) notify(ctx context.Context, userUUID string, renderer *renderer) error {
+	eventType := renderer.eventType
+
+	subscriptions, err := s.subscriptionRepository.ListSubscriptions(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if len(subscriptions) == 0 {
+		logger.Info(ctx, "User has no notification recipients, skipping",
+			zap.String("user_uuid", userUUID),
+			zap.String("event_type", string(eventType)))
+		return nil
+	}
+
+	preferences, err := s.subscriptionRepository.GetPreferences(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if !preferences.Enabled(eventType) {
+		notificationsSkipped.WithLabelValues(string(eventType)).Inc()
+		logger.Info(ctx, "User opted out of the event, skipping",
+			zap.String("user_uuid", userUUID),
+			zap.String("event_type", string(eventType)))
+		return nil
+	}
+
+	now := time.Now()
+	if preferences.QuietHours != nil {
+		if until, quiet := preferences.QuietHours.DeferUntil(now); quiet {
+			deliveries := make([]*model.Delivery, 0, len(subscriptions))
+			for _, subscription := range subscriptions {
+				message, renderErr := renderer.render(subscription.Channel)
+				if renderErr != nil {
+					return renderErr
+				}
+				deliveries = append(deliveries, newDelivery(subscription, message, until, 0, now))
+			}
+
+			if err = s.deliveryRepository.CreateDeliveries(ctx, deliveries); err != nil {
+				return err
+			}
+
+			notificationsDeferred.WithLabelValues(string(eventType)).Add(float64(len(deliveries)))
+			logger.Info(ctx, "Quiet hours, notification deferred",
+				zap.String("user_uuid", userUUID),
+				zap.String("event_type", string(eventType)),
+				zap.Time("deliver_at", until))
+			return nil
+		}
+	}
+
+	var failed []*model.Delivery
+	for _, subscription := range subscriptions {
+		message, renderErr := renderer.render(subscription.Channel)
+		if renderErr != nil {
+			return renderErr
+		}
+
+		if sendErr := s.send(ctx, subscription, message); sendErr != nil {
+			logger.Warn(ctx, "Failed to send notification, will retry",
+				zap.String("subscription_uuid", subscription.UUID),
+				zap.String("channel", string(subscription.Channel)),
+				zap.Error(sendErr))
+
+			delay, _ := retryDelay(1)
+			failed = append(failed, newDelivery(subscription, message, now.Add(delay), 1, now))
+		}
+	}
+
+	if len(failed) > 0 {
+		return s.deliveryRepository.CreateDeliveries(ctx, failed)
+	}
+
+	return nil
+}
+
+// newDelivery откладывает message; UUID уведомления сохраняется, чтобы получатель распознал повтор
+func newDelivery(subscription *model.Subscription, message *model.Message, deliverAt time.Time, attempts int, now time.Time) *model.Delivery {
+	return &model.Delivery{
+		UUID:         message.UUID,
+		UserUUID:     subscription.UserUUID,
+		Subscription: subscription,
+		EventType:    message.EventType,
+		Subject:      message.Subject,
+		Body:         message.Body,
+		DeliverAt:    deliverAt,
+		Attempts:     attempts,
+		CreatedAt:    now,
+	}
+}
@@ -0,0 +1,25 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+// send отправляет message получателю подписки через ее канал
+func (s *service) send(ctx context.Context, subscription *model.Subscription, message *model.Message) error {
+	var err error
+	if channel, ok := s.channels[subscription.Channel]; ok {
+		err = channel.Send(ctx, subscription, message)
+	} else {
+		err = model.ErrUnsupportedChannel
+	}
+
+	if err != nil {
+		notificationsFailed.WithLabelValues(string(subscription.Channel), string(message.EventType)).Inc()
+		return err
+	}
+
+	notificationsSent.WithLabelValues(string(subscription.Channel), string(message.EventType)).Inc()
+	return nil
+}
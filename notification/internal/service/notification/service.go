@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/notification/internal/repository"
+	notificationService "github.com/space-wanderer/microservices/notification/internal/service"
+)
+
+type service struct {
+	subscriptionRepository repository.SubscriptionRepository
+	deliveryRepository     repository.DeliveryRepository
+	channels               map[model.Channel]notificationService.Channel
+
+	dispatchInterval time.Duration
+}
+
+func NewService(
+	subscriptionRepository repository.SubscriptionRepository,
+	deliveryRepository repository.DeliveryRepository,
+	channels []notificationService.Channel,
+	dispatchInterval time.Duration,
+) *service {
+	byType := make(map[model.Channel]notificationService.Channel, len(channels))
+	for _, channel := range channels {
+		byType[channel.Type()] = channel
+	}
+
+	return &service{
+		subscriptionRepository: subscriptionRepository,
+		deliveryRepository:     deliveryRepository,
+		channels:               byType,
+		dispatchInterval:       dispatchInterval,
+	}
+}
+
+func (s *service) SendOrderPaidNotification(ctx context.Context, uuid string, event model.OrderPaidEvent) error {
+	return s.notify(ctx, event.UserUUID, newOrderPaidRenderer(uuid, event))
+}
+
+func (s *service) SendShipAssembledNotification(ctx context.Context, uuid string, event model.ShipAssembledEvent) error {
+	return s.notify(ctx, event.UserUUID, newShipAssembledRenderer(uuid, event))
+}
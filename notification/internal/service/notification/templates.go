@@ -0,0 +1,137 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+const (
+	paidNotificationTemplate      = "paid_notification"
+	assembledNotificationTemplate = "assembled_notification"
+)
+
+// channelTemplateSuffixes - суффиксы имен шаблонов каждого канала. Шаблон темы называется
+// "<имя><суффикс>_subject" и нужен только каналам с темой сообщения
+var channelTemplateSuffixes = map[model.Channel]string{
+	model.ChannelTelegram: "",
+	model.ChannelEmail:    "_email",
+	model.ChannelWebhook:  "_webhook",
+}
+
+type orderPaidTemplateData struct {
+	EventUUID       string
+	OrderUUID       string
+	UserUUID        string
+	PaymentMethod   string
+	TransactionUUID string
+}
+
+type shipAssembledTemplateData struct {
+	EventUUID    string
+	OrderUUID    string
+	UserUUID     string
+	BuildTimeSec int64
+}
+
+var notificaitonTemplate = template.Must(template.New("notification").
+	Funcs(template.FuncMap{"json": toJSON}).
+	ParseFS(templates, "templates/*.tmpl"))
+
+// renderer формирует уведомление об одном событии для каждого канала, кешируя результат
+type renderer struct {
+	name      string
+	eventType model.EventType
+	data      any
+	messages  map[model.Channel]*model.Message
+}
+
+func newRenderer(name string, eventType model.EventType, data any) *renderer {
+	return &renderer{
+		name:      name,
+		eventType: eventType,
+		data:      data,
+		messages:  make(map[model.Channel]*model.Message),
+	}
+}
+
+// render возвращает уведомление для канала channel с новым UUID
+func (r *renderer) render(channel model.Channel) (*model.Message, error) {
+	rendered, ok := r.messages[channel]
+	if !ok {
+		suffix, supported := channelTemplateSuffixes[channel]
+		if !supported {
+			return nil, model.ErrUnsupportedChannel
+		}
+
+		body, err := execute(r.name+suffix, r.data)
+		if err != nil {
+			return nil, err
+		}
+
+		var subject string
+		if notificaitonTemplate.Lookup(r.name+suffix+"_subject") != nil {
+			if subject, err = execute(r.name+suffix+"_subject", r.data); err != nil {
+				return nil, err
+			}
+		}
+
+		rendered = &model.Message{
+			EventType: r.eventType,
+			Subject:   strings.TrimSpace(subject),
+			Body:      body,
+		}
+		r.messages[channel] = rendered
+	}
+
+	message := *rendered
+	message.UUID = uuid.NewString()
+	return &message, nil
+}
+
+func execute(name string, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := notificaitonTemplate.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// toJSON экранирует значение для подстановки в JSON-шаблоны webhook
+func toJSON(value any) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func newOrderPaidRenderer(orderUUID string, event model.OrderPaidEvent) *renderer {
+	return newRenderer(paidNotificationTemplate, model.EventTypeOrderPaid, orderPaidTemplateData{
+		EventUUID:       event.EventUUID,
+		OrderUUID:       orderUUID,
+		UserUUID:        event.UserUUID,
+		PaymentMethod:   event.PaymentMethod,
+		TransactionUUID: event.TransactionUUID,
+	})
+}
+
+func newShipAssembledRenderer(orderUUID string, event model.ShipAssembledEvent) *renderer {
+	return newRenderer(assembledNotificationTemplate, model.EventTypeShipAssembled, shipAssembledTemplateData{
+		EventUUID:    event.EventUUID,
+		OrderUUID:    orderUUID,
+		UserUUID:     event.UserUUID,
+		BuildTimeSec: event.BuildTimeSec,
+	})
+}
@@ -0,0 +1,129 @@
+package notification
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+const (
+	testOrderUUID       = "550e8400-e29b-41d4-a716-446655440000"
+	testUserUUID        = "550e8400-e29b-41d4-a716-446655440001"
+	testTransactionUUID = "550e8400-e29b-41d4-a716-446655440002"
+	testEventUUID       = "550e8400-e29b-41d4-a716-446655440003"
+)
+
+func paidRenderer() *renderer {
+	return newOrderPaidRenderer(testOrderUUID, model.OrderPaidEvent{
+		EventUUID:       testEventUUID,
+		UserUUID:        testUserUUID,
+		PaymentMethod:   "CARD",
+		TransactionUUID: testTransactionUUID,
+	})
+}
+
+func assembledRenderer() *renderer {
+	return newShipAssembledRenderer(testOrderUUID, model.ShipAssembledEvent{
+		EventUUID:    testEventUUID,
+		UserUUID:     testUserUUID,
+		BuildTimeSec: 42,
+	})
+}
+
+func TestRender_Telegram(t *testing.T) {
+	paid, err := paidRenderer().render(model.ChannelTelegram)
+	require.NoError(t, err)
+	assert.Equal(t, model.EventTypeOrderPaid, paid.EventType)
+	assert.Empty(t, paid.Subject)
+	assert.Contains(t, paid.Body, "<code>"+testOrderUUID+"</code>")
+	assert.Contains(t, paid.Body, "Способ оплаты: CARD")
+	assert.Contains(t, paid.Body, testTransactionUUID)
+
+	assembled, err := assembledRenderer().render(model.ChannelTelegram)
+	require.NoError(t, err)
+	assert.Equal(t, model.EventTypeShipAssembled, assembled.EventType)
+	assert.Empty(t, assembled.Subject)
+	assert.Contains(t, assembled.Body, "Время сборки: 42 сек")
+}
+
+func TestRender_Email(t *testing.T) {
+	paid, err := paidRenderer().render(model.ChannelEmail)
+	require.NoError(t, err)
+	assert.Equal(t, "Заказ "+testOrderUUID+" оплачен", paid.Subject)
+	assert.Contains(t, paid.Body, "<!DOCTYPE html>")
+	assert.Contains(t, paid.Body, "ID транзакции: <code>"+testTransactionUUID+"</code>")
+
+	assembled, err := assembledRenderer().render(model.ChannelEmail)
+	require.NoError(t, err)
+	assert.Equal(t, "Корабль по заказу "+testOrderUUID+" собран", assembled.Subject)
+	assert.Contains(t, assembled.Body, "Время сборки: 42 сек")
+}
+
+func TestRender_Webhook(t *testing.T) {
+	paid, err := paidRenderer().render(model.ChannelWebhook)
+	require.NoError(t, err)
+	assert.Empty(t, paid.Subject)
+
+	var paidBody map[string]any
+	require.NoError(t, json.Unmarshal([]byte(paid.Body), &paidBody))
+	assert.Equal(t, map[string]any{
+		"event_uuid":       testEventUUID,
+		"event_type":       "ORDER_PAID",
+		"order_uuid":       testOrderUUID,
+		"user_uuid":        testUserUUID,
+		"payment_method":   "CARD",
+		"transaction_uuid": testTransactionUUID,
+	}, paidBody)
+
+	assembled, err := assembledRenderer().render(model.ChannelWebhook)
+	require.NoError(t, err)
+
+	var assembledBody map[string]any
+	require.NoError(t, json.Unmarshal([]byte(assembled.Body), &assembledBody))
+	assert.Equal(t, map[string]any{
+		"event_uuid":     testEventUUID,
+		"event_type":     "SHIP_ASSEMBLED",
+		"order_uuid":     testOrderUUID,
+		"user_uuid":      testUserUUID,
+		"build_time_sec": float64(42),
+	}, assembledBody)
+}
+
+func TestRender_WebhookEscapesValues(t *testing.T) {
+	r := newOrderPaidRenderer(testOrderUUID, model.OrderPaidEvent{
+		EventUUID:     testEventUUID,
+		UserUUID:      testUserUUID,
+		PaymentMethod: "CARD\", \"injected\": \"yes",
+	})
+
+	message, err := r.render(model.ChannelWebhook)
+	require.NoError(t, err)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal([]byte(message.Body), &body))
+	assert.Equal(t, "CARD\", \"injected\": \"yes", body["payment_method"])
+	assert.NotContains(t, body, "injected")
+}
+
+func TestRender_NewUUIDForEachMessage(t *testing.T) {
+	r := paidRenderer()
+
+	first, err := r.render(model.ChannelEmail)
+	require.NoError(t, err)
+	second, err := r.render(model.ChannelEmail)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, first.UUID)
+	assert.NotEqual(t, first.UUID, second.UUID)
+	assert.Equal(t, first.Body, second.Body)
+}
+
+func TestRender_UnsupportedChannel(t *testing.T) {
+	_, err := paidRenderer().render(model.Channel("SMS"))
+
+	require.ErrorIs(t, err, model.ErrUnsupportedChannel)
+}
@@ -10,7 +10,38 @@ type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
 
-type TelegramService interface {
+// Channel отправляет уведомления получателям одного канала доставки
+type Channel interface {
+	Type() model.Channel
+	// ValidateRecipient проверяет адрес получателя до создания подписки
+	ValidateRecipient(recipient string) error
+	Send(ctx context.Context, subscription *model.Subscription, message *model.Message) error
+}
+
+// NotificationService отправляет уведомления о событиях получателям, на которых подписан пользователь
+type NotificationService interface {
 	SendOrderPaidNotification(ctx context.Context, uuid string, event model.OrderPaidEvent) error
 	SendShipAssembledNotification(ctx context.Context, uuid string, event model.ShipAssembledEvent) error
+	// RunDeliveryDispatcher отправляет уведомления, отложенные на время тихих часов или после неудачной попытки
+	RunDeliveryDispatcher(ctx context.Context) error
+}
+
+// SubscriptionService управляет получателями уведомлений и настройками пользователей
+type SubscriptionService interface {
+	CreateTelegramLinkToken(ctx context.Context, userUUID string) (*model.LinkToken, error)
+	// LinkTelegramChat привязывает чат chatID к пользователю, выдавшему токен token
+	LinkTelegramChat(ctx context.Context, token string, chatID int64) (*model.Subscription, error)
+	// CreateEmailSubscription отправляет на адрес email письмо с токеном подтверждения
+	CreateEmailSubscription(ctx context.Context, userUUID, email string) (*model.LinkToken, error)
+	// ConfirmEmailSubscription погашает токен из письма и подписывает адрес на уведомления
+	ConfirmEmailSubscription(ctx context.Context, userUUID, token string) (*model.Subscription, error)
+	// CreateWebhookSubscription подписывает URL на уведомления; подписка содержит секрет подписи запросов
+	CreateWebhookSubscription(ctx context.Context, userUUID, url string) (*model.Subscription, error)
+	// ListWebhookLogs возвращает до pageSize последних попыток доставки на webhook-подписку пользователя
+	ListWebhookLogs(ctx context.Context, userUUID, subscriptionUUID string, pageSize int) ([]*model.WebhookLog, error)
+	GetSettings(ctx context.Context, userUUID string) (*model.Settings, error)
+	DeleteSubscription(ctx context.Context, userUUID, subscriptionUUID string) error
+	SetEventEnabled(ctx context.Context, userUUID string, eventType model.EventType, enabled bool) error
+	// SetQuietHours задает тихие часы; nil отключает их
+	SetQuietHours(ctx context.Context, userUUID string, quietHours *model.QuietHours) error
 }
@@ -0,0 +1,120 @@
+package subscription
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+//go:embed templates/email_confirmation.tmpl
+var templates embed.FS
+
+var confirmationTemplate = template.Must(template.ParseFS(templates, "templates/email_confirmation.tmpl"))
+
+type confirmationTemplateData struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// CreateEmailSubscription выдает токен подтверждения адреса email и отправляет его письмом.
+// Сам токен в ответе не возвращается: подтверждение доказывает, что пользователь владеет адресом
+func (s *service) CreateEmailSubscription(ctx context.Context, userUUID, email string) (*model.LinkToken, error) {
+	if err := validateUserUUID(userUUID); err != nil {
+		return nil, err
+	}
+
+	email = strings.TrimSpace(email)
+	if err := s.emailChannel.ValidateRecipient(email); err != nil {
+		return nil, err
+	}
+
+	token, err := newLinkToken()
+	if err != nil {
+		return nil, err
+	}
+
+	linkToken := &model.LinkToken{
+		Token:     token,
+		UserUUID:  userUUID,
+		Channel:   model.ChannelEmail,
+		Recipient: email,
+		ExpiresAt: time.Now().Add(s.emailConfirmationTTL),
+	}
+
+	message, err := buildConfirmationMessage(linkToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.subscriptionRepository.CreateLinkToken(ctx, linkToken); err != nil {
+		return nil, err
+	}
+
+	err = s.emailChannel.Send(ctx, &model.Subscription{
+		UserUUID:  userUUID,
+		Channel:   model.ChannelEmail,
+		Recipient: email,
+	}, message)
+	if err != nil {
+		logger.Error(ctx, "❌ Failed to send email confirmation",
+			zap.String("user_uuid", userUUID),
+			zap.Error(err))
+		return nil, model.ErrConfirmationNotSent
+	}
+
+	return linkToken, nil
+}
+
+// ConfirmEmailSubscription погашает токен из письма и подписывает подтвержденный адрес на уведомления.
+// Токен другого пользователя не принимается
+func (s *service) ConfirmEmailSubscription(ctx context.Context, userUUID, token string) (*model.Subscription, error) {
+	if err := validateUserUUID(userUUID); err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.subscriptionRepository.LinkRecipient(ctx, strings.TrimSpace(token), &model.Subscription{
+		UUID:      uuid.NewString(),
+		UserUUID:  userUUID,
+		Channel:   model.ChannelEmail,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "Email confirmed",
+		zap.String("user_uuid", subscription.UserUUID),
+		zap.String("subscription_uuid", subscription.UUID))
+
+	return subscription, nil
+}
+
+func buildConfirmationMessage(linkToken *model.LinkToken) (*model.Message, error) {
+	data := confirmationTemplateData{
+		Token:     linkToken.Token,
+		ExpiresAt: linkToken.ExpiresAt,
+	}
+
+	var subject, body bytes.Buffer
+	if err := confirmationTemplate.ExecuteTemplate(&subject, "email_confirmation_subject", data); err != nil {
+		return nil, err
+	}
+	if err := confirmationTemplate.ExecuteTemplate(&body, "email_confirmation", data); err != nil {
+		return nil, err
+	}
+
+	return &model.Message{
+		UUID:    uuid.NewString(),
+		Subject: subject.String(),
+		Body:    body.String(),
+	}, nil
+}
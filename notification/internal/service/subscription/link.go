@@ -0,0 +1,75 @@
+package subscription
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// linkTokenBytes - длина токена привязки до кодирования. Telegram принимает в /start
+// до 64 символов из A-Z, a-z, 0-9, _ и -, поэтому токен кодируется в base64url
+const linkTokenBytes = 24
+
+// CreateTelegramLinkToken выдает одноразовый токен, которым пользователь привязывает Telegram-чат
+func (s *service) CreateTelegramLinkToken(ctx context.Context, userUUID string) (*model.LinkToken, error) {
+	if err := validateUserUUID(userUUID); err != nil {
+		return nil, err
+	}
+
+	token, err := newLinkToken()
+	if err != nil {
+		return nil, err
+	}
+
+	linkToken := &model.LinkToken{
+		Token:     token,
+		UserUUID:  userUUID,
+		Channel:   model.ChannelTelegram,
+		Link:      fmt.Sprintf("https://t.me/%s?start=%s", s.botUsername, token),
+		ExpiresAt: time.Now().Add(s.linkTokenTTL),
+	}
+
+	if err = s.subscriptionRepository.CreateLinkToken(ctx, linkToken); err != nil {
+		return nil, err
+	}
+
+	return linkToken, nil
+}
+
+// LinkTelegramChat погашает токен и подписывает его владельца на уведомления в чат chatID
+func (s *service) LinkTelegramChat(ctx context.Context, token string, chatID int64) (*model.Subscription, error) {
+	subscription, err := s.subscriptionRepository.LinkRecipient(ctx, token, &model.Subscription{
+		UUID:      uuid.NewString(),
+		Channel:   model.ChannelTelegram,
+		Recipient: strconv.FormatInt(chatID, 10),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "Telegram chat linked",
+		zap.String("user_uuid", subscription.UserUUID),
+		zap.String("subscription_uuid", subscription.UUID))
+
+	return subscription, nil
+}
+
+// newLinkToken возвращает случайный токен привязки
+func newLinkToken() (string, error) {
+	raw := make([]byte, linkTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate link token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
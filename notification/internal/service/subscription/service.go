@@ -0,0 +1,39 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/space-wanderer/microservices/notification/internal/repository"
+	notificationService "github.com/space-wanderer/microservices/notification/internal/service"
+)
+
+type service struct {
+	subscriptionRepository repository.SubscriptionRepository
+	webhookLogRepository   repository.WebhookLogRepository
+	emailChannel           notificationService.Channel
+	webhookChannel         notificationService.Channel
+
+	botUsername          string
+	linkTokenTTL         time.Duration
+	emailConfirmationTTL time.Duration
+}
+
+func NewService(
+	subscriptionRepository repository.SubscriptionRepository,
+	webhookLogRepository repository.WebhookLogRepository,
+	emailChannel notificationService.Channel,
+	webhookChannel notificationService.Channel,
+	botUsername string,
+	linkTokenTTL time.Duration,
+	emailConfirmationTTL time.Duration,
+) *service {
+	return &service{
+		subscriptionRepository: subscriptionRepository,
+		webhookLogRepository:   webhookLogRepository,
+		emailChannel:           emailChannel,
+		webhookChannel:         webhookChannel,
+		botUsername:            botUsername,
+		linkTokenTTL:           linkTokenTTL,
+		emailConfirmationTTL:   emailConfirmationTTL,
+	}
+}
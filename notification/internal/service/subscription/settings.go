@@ -0,0 +1,75 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+)
+
+func (s *service) GetSettings(ctx context.Context, userUUID string) (*model.Settings, error) {
+	if err := validateUserUUID(userUUID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.subscriptionRepository.ListSubscriptions(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.subscriptionRepository.GetPreferences(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Settings{
+		Subscriptions: subscriptions,
+		Preferences:   *preferences,
+	}, nil
+}
+
+func (s *service) DeleteSubscription(ctx context.Context, userUUID, subscriptionUUID string) error {
+	if err := validateUserUUID(userUUID); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(subscriptionUUID); err != nil {
+		return model.ErrSubscriptionNotFound
+	}
+
+	return s.subscriptionRepository.DeleteSubscription(ctx, userUUID, subscriptionUUID)
+}
+
+func (s *service) SetEventEnabled(ctx context.Context, userUUID string, eventType model.EventType, enabled bool) error {
+	if err := validateUserUUID(userUUID); err != nil {
+		return err
+	}
+
+	switch eventType {
+	case model.EventTypeOrderPaid, model.EventTypeShipAssembled:
+	default:
+		return model.ErrInvalidEventType
+	}
+
+	return s.subscriptionRepository.SetEventEnabled(ctx, userUUID, eventType, enabled)
+}
+
+func (s *service) SetQuietHours(ctx context.Context, userUUID string, quietHours *model.QuietHours) error {
+	if err := validateUserUUID(userUUID); err != nil {
+		return err
+	}
+
+	if quietHours != nil && !quietHours.Valid() {
+		return model.ErrInvalidQuietHours
+	}
+
+	return s.subscriptionRepository.SetQuietHours(ctx, userUUID, quietHours)
+}
+
+func validateUserUUID(userUUID string) error {
+	if _, err := uuid.Parse(userUUID); err != nil {
+		return model.ErrInvalidUserUUID
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package subscription
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/notification/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+const (
+	// webhookSecretBytes - длина секрета подписи webhook до кодирования в hex
+	webhookSecretBytes = 32
+
+	defaultWebhookLogPageSize = 50
+	maxWebhookLogPageSize     = 500
+)
+
+// CreateWebhookSubscription подписывает URL на уведомления и выдает секрет подписи запросов
+func (s *service) CreateWebhookSubscription(ctx context.Context, userUUID, url string) (*model.Subscription, error) {
+	if err := validateUserUUID(userUUID); err != nil {
+		return nil, err
+	}
+
+	url = strings.TrimSpace(url)
+	if err := s.webhookChannel.ValidateRecipient(url); err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	subscription := &model.Subscription{
+		UUID:      uuid.NewString(),
+		UserUUID:  userUUID,
+		Channel:   model.ChannelWebhook,
+		Recipient: url,
+		Secret:    hex.EncodeToString(raw),
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.subscriptionRepository.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "Webhook subscribed",
+		zap.String("user_uuid", userUUID),
+		zap.String("subscription_uuid", subscription.UUID))
+
+	return subscription, nil
+}
+
+// ListWebhookLogs возвращает журнал доставки webhook-подписки пользователя.
+// pageSize 0 заменяется значением по умолчанию, слишком большой — максимумом
+func (s *service) ListWebhookLogs(ctx context.Context, userUUID, subscriptionUUID string, pageSize int) ([]*model.WebhookLog, error) {
+	if err := validateUserUUID(userUUID); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case pageSize < 0:
+		return nil, model.ErrInvalidPageSize
+	case pageSize == 0:
+		pageSize = defaultWebhookLogPageSize
+	case pageSize > maxWebhookLogPageSize:
+		pageSize = maxWebhookLogPageSize
+	}
+
+	if _, err := uuid.Parse(subscriptionUUID); err != nil {
+		return nil, model.ErrSubscriptionNotFound
+	}
+
+	subscription, err := s.subscriptionRepository.GetSubscription(ctx, userUUID, subscriptionUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.Channel != model.ChannelWebhook {
+		return nil, model.ErrSubscriptionNotFound
+	}
+
+	return s.webhookLogRepository.ListWebhookLogs(ctx, subscriptionUUID, pageSize)
+}
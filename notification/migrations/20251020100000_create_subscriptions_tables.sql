@@ -0,0 +1,58 @@
+-- +goose Up
+CREATE TABLE notification_subscriptions (
+    subscription_uuid VARCHAR(36) PRIMARY KEY,
+    user_uuid VARCHAR(36) NOT NULL,
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('TELEGRAM')),
+    recipient TEXT NOT NULL, -- адрес в канале: для Telegram — идентификатор чата
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (user_uuid, channel, recipient)
+);
+
+CREATE TABLE notification_link_tokens (
+    token_hash VARCHAR(64) PRIMARY KEY, -- sha256 токена, сам токен не хранится
+    user_uuid VARCHAR(36) NOT NULL,
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('TELEGRAM')),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_link_tokens_expires_at ON notification_link_tokens(expires_at);
+
+-- Настройки хранятся только для пользователей, которые их меняли
+CREATE TABLE notification_preferences (
+    user_uuid VARCHAR(36) PRIMARY KEY,
+    quiet_start_minute INTEGER CHECK (quiet_start_minute BETWEEN 0 AND 1439),
+    quiet_end_minute INTEGER CHECK (quiet_end_minute BETWEEN 0 AND 1439),
+    quiet_time_zone VARCHAR(64),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE notification_opt_outs (
+    user_uuid VARCHAR(36) NOT NULL,
+    event_type VARCHAR(30) NOT NULL CHECK (event_type IN ('ORDER_PAID', 'SHIP_ASSEMBLED')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_uuid, event_type)
+);
+
+-- Уведомления, отложенные на время тихих часов или после неудачной отправки
+CREATE TABLE notification_deliveries (
+    delivery_uuid VARCHAR(36) PRIMARY KEY,
+    user_uuid VARCHAR(36) NOT NULL,
+    event_type VARCHAR(30) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    recipient TEXT NOT NULL,
+    text TEXT NOT NULL,
+    deliver_at TIMESTAMP NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_deliveries_deliver_at ON notification_deliveries(deliver_at);
+
+-- +goose Down
+DROP TABLE notification_deliveries;
+DROP TABLE notification_opt_outs;
+DROP TABLE notification_preferences;
+DROP TABLE notification_link_tokens;
+DROP TABLE notification_subscriptions;
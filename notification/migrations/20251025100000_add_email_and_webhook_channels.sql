@@ -0,0 +1,69 @@
+-- +goose Up
+ALTER TABLE notification_subscriptions DROP CONSTRAINT notification_subscriptions_channel_check;
+ALTER TABLE notification_subscriptions ADD CONSTRAINT notification_subscriptions_channel_check
+    CHECK (channel IN ('TELEGRAM', 'EMAIL', 'WEBHOOK'));
+ALTER TABLE notification_subscriptions ADD COLUMN secret TEXT; -- секрет подписи запросов webhook
+
+ALTER TABLE notification_link_tokens DROP CONSTRAINT notification_link_tokens_channel_check;
+ALTER TABLE notification_link_tokens ADD CONSTRAINT notification_link_tokens_channel_check
+    CHECK (channel IN ('TELEGRAM', 'EMAIL'));
+ALTER TABLE notification_link_tokens ADD COLUMN recipient TEXT; -- подтверждаемый адрес, если он известен заранее
+
+-- Отложенное уведомление ссылается на подписку: при отправке нужны ее актуальный адрес и секрет,
+-- а при удалении подписки уведомления удаляются вместе с ней
+ALTER TABLE notification_deliveries ADD COLUMN subscription_uuid VARCHAR(36);
+UPDATE notification_deliveries d
+SET subscription_uuid = s.subscription_uuid
+FROM notification_subscriptions s
+WHERE s.user_uuid = d.user_uuid AND s.channel = d.channel AND s.recipient = d.recipient;
+DELETE FROM notification_deliveries WHERE subscription_uuid IS NULL;
+ALTER TABLE notification_deliveries ALTER COLUMN subscription_uuid SET NOT NULL;
+ALTER TABLE notification_deliveries ADD CONSTRAINT notification_deliveries_subscription_fkey
+    FOREIGN KEY (subscription_uuid) REFERENCES notification_subscriptions(subscription_uuid) ON DELETE CASCADE;
+ALTER TABLE notification_deliveries DROP COLUMN channel;
+ALTER TABLE notification_deliveries DROP COLUMN recipient;
+ALTER TABLE notification_deliveries ADD COLUMN subject TEXT NOT NULL DEFAULT '';
+ALTER TABLE notification_deliveries RENAME COLUMN text TO body;
+
+CREATE INDEX idx_notification_deliveries_subscription_uuid ON notification_deliveries(subscription_uuid);
+
+CREATE TABLE notification_webhook_logs (
+    log_uuid VARCHAR(36) PRIMARY KEY,
+    subscription_uuid VARCHAR(36) NOT NULL REFERENCES notification_subscriptions(subscription_uuid) ON DELETE CASCADE,
+    message_uuid VARCHAR(36) NOT NULL, -- общий для всех попыток доставки одного уведомления
+    event_type VARCHAR(30) NOT NULL,
+    attempt INTEGER NOT NULL,
+    status_code INTEGER, -- HTTP-статус ответа, NULL — ответ не получен
+    error TEXT,
+    duration_ms BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_webhook_logs_subscription ON notification_webhook_logs(subscription_uuid, created_at DESC);
+
+-- +goose Down
+DROP TABLE notification_webhook_logs;
+
+DROP INDEX idx_notification_deliveries_subscription_uuid;
+ALTER TABLE notification_deliveries RENAME COLUMN body TO text;
+ALTER TABLE notification_deliveries DROP COLUMN subject;
+ALTER TABLE notification_deliveries ADD COLUMN channel VARCHAR(20);
+ALTER TABLE notification_deliveries ADD COLUMN recipient TEXT;
+UPDATE notification_deliveries d
+SET channel = s.channel, recipient = s.recipient
+FROM notification_subscriptions s
+WHERE s.subscription_uuid = d.subscription_uuid;
+DELETE FROM notification_deliveries WHERE channel <> 'TELEGRAM';
+ALTER TABLE notification_deliveries ALTER COLUMN channel SET NOT NULL;
+ALTER TABLE notification_deliveries ALTER COLUMN recipient SET NOT NULL;
+ALTER TABLE notification_deliveries DROP COLUMN subscription_uuid;
+
+DELETE FROM notification_link_tokens WHERE channel <> 'TELEGRAM';
+ALTER TABLE notification_link_tokens DROP COLUMN recipient;
+ALTER TABLE notification_link_tokens DROP CONSTRAINT notification_link_tokens_channel_check;
+ALTER TABLE notification_link_tokens ADD CONSTRAINT notification_link_tokens_channel_check CHECK (channel IN ('TELEGRAM'));
+
+DELETE FROM notification_subscriptions WHERE channel <> 'TELEGRAM';
+ALTER TABLE notification_subscriptions DROP COLUMN secret;
+ALTER TABLE notification_subscriptions DROP CONSTRAINT notification_subscriptions_channel_check;
+ALTER TABLE notification_subscriptions ADD CONSTRAINT notification_subscriptions_channel_check CHECK (channel IN ('TELEGRAM'));
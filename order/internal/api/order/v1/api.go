@@ -2,24 +2,83 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
+	"github.com/ogen-go/ogen/ogenerrors"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
 	"github.com/space-wanderer/microservices/order/internal/service"
 	orderV1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
+	sharedErrors "github.com/space-wanderer/microservices/shared/pkg/errors"
 )
 
 type api struct {
-	orderService service.OrderService
+	orderService       service.OrderService
+	idempotencyService service.IdempotencyService
+}
+
+func NewAPI(orderService service.OrderService, idempotencyService service.IdempotencyService) orderV1.Handler {
+	return &api{
+		orderService:       orderService,
+		idempotencyService: idempotencyService,
+	}
 }
 
-func NewAPI(orderService service.OrderService) orderV1.Handler {
-	return &api{orderService: orderService}
+// idempotent выполняет fn через сервис идемпотентности, если клиент передал ключ.
+// Ключ действует только в пределах пользователя userUUID
+func (a *api) idempotent(
+	ctx context.Context,
+	userUUID string,
+	key orderV1.OptString,
+	operation model.IdempotentOperation,
+	request any,
+	fn func(ctx context.Context) (model.Order, error),
+) (model.Order, error) {
+	if !key.IsSet() {
+		return fn(ctx)
+	}
+	return a.idempotencyService.Execute(ctx, userUUID, operation, key.Value, request, fn)
 }
 
 func (a *api) NewError(ctx context.Context, err error) *orderV1.GenericErrorStatusCode {
 	return &orderV1.GenericErrorStatusCode{
-		StatusCode: 500,
+		StatusCode: statusCodeFromError(err),
 		Response: orderV1.GenericError{
 			Message: err.Error(),
 		},
 	}
 }
+
+// statusCodeFromError сопоставляет бизнес-ошибки с HTTP-кодами
+func statusCodeFromError(err error) int {
+	// Отсутствующий заголовок Authorization ogen возвращает без бизнес-ошибки внутри
+	var securityErr *ogenerrors.SecurityError
+	if errors.As(err, &securityErr) {
+		return http.StatusUnauthorized
+	}
+
+	businessErr := sharedErrors.GetBusinessError(err)
+	if businessErr == nil {
+		return http.StatusInternalServerError
+	}
+
+	switch businessErr.Code() {
+	case sharedErrors.NotFoundErrCode:
+		return http.StatusNotFound
+	case sharedErrors.InvalidArgumentErrCode:
+		return http.StatusBadRequest
+	case sharedErrors.FailedPreconditionErrCode:
+		return http.StatusConflict
+	case sharedErrors.PaymentDeclinedErrCode:
+		return http.StatusPaymentRequired
+	case sharedErrors.PermissionDeniedErrCode:
+		return http.StatusForbidden
+	case sharedErrors.UnavailableErrCode:
+		return http.StatusServiceUnavailable
+	case sharedErrors.UnauthenticatedErrCode:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
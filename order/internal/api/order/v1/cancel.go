@@ -7,6 +7,11 @@ import (
 )
 
 func (a *api) CancelOrderByUuid(ctx context.Context, params orderV1.CancelOrderByUuidParams) (orderV1.CancelOrderByUuidRes, error) {
+	// Отменить заказ может только его владелец
+	if _, _, err := a.ownedOrder(ctx, params.OrderUUID.String()); err != nil {
+		return nil, err
+	}
+
 	_, err := a.orderService.CancelOrderByUuid(ctx, params.OrderUUID.String())
 	if err != nil {
 		return nil, err
@@ -2,20 +2,38 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"github.com/google/uuid"
 
 	"github.com/space-wanderer/microservices/order/internal/converter"
+	"github.com/space-wanderer/microservices/order/internal/model"
 	orderV1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
 )
 
-func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest) (orderV1.CreateOrderRes, error) {
+func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest, params orderV1.CreateOrderParams) (orderV1.CreateOrderRes, error) {
+	// Заказ создается от имени владельца токена
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Конвертируем запрос в модель сервиса
-	order := converter.ConvertCreateOrderRequestToModelOrder(req)
+	order := converter.ConvertCreateOrderRequestToModelOrder(userUUID, req)
 
-	// Создаем заказ через сервис
-	createdOrder, err := a.orderService.CreateOrder(ctx, *order)
+	// Создаем заказ через сервис, повтор с тем же ключом вернет уже созданный заказ
+	createdOrder, err := a.idempotent(ctx, userUUID, params.IdempotencyKey, model.IdempotentOperationCreateOrder, order,
+		func(ctx context.Context) (model.Order, error) {
+			return a.orderService.CreateOrder(ctx, *order)
+		})
 	if err != nil {
+		if errors.Is(err, model.ErrInsufficientStock) {
+			return &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -26,6 +44,6 @@ func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest)
 	}
 	return &orderV1.CreateOrderResponse{
 		OrderUUID:  orderUUID,
-		TotalPrice: createdOrder.TotalPrice,
+		TotalPrice: createdOrder.TotalPrice.InexactFloat64(),
 	}, nil
 }
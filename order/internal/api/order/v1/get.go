@@ -8,8 +8,8 @@ import (
 )
 
 func (a *api) GetOrderByUuid(ctx context.Context, params orderV1.GetOrderByUuidParams) (orderV1.GetOrderByUuidRes, error) {
-	// Получаем заказ через сервис
-	order, err := a.orderService.GetOrderByUuid(ctx, params.OrderUUID.String())
+	// Получаем заказ через сервис, чужой заказ не отдаем
+	order, _, err := a.ownedOrder(ctx, params.OrderUUID.String())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	orderV1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
+)
+
+func (a *api) GetOrderHistory(ctx context.Context, params orderV1.GetOrderHistoryParams) (orderV1.GetOrderHistoryRes, error) {
+	if _, _, err := a.ownedOrder(ctx, params.OrderUUID.String()); err != nil {
+		return nil, err
+	}
+
+	history, err := a.orderService.GetOrderHistory(ctx, params.OrderUUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertStatusHistoryToOrderHistoryResponse(params.OrderUUID.String(), history), nil
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	orderV1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
+)
+
+func (a *api) ListOrders(ctx context.Context, params orderV1.ListOrdersParams) (orderV1.ListOrdersRes, error) {
+	// Пользователь видит только свои заказы
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, page := converter.ConvertListOrdersParamsToModel(userUUID, params)
+
+	// Получаем страницу заказов через сервис
+	orders, nextPageToken, err := a.orderService.ListOrders(ctx, filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	// Конвертируем в DTO
+	orderDtos := make([]orderV1.OrderDto, 0, len(orders))
+	for i := range orders {
+		orderDtos = append(orderDtos, converter.ConvertModelOrderToOrderDto(&orders[i]))
+	}
+
+	response := &orderV1.ListOrdersResponse{Orders: orderDtos}
+	if nextPageToken != "" {
+		response.NextPageToken = orderV1.NewOptString(nextPageToken)
+	}
+
+	return response, nil
+}
@@ -10,7 +10,28 @@ import (
 )
 
 func (a *api) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, params orderV1.PayOrderParams) (orderV1.PayOrderRes, error) {
-	order, err := a.orderService.PayOrder(ctx, params.OrderUUID.String(), "", model.PaymentMethod(req.PaymentMethod))
+	orderUUID := params.OrderUUID.String()
+	idempotencyKey := params.IdempotencyKey.Or("")
+
+	// Оплатить заказ может только его владелец, он же становится плательщиком
+	_, userUUID, err := a.ownedOrder(ctx, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Отпечаток запроса включает заказ, чтобы ключ нельзя было переиспользовать для другого заказа
+	request := struct {
+		OrderUUID     string
+		PaymentMethod string
+	}{
+		OrderUUID:     orderUUID,
+		PaymentMethod: string(req.PaymentMethod),
+	}
+
+	order, err := a.idempotent(ctx, userUUID, params.IdempotencyKey, model.IdempotentOperationPayOrder, request,
+		func(ctx context.Context) (model.Order, error) {
+			return a.orderService.PayOrder(ctx, orderUUID, userUUID, model.PaymentMethod(req.PaymentMethod), idempotencyKey)
+		})
 	if err != nil {
 		return nil, err
 	}
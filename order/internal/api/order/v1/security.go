@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/auth"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	orderV1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
+)
+
+type securityHandler struct {
+	verifier *auth.Verifier
+}
+
+func NewSecurityHandler(verifier *auth.Verifier) orderV1.SecurityHandler {
+	return &securityHandler{verifier: verifier}
+}
+
+// HandleBearerAuth проверяет JWT и кладет UUID пользователя из claim sub в контекст запроса
+func (h *securityHandler) HandleBearerAuth(ctx context.Context, _ orderV1.OperationName, t orderV1.BearerAuth) (context.Context, error) {
+	claims, err := h.verifier.Verify(t.Token)
+	if err != nil {
+		return ctx, fmt.Errorf("%w: %w", model.ErrUnauthenticated, err)
+	}
+
+	userUUID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return ctx, fmt.Errorf("%w: sub is not a uuid", model.ErrUnauthenticated)
+	}
+
+	return logger.ContextWithUserID(ctx, userUUID.String()), nil
+}
+
+// currentUserUUID возвращает UUID пользователя, прошедшего аутентификацию
+func currentUserUUID(ctx context.Context) (string, error) {
+	userUUID, ok := logger.UserIDFromContext(ctx)
+	if !ok {
+		return "", model.ErrUnauthenticated
+	}
+
+	return userUUID, nil
+}
+
+// ownedOrder возвращает заказ, если он принадлежит текущему пользователю
+func (a *api) ownedOrder(ctx context.Context, orderUUID string) (model.Order, string, error) {
+	userUUID, err := currentUserUUID(ctx)
+	if err != nil {
+		return model.Order{}, "", err
+	}
+
+	order, err := a.orderService.GetOrderByUuid(ctx, orderUUID)
+	if err != nil {
+		return model.Order{}, "", err
+	}
+
+	if order.UserUUID != userUUID {
+		return model.Order{}, "", model.ErrOrderAccessDenied
+	}
+
+	return order, userUUID, nil
+}
@@ -12,9 +12,15 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 
+	orderV1API "github.com/space-wanderer/microservices/order/internal/api/order/v1"
 	"github.com/space-wanderer/microservices/order/internal/config"
 	"github.com/space-wanderer/microservices/platform/pkg/closer"
+	"github.com/space-wanderer/microservices/platform/pkg/health"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	httpMiddleware "github.com/space-wanderer/microservices/platform/pkg/middleware/http"
+	kafkaMiddleware "github.com/space-wanderer/microservices/platform/pkg/middleware/kafka"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
 	order_v1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
 )
 
@@ -45,6 +51,21 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Запускаем consumer событий о неудачной сборке
+	go func() {
+		consumerService := a.diContainer.ShipAssemblyFailedConsumerService(ctx)
+		if consumerService != nil {
+			if err := consumerService.RunConsumer(ctx); err != nil {
+				logger.Error(ctx, "Failed to run assembly failed consumer", zap.Error(err))
+			}
+		}
+	}()
+
+	a.runOutboxRelay(ctx)
+	a.runExpirySweeper(ctx)
+	a.runIdempotencyCleanup(ctx)
+	a.runInboxCleanup(ctx)
+
 	return a.runHTTPServer(ctx)
 }
 
@@ -53,6 +74,9 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDi,
 		a.initLogger,
 		a.initCloser,
+		a.initTracing,
+		a.initMetrics,
+		a.initHealth,
 		a.initListener,
 		a.initMigrations,
 		a.initHTTPServer,
@@ -85,6 +109,55 @@ func (a *App) initCloser(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx,
+		config.AppConfig().Tracing.ServiceName(),
+		config.AppConfig().Tracing.CollectorEndpoint(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
+
+	closer.AddNamed("Tracer provider", shutdown)
+
+	return nil
+}
+
+func (a *App) initMetrics(ctx context.Context) error {
+	listener, err := net.Listen("tcp", config.AppConfig().Metrics.Address())
+	if err != nil {
+		return fmt.Errorf("failed to listen metrics address: %w", err)
+	}
+
+	server := metrics.NewServer(config.AppConfig().Metrics.Address())
+	go func() {
+		logger.Info(ctx, fmt.Sprintf("Metrics server listening on %s", config.AppConfig().Metrics.Address()))
+		if serveErr := server.Serve(listener); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			logger.Error(ctx, "Metrics server error", zap.Error(serveErr))
+		}
+	}()
+
+	closer.AddNamed("Metrics server", server.Shutdown)
+
+	return nil
+}
+
+func (a *App) initHealth(ctx context.Context) error {
+	registry := a.diContainer.HealthRegistry(ctx)
+
+	healthCtx, cancel := context.WithCancel(ctx)
+	go registry.Run(healthCtx)
+
+	// /readyz начинает отвечать 503 до остановки HTTP-сервера, чтобы балансировщик успел убрать инстанс
+	closer.AddBeforeShutdown("Health status", registry.Shutdown)
+	closer.AddNamed("Health checks", func(context.Context) error {
+		cancel()
+		return nil
+	})
+
+	return nil
+}
+
 func (a *App) initListener(ctx context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().OrderHTTP.Address())
 	if err != nil {
@@ -107,7 +180,12 @@ func (a *App) initListener(ctx context.Context) error {
 func (a *App) initHTTPServer(ctx context.Context) error {
 	// Создаем OpenAPI сервер
 	api := a.diContainer.OrderV1API(ctx)
-	s, err := order_v1.NewServer(api)
+	verifier := a.diContainer.AuthVerifier(ctx)
+	if verifier == nil {
+		return fmt.Errorf("failed to create auth verifier")
+	}
+
+	s, err := order_v1.NewServer(api, orderV1API.NewSecurityHandler(verifier))
 	if err != nil {
 		return fmt.Errorf("failed to create OpenAPI server: %w", err)
 	}
@@ -116,10 +194,19 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	r := chi.NewRouter()
 
 	// Добавляем middleware
+	r.Use(httpMiddleware.TracePropagation)
+	r.Use(metrics.HTTPMiddleware(func(r *http.Request) (string, bool) {
+		route, ok := s.FindPath(r.Method, r.URL)
+		return route.OperationID(), ok
+	}))
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Timeout(10 * time.Second))
 
+	// Проверки живости и готовности
+	r.Method(http.MethodGet, health.LivenessPath, health.LivenessHandler())
+	r.Method(http.MethodGet, health.ReadinessPath, a.diContainer.HealthRegistry(ctx).ReadinessHandler())
+
 	// Монтируем обработчики OpenAPI
 	r.Mount("/", s)
 
@@ -150,6 +237,107 @@ func (a *App) initMigrations(ctx context.Context) error {
 	return nil
 }
 
+// runOutboxRelay запускает relay outbox в горутине и дожидается его остановки при закрытии приложения
+func (a *App) runOutboxRelay(ctx context.Context) {
+	relayCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		if err := a.diContainer.OutboxRelayService(ctx).RunRelay(relayCtx); err != nil {
+			logger.Error(ctx, "Failed to run outbox relay", zap.Error(err))
+		}
+	}()
+
+	closer.AddNamed("Outbox relay", func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// runExpirySweeper запускает снятие просроченных заказов в горутине и дожидается его остановки
+// при закрытии приложения. Экземпляры сервиса разбирают заказы параллельно, не мешая друг другу
+func (a *App) runExpirySweeper(ctx context.Context) {
+	sweeperCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		if err := a.diContainer.OrderExpiryService(ctx).RunSweeper(sweeperCtx); err != nil {
+			logger.Error(ctx, "Failed to run order expiry sweeper", zap.Error(err))
+		}
+	}()
+
+	closer.AddNamed("Order expiry sweeper", func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// runIdempotencyCleanup запускает удаление устаревших ключей идемпотентности в горутине
+// и дожидается его остановки при закрытии приложения
+func (a *App) runIdempotencyCleanup(ctx context.Context) {
+	cleanupCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		if err := a.diContainer.IdempotencyService(ctx).RunCleanup(cleanupCtx); err != nil {
+			logger.Error(ctx, "Failed to run idempotency keys cleanup", zap.Error(err))
+		}
+	}()
+
+	closer.AddNamed("Idempotency keys cleanup", func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// runInboxCleanup запускает удаление устаревших отметок об обработанных событиях Kafka в горутине
+// и дожидается его остановки при закрытии приложения
+func (a *App) runInboxCleanup(ctx context.Context) {
+	cleanupCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		cfg := config.AppConfig().Inbox
+		if err := kafkaMiddleware.RunInboxCleanup(cleanupCtx, a.diContainer.InboxStore(ctx),
+			cfg.Retention(), cfg.CleanupInterval(), cfg.CleanupBatchSize(), logger.Logger()); err != nil {
+			logger.Error(ctx, "Failed to run Kafka inbox cleanup", zap.Error(err))
+		}
+	}()
+
+	closer.AddNamed("Kafka inbox cleanup", func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
 func (a *App) runHTTPServer(ctx context.Context) error {
 	logger.Info(ctx, fmt.Sprintf("HTTP server listening on %s", config.AppConfig().OrderHTTP.Address()))
 
@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	orderV1API "github.com/space-wanderer/microservices/order/internal/api/order/v1"
@@ -15,18 +16,32 @@ import (
 	"github.com/space-wanderer/microservices/order/internal/config"
 	kafkaConverter "github.com/space-wanderer/microservices/order/internal/converter/kafka"
 	orderDecoder "github.com/space-wanderer/microservices/order/internal/converter/kafka/decoder"
-	orderProducer "github.com/space-wanderer/microservices/order/internal/converter/kafka/producer"
+	orderEncoder "github.com/space-wanderer/microservices/order/internal/converter/kafka/encoder"
 	"github.com/space-wanderer/microservices/order/internal/repository"
+	idempotencyRepository "github.com/space-wanderer/microservices/order/internal/repository/idempotency"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
 	orderRepository "github.com/space-wanderer/microservices/order/internal/repository/order"
+	outboxRepository "github.com/space-wanderer/microservices/order/internal/repository/outbox"
 	"github.com/space-wanderer/microservices/order/internal/service"
+	assemblyFailedConsumer "github.com/space-wanderer/microservices/order/internal/service/consumer/assembly_failed_consumer"
 	orderConsumer "github.com/space-wanderer/microservices/order/internal/service/consumer/order_consumer"
+	expiryService "github.com/space-wanderer/microservices/order/internal/service/expiry"
+	idempotencyService "github.com/space-wanderer/microservices/order/internal/service/idempotency"
 	orderService "github.com/space-wanderer/microservices/order/internal/service/order"
+	outboxService "github.com/space-wanderer/microservices/order/internal/service/outbox"
+	"github.com/space-wanderer/microservices/platform/pkg/auth"
+	"github.com/space-wanderer/microservices/platform/pkg/closer"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/mtls"
+	platformHealth "github.com/space-wanderer/microservices/platform/pkg/health"
 	platformKafka "github.com/space-wanderer/microservices/platform/pkg/kafka"
 	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
 	"github.com/space-wanderer/microservices/platform/pkg/kafka/producer"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	kafkaMiddleware "github.com/space-wanderer/microservices/platform/pkg/middleware/kafka"
 	migrator "github.com/space-wanderer/microservices/platform/pkg/migrator/pg"
 	order_v1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
+	"github.com/space-wanderer/microservices/shared/pkg/interceptors"
 	inventory_v1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
 	payment_v1 "github.com/space-wanderer/microservices/shared/pkg/proto/payment/v1"
 )
@@ -34,9 +49,14 @@ import (
 type diContainer struct {
 	orderV1API order_v1.Handler
 
-	orderService service.OrderService
+	orderService       service.OrderService
+	idempotencyService service.IdempotencyService
 
-	orderRepository repository.OrderRepository
+	orderRepository  repository.OrderRepository
+	outboxRepository repository.OutboxRepository
+
+	idempotencyRepository repository.IdempotencyRepository
+	inboxStore            *kafkaMiddleware.PostgresInbox
 
 	inventoryClient inventory_v1.InventoryServiceClient
 	paymentClient   payment_v1.PaymentServiceClient
@@ -52,13 +72,41 @@ type diContainer struct {
 	pgMigrator *migrator.Migrator
 
 	// Kafka Producer для OrderPaidEvent
-	orderPaidProducer        platformKafka.Producer
-	orderPaidProducerService kafkaConverter.OrderPaidProducer
+	orderPaidProducer platformKafka.Producer
+	orderPaidEncoder  kafkaConverter.OrderPaidEncoder
+
+	// Kafka Producer для OrderRefundedEvent
+	orderRefundedProducer platformKafka.Producer
+	orderRefundedEncoder  kafkaConverter.OrderRefundedEncoder
+
+	// Kafka Producer для OrderExpiredEvent
+	orderExpiredProducer platformKafka.Producer
+	orderExpiredEncoder  kafkaConverter.OrderExpiredEncoder
+
+	// Relay событий из outbox в Kafka
+	outboxRelayService service.OutboxRelayService
+
+	// Снятие заказов, не оплаченных в срок
+	orderExpiryService service.OrderExpiryService
+
+	// Kafka Producer для топиков повторов и DLQ
+	consumerRetryProducer platformKafka.TopicProducer
 
 	// Kafka Consumer для ShipAssembledEvent
 	shipAssembledConsumer        platformKafka.Consumer
 	shipAssembledDecoder         kafkaConverter.ShipAssembledDecoder
 	shipAssembledConsumerService *orderConsumer.Service
+
+	// Kafka Consumer для ShipAssemblyFailedEvent
+	shipAssemblyFailedConsumer        platformKafka.Consumer
+	shipAssemblyFailedDecoder         kafkaConverter.ShipAssemblyFailedDecoder
+	shipAssemblyFailedConsumerService *assemblyFailedConsumer.Service
+
+	healthRegistry *platformHealth.Registry
+
+	tlsReloader *mtls.Reloader
+
+	authVerifier *auth.Verifier
 }
 
 func NewDiContainer() *diContainer {
@@ -67,18 +115,57 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) OrderV1API(ctx context.Context) order_v1.Handler {
 	if d.orderV1API == nil {
-		d.orderV1API = orderV1API.NewAPI(d.OrderService(ctx))
+		d.orderV1API = orderV1API.NewAPI(d.OrderService(ctx), d.IdempotencyService(ctx))
 	}
 	return d.orderV1API
 }
 
+// AuthVerifier создает проверку JWT с ключами из JWKS-файла или статическим ключом
+func (d *diContainer) AuthVerifier(ctx context.Context) *auth.Verifier {
+	if d.authVerifier == nil {
+		cfg := config.AppConfig().Auth
+
+		var (
+			keys *auth.KeySet
+			err  error
+		)
+		if cfg.JWKSFile() != "" {
+			keys, err = auth.LoadJWKSFile(cfg.JWKSFile())
+		} else {
+			keys, err = auth.StaticKey(cfg.StaticKey())
+		}
+		if err != nil {
+			log.Printf("❌ Ошибка загрузки ключей JWT: %v", err)
+			return nil
+		}
+
+		d.authVerifier = auth.NewVerifier(keys, cfg.Issuer(), cfg.Audience())
+	}
+	return d.authVerifier
+}
+
 func (d *diContainer) OrderService(ctx context.Context) service.OrderService {
 	if d.orderService == nil {
-		d.orderService = orderService.NewOrderService(d.OrderRepository(ctx), d.InventoryGRPCClient(ctx), d.PaymentGRPCClient(ctx), d.OrderPaidProducerService(ctx))
+		d.orderService = orderService.NewOrderService(d.OrderRepository(ctx), d.InventoryGRPCClient(ctx), d.PaymentGRPCClient(ctx), d.OrderPaidEncoder(ctx), d.OrderRefundedEncoder(ctx))
 	}
 	return d.orderService
 }
 
+func (d *diContainer) IdempotencyService(ctx context.Context) service.IdempotencyService {
+	if d.idempotencyService == nil {
+		cfg := config.AppConfig().Idempotency
+
+		d.idempotencyService = idempotencyService.NewService(
+			d.IdempotencyRepository(ctx),
+			cfg.LockTTL(),
+			cfg.KeyTTL(),
+			cfg.CleanupInterval(),
+			cfg.CleanupBatchSize(),
+		)
+	}
+	return d.idempotencyService
+}
+
 func (d *diContainer) OrderRepository(ctx context.Context) repository.OrderRepository {
 	if d.orderRepository == nil {
 		d.orderRepository = orderRepository.NewRepository(d.PGPool(ctx))
@@ -86,6 +173,28 @@ func (d *diContainer) OrderRepository(ctx context.Context) repository.OrderRepos
 	return d.orderRepository
 }
 
+func (d *diContainer) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if d.outboxRepository == nil {
+		d.outboxRepository = outboxRepository.NewRepository(d.PGPool(ctx))
+	}
+	return d.outboxRepository
+}
+
+func (d *diContainer) IdempotencyRepository(ctx context.Context) repository.IdempotencyRepository {
+	if d.idempotencyRepository == nil {
+		d.idempotencyRepository = idempotencyRepository.NewRepository(d.PGPool(ctx))
+	}
+	return d.idempotencyRepository
+}
+
+// InboxStore создает хранилище обработанных событий Kafka, общее для всех consumer-ов сервиса
+func (d *diContainer) InboxStore(ctx context.Context) *kafkaMiddleware.PostgresInbox {
+	if d.inboxStore == nil {
+		d.inboxStore = kafkaMiddleware.NewPostgresInbox(d.PGPool(ctx))
+	}
+	return d.inboxStore
+}
+
 func (d *diContainer) InventoryGRPCClient(ctx context.Context) grpcClient.InventoryClient {
 	if d.inventoryGRPCClient == nil {
 		d.inventoryGRPCClient = grpcClient.NewInventoryClient(d.InventoryClient(ctx))
@@ -107,6 +216,9 @@ func (d *diContainer) PGPool(ctx context.Context) *pgxpool.Pool {
 			log.Printf("❌ Ошибка подключения к PostgreSQL: %v", err)
 			return nil
 		}
+		if err = metrics.RegisterPgxPool(pgPool); err != nil {
+			log.Printf("❌ Ошибка регистрации метрик пула PostgreSQL: %v", err)
+		}
 		d.pgPool = pgPool
 	}
 	return d.pgPool
@@ -122,40 +234,74 @@ func (d *diContainer) PGMigrator(ctx context.Context) *migrator.Migrator {
 
 func (d *diContainer) InventoryClient(ctx context.Context) inventory_v1.InventoryServiceClient {
 	if d.inventoryClient == nil {
-		if d.inventoryConn == nil {
-			conn, err := grpc.NewClient(
-				config.AppConfig().OrderInventoryGRPC.Address(),
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-			)
-			if err != nil {
-				log.Printf("❌ Ошибка подключения к inventory service: %v", err)
-				return nil
-			}
-			d.inventoryConn = conn
-		}
-		d.inventoryClient = inventory_v1.NewInventoryServiceClient(d.inventoryConn)
+		conn := d.InventoryConn(ctx)
+		if conn == nil {
+			return nil
+		}
+		d.inventoryClient = inventory_v1.NewInventoryServiceClient(conn)
 	}
 	return d.inventoryClient
 }
 
+func (d *diContainer) InventoryConn(ctx context.Context) *grpc.ClientConn {
+	if d.inventoryConn == nil {
+		creds := d.GRPCTransportCredentials(ctx)
+		if creds == nil {
+			return nil
+		}
+
+		conn, err := grpc.NewClient(
+			config.AppConfig().OrderInventoryGRPC.Address(),
+			grpc.WithTransportCredentials(creds),
+			grpc.WithChainUnaryInterceptor(
+				interceptors.UnaryTracingClientInterceptor(),
+				metrics.UnaryClientInterceptor(),
+			),
+		)
+		if err != nil {
+			log.Printf("❌ Ошибка подключения к inventory service: %v", err)
+			return nil
+		}
+		d.inventoryConn = conn
+	}
+	return d.inventoryConn
+}
+
 func (d *diContainer) PaymentClient(ctx context.Context) payment_v1.PaymentServiceClient {
 	if d.paymentClient == nil {
-		if d.paymentConn == nil {
-			conn, err := grpc.NewClient(
-				config.AppConfig().OrderPaymentGRPC.Address(),
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-			)
-			if err != nil {
-				log.Printf("❌ Ошибка подключения к payment service: %v", err)
-				return nil
-			}
-			d.paymentConn = conn
-		}
-		d.paymentClient = payment_v1.NewPaymentServiceClient(d.paymentConn)
+		conn := d.PaymentConn(ctx)
+		if conn == nil {
+			return nil
+		}
+		d.paymentClient = payment_v1.NewPaymentServiceClient(conn)
 	}
 	return d.paymentClient
 }
 
+func (d *diContainer) PaymentConn(ctx context.Context) *grpc.ClientConn {
+	if d.paymentConn == nil {
+		creds := d.GRPCTransportCredentials(ctx)
+		if creds == nil {
+			return nil
+		}
+
+		conn, err := grpc.NewClient(
+			config.AppConfig().OrderPaymentGRPC.Address(),
+			grpc.WithTransportCredentials(creds),
+			grpc.WithChainUnaryInterceptor(
+				interceptors.UnaryTracingClientInterceptor(),
+				metrics.UnaryClientInterceptor(),
+			),
+		)
+		if err != nil {
+			log.Printf("❌ Ошибка подключения к payment service: %v", err)
+			return nil
+		}
+		d.paymentConn = conn
+	}
+	return d.paymentConn
+}
+
 // OrderPaidProducer создает Kafka producer для отправки OrderPaidEvent
 func (d *diContainer) OrderPaidProducer(ctx context.Context) platformKafka.Producer {
 	if d.orderPaidProducer == nil {
@@ -179,12 +325,113 @@ func (d *diContainer) OrderPaidProducer(ctx context.Context) platformKafka.Produ
 	return d.orderPaidProducer
 }
 
-// OrderPaidProducerService создает сервис для отправки OrderPaidEvent
-func (d *diContainer) OrderPaidProducerService(ctx context.Context) kafkaConverter.OrderPaidProducer {
-	if d.orderPaidProducerService == nil {
-		d.orderPaidProducerService = orderProducer.NewOrderProducer(d.OrderPaidProducer(ctx))
+// OrderPaidEncoder создает encoder для OrderPaidEvent
+func (d *diContainer) OrderPaidEncoder(ctx context.Context) kafkaConverter.OrderPaidEncoder {
+	if d.orderPaidEncoder == nil {
+		d.orderPaidEncoder = orderEncoder.NewOrderPaidEncoder()
 	}
-	return d.orderPaidProducerService
+	return d.orderPaidEncoder
+}
+
+// OrderRefundedProducer создает Kafka producer для отправки OrderRefundedEvent
+func (d *diContainer) OrderRefundedProducer(ctx context.Context) platformKafka.Producer {
+	if d.orderRefundedProducer == nil {
+		cfg := config.AppConfig()
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Producer.Return.Successes = true
+		saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+		saramaConfig.Producer.Retry.Max = 3
+
+		saramaProducer, err := sarama.NewSyncProducer(cfg.Kafka.Brokers(), saramaConfig)
+		if err != nil {
+			log.Printf("❌ Ошибка создания Sarama producer: %v", err)
+			return nil
+		}
+
+		d.orderRefundedProducer = producer.NewProducer(saramaProducer, cfg.OrderRefundedProducer.TopicName(), logger.Logger())
+	}
+	return d.orderRefundedProducer
+}
+
+// OrderRefundedEncoder создает encoder для OrderRefundedEvent
+func (d *diContainer) OrderRefundedEncoder(ctx context.Context) kafkaConverter.OrderRefundedEncoder {
+	if d.orderRefundedEncoder == nil {
+		d.orderRefundedEncoder = orderEncoder.NewOrderRefundedEncoder()
+	}
+	return d.orderRefundedEncoder
+}
+
+// OrderExpiredProducer создает Kafka producer для отправки OrderExpiredEvent
+func (d *diContainer) OrderExpiredProducer(ctx context.Context) platformKafka.Producer {
+	if d.orderExpiredProducer == nil {
+		cfg := config.AppConfig()
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Producer.Return.Successes = true
+		saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+		saramaConfig.Producer.Retry.Max = 3
+
+		saramaProducer, err := sarama.NewSyncProducer(cfg.Kafka.Brokers(), saramaConfig)
+		if err != nil {
+			log.Printf("❌ Ошибка создания Sarama producer: %v", err)
+			return nil
+		}
+
+		d.orderExpiredProducer = producer.NewProducer(saramaProducer, cfg.OrderExpiredProducer.TopicName(), logger.Logger())
+	}
+	return d.orderExpiredProducer
+}
+
+// OrderExpiredEncoder создает encoder для OrderExpiredEvent
+func (d *diContainer) OrderExpiredEncoder(ctx context.Context) kafkaConverter.OrderExpiredEncoder {
+	if d.orderExpiredEncoder == nil {
+		d.orderExpiredEncoder = orderEncoder.NewOrderExpiredEncoder()
+	}
+	return d.orderExpiredEncoder
+}
+
+// OutboxRelayService создает relay, отправляющий события из outbox в Kafka
+// и подтверждающий или снимающий резервы деталей заказов в InventoryService
+func (d *diContainer) OutboxRelayService(ctx context.Context) service.OutboxRelayService {
+	if d.outboxRelayService == nil {
+		cfg := config.AppConfig().OutboxRelay
+
+		d.outboxRelayService = outboxService.NewService(
+			d.OutboxRepository(ctx),
+			map[repoModel.OutboxEventType]platformKafka.Producer{
+				repoModel.OutboxEventTypeOrderPaid:     d.OrderPaidProducer(ctx),
+				repoModel.OutboxEventTypeOrderRefunded: d.OrderRefundedProducer(ctx),
+				repoModel.OutboxEventTypeOrderExpired:  d.OrderExpiredProducer(ctx),
+			},
+			map[repoModel.OutboxEventType]outboxService.TaskSender{
+				repoModel.OutboxEventTypeCommitReservation:  outboxService.NewReservationCommitter(d.InventoryGRPCClient(ctx)),
+				repoModel.OutboxEventTypeReleaseReservation: outboxService.NewReservationReleaser(d.InventoryGRPCClient(ctx)),
+			},
+			cfg.PollInterval(),
+			cfg.BatchSize(),
+			cfg.RetryBackoff(),
+			cfg.MaxBackoff(),
+		)
+	}
+	return d.outboxRelayService
+}
+
+// OrderExpiryService создает сервис, снимающий заказы, не оплаченные в течение срока оплаты
+func (d *diContainer) OrderExpiryService(ctx context.Context) service.OrderExpiryService {
+	if d.orderExpiryService == nil {
+		cfg := config.AppConfig().OrderExpiry
+
+		d.orderExpiryService = expiryService.NewService(
+			d.OrderRepository(ctx),
+			d.InventoryGRPCClient(ctx),
+			d.OrderExpiredEncoder(ctx),
+			cfg.PaymentWindow(),
+			cfg.SweepInterval(),
+			cfg.BatchSize(),
+		)
+	}
+	return d.orderExpiryService
 }
 
 // ShipAssembledConsumer создает Kafka consumer для получения ShipAssembledEvent
@@ -203,12 +450,51 @@ func (d *diContainer) ShipAssembledConsumer(ctx context.Context) platformKafka.C
 			return nil
 		}
 
+		// Необработанные сообщения уходят в топики повторов, которые читает тот же consumer, а затем в DLQ
+		retryPolicy := consumerRetryPolicy(cfg.OrderAssembledConsumer.TopicName(), cfg.OrderAssembledConsumer.ConsumerGroupID())
+		topics := append([]string{cfg.OrderAssembledConsumer.TopicName()}, retryPolicy.Topics()...)
+
 		// Создаем platform consumer
-		d.shipAssembledConsumer = consumer.NewConsumer(saramaConsumer, []string{cfg.OrderAssembledConsumer.TopicName()}, logger.Logger())
+		// Повторно доставленные события пропускаются по event_uuid
+		shipAssembledEventID := func(msg consumer.Message) string {
+			return d.ShipAssembledDecoder(ctx).Decode(msg.Value).EventUUID
+		}
+
+		d.shipAssembledConsumer = consumer.NewConsumer(saramaConsumer, topics, logger.Logger(),
+			consumer.Retry(retryPolicy, d.ConsumerRetryProducer(ctx), logger.Logger()),
+			kafkaMiddleware.Inbox(cfg.OrderAssembledConsumer.ConsumerGroupID(), d.InboxStore(ctx), shipAssembledEventID, logger.Logger()),
+		)
 	}
 	return d.shipAssembledConsumer
 }
 
+// ConsumerRetryProducer создает producer для переноса необработанных сообщений в топики повторов и DLQ
+func (d *diContainer) ConsumerRetryProducer(ctx context.Context) platformKafka.TopicProducer {
+	if d.consumerRetryProducer == nil {
+		cfg := config.AppConfig()
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Producer.Return.Successes = true
+		saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+		saramaConfig.Producer.Retry.Max = 3
+
+		syncProducer, err := sarama.NewSyncProducer(cfg.Kafka.Brokers(), saramaConfig)
+		if err != nil {
+			log.Printf("❌ Ошибка создания Kafka producer: %v", err)
+			return nil
+		}
+
+		d.consumerRetryProducer = producer.NewTopicProducer(syncProducer, logger.Logger())
+	}
+	return d.consumerRetryProducer
+}
+
+// consumerRetryPolicy возвращает политику повторов consumer group groupID для топика topic
+func consumerRetryPolicy(topic, groupID string) consumer.RetryPolicy {
+	cfg := config.AppConfig().KafkaConsumerRetry
+	return consumer.NewRetryPolicy(topic, groupID, cfg.MaxAttempts(), cfg.Backoff(), cfg.MaxBackoff(), cfg.Delays())
+}
+
 // ShipAssembledDecoder создает decoder для ShipAssembledEvent
 func (d *diContainer) ShipAssembledDecoder(ctx context.Context) kafkaConverter.ShipAssembledDecoder {
 	if d.shipAssembledDecoder == nil {
@@ -228,3 +514,107 @@ func (d *diContainer) ShipAssembledConsumerService(ctx context.Context) *orderCo
 	}
 	return d.shipAssembledConsumerService
 }
+
+// ShipAssemblyFailedConsumer создает Kafka consumer для получения ShipAssemblyFailedEvent
+func (d *diContainer) ShipAssemblyFailedConsumer(ctx context.Context) platformKafka.Consumer {
+	if d.shipAssemblyFailedConsumer == nil {
+		cfg := config.AppConfig()
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Consumer.Group.Rebalance.Strategy = sarama.NewBalanceStrategyRoundRobin()
+		saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+		saramaConsumer, err := sarama.NewConsumerGroup(cfg.Kafka.Brokers(), cfg.OrderAssemblyFailedConsumer.ConsumerGroupID(), saramaConfig)
+		if err != nil {
+			log.Printf("❌ Ошибка создания Sarama consumer: %v", err)
+			return nil
+		}
+
+		retryPolicy := consumerRetryPolicy(cfg.OrderAssemblyFailedConsumer.TopicName(), cfg.OrderAssemblyFailedConsumer.ConsumerGroupID())
+		topics := append([]string{cfg.OrderAssemblyFailedConsumer.TopicName()}, retryPolicy.Topics()...)
+
+		shipAssemblyFailedEventID := func(msg consumer.Message) string {
+			return d.ShipAssemblyFailedDecoder(ctx).Decode(msg.Value).EventUUID
+		}
+
+		d.shipAssemblyFailedConsumer = consumer.NewConsumer(saramaConsumer, topics, logger.Logger(),
+			consumer.Retry(retryPolicy, d.ConsumerRetryProducer(ctx), logger.Logger()),
+			kafkaMiddleware.Inbox(cfg.OrderAssemblyFailedConsumer.ConsumerGroupID(), d.InboxStore(ctx), shipAssemblyFailedEventID, logger.Logger()),
+		)
+	}
+	return d.shipAssemblyFailedConsumer
+}
+
+// ShipAssemblyFailedDecoder создает decoder для ShipAssemblyFailedEvent
+func (d *diContainer) ShipAssemblyFailedDecoder(ctx context.Context) kafkaConverter.ShipAssemblyFailedDecoder {
+	if d.shipAssemblyFailedDecoder == nil {
+		d.shipAssemblyFailedDecoder = orderDecoder.NewShipAssemblyFailedDecoder()
+	}
+	return d.shipAssemblyFailedDecoder
+}
+
+// ShipAssemblyFailedConsumerService создает сервис для обработки ShipAssemblyFailedEvent
+func (d *diContainer) ShipAssemblyFailedConsumerService(ctx context.Context) *assemblyFailedConsumer.Service {
+	if d.shipAssemblyFailedConsumerService == nil {
+		d.shipAssemblyFailedConsumerService = assemblyFailedConsumer.NewService(
+			d.ShipAssemblyFailedConsumer(ctx),
+			d.ShipAssemblyFailedDecoder(ctx),
+			d.OrderService(ctx),
+		)
+	}
+	return d.shipAssemblyFailedConsumerService
+}
+
+// HealthRegistry создает реестр health-проверок: БД, Kafka и downstream gRPC-сервисы
+func (d *diContainer) HealthRegistry(ctx context.Context) *platformHealth.Registry {
+	if d.healthRegistry == nil {
+		cfg := config.AppConfig().Health
+		registry := platformHealth.NewRegistry(cfg.CheckInterval(), cfg.CheckTimeout())
+		registry.Register("postgres", platformHealth.PgxPoolChecker(d.PGPool(ctx)))
+
+		kafkaChecker := platformHealth.NewKafkaChecker(config.AppConfig().Kafka.Brokers())
+		closer.AddNamed("Kafka health client", kafkaChecker.Close)
+		registry.Register("kafka", kafkaChecker.Check)
+
+		registry.Register("inventory", platformHealth.GRPCChecker(d.InventoryConn(ctx), inventory_v1.InventoryService_ServiceDesc.ServiceName))
+		registry.Register("payment", platformHealth.GRPCChecker(d.PaymentConn(ctx), payment_v1.PaymentService_ServiceDesc.ServiceName))
+		d.healthRegistry = registry
+	}
+	return d.healthRegistry
+}
+
+// TLSReloader загружает сертификаты mTLS и перечитывает их при изменении файлов
+func (d *diContainer) TLSReloader(ctx context.Context) *mtls.Reloader {
+	if d.tlsReloader == nil {
+		cfg := config.AppConfig().GRPCTLS
+		reloader, err := mtls.NewReloader(cfg.CertFile(), cfg.KeyFile(), cfg.CAFile())
+		if err != nil {
+			log.Printf("❌ Ошибка загрузки TLS-сертификатов: %v", err)
+			return nil
+		}
+
+		reloadCtx, cancel := context.WithCancel(ctx)
+		go reloader.Run(reloadCtx, cfg.ReloadInterval())
+		closer.AddNamed("TLS reloader", func(context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.tlsReloader = reloader
+	}
+	return d.tlsReloader
+}
+
+// GRPCTransportCredentials возвращает креды клиентов inventory и payment: mTLS, если он настроен, иначе plaintext
+func (d *diContainer) GRPCTransportCredentials(ctx context.Context) credentials.TransportCredentials {
+	if !config.AppConfig().GRPCTLS.Enabled() {
+		return insecure.NewCredentials()
+	}
+
+	reloader := d.TLSReloader(ctx)
+	if reloader == nil {
+		return nil
+	}
+
+	return reloader.ClientCredentials()
+}
@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/order/internal/model"
+	genaratedInventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+// ReservationItemsToProto конвертирует позиции резерва из модели в proto
+func ReservationItemsToProto(items []model.ReservationItem) []*genaratedInventoryV1.ReservationItem {
+	protoItems := make([]*genaratedInventoryV1.ReservationItem, len(items))
+	for i, item := range items {
+		protoItems[i] = &genaratedInventoryV1.ReservationItem{
+			PartUuid: item.PartUUID,
+			Quantity: item.Quantity,
+		}
+	}
+	return protoItems
+}
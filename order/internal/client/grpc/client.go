@@ -3,6 +3,8 @@ package grpc
 import (
 	"context"
 
+	"github.com/shopspring/decimal"
+
 	inventoryV1 "github.com/space-wanderer/microservices/order/internal/client/grpc/inventory/v1"
 	paymentV1 "github.com/space-wanderer/microservices/order/internal/client/grpc/payment/v1"
 	"github.com/space-wanderer/microservices/order/internal/model"
@@ -12,10 +14,14 @@ import (
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error)
+	ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) error
+	CommitReservation(ctx context.Context, orderUUID string) error
+	ReleaseReservation(ctx context.Context, orderUUID string) error
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod string) (string, error)
+	PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod string, amount decimal.Decimal, idempotencyKey string) (string, error)
+	RefundPayment(ctx context.Context, transactionUUID string, amount decimal.Decimal, reason, idempotencyKey string) (model.Refund, error)
 }
 
 func NewInventoryClient(generatedClient genaratedInventoryV1.InventoryServiceClient) InventoryClient {
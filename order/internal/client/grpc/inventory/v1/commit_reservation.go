@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	genaratedInventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) CommitReservation(ctx context.Context, orderUUID string) error {
+	_, err := c.generatedClient.CommitReservation(ctx, &genaratedInventoryV1.CommitReservationRequest{
+		OrderUuid: orderUUID,
+	})
+	if err != nil {
+		// Заказы, созданные до появления резервов, не имеют резерва в inventory
+		if status.Code(err) == codes.NotFound {
+			return model.ErrReservationNotFound
+		}
+		// Подтвержденный резерв возвращается без ошибки, поэтому отказ означает, что резерв уже снят
+		if status.Code(err) == codes.FailedPrecondition {
+			return model.ErrReservationReleased
+		}
+		return err
+	}
+	return nil
+}
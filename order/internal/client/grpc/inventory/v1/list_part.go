@@ -8,12 +8,24 @@ import (
 	genaratedInventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
 )
 
+// ListParts возвращает все детали по фильтру, проходя по страницам inventory
 func (c *client) ListParts(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
-	parts, err := c.generatedClient.ListParts(ctx, &genaratedInventoryV1.ListPartsRequest{
+	req := &genaratedInventoryV1.ListPartsRequest{
 		Filter: converter.PartsFilterToProto(filter),
-	})
-	if err != nil {
-		return nil, err
 	}
-	return converter.PartListProtoToModel(parts.Parts), nil
+
+	var parts []*model.Part
+	for {
+		resp, err := c.generatedClient.ListParts(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, converter.PartListProtoToModel(resp.GetParts())...)
+
+		if resp.GetNextPageToken() == "" {
+			return parts, nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
 }
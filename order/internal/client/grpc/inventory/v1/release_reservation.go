@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	genaratedInventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ReleaseReservation(ctx context.Context, orderUUID string) error {
+	_, err := c.generatedClient.ReleaseReservation(ctx, &genaratedInventoryV1.ReleaseReservationRequest{
+		OrderUuid: orderUUID,
+	})
+	if err != nil {
+		// Заказы, созданные до появления резервов, не имеют резерва в inventory
+		if status.Code(err) == codes.NotFound {
+			return model.ErrReservationNotFound
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/space-wanderer/microservices/order/internal/client/converter"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	genaratedInventoryV1 "github.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem) error {
+	_, err := c.generatedClient.ReserveParts(ctx, &genaratedInventoryV1.ReservePartsRequest{
+		OrderUuid: orderUUID,
+		Items:     converter.ReservationItemsToProto(items),
+	})
+	if err != nil {
+		// Нехватка остатков приходит как FailedPrecondition и возвращается как бизнес-ошибка заказа
+		if status.Code(err) == codes.FailedPrecondition {
+			return model.ErrInsufficientStock
+		}
+		return err
+	}
+	return nil
+}
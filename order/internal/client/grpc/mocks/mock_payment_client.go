@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	decimal "github.com/shopspring/decimal"
+	model "github.com/space-wanderer/microservices/order/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,9 +23,9 @@ func (_m *PaymentClient) EXPECT() *PaymentClient_Expecter {
 	return &PaymentClient_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod
-func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod string) (string, error) {
-	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod)
+// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod, amount, idempotencyKey
+func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod string, amount decimal.Decimal, idempotencyKey string) (string, error) {
+	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod, amount, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -31,17 +33,17 @@ func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, userUUI
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
-		return rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, decimal.Decimal, string) (string, error)); ok {
+		return rf(ctx, orderUUID, userUUID, paymentMethod, amount, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
-		r0 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, decimal.Decimal, string) string); ok {
+		r0 = rf(ctx, orderUUID, userUUID, paymentMethod, amount, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, decimal.Decimal, string) error); ok {
+		r1 = rf(ctx, orderUUID, userUUID, paymentMethod, amount, idempotencyKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,13 +61,15 @@ type PaymentClient_PayOrder_Call struct {
 //   - orderUUID string
 //   - userUUID string
 //   - paymentMethod string
-func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}) *PaymentClient_PayOrder_Call {
-	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod)}
+//   - amount decimal.Decimal
+//   - idempotencyKey string
+func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}, amount interface{}, idempotencyKey interface{}) *PaymentClient_PayOrder_Call {
+	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod, amount, idempotencyKey)}
 }
 
-func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod string)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod string, amount decimal.Decimal, idempotencyKey string)) *PaymentClient_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(decimal.Decimal), args[5].(string))
 	})
 	return _c
 }
@@ -75,7 +79,67 @@ func (_c *PaymentClient_PayOrder_Call) Return(_a0 string, _a1 error) *PaymentCli
 	return _c
 }
 
-func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string, decimal.Decimal, string) (string, error)) *PaymentClient_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, transactionUUID, amount, reason, idempotencyKey
+func (_m *PaymentClient) RefundPayment(ctx context.Context, transactionUUID string, amount decimal.Decimal, reason string, idempotencyKey string) (model.Refund, error) {
+	ret := _m.Called(ctx, transactionUUID, amount, reason, idempotencyKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 model.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, decimal.Decimal, string, string) (model.Refund, error)); ok {
+		return rf(ctx, transactionUUID, amount, reason, idempotencyKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, decimal.Decimal, string, string) model.Refund); ok {
+		r0 = rf(ctx, transactionUUID, amount, reason, idempotencyKey)
+	} else {
+		r0 = ret.Get(0).(model.Refund)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, decimal.Decimal, string, string) error); ok {
+		r1 = rf(ctx, transactionUUID, amount, reason, idempotencyKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentClient_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - amount decimal.Decimal
+//   - reason string
+//   - idempotencyKey string
+func (_e *PaymentClient_Expecter) RefundPayment(ctx interface{}, transactionUUID interface{}, amount interface{}, reason interface{}, idempotencyKey interface{}) *PaymentClient_RefundPayment_Call {
+	return &PaymentClient_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, transactionUUID, amount, reason, idempotencyKey)}
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Run(run func(ctx context.Context, transactionUUID string, amount decimal.Decimal, reason string, idempotencyKey string)) *PaymentClient_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(decimal.Decimal), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Return(_a0 model.Refund, _a1 error) *PaymentClient_RefundPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) RunAndReturn(run func(context.Context, string, decimal.Decimal, string, string) (model.Refund, error)) *PaymentClient_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,17 +2,27 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	"github.com/space-wanderer/microservices/order/internal/model"
 	generatedPaymentV1 "github.com/space-wanderer/microservices/shared/pkg/proto/payment/v1"
 )
 
-// PayOrder обрабатывает платеж через PaymentService
-func (c *client) PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod string) (string, error) {
+// PayOrder обрабатывает платеж через PaymentService.
+// Повтор с тем же idempotencyKey не приводит к повторному списанию
+func (c *client) PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod string, amount decimal.Decimal, idempotencyKey string) (string, error) {
 	req := &generatedPaymentV1.PayOrderRequest{
-		OrderUuid:     orderUUID,
-		UserUuid:      userUUID,
-		PaymentMethod: generatedPaymentV1.PaymentMethod(generatedPaymentV1.PaymentMethod_value[paymentMethod]),
+		OrderUuid:      orderUUID,
+		UserUuid:       userUUID,
+		PaymentMethod:  generatedPaymentV1.PaymentMethod(generatedPaymentV1.PaymentMethod_value["PAYMENT_METHOD_"+paymentMethod]),
+		AmountMinor:    converter.AmountToMinor(amount),
+		IdempotencyKey: idempotencyKey,
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -20,8 +30,34 @@ func (c *client) PayOrder(ctx context.Context, orderUUID, userUUID, paymentMetho
 
 	resp, err := c.generatedClient.PayOrder(ctx, req)
 	if err != nil {
-		return "", err
+		return "", paymentError(err)
+	}
+
+	if resp.GetStatus() != generatedPaymentV1.TransactionStatus_TRANSACTION_STATUS_CAPTURED {
+		return "", fmt.Errorf("%w: unexpected transaction status %s", model.ErrPaymentDeclined, resp.GetStatus())
 	}
 
 	return resp.TransactionUuid, nil
 }
+
+// paymentError переводит gRPC статус ответа PaymentService в бизнес-ошибку заказа,
+// сохраняя описание причины от платежного сервиса
+func paymentError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", model.ErrPaymentDeclined, st.Message())
+	case codes.PermissionDenied:
+		return fmt.Errorf("%w: %s", model.ErrPaymentMethodNotAllowed, st.Message())
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", model.ErrInvalidPaymentRequest, st.Message())
+	case codes.Unavailable:
+		return fmt.Errorf("%w: %s", model.ErrPaymentUnavailable, st.Message())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	generatedPaymentV1 "github.com/space-wanderer/microservices/shared/pkg/proto/payment/v1"
+)
+
+// RefundPayment возвращает средства по транзакции через PaymentService.
+// Нулевая сумма возвращает весь невозвращенный остаток
+func (c *client) RefundPayment(ctx context.Context, transactionUUID string, amount decimal.Decimal, reason, idempotencyKey string) (model.Refund, error) {
+	req := &generatedPaymentV1.RefundPaymentRequest{
+		TransactionUuid: transactionUUID,
+		AmountMinor:     converter.AmountToMinor(amount),
+		Reason:          reason,
+		IdempotencyKey:  idempotencyKey,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := c.generatedClient.RefundPayment(ctx, req)
+	if err != nil {
+		return model.Refund{}, refundError(err)
+	}
+
+	if resp.GetStatus() != generatedPaymentV1.RefundStatus_REFUND_STATUS_SUCCEEDED {
+		return model.Refund{}, fmt.Errorf("%w: unexpected refund status %s", model.ErrPaymentUnavailable, resp.GetStatus())
+	}
+
+	return model.Refund{
+		RefundUUID:      resp.GetRefundUuid(),
+		TransactionUUID: resp.GetTransactionUuid(),
+		Amount:          converter.AmountFromMinor(resp.GetAmountMinor()),
+	}, nil
+}
+
+// refundError переводит отказ PaymentService в возврате в бизнес-ошибку заказа,
+// остальные коды обрабатываются так же, как при оплате
+func refundError(err error) error {
+	if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+		return fmt.Errorf("%w: %s", model.ErrRefundRejected, st.Message())
+	}
+	return paymentError(err)
+}
@@ -11,14 +11,27 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger                 LoggerConfig
-	OrderHTTP              OrderHTTPConfig
-	OrderPaymentGRPC       OrderPaymentGRPCConfig
-	OrderInventoryGRPC     OrderInventoryGRPCConfig
-	Postgres               PosgresConfig
-	Kafka                  KafkaConfig
-	OrderAssembledConsumer OrderAssembledConsumerConfig
-	OrderPaidProducer      OrderPaidProducerConfig
+	Logger                      LoggerConfig
+	Tracing                     TracingConfig
+	Metrics                     MetricsConfig
+	Health                      HealthConfig
+	GRPCTLS                     GRPCTLSConfig
+	Auth                        AuthConfig
+	OrderHTTP                   OrderHTTPConfig
+	OrderPaymentGRPC            OrderPaymentGRPCConfig
+	OrderInventoryGRPC          OrderInventoryGRPCConfig
+	Postgres                    PosgresConfig
+	Inbox                       InboxConfig
+	Kafka                       KafkaConfig
+	KafkaConsumerRetry          KafkaConsumerRetryConfig
+	OrderAssembledConsumer      OrderAssembledConsumerConfig
+	OrderAssemblyFailedConsumer OrderAssemblyFailedConsumerConfig
+	OrderPaidProducer           OrderPaidProducerConfig
+	OrderRefundedProducer       OrderRefundedProducerConfig
+	OrderExpiredProducer        OrderExpiredProducerConfig
+	OutboxRelay                 OutboxRelayConfig
+	OrderExpiry                 OrderExpiryConfig
+	Idempotency                 IdempotencyConfig
 }
 
 func Load(path ...string) error {
@@ -32,6 +45,31 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
+	metricsCfg, err := env.NewMetricsConfig()
+	if err != nil {
+		return err
+	}
+
+	healthCfg, err := env.NewHealthConfig()
+	if err != nil {
+		return err
+	}
+
+	grpcTLSCfg, err := env.NewGRPCTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	authCfg, err := env.NewAuthConfig()
+	if err != nil {
+		return err
+	}
+
 	orderHTTPConfig, err := env.NewOrderHTTPConfig()
 	if err != nil {
 		return err
@@ -52,30 +90,83 @@ func Load(path ...string) error {
 		return err
 	}
 
+	inboxConfig, err := env.NewInboxConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaConfig, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
 	}
 
+	kafkaConsumerRetryConfig, err := env.NewKafkaConsumerRetryConfig()
+	if err != nil {
+		return err
+	}
+
 	orderAssembledConsumerConfig, err := env.NewOrderAssembledConsumerConfig()
 	if err != nil {
 		return err
 	}
 
+	orderAssemblyFailedConsumerConfig, err := env.NewOrderAssemblyFailedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	orderPaidProducerConfig, err := env.NewOrderPaidProducerConfig()
 	if err != nil {
 		return err
 	}
 
+	orderRefundedProducerConfig, err := env.NewOrderRefundedProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	orderExpiredProducerConfig, err := env.NewOrderExpiredProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	outboxRelayConfig, err := env.NewOutboxRelayConfig()
+	if err != nil {
+		return err
+	}
+
+	orderExpiryConfig, err := env.NewOrderExpiryConfig()
+	if err != nil {
+		return err
+	}
+
+	idempotencyConfig, err := env.NewIdempotencyConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:                 loggerCfg,
-		OrderHTTP:              orderHTTPConfig,
-		OrderPaymentGRPC:       orderPaymentGRPCConfig,
-		OrderInventoryGRPC:     orderInventoryGRPCConfig,
-		Postgres:               postgresConfig,
-		Kafka:                  kafkaConfig,
-		OrderAssembledConsumer: orderAssembledConsumerConfig,
-		OrderPaidProducer:      orderPaidProducerConfig,
+		Logger:                      loggerCfg,
+		Tracing:                     tracingCfg,
+		Metrics:                     metricsCfg,
+		Health:                      healthCfg,
+		GRPCTLS:                     grpcTLSCfg,
+		Auth:                        authCfg,
+		OrderHTTP:                   orderHTTPConfig,
+		OrderPaymentGRPC:            orderPaymentGRPCConfig,
+		OrderInventoryGRPC:          orderInventoryGRPCConfig,
+		Postgres:                    postgresConfig,
+		Inbox:                       inboxConfig,
+		Kafka:                       kafkaConfig,
+		KafkaConsumerRetry:          kafkaConsumerRetryConfig,
+		OrderAssembledConsumer:      orderAssembledConsumerConfig,
+		OrderAssemblyFailedConsumer: orderAssemblyFailedConsumerConfig,
+		OrderPaidProducer:           orderPaidProducerConfig,
+		OrderRefundedProducer:       orderRefundedProducerConfig,
+		OrderExpiredProducer:        orderExpiredProducerConfig,
+		OutboxRelay:                 outboxRelayConfig,
+		OrderExpiry:                 orderExpiryConfig,
+		Idempotency:                 idempotencyConfig,
 	}
 
 	return nil
@@ -0,0 +1,65 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type grpcTLSEnvConfig struct {
+	CertFile       string        `env:"GRPC_TLS_CERT_FILE"`
+	KeyFile        string        `env:"GRPC_TLS_KEY_FILE"`
+	CAFile         string        `env:"GRPC_TLS_CA_FILE"`
+	ReloadInterval time.Duration `env:"GRPC_TLS_RELOAD_INTERVAL,required"`
+}
+
+type grpcTLSConfig struct {
+	raw grpcTLSEnvConfig
+}
+
+func NewGRPCTLSConfig() (*grpcTLSConfig, error) {
+	var raw grpcTLSEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	// mTLS включается только полным набором файлов, частичная настройка — ошибка
+	set := 0
+	for _, path := range []string{raw.CertFile, raw.KeyFile, raw.CAFile} {
+		if path != "" {
+			set++
+		}
+	}
+	if set != 0 && set != 3 {
+		return nil, errors.New("GRPC_TLS_CERT_FILE, GRPC_TLS_KEY_FILE and GRPC_TLS_CA_FILE must be set together")
+	}
+
+	if raw.ReloadInterval <= 0 {
+		return nil, errors.New("GRPC_TLS_RELOAD_INTERVAL must be positive")
+	}
+
+	return &grpcTLSConfig{raw: raw}, nil
+}
+
+// Enabled сообщает, что gRPC-соединения защищены mTLS; иначе используется plaintext
+func (cfg *grpcTLSConfig) Enabled() bool {
+	return cfg.raw.CertFile != ""
+}
+
+func (cfg *grpcTLSConfig) CertFile() string {
+	return cfg.raw.CertFile
+}
+
+func (cfg *grpcTLSConfig) KeyFile() string {
+	return cfg.raw.KeyFile
+}
+
+func (cfg *grpcTLSConfig) CAFile() string {
+	return cfg.raw.CAFile
+}
+
+// ReloadInterval возвращает период проверки файлов сертификатов на изменения
+func (cfg *grpcTLSConfig) ReloadInterval() time.Duration {
+	return cfg.raw.ReloadInterval
+}
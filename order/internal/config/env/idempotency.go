@@ -0,0 +1,56 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type idempotencyEnvConfig struct {
+	LockTTL          time.Duration `env:"IDEMPOTENCY_LOCK_TTL,required"`
+	KeyTTL           time.Duration `env:"IDEMPOTENCY_KEY_TTL,required"`
+	CleanupInterval  time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL,required"`
+	CleanupBatchSize int           `env:"IDEMPOTENCY_CLEANUP_BATCH_SIZE,required"`
+}
+
+type idempotencyConfig struct {
+	raw idempotencyEnvConfig
+}
+
+func NewIdempotencyConfig() (*idempotencyConfig, error) {
+	var raw idempotencyEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	// Ключ не должен удаляться раньше, чем истечет блокировка выполняющегося запроса
+	if raw.KeyTTL <= raw.LockTTL {
+		return nil, errors.New("IDEMPOTENCY_KEY_TTL must be greater than IDEMPOTENCY_LOCK_TTL")
+	}
+	if raw.CleanupInterval <= 0 {
+		return nil, errors.New("IDEMPOTENCY_CLEANUP_INTERVAL must be positive")
+	}
+	if raw.CleanupBatchSize <= 0 {
+		return nil, errors.New("IDEMPOTENCY_CLEANUP_BATCH_SIZE must be positive")
+	}
+
+	return &idempotencyConfig{raw: raw}, nil
+}
+
+func (cfg *idempotencyConfig) LockTTL() time.Duration {
+	return cfg.raw.LockTTL
+}
+
+// KeyTTL - время хранения ключа идемпотентности с момента создания
+func (cfg *idempotencyConfig) KeyTTL() time.Duration {
+	return cfg.raw.KeyTTL
+}
+
+func (cfg *idempotencyConfig) CleanupInterval() time.Duration {
+	return cfg.raw.CleanupInterval
+}
+
+func (cfg *idempotencyConfig) CleanupBatchSize() int {
+	return cfg.raw.CleanupBatchSize
+}
@@ -0,0 +1,51 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type inboxEnvConfig struct {
+	Retention        time.Duration `env:"INBOX_RETENTION,required"`
+	CleanupInterval  time.Duration `env:"INBOX_CLEANUP_INTERVAL,required"`
+	CleanupBatchSize int           `env:"INBOX_CLEANUP_BATCH_SIZE,required"`
+}
+
+type inboxConfig struct {
+	raw inboxEnvConfig
+}
+
+func NewInboxConfig() (*inboxConfig, error) {
+	var raw inboxEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.Retention <= 0 {
+		return nil, errors.New("INBOX_RETENTION must be positive")
+	}
+	if raw.CleanupInterval <= 0 {
+		return nil, errors.New("INBOX_CLEANUP_INTERVAL must be positive")
+	}
+	if raw.CleanupBatchSize <= 0 {
+		return nil, errors.New("INBOX_CLEANUP_BATCH_SIZE must be positive")
+	}
+
+	return &inboxConfig{raw: raw}, nil
+}
+
+// Retention - время хранения отметки об обработанном событии Kafka. Должно быть больше
+// срока хранения сообщений в топиках, иначе повторная доставка старого события обработается заново
+func (cfg *inboxConfig) Retention() time.Duration {
+	return cfg.raw.Retention
+}
+
+func (cfg *inboxConfig) CleanupInterval() time.Duration {
+	return cfg.raw.CleanupInterval
+}
+
+func (cfg *inboxConfig) CleanupBatchSize() int {
+	return cfg.raw.CleanupBatchSize
+}
@@ -0,0 +1,29 @@
+package env
+
+import "github.com/caarlos0/env/v11"
+
+type orderAssemblyFailedConsumerEnvConfig struct {
+	TopicName       string `env:"ORDER_ASSEMBLY_FAILED_TOPIC_NAME,required"`
+	ConsumerGroupID string `env:"ORDER_ASSEMBLY_FAILED_CONSUMER_GROUP_ID,required"`
+}
+
+type orderAssemblyFailedConsumerConfig struct {
+	raw orderAssemblyFailedConsumerEnvConfig
+}
+
+func NewOrderAssemblyFailedConsumerConfig() (*orderAssemblyFailedConsumerConfig, error) {
+	var raw orderAssemblyFailedConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderAssemblyFailedConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *orderAssemblyFailedConsumerConfig) TopicName() string {
+	return cfg.raw.TopicName
+}
+
+func (cfg *orderAssemblyFailedConsumerConfig) ConsumerGroupID() string {
+	return cfg.raw.ConsumerGroupID
+}
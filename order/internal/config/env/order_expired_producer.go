@@ -0,0 +1,24 @@
+package env
+
+import "github.com/caarlos0/env/v11"
+
+type orderExpiredProducerEnvConfig struct {
+	TopicName string `env:"ORDER_EXPIRED_TOPIC_NAME,required"`
+}
+
+type orderExpiredProducerConfig struct {
+	raw orderExpiredProducerEnvConfig
+}
+
+func NewOrderExpiredProducerConfig() (*orderExpiredProducerConfig, error) {
+	var raw orderExpiredProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderExpiredProducerConfig{raw: raw}, nil
+}
+
+func (cfg *orderExpiredProducerConfig) TopicName() string {
+	return cfg.raw.TopicName
+}
@@ -0,0 +1,50 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderExpiryEnvConfig struct {
+	PaymentWindow time.Duration `env:"ORDER_EXPIRY_PAYMENT_WINDOW,required"`
+	SweepInterval time.Duration `env:"ORDER_EXPIRY_SWEEP_INTERVAL,required"`
+	BatchSize     int           `env:"ORDER_EXPIRY_BATCH_SIZE,required"`
+}
+
+type orderExpiryConfig struct {
+	raw orderExpiryEnvConfig
+}
+
+func NewOrderExpiryConfig() (*orderExpiryConfig, error) {
+	var raw orderExpiryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.PaymentWindow <= 0 {
+		return nil, errors.New("ORDER_EXPIRY_PAYMENT_WINDOW must be positive")
+	}
+	if raw.SweepInterval <= 0 {
+		return nil, errors.New("ORDER_EXPIRY_SWEEP_INTERVAL must be positive")
+	}
+	if raw.BatchSize <= 0 {
+		return nil, errors.New("ORDER_EXPIRY_BATCH_SIZE must be positive")
+	}
+
+	return &orderExpiryConfig{raw: raw}, nil
+}
+
+// PaymentWindow - срок оплаты, по истечении которого неоплаченный заказ снимается
+func (cfg *orderExpiryConfig) PaymentWindow() time.Duration {
+	return cfg.raw.PaymentWindow
+}
+
+func (cfg *orderExpiryConfig) SweepInterval() time.Duration {
+	return cfg.raw.SweepInterval
+}
+
+func (cfg *orderExpiryConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
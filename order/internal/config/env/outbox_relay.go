@@ -0,0 +1,43 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type outboxRelayEnvConfig struct {
+	PollInterval time.Duration `env:"OUTBOX_RELAY_POLL_INTERVAL,required"`
+	BatchSize    int           `env:"OUTBOX_RELAY_BATCH_SIZE,required"`
+	RetryBackoff time.Duration `env:"OUTBOX_RELAY_RETRY_BACKOFF,required"`
+	MaxBackoff   time.Duration `env:"OUTBOX_RELAY_MAX_BACKOFF,required"`
+}
+
+type outboxRelayConfig struct {
+	raw outboxRelayEnvConfig
+}
+
+func NewOutboxRelayConfig() (*outboxRelayConfig, error) {
+	var raw outboxRelayEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &outboxRelayConfig{raw: raw}, nil
+}
+
+func (cfg *outboxRelayConfig) PollInterval() time.Duration {
+	return cfg.raw.PollInterval
+}
+
+func (cfg *outboxRelayConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
+
+func (cfg *outboxRelayConfig) RetryBackoff() time.Duration {
+	return cfg.raw.RetryBackoff
+}
+
+func (cfg *outboxRelayConfig) MaxBackoff() time.Duration {
+	return cfg.raw.MaxBackoff
+}
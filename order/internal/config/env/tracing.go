@@ -0,0 +1,31 @@
+package env
+
+import "github.com/caarlos0/env/v11"
+
+type tracingEnvConfig struct {
+	CollectorEndpoint string `env:"TRACING_COLLECTOR_ENDPOINT,required"`
+	ServiceName       string `env:"TRACING_SERVICE_NAME,required"`
+}
+
+type tracingConfig struct {
+	raw tracingEnvConfig
+}
+
+func NewTracingConfig() (*tracingConfig, error) {
+	var raw tracingEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &tracingConfig{raw: raw}, nil
+}
+
+// CollectorEndpoint возвращает адрес OTLP/gRPC коллектора (host:port)
+func (cfg *tracingConfig) CollectorEndpoint() string {
+	return cfg.raw.CollectorEndpoint
+}
+
+// ServiceName возвращает имя сервиса в span'ах
+func (cfg *tracingConfig) ServiceName() string {
+	return cfg.raw.ServiceName
+}
@@ -1,10 +1,41 @@
 package config
 
+import "time"
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+}
+
+type MetricsConfig interface {
+	Address() string
+}
+
+type AuthConfig interface {
+	JWKSFile() string
+	StaticKey() string
+	Issuer() string
+	Audience() string
+}
+
+type HealthConfig interface {
+	CheckInterval() time.Duration
+	CheckTimeout() time.Duration
+}
+
+type GRPCTLSConfig interface {
+	Enabled() bool
+	CertFile() string
+	KeyFile() string
+	CAFile() string
+	ReloadInterval() time.Duration
+}
+
 type OrderHTTPConfig interface {
 	Address() string
 }
@@ -27,11 +58,60 @@ type KafkaConfig interface {
 	Brokers() []string
 }
 
+// KafkaConsumerRetryConfig - политика повторной обработки сообщений Kafka и DLQ
+type KafkaConsumerRetryConfig interface {
+	MaxAttempts() int
+	Backoff() time.Duration
+	MaxBackoff() time.Duration
+	Delays() []time.Duration
+}
+
 type OrderAssembledConsumerConfig interface {
 	TopicName() string
 	ConsumerGroupID() string
 }
 
+type OrderAssemblyFailedConsumerConfig interface {
+	TopicName() string
+	ConsumerGroupID() string
+}
+
 type OrderPaidProducerConfig interface {
 	TopicName() string
 }
+
+type OrderRefundedProducerConfig interface {
+	TopicName() string
+}
+
+type OrderExpiredProducerConfig interface {
+	TopicName() string
+}
+
+type OutboxRelayConfig interface {
+	PollInterval() time.Duration
+	BatchSize() int
+	RetryBackoff() time.Duration
+	MaxBackoff() time.Duration
+}
+
+// OrderExpiryConfig - срок оплаты заказа и параметры фонового снятия просроченных заказов
+type OrderExpiryConfig interface {
+	PaymentWindow() time.Duration
+	SweepInterval() time.Duration
+	BatchSize() int
+}
+
+type IdempotencyConfig interface {
+	LockTTL() time.Duration
+	KeyTTL() time.Duration
+	CleanupInterval() time.Duration
+	CleanupBatchSize() int
+}
+
+// InboxConfig - хранение отметок об обработанных событиях Kafka
+type InboxConfig interface {
+	Retention() time.Duration
+	CleanupInterval() time.Duration
+	CleanupBatchSize() int
+}
@@ -0,0 +1,17 @@
+package converter
+
+import "github.com/shopspring/decimal"
+
+// minorUnitsExp - порядок младшей денежной единицы: суммы в gRPC и Kafka передаются в копейках
+const minorUnitsExp = -2
+
+// AmountToMinor переводит сумму в копейки. Цены заказа хранятся с точностью до копейки,
+// поэтому округление не меняет значения
+func AmountToMinor(amount decimal.Decimal) int64 {
+	return amount.Shift(-minorUnitsExp).Round(0).IntPart()
+}
+
+// AmountFromMinor переводит сумму из копеек в рубли без потери точности
+func AmountFromMinor(amount int64) decimal.Decimal {
+	return decimal.New(amount, minorUnitsExp)
+}
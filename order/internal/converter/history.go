@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	order_v1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
+)
+
+// ConvertStatusChangeToRepoStatusTransition конвертирует смену статуса из service в repository model
+func ConvertStatusChangeToRepoStatusTransition(change model.StatusChange) *repoModel.StatusTransition {
+	transition := &repoModel.StatusTransition{
+		OrderUUID: change.OrderUUID,
+		To:        convertModelStatusToRepoStatus(change.To),
+		Actor:     string(change.Actor),
+		Reason:    change.Reason,
+		CreatedAt: change.CreatedAt,
+	}
+
+	// Пустой статус означает создание заказа и не должен превратиться в PENDING_PAYMENT
+	if change.From != "" {
+		transition.From = convertModelStatusToRepoStatus(change.From)
+	}
+
+	return transition
+}
+
+// ConvertRepoStatusHistoryToModel конвертирует историю статусов из repository в service model
+func ConvertRepoStatusHistoryToModel(history []*repoModel.StatusTransition) []model.StatusChange {
+	changes := make([]model.StatusChange, 0, len(history))
+	for _, transition := range history {
+		change := model.StatusChange{
+			OrderUUID: transition.OrderUUID,
+			To:        convertRepoStatusToModelStatus(transition.To),
+			Actor:     model.Actor(transition.Actor),
+			Reason:    transition.Reason,
+			CreatedAt: transition.CreatedAt,
+		}
+		if transition.From != "" {
+			change.From = convertRepoStatusToModelStatus(transition.From)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// ConvertStatusHistoryToOrderHistoryResponse конвертирует историю статусов в ответ API
+func ConvertStatusHistoryToOrderHistoryResponse(orderUUID string, history []model.StatusChange) *order_v1.OrderHistoryResponse {
+	items := make([]order_v1.OrderStatusChangeDto, 0, len(history))
+	for _, change := range history {
+		item := order_v1.OrderStatusChangeDto{
+			ToStatus:  convertModelStatusToOrderStatus(change.To),
+			Actor:     string(change.Actor),
+			Reason:    change.Reason,
+			CreatedAt: change.CreatedAt,
+		}
+		if change.From != "" {
+			item.FromStatus = order_v1.NewOptOrderStatus(convertModelStatusToOrderStatus(change.From))
+		}
+		items = append(items, item)
+	}
+
+	return &order_v1.OrderHistoryResponse{
+		OrderUUID: uuid.MustParse(orderUUID),
+		History:   items,
+	}
+}
@@ -0,0 +1,30 @@
+package decoder
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/space-wanderer/microservices/order/internal/converter/kafka"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	events_v1 "github.com/space-wanderer/microservices/shared/pkg/proto/events/v1"
+)
+
+type shipAssemblyFailedDecoder struct{}
+
+func NewShipAssemblyFailedDecoder() kafka.ShipAssemblyFailedDecoder {
+	return &shipAssemblyFailedDecoder{}
+}
+
+func (d *shipAssemblyFailedDecoder) Decode(data []byte) model.ShipAssemblyFailedEvent {
+	var pbEvent events_v1.ShipAssemblyFailedEvent
+	if err := proto.Unmarshal(data, &pbEvent); err != nil {
+		return model.ShipAssemblyFailedEvent{}
+	}
+
+	return model.ShipAssemblyFailedEvent{
+		EventUUID: pbEvent.EventUuid,
+		OrderUUID: pbEvent.OrderUuid,
+		UserUUID:  pbEvent.UserUuid,
+		Stage:     pbEvent.Stage,
+		Reason:    pbEvent.Reason,
+	}
+}
@@ -0,0 +1,26 @@
+package encoder
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/space-wanderer/microservices/order/internal/converter/kafka"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	events_v1 "github.com/space-wanderer/microservices/shared/pkg/proto/events/v1"
+)
+
+type orderExpiredEncoder struct{}
+
+func NewOrderExpiredEncoder() kafka.OrderExpiredEncoder {
+	return &orderExpiredEncoder{}
+}
+
+func (e *orderExpiredEncoder) Encode(event model.OrderExpiredEvent) ([]byte, error) {
+	pbEvent := &events_v1.OrderExpiredEvent{
+		EventUuid:        event.EventUUID,
+		OrderUuid:        event.OrderUUID,
+		UserUuid:         event.UserUUID,
+		PaymentWindowSec: event.PaymentWindowSec,
+	}
+
+	return proto.Marshal(pbEvent)
+}
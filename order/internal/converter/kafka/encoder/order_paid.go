@@ -0,0 +1,34 @@
+package encoder
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/space-wanderer/microservices/order/internal/converter/kafka"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	events_v1 "github.com/space-wanderer/microservices/shared/pkg/proto/events/v1"
+)
+
+type orderPaidEncoder struct{}
+
+func NewOrderPaidEncoder() kafka.OrderPaidEncoder {
+	return &orderPaidEncoder{}
+}
+
+func (e *orderPaidEncoder) Encode(event model.OrderPaidEvent) ([]byte, error) {
+	pbEvent := &events_v1.OrderPaidEvent{
+		EventUuid:       event.EventUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		PaymentMethod:   event.PaymentMethod,
+		TransactionUuid: event.TransactionUUID,
+		Items:           make([]*events_v1.OrderItem, 0, len(event.Items)),
+	}
+	for _, item := range event.Items {
+		pbEvent.Items = append(pbEvent.Items, &events_v1.OrderItem{
+			PartUuid: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return proto.Marshal(pbEvent)
+}
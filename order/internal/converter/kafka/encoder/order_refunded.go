@@ -0,0 +1,29 @@
+package encoder
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	"github.com/space-wanderer/microservices/order/internal/converter/kafka"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	events_v1 "github.com/space-wanderer/microservices/shared/pkg/proto/events/v1"
+)
+
+type orderRefundedEncoder struct{}
+
+func NewOrderRefundedEncoder() kafka.OrderRefundedEncoder {
+	return &orderRefundedEncoder{}
+}
+
+func (e *orderRefundedEncoder) Encode(event model.OrderRefundedEvent) ([]byte, error) {
+	pbEvent := &events_v1.OrderRefundedEvent{
+		EventUuid:       event.EventUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		TransactionUuid: event.TransactionUUID,
+		RefundUuid:      event.RefundUUID,
+		AmountMinor:     converter.AmountToMinor(event.Amount),
+	}
+
+	return proto.Marshal(pbEvent)
+}
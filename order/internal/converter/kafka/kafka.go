@@ -1,17 +1,30 @@
 package kafka
 
 import (
-	"context"
-
 	"github.com/space-wanderer/microservices/order/internal/model"
 )
 
-// OrderPaidProducer интерфейс для отправки OrderPaidEvent
-type OrderPaidProducer interface {
-	ProduceOrderPaidEvent(ctx context.Context, event model.OrderPaidEvent) error
+// OrderPaidEncoder интерфейс для кодирования OrderPaidEvent
+type OrderPaidEncoder interface {
+	Encode(event model.OrderPaidEvent) ([]byte, error)
+}
+
+// OrderRefundedEncoder интерфейс для кодирования OrderRefundedEvent
+type OrderRefundedEncoder interface {
+	Encode(event model.OrderRefundedEvent) ([]byte, error)
+}
+
+// OrderExpiredEncoder интерфейс для кодирования OrderExpiredEvent
+type OrderExpiredEncoder interface {
+	Encode(event model.OrderExpiredEvent) ([]byte, error)
 }
 
 // ShipAssembledDecoder интерфейс для декодирования ShipAssembledEvent
 type ShipAssembledDecoder interface {
 	Decode(data []byte) model.ShipAssembledEvent
 }
+
+// ShipAssemblyFailedDecoder интерфейс для декодирования ShipAssemblyFailedEvent
+type ShipAssemblyFailedDecoder interface {
+	Decode(data []byte) model.ShipAssemblyFailedEvent
+}
@@ -0,0 +1,116 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	order_v1 "github.com/space-wanderer/microservices/shared/pkg/api/order/v1"
+)
+
+// ConvertListOrdersParamsToModel конвертирует параметры запроса списка заказов пользователя userUUID в фильтр и страницу
+func ConvertListOrdersParamsToModel(userUUID string, params order_v1.ListOrdersParams) (*model.OrdersFilter, model.OrdersPageRequest) {
+	filter := &model.OrdersFilter{UserUUID: userUUID}
+
+	for _, status := range params.Status {
+		filter.Statuses = append(filter.Statuses, convertOrderStatusToModelStatus(status))
+	}
+
+	for _, method := range params.PaymentMethod {
+		filter.PaymentMethods = append(filter.PaymentMethods, convertOrderPaymentMethodToModelPaymentMethod(method))
+	}
+
+	// created_at хранится без часового пояса в UTC, поэтому границы приводятся к UTC
+	if createdFrom, ok := params.CreatedFrom.Get(); ok {
+		createdFrom = createdFrom.UTC()
+		filter.CreatedFrom = &createdFrom
+	}
+
+	if createdTo, ok := params.CreatedTo.Get(); ok {
+		createdTo = createdTo.UTC()
+		filter.CreatedTo = &createdTo
+	}
+
+	page := model.OrdersPageRequest{
+		Size:       params.PageSize.Or(0),
+		Token:      params.PageToken.Or(""),
+		OrderBy:    model.OrdersOrderByCreatedAt,
+		Descending: params.Descending.Or(false),
+	}
+
+	if params.OrderBy.Or(order_v1.OrdersOrderByCREATEDAT) == order_v1.OrdersOrderByTOTALPRICE {
+		page.OrderBy = model.OrdersOrderByTotalPrice
+	}
+
+	return filter, page
+}
+
+// ConvertModelOrdersFilterToRepoOrdersFilter конвертирует OrdersFilter из service model в repository model
+func ConvertModelOrdersFilterToRepoOrdersFilter(modelFilter *model.OrdersFilter) *repoModel.OrdersFilter {
+	if modelFilter == nil {
+		return nil
+	}
+
+	repoFilter := &repoModel.OrdersFilter{
+		UserUUID:    modelFilter.UserUUID,
+		CreatedFrom: modelFilter.CreatedFrom,
+		CreatedTo:   modelFilter.CreatedTo,
+	}
+
+	for _, status := range modelFilter.Statuses {
+		repoFilter.Statuses = append(repoFilter.Statuses, convertModelStatusToRepoStatus(status))
+	}
+
+	for _, method := range modelFilter.PaymentMethods {
+		repoFilter.PaymentMethods = append(repoFilter.PaymentMethods, convertModelPaymentMethodToRepoPaymentMethod(method))
+	}
+
+	return repoFilter
+}
+
+// ConvertRepoOrdersToModelOrders конвертирует список заказов из repository model в service model
+func ConvertRepoOrdersToModelOrders(repoOrders []*repoModel.Order) []model.Order {
+	orders := make([]model.Order, 0, len(repoOrders))
+	for _, repoOrder := range repoOrders {
+		if order := ConvertRepoOrderToModelOrder(repoOrder); order != nil {
+			orders = append(orders, *order)
+		}
+	}
+	return orders
+}
+
+// convertOrderStatusToModelStatus конвертирует статус order API в service model
+func convertOrderStatusToModelStatus(status order_v1.OrderStatus) model.Status {
+	switch status {
+	case order_v1.OrderStatusPAID:
+		return model.StatusPaid
+	case order_v1.OrderStatusCANCELLED:
+		return model.StatusCanceled
+	case order_v1.OrderStatusASSEMBLED:
+		return model.StatusAssembled
+	case order_v1.OrderStatusREFUNDPENDING:
+		return model.StatusRefundPending
+	case order_v1.OrderStatusREFUNDED:
+		return model.StatusRefunded
+	case order_v1.OrderStatusEXPIRED:
+		return model.StatusExpired
+	case order_v1.OrderStatusASSEMBLYFAILED:
+		return model.StatusAssemblyFailed
+	default:
+		return model.StatusPendingPayment
+	}
+}
+
+// convertOrderPaymentMethodToModelPaymentMethod конвертирует способ оплаты order API в service model
+func convertOrderPaymentMethodToModelPaymentMethod(method order_v1.PaymentMethod) model.PaymentMethod {
+	switch method {
+	case order_v1.PaymentMethodCARD:
+		return model.PaymentMethodCard
+	case order_v1.PaymentMethodSBP:
+		return model.PaymentMethodSBP
+	case order_v1.PaymentMethodCREDITCARD:
+		return model.PaymentMethodCreditCard
+	case order_v1.PaymentMethodINVESTORMONEY:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnknown
+	}
+}
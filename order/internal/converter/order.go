@@ -1,8 +1,6 @@
 package converter
 
 import (
-	"fmt"
-
 	"github.com/google/uuid"
 
 	"github.com/space-wanderer/microservices/order/internal/model"
@@ -20,10 +18,12 @@ func ConvertRepoOrderToModelOrder(repoOrder *repoModel.Order) *model.Order {
 		OrderUUID:       repoOrder.OrderUUID,
 		UserUUID:        repoOrder.UserUUID,
 		PartUuids:       repoOrder.PartUuids,
+		Items:           convertRepoOrderItemsToModel(repoOrder.Items),
 		TotalPrice:      repoOrder.TotalPrice,
 		TransactionUUID: repoOrder.TransactionUUID,
 		PaymentMethod:   convertRepoPaymentMethodToModelPaymentMethod(repoOrder.PaymentMethod),
 		Status:          convertRepoStatusToModelStatus(repoOrder.Status),
+		CreatedAt:       repoOrder.CreatedAt,
 	}
 }
 
@@ -37,10 +37,12 @@ func ConvertModelOrderToRepoOrder(modelOrder *model.Order) *repoModel.Order {
 		OrderUUID:       modelOrder.OrderUUID,
 		UserUUID:        modelOrder.UserUUID,
 		PartUuids:       modelOrder.PartUuids,
+		Items:           convertModelOrderItemsToRepo(modelOrder.Items),
 		TotalPrice:      modelOrder.TotalPrice,
 		TransactionUUID: modelOrder.TransactionUUID,
 		PaymentMethod:   convertModelPaymentMethodToRepoPaymentMethod(modelOrder.PaymentMethod),
 		Status:          convertModelStatusToRepoStatus(modelOrder.Status),
+		CreatedAt:       modelOrder.CreatedAt,
 	}
 }
 
@@ -50,7 +52,8 @@ func ConvertModelOrderToOrderDto(modelOrder *model.Order) order_v1.OrderDto {
 		OrderUUID:  uuid.MustParse(modelOrder.OrderUUID),
 		UserUUID:   uuid.MustParse(modelOrder.UserUUID),
 		PartUuids:  convertStringSliceToUUIDSlice(modelOrder.PartUuids),
-		TotalPrice: modelOrder.TotalPrice,
+		Items:      convertModelOrderItemsToOrderItemDtos(modelOrder.Items),
+		TotalPrice: modelOrder.TotalPrice.InexactFloat64(),
 		Status:     convertModelStatusToOrderStatus(modelOrder.Status),
 	}
 
@@ -63,19 +66,61 @@ func ConvertModelOrderToOrderDto(modelOrder *model.Order) order_v1.OrderDto {
 		orderDto.PaymentMethod = convertModelPaymentMethodToOrderPaymentMethod(modelOrder.PaymentMethod)
 	}
 
+	if !modelOrder.CreatedAt.IsZero() {
+		orderDto.CreatedAt = order_v1.NewOptDateTime(modelOrder.CreatedAt)
+	}
+
 	return orderDto
 }
 
-// ConvertCreateOrderRequestToModelOrder конвертирует CreateOrderRequest в service model
-func ConvertCreateOrderRequestToModelOrder(req *order_v1.CreateOrderRequest) *model.Order {
+// ConvertCreateOrderRequestToModelOrder конвертирует CreateOrderRequest пользователя userUUID в service model
+func ConvertCreateOrderRequestToModelOrder(userUUID string, req *order_v1.CreateOrderRequest) *model.Order {
 	return &model.Order{
-		UserUUID:      req.UserUUID.String(),
-		PartUuids:     convertUUIDSliceToStringSlice(req.PartUuids),
+		UserUUID:      userUUID,
+		Items:         convertCreateOrderItemsToModel(req),
 		Status:        model.StatusPendingPayment,
 		PaymentMethod: model.PaymentMethodUnknown, // Устанавливаем по умолчанию
 	}
 }
 
+// convertCreateOrderItemsToModel собирает позиции из items и устаревшего part_uuids, где каждый UUID — одна деталь
+func convertCreateOrderItemsToModel(req *order_v1.CreateOrderRequest) []model.OrderItem {
+	items := make([]model.OrderItem, 0, len(req.Items)+len(req.PartUuids))
+	for _, item := range req.Items {
+		items = append(items, model.OrderItem{
+			PartUUID: item.PartUUID.String(),
+			Quantity: item.Quantity,
+		})
+	}
+	for _, partUUID := range req.PartUuids {
+		items = append(items, model.OrderItem{
+			PartUUID: partUUID.String(),
+			Quantity: 1,
+		})
+	}
+	return items
+}
+
+// convertModelOrderItemsToOrderItemDtos конвертирует позиции заказа из service model в API DTO
+func convertModelOrderItemsToOrderItemDtos(items []model.OrderItem) []order_v1.OrderItemDto {
+	if items == nil {
+		return nil
+	}
+
+	dtos := make([]order_v1.OrderItemDto, len(items))
+	for i, item := range items {
+		dtos[i] = order_v1.OrderItemDto{
+			PartUUID: uuid.MustParse(item.PartUUID),
+			Quantity: item.Quantity,
+			PartName: item.PartName,
+		}
+		if item.UnitPrice != nil {
+			dtos[i].UnitPrice = order_v1.NewOptFloat64(item.UnitPrice.InexactFloat64())
+		}
+	}
+	return dtos
+}
+
 // convertRepoPaymentMethodToModelPaymentMethod конвертирует PaymentMethod из repository в service model
 func convertRepoPaymentMethodToModelPaymentMethod(repoMethod repoModel.PaymentMethod) model.PaymentMethod {
 	switch repoMethod {
@@ -92,6 +137,42 @@ func convertRepoPaymentMethodToModelPaymentMethod(repoMethod repoModel.PaymentMe
 	}
 }
 
+// convertModelOrderItemsToRepo конвертирует позиции заказа из service в repository model
+func convertModelOrderItemsToRepo(items []model.OrderItem) []repoModel.OrderItem {
+	if items == nil {
+		return nil
+	}
+
+	repoItems := make([]repoModel.OrderItem, len(items))
+	for i, item := range items {
+		repoItems[i] = repoModel.OrderItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			PartName:  item.PartName,
+		}
+	}
+	return repoItems
+}
+
+// convertRepoOrderItemsToModel конвертирует позиции заказа из repository в service model
+func convertRepoOrderItemsToModel(repoItems []repoModel.OrderItem) []model.OrderItem {
+	if repoItems == nil {
+		return nil
+	}
+
+	items := make([]model.OrderItem, len(repoItems))
+	for i, item := range repoItems {
+		items[i] = model.OrderItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			PartName:  item.PartName,
+		}
+	}
+	return items
+}
+
 // convertModelPaymentMethodToRepoPaymentMethod конвертирует PaymentMethod из service в repository model
 func convertModelPaymentMethodToRepoPaymentMethod(modelMethod model.PaymentMethod) repoModel.PaymentMethod {
 	switch modelMethod {
@@ -119,6 +200,14 @@ func convertRepoStatusToModelStatus(repoStatus repoModel.Status) model.Status {
 		return model.StatusCanceled
 	case repoModel.StatusAssembled:
 		return model.StatusAssembled
+	case repoModel.StatusRefundPending:
+		return model.StatusRefundPending
+	case repoModel.StatusRefunded:
+		return model.StatusRefunded
+	case repoModel.StatusExpired:
+		return model.StatusExpired
+	case repoModel.StatusAssemblyFailed:
+		return model.StatusAssemblyFailed
 	default:
 		return model.StatusPendingPayment
 	}
@@ -135,6 +224,14 @@ func convertModelStatusToRepoStatus(modelStatus model.Status) repoModel.Status {
 		return repoModel.StatusCanceled
 	case model.StatusAssembled:
 		return repoModel.StatusAssembled
+	case model.StatusRefundPending:
+		return repoModel.StatusRefundPending
+	case model.StatusRefunded:
+		return repoModel.StatusRefunded
+	case model.StatusExpired:
+		return repoModel.StatusExpired
+	case model.StatusAssemblyFailed:
+		return repoModel.StatusAssemblyFailed
 	default:
 		return repoModel.StatusPendingPayment
 	}
@@ -151,6 +248,14 @@ func convertModelStatusToOrderStatus(modelStatus model.Status) order_v1.OrderSta
 		return order_v1.OrderStatusCANCELLED
 	case model.StatusAssembled:
 		return order_v1.OrderStatusASSEMBLED
+	case model.StatusRefundPending:
+		return order_v1.OrderStatusREFUNDPENDING
+	case model.StatusRefunded:
+		return order_v1.OrderStatusREFUNDED
+	case model.StatusExpired:
+		return order_v1.OrderStatusEXPIRED
+	case model.StatusAssemblyFailed:
+		return order_v1.OrderStatusASSEMBLYFAILED
 	default:
 		return order_v1.OrderStatusPENDINGPAYMENT
 	}
@@ -184,33 +289,3 @@ func convertStringSliceToUUIDSlice(stringSlice []string) []uuid.UUID {
 	}
 	return uuidSlice
 }
-
-// convertUUIDSliceToStringSlice конвертирует []uuid.UUID в []string
-func convertUUIDSliceToStringSlice(uuidSlice []uuid.UUID) []string {
-	if uuidSlice == nil {
-		return nil
-	}
-
-	stringSlice := make([]string, len(uuidSlice))
-	for i, u := range uuidSlice {
-		stringSlice[i] = u.String()
-	}
-	return stringSlice
-}
-
-// ConvertStringSliceToUUIDSlice конвертирует []string в []uuid.UUID
-func ConvertStringSliceToUUIDSlice(stringSlice []string) ([]uuid.UUID, error) {
-	if stringSlice == nil {
-		return nil, nil
-	}
-
-	uuidSlice := make([]uuid.UUID, len(stringSlice))
-	for i, str := range stringSlice {
-		parsedUUID, err := uuid.Parse(str)
-		if err != nil {
-			return nil, fmt.Errorf("invalid UUID at index %d: %s", i, str)
-		}
-		uuidSlice[i] = parsedUUID
-	}
-	return uuidSlice, nil
-}
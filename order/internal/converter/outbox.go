@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// ConvertOrderPaidEventToOutboxEvent конвертирует закодированный OrderPaidEvent в событие outbox
+func ConvertOrderPaidEventToOutboxEvent(event model.OrderPaidEvent, payload []byte) *repoModel.OutboxEvent {
+	return &repoModel.OutboxEvent{
+		EventUUID:     event.EventUUID,
+		AggregateUUID: event.OrderUUID,
+		EventType:     repoModel.OutboxEventTypeOrderPaid,
+		Payload:       payload,
+	}
+}
+
+// ConvertOrderRefundedEventToOutboxEvent конвертирует закодированный OrderRefundedEvent в событие outbox
+func ConvertOrderRefundedEventToOutboxEvent(event model.OrderRefundedEvent, payload []byte) *repoModel.OutboxEvent {
+	return &repoModel.OutboxEvent{
+		EventUUID:     event.EventUUID,
+		AggregateUUID: event.OrderUUID,
+		EventType:     repoModel.OutboxEventTypeOrderRefunded,
+		Payload:       payload,
+	}
+}
+
+// ConvertOrderExpiredEventToOutboxEvent конвертирует закодированный OrderExpiredEvent в событие outbox
+func ConvertOrderExpiredEventToOutboxEvent(event model.OrderExpiredEvent, payload []byte) *repoModel.OutboxEvent {
+	return &repoModel.OutboxEvent{
+		EventUUID:     event.EventUUID,
+		AggregateUUID: event.OrderUUID,
+		EventType:     repoModel.OutboxEventTypeOrderExpired,
+		Payload:       payload,
+	}
+}
+
+// ConvertCommitReservationToOutboxEvent создает задачу outbox подтвердить резерв деталей заказа.
+// Задача выполняется relay-ем с повторами, пока InventoryService ее не примет
+func ConvertCommitReservationToOutboxEvent(eventUUID, orderUUID string) *repoModel.OutboxEvent {
+	return &repoModel.OutboxEvent{
+		EventUUID:     eventUUID,
+		AggregateUUID: orderUUID,
+		EventType:     repoModel.OutboxEventTypeCommitReservation,
+		Payload:       []byte(orderUUID),
+	}
+}
+
+// ConvertReleaseReservationToOutboxEvent создает задачу outbox вернуть детали отмененного заказа на склад.
+// Задача выполняется relay-ем с повторами после предыдущих событий заказа, в том числе после подтверждения резерва
+func ConvertReleaseReservationToOutboxEvent(eventUUID, orderUUID string) *repoModel.OutboxEvent {
+	return &repoModel.OutboxEvent{
+		EventUUID:     eventUUID,
+		AggregateUUID: orderUUID,
+		EventType:     repoModel.OutboxEventTypeReleaseReservation,
+		Payload:       []byte(orderUUID),
+	}
+}
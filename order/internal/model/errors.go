@@ -7,8 +7,28 @@ import (
 )
 
 var (
-	ErrOrderNotFound          = sharedErrors.NewNotFoundError(errors.New("order not found"))
-	ErrOrderAlreadyPaid       = sharedErrors.NewInvalidArgumentError(errors.New("order already paid"))
-	ErrOrderCannotBeCancelled = sharedErrors.NewInvalidArgumentError(errors.New("order cannot be cancelled"))
-	ErrInvalidOrderUUID       = sharedErrors.NewInvalidArgumentError(errors.New("invalid order uuid"))
+	ErrOrderNotFound           = sharedErrors.NewNotFoundError(errors.New("order not found"))
+	ErrPartsNotFound           = sharedErrors.NewNotFoundError(errors.New("parts not found"))
+	ErrInvalidOrderItems       = sharedErrors.NewInvalidArgumentError(errors.New("order must contain items with positive quantity"))
+	ErrOrderTotalTooLarge      = sharedErrors.NewInvalidArgumentError(errors.New("order total price is too large"))
+	ErrOrderAlreadyPaid        = sharedErrors.NewInvalidArgumentError(errors.New("order already paid"))
+	ErrOrderExpired            = sharedErrors.NewFailedPreconditionError(errors.New("order payment window expired"))
+	ErrOrderCannotBeCancelled  = sharedErrors.NewInvalidArgumentError(errors.New("order cannot be cancelled"))
+	ErrInvalidOrderUUID        = sharedErrors.NewInvalidArgumentError(errors.New("invalid order uuid"))
+	ErrInsufficientStock       = sharedErrors.NewFailedPreconditionError(errors.New("insufficient stock"))
+	ErrReservationNotFound     = sharedErrors.NewNotFoundError(errors.New("reservation not found"))
+	ErrReservationReleased     = sharedErrors.NewFailedPreconditionError(errors.New("reservation already released"))
+	ErrPaymentDeclined         = sharedErrors.NewPaymentDeclinedError(errors.New("payment rejected"))
+	ErrPaymentMethodNotAllowed = sharedErrors.NewPermissionDeniedError(errors.New("payment method not allowed"))
+	ErrInvalidPaymentRequest   = sharedErrors.NewInvalidArgumentError(errors.New("invalid payment request"))
+	ErrPaymentUnavailable      = sharedErrors.NewUnavailableError(errors.New("payment service unavailable"))
+	ErrInvalidPageSize         = sharedErrors.NewInvalidArgumentError(errors.New("invalid page size"))
+	ErrInvalidPageToken        = sharedErrors.NewInvalidArgumentError(errors.New("invalid page token"))
+	ErrInvalidCreatedAtRange   = sharedErrors.NewInvalidArgumentError(errors.New("created_from must be before created_to"))
+	ErrRefundRejected          = sharedErrors.NewFailedPreconditionError(errors.New("refund rejected"))
+	ErrIdempotencyKeyConflict  = sharedErrors.NewInvalidArgumentError(errors.New("idempotency key reused with different request"))
+	ErrIdempotencyKeyInUse     = sharedErrors.NewFailedPreconditionError(errors.New("request with this idempotency key is in progress"))
+	ErrInvalidStatusTransition = sharedErrors.NewFailedPreconditionError(errors.New("invalid order status transition"))
+	ErrUnauthenticated         = sharedErrors.NewUnauthenticatedError(errors.New("authentication required"))
+	ErrOrderAccessDenied       = sharedErrors.NewPermissionDeniedError(errors.New("order belongs to another user"))
 )
@@ -1,11 +1,30 @@
 package model
 
+import "github.com/shopspring/decimal"
+
 type OrderPaidEvent struct {
 	EventUUID       string
 	OrderUUID       string
 	UserUUID        string
 	PaymentMethod   string
 	TransactionUUID string
+	Items           []OrderItem
+}
+
+type OrderRefundedEvent struct {
+	EventUUID       string
+	OrderUUID       string
+	UserUUID        string
+	TransactionUUID string
+	RefundUUID      string
+	Amount          decimal.Decimal
+}
+
+type OrderExpiredEvent struct {
+	EventUUID        string
+	OrderUUID        string
+	UserUUID         string
+	PaymentWindowSec int64
 }
 
 type ShipAssembledEvent struct {
@@ -14,3 +33,11 @@ type ShipAssembledEvent struct {
 	UserUUID     string
 	BuildTimeSec int
 }
+
+type ShipAssemblyFailedEvent struct {
+	EventUUID string
+	OrderUUID string
+	UserUUID  string
+	Stage     string
+	Reason    string
+}
@@ -0,0 +1,20 @@
+package model
+
+// IdempotentOperation - операция, повтор которой распознается по ключу идемпотентности
+type IdempotentOperation string
+
+const (
+	IdempotentOperationCreateOrder IdempotentOperation = "CREATE_ORDER"
+	IdempotentOperationPayOrder    IdempotentOperation = "PAY_ORDER"
+)
+
+// IdempotencyRecord - сохраненный запрос с ключом идемпотентности. Ключ действует
+// в пределах пользователя и операции. Response заполнен только после успешного выполнения запроса
+type IdempotencyRecord struct {
+	UserUUID    string
+	Operation   IdempotentOperation
+	Key         string
+	RequestHash string
+	Response    []byte
+	Completed   bool
+}
@@ -0,0 +1,18 @@
+package model
+
+// OrdersOrderBy - поле сортировки списка заказов
+type OrdersOrderBy string
+
+const (
+	OrdersOrderByCreatedAt  OrdersOrderBy = "CREATED_AT"
+	OrdersOrderByTotalPrice OrdersOrderBy = "TOTAL_PRICE"
+)
+
+// OrdersPageRequest - параметры страницы списка заказов.
+// Token - непрозрачный токен из предыдущего ответа, пустой для первой страницы
+type OrdersPageRequest struct {
+	Size       int32
+	Token      string
+	OrderBy    OrdersOrderBy
+	Descending bool
+}
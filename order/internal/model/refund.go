@@ -0,0 +1,10 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// Refund - возврат средств, проведенный PaymentService
+type Refund struct {
+	RefundUUID      string
+	TransactionUUID string
+	Amount          decimal.Decimal
+}
@@ -0,0 +1,6 @@
+package model
+
+type ReservationItem struct {
+	PartUUID string
+	Quantity int64
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Actor - инициатор смены статуса заказа
+type Actor string
+
+const (
+	// ActorAssembly - сборка корабля, о которой сообщает событие ShipAssembled
+	ActorAssembly Actor = "assembly"
+	// ActorSystem - внутренние процессы сервиса заказов
+	ActorSystem Actor = "system"
+)
+
+// UserActor возвращает инициатора для действия пользователя
+func UserActor(userUUID string) Actor {
+	return Actor("user:" + userUUID)
+}
+
+// StatusChange - запись истории статусов заказа
+type StatusChange struct {
+	OrderUUID string
+	// From пустой для записи о создании заказа
+	From      Status
+	To        Status
+	Actor     Actor
+	Reason    string
+	CreatedAt time.Time
+}
+
+// orderTransitions - допустимые переходы между статусами заказа.
+// Статусы, которых нет среди ключей, конечные
+var orderTransitions = map[Status][]Status{
+	StatusPendingPayment: {StatusPaid, StatusCanceled, StatusExpired},
+	StatusPaid:           {StatusAssembled, StatusRefundPending, StatusAssemblyFailed},
+	StatusAssemblyFailed: {StatusRefundPending},
+	StatusRefundPending:  {StatusRefunded},
+}
+
+// StatusTransitionError - попытка перевести заказ в статус, недопустимый из текущего
+type StatusTransitionError struct {
+	From Status
+	To   Status
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("invalid order status transition %s -> %s", e.From, e.To)
+}
+
+func (e *StatusTransitionError) Unwrap() error {
+	return ErrInvalidStatusTransition
+}
+
+// ValidateTransition проверяет переход заказа из статуса from в статус to
+// и возвращает *StatusTransitionError, если таблица переходов его не допускает
+func ValidateTransition(from, to Status) error {
+	for _, allowed := range orderTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return &StatusTransitionError{From: from, To: to}
+}
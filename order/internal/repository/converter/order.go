@@ -15,10 +15,12 @@ func ConvertModelOrderToRepoOrder(modelOrder *model.Order) *repoModel.Order {
 		OrderUUID:       modelOrder.OrderUUID,
 		UserUUID:        modelOrder.UserUUID,
 		PartUuids:       modelOrder.PartUuids,
+		Items:           convertModelOrderItemsToRepo(modelOrder.Items),
 		TotalPrice:      modelOrder.TotalPrice,
 		TransactionUUID: modelOrder.TransactionUUID,
 		PaymentMethod:   convertModelPaymentMethodToRepoPaymentMethod(modelOrder.PaymentMethod),
 		Status:          convertModelStatusToRepoStatus(modelOrder.Status),
+		CreatedAt:       modelOrder.CreatedAt,
 	}
 }
 
@@ -32,13 +34,51 @@ func ConvertRepoOrderToModelOrder(repoOrder *repoModel.Order) *model.Order {
 		OrderUUID:       repoOrder.OrderUUID,
 		UserUUID:        repoOrder.UserUUID,
 		PartUuids:       repoOrder.PartUuids,
+		Items:           convertRepoOrderItemsToModel(repoOrder.Items),
 		TotalPrice:      repoOrder.TotalPrice,
 		TransactionUUID: repoOrder.TransactionUUID,
 		PaymentMethod:   convertRepoPaymentMethodToModelPaymentMethod(repoOrder.PaymentMethod),
 		Status:          convertRepoStatusToModelStatus(repoOrder.Status),
+		CreatedAt:       repoOrder.CreatedAt,
 	}
 }
 
+// convertModelOrderItemsToRepo конвертирует позиции заказа из service в repository model
+func convertModelOrderItemsToRepo(items []model.OrderItem) []repoModel.OrderItem {
+	if items == nil {
+		return nil
+	}
+
+	repoItems := make([]repoModel.OrderItem, len(items))
+	for i, item := range items {
+		repoItems[i] = repoModel.OrderItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			PartName:  item.PartName,
+		}
+	}
+	return repoItems
+}
+
+// convertRepoOrderItemsToModel конвертирует позиции заказа из repository в service model
+func convertRepoOrderItemsToModel(repoItems []repoModel.OrderItem) []model.OrderItem {
+	if repoItems == nil {
+		return nil
+	}
+
+	items := make([]model.OrderItem, len(repoItems))
+	for i, item := range repoItems {
+		items[i] = model.OrderItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			PartName:  item.PartName,
+		}
+	}
+	return items
+}
+
 // convertModelPaymentMethodToRepoPaymentMethod конвертирует PaymentMethod из service в repository model
 func convertModelPaymentMethodToRepoPaymentMethod(modelMethod model.PaymentMethod) repoModel.PaymentMethod {
 	switch modelMethod {
@@ -0,0 +1,61 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// AcquireIdempotencyKey захватывает ключ пользователя для выполнения запроса. Ключ, захваченный раньше,
+// переходит к новому запросу, только если тот же запрос не завершился за lockTTL,
+// например из-за падения сервиса. Если ключ занят, возвращает сохраненную запись и false
+func (r *repository) AcquireIdempotencyKey(ctx context.Context, record *model.IdempotencyRecord, lockTTL time.Duration) (*model.IdempotencyRecord, bool, error) {
+	var operation string
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO idempotency_keys (user_uuid, operation, idempotency_key, request_hash, locked_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (user_uuid, operation, idempotency_key) DO UPDATE SET locked_at = NOW()
+		WHERE idempotency_keys.completed_at IS NULL
+			AND idempotency_keys.request_hash = EXCLUDED.request_hash
+			AND idempotency_keys.locked_at < NOW() - make_interval(secs => $5)
+		RETURNING operation
+	`, record.UserUUID, record.Operation, record.Key, record.RequestHash, lockTTL.Seconds()).Scan(&operation)
+	if err == nil {
+		return nil, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+
+	var stored repoModel.IdempotencyRecord
+	err = r.db.QueryRow(ctx, `
+		SELECT user_uuid, operation, idempotency_key, request_hash, response, locked_at, completed_at
+		FROM idempotency_keys
+		WHERE user_uuid = $1 AND operation = $2 AND idempotency_key = $3
+	`, record.UserUUID, record.Operation, record.Key).Scan(
+		&stored.UserUUID,
+		&stored.Operation,
+		&stored.Key,
+		&stored.RequestHash,
+		&stored.Response,
+		&stored.LockedAt,
+		&stored.CompletedAt,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &model.IdempotencyRecord{
+		UserUUID:    stored.UserUUID,
+		Operation:   model.IdempotentOperation(stored.Operation),
+		Key:         stored.Key,
+		RequestHash: stored.RequestHash,
+		Response:    stored.Response,
+		Completed:   stored.CompletedAt != nil,
+	}, false, nil
+}
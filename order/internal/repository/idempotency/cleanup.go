@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// DeleteExpiredIdempotencyKeys удаляет не больше limit ключей, созданных раньше createdBefore,
+// и возвращает количество удаленных. Строки, занятые другим экземпляром, пропускаются
+func (r *repository) DeleteExpiredIdempotencyKeys(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	tag, err := r.db.Exec(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE (user_uuid, operation, idempotency_key) IN (
+			SELECT user_uuid, operation, idempotency_key
+			FROM idempotency_keys
+			WHERE created_at < $1
+			ORDER BY created_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+	`, createdBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+)
+
+// CompleteIdempotencyKey сохраняет ответ успешно выполненного запроса
+func (r *repository) CompleteIdempotencyKey(ctx context.Context, userUUID string, operation model.IdempotentOperation, key string, response []byte) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE idempotency_keys
+		SET response = $4, completed_at = NOW()
+		WHERE user_uuid = $1 AND operation = $2 AND idempotency_key = $3
+	`, userUUID, operation, key, response)
+	return err
+}
+
+// ReleaseIdempotencyKey освобождает ключ незавершенного запроса, чтобы его можно было повторить
+func (r *repository) ReleaseIdempotencyKey(ctx context.Context, userUUID string, operation model.IdempotentOperation, key string) error {
+	_, err := r.db.Exec(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE user_uuid = $1 AND operation = $2 AND idempotency_key = $3 AND completed_at IS NULL
+	`, userUUID, operation, key)
+	return err
+}
@@ -0,0 +1,11 @@
+package idempotency
+
+import "github.com/jackc/pgx/v5/pgxpool"
+
+type repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *repository {
+	return &repository{db: db}
+}
@@ -5,8 +5,10 @@ package mocks
 import (
 	context "context"
 
+	repository "github.com/space-wanderer/microservices/order/internal/repository"
 	model "github.com/space-wanderer/microservices/order/internal/repository/model"
 	mock "github.com/stretchr/testify/mock"
+	time "time"
 )
 
 // OrderRepository is an autogenerated mock type for the OrderRepository type
@@ -22,9 +24,9 @@ func (_m *OrderRepository) EXPECT() *OrderRepository_Expecter {
 	return &OrderRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateOrder provides a mock function with given fields: ctx, order
-func (_m *OrderRepository) CreateOrder(ctx context.Context, order *model.Order) (string, error) {
-	ret := _m.Called(ctx, order)
+// CreateOrder provides a mock function with given fields: ctx, order, transition
+func (_m *OrderRepository) CreateOrder(ctx context.Context, order *model.Order, transition *model.StatusTransition) (string, error) {
+	ret := _m.Called(ctx, order, transition)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -32,17 +34,17 @@ func (_m *OrderRepository) CreateOrder(ctx context.Context, order *model.Order)
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Order) (string, error)); ok {
-		return rf(ctx, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.StatusTransition) (string, error)); ok {
+		return rf(ctx, order, transition)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Order) string); ok {
-		r0 = rf(ctx, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.StatusTransition) string); ok {
+		r0 = rf(ctx, order, transition)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.Order) error); ok {
-		r1 = rf(ctx, order)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Order, *model.StatusTransition) error); ok {
+		r1 = rf(ctx, order, transition)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +60,14 @@ type OrderRepository_CreateOrder_Call struct {
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - order *model.Order
-func (_e *OrderRepository_Expecter) CreateOrder(ctx interface{}, order interface{}) *OrderRepository_CreateOrder_Call {
-	return &OrderRepository_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, order)}
+//   - transition *model.StatusTransition
+func (_e *OrderRepository_Expecter) CreateOrder(ctx interface{}, order interface{}, transition interface{}) *OrderRepository_CreateOrder_Call {
+	return &OrderRepository_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, order, transition)}
 }
 
-func (_c *OrderRepository_CreateOrder_Call) Run(run func(ctx context.Context, order *model.Order)) *OrderRepository_CreateOrder_Call {
+func (_c *OrderRepository_CreateOrder_Call) Run(run func(ctx context.Context, order *model.Order, transition *model.StatusTransition)) *OrderRepository_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Order))
+		run(args[0].(context.Context), args[1].(*model.Order), args[2].(*model.StatusTransition))
 	})
 	return _c
 }
@@ -74,7 +77,66 @@ func (_c *OrderRepository_CreateOrder_Call) Return(_a0 string, _a1 error) *Order
 	return _c
 }
 
-func (_c *OrderRepository_CreateOrder_Call) RunAndReturn(run func(context.Context, *model.Order) (string, error)) *OrderRepository_CreateOrder_Call {
+func (_c *OrderRepository_CreateOrder_Call) RunAndReturn(run func(context.Context, *model.Order, *model.StatusTransition) (string, error)) *OrderRepository_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireOrders provides a mock function with given fields: ctx, createdBefore, limit, expirer
+func (_m *OrderRepository) ExpireOrders(ctx context.Context, createdBefore time.Time, limit int, expirer repository.OrderExpirer) (int, error) {
+	ret := _m.Called(ctx, createdBefore, limit, expirer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, repository.OrderExpirer) (int, error)); ok {
+		return rf(ctx, createdBefore, limit, expirer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, repository.OrderExpirer) int); ok {
+		r0 = rf(ctx, createdBefore, limit, expirer)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, repository.OrderExpirer) error); ok {
+		r1 = rf(ctx, createdBefore, limit, expirer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_ExpireOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireOrders'
+type OrderRepository_ExpireOrders_Call struct {
+	*mock.Call
+}
+
+// ExpireOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+//   - expirer repository.OrderExpirer
+func (_e *OrderRepository_Expecter) ExpireOrders(ctx interface{}, createdBefore interface{}, limit interface{}, expirer interface{}) *OrderRepository_ExpireOrders_Call {
+	return &OrderRepository_ExpireOrders_Call{Call: _e.mock.On("ExpireOrders", ctx, createdBefore, limit, expirer)}
+}
+
+func (_c *OrderRepository_ExpireOrders_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int, expirer repository.OrderExpirer)) *OrderRepository_ExpireOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int), args[3].(repository.OrderExpirer))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ExpireOrders_Call) Return(_a0 int, _a1 error) *OrderRepository_ExpireOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_ExpireOrders_Call) RunAndReturn(run func(context.Context, time.Time, int, repository.OrderExpirer) (int, error)) *OrderRepository_ExpireOrders_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -138,17 +200,143 @@ func (_c *OrderRepository_GetOrderByUuid_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// UpdateOrder provides a mock function with given fields: ctx, order
-func (_m *OrderRepository) UpdateOrder(ctx context.Context, order *model.Order) error {
-	ret := _m.Called(ctx, order)
+// GetStatusHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderRepository) GetStatusHistory(ctx context.Context, orderUUID string) ([]*model.StatusTransition, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusHistory")
+	}
+
+	var r0 []*model.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.StatusTransition, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.StatusTransition); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_GetStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusHistory'
+type OrderRepository_GetStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderRepository_Expecter) GetStatusHistory(ctx interface{}, orderUUID interface{}) *OrderRepository_GetStatusHistory_Call {
+	return &OrderRepository_GetStatusHistory_Call{Call: _e.mock.On("GetStatusHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) Return(_a0 []*model.StatusTransition, _a1 error) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) RunAndReturn(run func(context.Context, string) ([]*model.StatusTransition, error)) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter, page
+func (_m *OrderRepository) ListOrders(ctx context.Context, filter *model.OrdersFilter, page *model.OrdersPage) ([]*model.Order, bool, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []*model.Order
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.OrdersFilter, *model.OrdersPage) ([]*model.Order, bool, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.OrdersFilter, *model.OrdersPage) []*model.Order); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.OrdersFilter, *model.OrdersPage) bool); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.OrdersFilter, *model.OrdersPage) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrderRepository_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderRepository_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.OrdersFilter
+//   - page *model.OrdersPage
+func (_e *OrderRepository_Expecter) ListOrders(ctx interface{}, filter interface{}, page interface{}) *OrderRepository_ListOrders_Call {
+	return &OrderRepository_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter, page)}
+}
+
+func (_c *OrderRepository_ListOrders_Call) Run(run func(ctx context.Context, filter *model.OrdersFilter, page *model.OrdersPage)) *OrderRepository_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.OrdersFilter), args[2].(*model.OrdersPage))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) Return(_a0 []*model.Order, _a1 bool, _a2 error) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) RunAndReturn(run func(context.Context, *model.OrdersFilter, *model.OrdersPage) ([]*model.Order, bool, error)) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrder provides a mock function with given fields: ctx, order, transition
+func (_m *OrderRepository) UpdateOrder(ctx context.Context, order *model.Order, transition *model.StatusTransition) error {
+	ret := _m.Called(ctx, order, transition)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateOrder")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Order) error); ok {
-		r0 = rf(ctx, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.StatusTransition) error); ok {
+		r0 = rf(ctx, order, transition)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -164,13 +352,14 @@ type OrderRepository_UpdateOrder_Call struct {
 // UpdateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - order *model.Order
-func (_e *OrderRepository_Expecter) UpdateOrder(ctx interface{}, order interface{}) *OrderRepository_UpdateOrder_Call {
-	return &OrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, order)}
+//   - transition *model.StatusTransition
+func (_e *OrderRepository_Expecter) UpdateOrder(ctx interface{}, order interface{}, transition interface{}) *OrderRepository_UpdateOrder_Call {
+	return &OrderRepository_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, order, transition)}
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, order *model.Order)) *OrderRepository_UpdateOrder_Call {
+func (_c *OrderRepository_UpdateOrder_Call) Run(run func(ctx context.Context, order *model.Order, transition *model.StatusTransition)) *OrderRepository_UpdateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Order))
+		run(args[0].(context.Context), args[1].(*model.Order), args[2].(*model.StatusTransition))
 	})
 	return _c
 }
@@ -180,7 +369,56 @@ func (_c *OrderRepository_UpdateOrder_Call) Return(_a0 error) *OrderRepository_U
 	return _c
 }
 
-func (_c *OrderRepository_UpdateOrder_Call) RunAndReturn(run func(context.Context, *model.Order) error) *OrderRepository_UpdateOrder_Call {
+func (_c *OrderRepository_UpdateOrder_Call) RunAndReturn(run func(context.Context, *model.Order, *model.StatusTransition) error) *OrderRepository_UpdateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrderWithOutbox provides a mock function with given fields: ctx, order, transition, events
+func (_m *OrderRepository) UpdateOrderWithOutbox(ctx context.Context, order *model.Order, transition *model.StatusTransition, events []*model.OutboxEvent) error {
+	ret := _m.Called(ctx, order, transition, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderWithOutbox")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, *model.StatusTransition, []*model.OutboxEvent) error); ok {
+		r0 = rf(ctx, order, transition, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_UpdateOrderWithOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderWithOutbox'
+type OrderRepository_UpdateOrderWithOutbox_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderWithOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - transition *model.StatusTransition
+//   - events []*model.OutboxEvent
+func (_e *OrderRepository_Expecter) UpdateOrderWithOutbox(ctx interface{}, order interface{}, transition interface{}, events interface{}) *OrderRepository_UpdateOrderWithOutbox_Call {
+	return &OrderRepository_UpdateOrderWithOutbox_Call{Call: _e.mock.On("UpdateOrderWithOutbox", ctx, order, transition, events)}
+}
+
+func (_c *OrderRepository_UpdateOrderWithOutbox_Call) Run(run func(ctx context.Context, order *model.Order, transition *model.StatusTransition, events []*model.OutboxEvent)) *OrderRepository_UpdateOrderWithOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Order), args[2].(*model.StatusTransition), args[3].([]*model.OutboxEvent))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_UpdateOrderWithOutbox_Call) Return(_a0 error) *OrderRepository_UpdateOrderWithOutbox_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_UpdateOrderWithOutbox_Call) RunAndReturn(run func(context.Context, *model.Order, *model.StatusTransition, []*model.OutboxEvent) error) *OrderRepository_UpdateOrderWithOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,11 @@
+package model
+
+import "errors"
+
+var (
+	// ErrOrderStatusChanged - статус заказа изменился между чтением и обновлением
+	ErrOrderStatusChanged = errors.New("order status changed concurrently")
+	// ErrOutboxEventRejected - событие outbox не может быть доставлено никогда. Оно снимается
+	// с отправки с пометкой rejected_at и не задерживает следующие события заказа
+	ErrOutboxEventRejected = errors.New("outbox event rejected")
+)
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type IdempotencyRecord struct {
+	UserUUID    string
+	Operation   string
+	Key         string
+	RequestHash string
+	Response    []byte
+	LockedAt    time.Time
+	CompletedAt *time.Time
+}
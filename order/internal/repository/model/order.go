@@ -1,13 +1,30 @@
 package model
 
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
 type Order struct {
 	OrderUUID       string
 	UserUUID        string
 	PartUuids       []string
-	TotalPrice      float32
+	Items           []OrderItem
+	TotalPrice      decimal.Decimal
 	TransactionUUID *string
 	PaymentMethod   PaymentMethod
 	Status          Status
+	CreatedAt       time.Time
+}
+
+// OrderItem - позиция заказа с ценой и названием детали на момент оформления
+type OrderItem struct {
+	PartUUID string
+	Quantity int64
+	// UnitPrice - nil у позиций, перенесенных из заказов без снимка цены
+	UnitPrice *decimal.Decimal
+	PartName  string
 }
 
 type PaymentMethod string
@@ -27,4 +44,33 @@ const (
 	StatusPaid           Status = "PAID"
 	StatusCanceled       Status = "CANCELED"
 	StatusAssembled      Status = "ASSEMBLED"
+	// StatusRefundPending - заказ отменен после оплаты, возврат средств еще не подтвержден
+	StatusRefundPending Status = "REFUND_PENDING"
+	StatusRefunded      Status = "REFUNDED"
+	// StatusExpired - заказ не оплачен в течение срока оплаты и снят автоматически
+	StatusExpired Status = "EXPIRED"
+	// StatusAssemblyFailed - сборка корабля не удалась, заказ можно отменить с возвратом средств
+	StatusAssemblyFailed Status = "ASSEMBLY_FAILED"
 )
+
+// OrdersFilter - фильтр списка заказов, пустые поля не ограничивают выборку
+type OrdersFilter struct {
+	UserUUID       string
+	Statuses       []Status
+	PaymentMethods []PaymentMethod
+	// CreatedFrom включается в диапазон, CreatedTo - нет
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// StatusTransition - смена статуса заказа, которую репозиторий применяет условно
+// и сохраняет в историю статусов в той же транзакции
+type StatusTransition struct {
+	OrderUUID string
+	// From - ожидаемый текущий статус заказа, пустой для создаваемого заказа
+	From      Status
+	To        Status
+	Actor     string
+	Reason    string
+	CreatedAt time.Time
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+type OutboxEvent struct {
+	ID            int64
+	EventUUID     string
+	AggregateUUID string
+	EventType     OutboxEventType
+	Payload       []byte
+	Attempts      int
+	TraceContext  map[string]string
+	CreatedAt     time.Time
+}
+
+type OutboxEventType string
+
+const (
+	OutboxEventTypeOrderPaid     OutboxEventType = "ORDER_PAID"
+	OutboxEventTypeOrderRefunded OutboxEventType = "ORDER_REFUNDED"
+	OutboxEventTypeOrderExpired  OutboxEventType = "ORDER_EXPIRED"
+	// OutboxEventTypeCommitReservation - задача подтвердить резерв деталей оплаченного заказа в InventoryService
+	OutboxEventTypeCommitReservation OutboxEventType = "COMMIT_RESERVATION"
+	// OutboxEventTypeReleaseReservation - задача вернуть детали отмененного заказа на склад в InventoryService
+	OutboxEventTypeReleaseReservation OutboxEventType = "RELEASE_RESERVATION"
+)
+
+type OutboxStats struct {
+	Pending int64
+	Lag     time.Duration
+}
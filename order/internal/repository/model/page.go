@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// OrdersOrderBy - колонка сортировки списка заказов
+type OrdersOrderBy string
+
+const (
+	OrdersOrderByCreatedAt  OrdersOrderBy = "created_at"
+	OrdersOrderByTotalPrice OrdersOrderBy = "total_price"
+)
+
+// OrdersPage - параметры keyset-страницы: выбираются заказы строго после курсора
+type OrdersPage struct {
+	Size       int64
+	OrderBy    OrdersOrderBy
+	Descending bool
+	After      *OrdersCursor
+}
+
+// OrdersCursor - ключ последнего заказа предыдущей страницы.
+// TotalPrice хранится десятичной строкой с точностью колонки total_price
+type OrdersCursor struct {
+	OrderUUID  string
+	CreatedAt  time.Time
+	TotalPrice string
+}
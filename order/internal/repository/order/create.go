@@ -8,7 +8,8 @@ import (
 	"github.com/space-wanderer/microservices/order/internal/repository/model"
 )
 
-func (r *repository) CreateOrder(ctx context.Context, req *model.Order) (string, error) {
+// CreateOrder сохраняет заказ вместе с позициями и первой записью истории статусов
+func (r *repository) CreateOrder(ctx context.Context, req *model.Order, transition *model.StatusTransition) (string, error) {
 	conn, err := r.db.Acquire(ctx)
 	if err != nil {
 		return "", err
@@ -26,7 +27,10 @@ func (r *repository) CreateOrder(ctx context.Context, req *model.Order) (string,
 		}
 	}()
 
-	orderUUID := uuid.New().String()
+	orderUUID := req.OrderUUID
+	if orderUUID == "" {
+		orderUUID = uuid.New().String()
+	}
 
 	_, err = tx.Exec(ctx, `
 		INSERT INTO orders (order_uuid, user_uuid, part_uuids, total_price, transaction_uuid, payment_method, status)
@@ -36,6 +40,17 @@ func (r *repository) CreateOrder(ctx context.Context, req *model.Order) (string,
 		return "", err
 	}
 
+	if err = insertOrderItemsTx(ctx, tx, orderUUID, req.Items); err != nil {
+		return "", err
+	}
+
+	if transition != nil {
+		transition.OrderUUID = orderUUID
+		if err = insertStatusHistoryTx(ctx, tx, transition); err != nil {
+			return "", err
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return "", err
 	}
@@ -0,0 +1,84 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	repo "github.com/space-wanderer/microservices/order/internal/repository"
+	"github.com/space-wanderer/microservices/order/internal/repository/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// ExpireOrders снимает пачку просроченных неоплаченных заказов в одной транзакции.
+// FOR UPDATE SKIP LOCKED позволяет нескольким экземплярам сервиса разбирать заказы
+// параллельно, не блокируя друг друга и не обрабатывая один заказ дважды
+func (r *repository) ExpireOrders(ctx context.Context, createdBefore time.Time, limit int, expirer repo.OrderExpirer) (int, error) {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			_ = rollbackErr
+		}
+	}()
+
+	rows, err := tx.Query(ctx, `
+		SELECT `+orderColumns+`
+		FROM orders
+		WHERE status = $1 AND created_at < $2
+		ORDER BY created_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`, model.StatusPendingPayment, createdBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var orders []*model.Order
+	for rows.Next() {
+		order, scanErr := scanOrder(rows)
+		if scanErr != nil {
+			rows.Close()
+			return 0, scanErr
+		}
+		orders = append(orders, order)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, order := range orders {
+		transition, event, expireErr := expirer.Expire(ctx, order)
+		if expireErr != nil {
+			logger.Error(ctx, "❌ Failed to expire order",
+				zap.String("order_uuid", order.OrderUUID),
+				zap.Error(expireErr))
+			continue
+		}
+
+		if err = updateOrderTx(ctx, tx, order, transition); err != nil {
+			return 0, err
+		}
+		if err = insertOutboxEventTx(ctx, tx, event); err != nil {
+			return 0, err
+		}
+		expired++
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return expired, nil
+}
@@ -17,12 +17,11 @@ func (r *repository) GetOrderByUuid(ctx context.Context, uuid string) (*model.Or
 	}
 	defer conn.Release()
 
-	var order model.Order
-	err = conn.QueryRow(ctx, `
-		SELECT order_uuid, user_uuid, part_uuids, total_price, payment_method, status
+	order, err := scanOrder(conn.QueryRow(ctx, `
+		SELECT `+orderColumns+`
 		FROM orders 
 		WHERE order_uuid = $1
-	`, uuid).Scan(&order.OrderUUID, &order.UserUUID, &order.PartUuids, &order.TotalPrice, &order.PaymentMethod, &order.Status)
+	`, uuid))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, fmt.Errorf("order not found")
@@ -30,5 +29,10 @@ func (r *repository) GetOrderByUuid(ctx context.Context, uuid string) (*model.Or
 		return nil, err
 	}
 
-	return &order, nil
+	order.Items, err = getOrderItems(ctx, conn, order.OrderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
 }
@@ -0,0 +1,60 @@
+package order
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// insertStatusHistoryTx записывает смену статуса заказа в историю в рамках переданной транзакции
+func insertStatusHistoryTx(ctx context.Context, tx pgx.Tx, transition *model.StatusTransition) error {
+	var fromStatus *string
+	if transition.From != "" {
+		from := string(transition.From)
+		fromStatus = &from
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO order_status_history (order_uuid, from_status, to_status, actor, reason)
+		VALUES ($1, $2, $3, $4, $5)
+	`, transition.OrderUUID, fromStatus, transition.To, transition.Actor, transition.Reason)
+	return err
+}
+
+func (r *repository) GetStatusHistory(ctx context.Context, orderUUID string) ([]*model.StatusTransition, error) {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Release()
+
+	rows, err := conn.Query(ctx, `
+		SELECT order_uuid, from_status, to_status, actor, reason, created_at
+		FROM order_status_history
+		WHERE order_uuid = $1
+		ORDER BY created_at, id
+	`, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]*model.StatusTransition, 0)
+	for rows.Next() {
+		var (
+			transition model.StatusTransition
+			fromStatus *string
+		)
+		if err := rows.Scan(&transition.OrderUUID, &fromStatus, &transition.To, &transition.Actor, &transition.Reason, &transition.CreatedAt); err != nil {
+			return nil, err
+		}
+		if fromStatus != nil {
+			transition.From = model.Status(*fromStatus)
+		}
+		history = append(history, &transition)
+	}
+
+	return history, rows.Err()
+}
@@ -0,0 +1,74 @@
+package order
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// insertOrderItemsTx сохраняет позиции заказа в рамках переданной транзакции одним запросом
+func insertOrderItemsTx(ctx context.Context, tx pgx.Tx, orderUUID string, items []model.OrderItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	partUUIDs := make([]string, len(items))
+	quantities := make([]int64, len(items))
+	unitPrices := make([]*string, len(items))
+	partNames := make([]string, len(items))
+	for i, item := range items {
+		partUUIDs[i] = item.PartUUID
+		quantities[i] = item.Quantity
+		if item.UnitPrice != nil {
+			price := item.UnitPrice.StringFixed(2)
+			unitPrices[i] = &price
+		}
+		partNames[i] = item.PartName
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO order_items (order_uuid, part_uuid, quantity, unit_price, part_name)
+		SELECT $1, item.part_uuid, item.quantity, item.unit_price::numeric, item.part_name
+		FROM unnest($2::text[], $3::bigint[], $4::text[], $5::text[]) AS item(part_uuid, quantity, unit_price, part_name)
+	`, orderUUID, partUUIDs, quantities, unitPrices, partNames)
+	return err
+}
+
+// getOrderItems возвращает позиции заказа в порядке UUID деталей
+func getOrderItems(ctx context.Context, conn *pgxpool.Conn, orderUUID string) ([]model.OrderItem, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT part_uuid, quantity, unit_price::text, part_name
+		FROM order_items
+		WHERE order_uuid = $1
+		ORDER BY part_uuid
+	`, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]model.OrderItem, 0)
+	for rows.Next() {
+		var (
+			item      model.OrderItem
+			unitPrice *string
+		)
+		if err := rows.Scan(&item.PartUUID, &item.Quantity, &unitPrice, &item.PartName); err != nil {
+			return nil, err
+		}
+		if unitPrice != nil {
+			price, err := decimal.NewFromString(*unitPrice)
+			if err != nil {
+				return nil, err
+			}
+			item.UnitPrice = &price
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
@@ -0,0 +1,146 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// orderColumns - колонки заказа в порядке сканирования scanOrder
+const orderColumns = `order_uuid, user_uuid, part_uuids, total_price, transaction_uuid, payment_method, status, created_at`
+
+// ListOrders возвращает одну страницу заказов и признак того, что за ней есть еще заказы.
+// Страница выбирается по ключу (поле сортировки, order_uuid), поэтому стоимость запроса
+// не зависит от глубины пролистывания
+func (r *repository) ListOrders(ctx context.Context, filter *model.OrdersFilter, page *model.OrdersPage) ([]*model.Order, bool, error) {
+	conditions, args := buildOrdersConditions(filter)
+
+	direction := "ASC"
+	if page.Descending {
+		direction = "DESC"
+	}
+
+	if page.After != nil {
+		op := ">"
+		if page.Descending {
+			op = "<"
+		}
+
+		// Цена сравнивается как numeric, чтобы float32 не сдвинул границу страницы
+		value, cast := interface{}(page.After.CreatedAt), ""
+		if page.OrderBy == model.OrdersOrderByTotalPrice {
+			value, cast = page.After.TotalPrice, "::numeric"
+		}
+
+		args = append(args, value, page.After.OrderUUID)
+		conditions = append(conditions, fmt.Sprintf("(%s, order_uuid) %s ($%d%s, $%d)", page.OrderBy, op, len(args)-1, cast, len(args)))
+	}
+
+	query := "SELECT " + orderColumns + " FROM orders"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	// Запрашиваем на один заказ больше, чтобы узнать, есть ли следующая страница
+	args = append(args, page.Size+1)
+	query += fmt.Sprintf(" ORDER BY %s %s, order_uuid %s LIMIT $%d", page.OrderBy, direction, direction, len(args))
+
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer conn.Release()
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	var orders []*model.Order
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, false, err
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	hasMore := int64(len(orders)) > page.Size
+	if hasMore {
+		orders = orders[:page.Size]
+	}
+
+	return orders, hasMore, nil
+}
+
+// buildOrdersConditions строит условия WHERE и их аргументы по фильтру заказов
+func buildOrdersConditions(filter *model.OrdersFilter) ([]string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	if filter == nil {
+		return conditions, args
+	}
+
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.UserUUID != "" {
+		add("user_uuid = $%d", filter.UserUUID)
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+		add("status = ANY($%d)", statuses)
+	}
+
+	if len(filter.PaymentMethods) > 0 {
+		methods := make([]string, len(filter.PaymentMethods))
+		for i, method := range filter.PaymentMethods {
+			methods[i] = string(method)
+		}
+		add("payment_method = ANY($%d)", methods)
+	}
+
+	if filter.CreatedFrom != nil {
+		add("created_at >= $%d", *filter.CreatedFrom)
+	}
+
+	if filter.CreatedTo != nil {
+		add("created_at < $%d", *filter.CreatedTo)
+	}
+
+	return conditions, args
+}
+
+func scanOrder(row pgx.Row) (*model.Order, error) {
+	var order model.Order
+	err := row.Scan(
+		&order.OrderUUID,
+		&order.UserUUID,
+		&order.PartUuids,
+		&order.TotalPrice,
+		&order.TransactionUUID,
+		&order.PaymentMethod,
+		&order.Status,
+		&order.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
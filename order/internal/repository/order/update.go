@@ -10,7 +10,7 @@ import (
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
 )
 
-func (r *repository) UpdateOrder(ctx context.Context, order *model.Order) error {
+func (r *repository) UpdateOrder(ctx context.Context, order *model.Order, transition *model.StatusTransition) error {
 	logger.Info(ctx, "🔄 Starting UpdateOrder",
 		zap.String("order_uuid", order.OrderUUID),
 		zap.String("status", string(order.Status)),
@@ -40,11 +40,37 @@ func (r *repository) UpdateOrder(ctx context.Context, order *model.Order) error
 		zap.String("status", string(order.Status)),
 		zap.Any("transaction_uuid", order.TransactionUUID))
 
+	if err = updateOrderTx(ctx, tx, order, transition); err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		logger.Error(ctx, "❌ Failed to commit transaction", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "✅ Successfully updated order",
+		zap.String("order_uuid", order.OrderUUID),
+		zap.String("status", string(order.Status)))
+
+	return nil
+}
+
+// updateOrderTx обновляет заказ в рамках переданной транзакции. Если передан transition,
+// заказ обновляется только из статуса transition.From, а переход записывается в историю.
+// Без transition проверка текущего статуса снимается
+func updateOrderTx(ctx context.Context, tx pgx.Tx, order *model.Order, transition *model.StatusTransition) error {
+	var expectedStatus model.Status
+	if transition != nil {
+		expectedStatus = transition.From
+	}
+
 	result, err := tx.Exec(ctx, `
 		UPDATE orders 
 		SET user_uuid = $1, part_uuids = $2, total_price = $3, transaction_uuid = $4, payment_method = $5, status = $6, updated_at = NOW()
-		WHERE order_uuid = $7
-	`, order.UserUUID, order.PartUuids, order.TotalPrice, order.TransactionUUID, order.PaymentMethod, order.Status, order.OrderUUID)
+		WHERE order_uuid = $7 AND ($8 = '' OR status = $8)
+	`, order.UserUUID, order.PartUuids, order.TotalPrice, order.TransactionUUID, order.PaymentMethod, order.Status, order.OrderUUID, string(expectedStatus))
 	if err != nil {
 		logger.Error(ctx, "❌ Failed to execute UPDATE query", zap.Error(err))
 		return err
@@ -57,19 +83,20 @@ func (r *repository) UpdateOrder(ctx context.Context, order *model.Order) error
 		zap.Int64("rows_affected", rowsAffected))
 
 	if rowsAffected == 0 {
+		if expectedStatus != "" {
+			logger.Warn(ctx, "⚠️ Order status changed concurrently",
+				zap.String("order_uuid", order.OrderUUID),
+				zap.String("expected_status", string(expectedStatus)))
+			return model.ErrOrderStatusChanged
+		}
 		logger.Error(ctx, "❌ No rows affected", zap.String("order_uuid", order.OrderUUID))
 		return pgx.ErrNoRows
 	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		logger.Error(ctx, "❌ Failed to commit transaction", zap.Error(err))
-		return err
+	if transition != nil {
+		transition.OrderUUID = order.OrderUUID
+		return insertStatusHistoryTx(ctx, tx, transition)
 	}
 
-	logger.Info(ctx, "✅ Successfully updated order",
-		zap.String("order_uuid", order.OrderUUID),
-		zap.String("status", string(order.Status)))
-
 	return nil
 }
@@ -0,0 +1,71 @@
+package order
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/order/internal/repository/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
+)
+
+// UpdateOrderWithOutbox обновляет заказ и сохраняет события в outbox в одной транзакции.
+// Обновление проходит только из статуса transition.From, поэтому из двух параллельных
+// запросов событие сохранит только один
+func (r *repository) UpdateOrderWithOutbox(ctx context.Context, order *model.Order, transition *model.StatusTransition, events []*model.OutboxEvent) error {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			_ = rollbackErr
+		}
+	}()
+
+	if err = updateOrderTx(ctx, tx, order, transition); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if err = insertOutboxEventTx(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		logger.Info(ctx, "✅ Order updated with outbox event",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("event_uuid", event.EventUUID),
+			zap.String("event_type", string(event.EventType)))
+	}
+
+	return nil
+}
+
+// insertOutboxEventTx сохраняет событие в outbox в рамках переданной транзакции
+// вместе с контекстом трассировки текущего запроса
+func insertOutboxEventTx(ctx context.Context, tx pgx.Tx, event *model.OutboxEvent) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO outbox (event_uuid, aggregate_uuid, event_type, payload, trace_context)
+		VALUES ($1, $2, $3, $4, $5)
+	`, event.EventUUID, event.AggregateUUID, event.EventType, event.Payload, tracing.Carrier(ctx))
+	if err != nil {
+		logger.Error(ctx, "❌ Failed to insert outbox event", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+
+	repo "github.com/space-wanderer/microservices/order/internal/repository"
+	"github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// ProcessOutboxBatch выбирает пачку неотправленных событий и публикует их через publisher.
+// События одного заказа отправляются строго в порядке записи: после неудачной попытки
+// последующие события этого заказа ждут, пока не будет отправлено предыдущее.
+// Событие, отклоненное с model.ErrOutboxEventRejected, снимается с отправки без повторов.
+func (r *repository) ProcessOutboxBatch(ctx context.Context, limit int, publisher repo.OutboxPublisher) (int, error) {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			_ = rollbackErr
+		}
+	}()
+
+	// Если outbox уже обрабатывает другой экземпляр сервиса, пропускаем итерацию
+	var locked bool
+	if err = tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, relayLockID).Scan(&locked); err != nil {
+		return 0, err
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT o.id, o.event_uuid, o.aggregate_uuid, o.event_type, o.payload, o.attempts, o.trace_context, o.created_at
+		FROM outbox o
+		WHERE o.processed_at IS NULL
+		  AND o.next_attempt_at <= NOW()
+		  AND NOT EXISTS (
+		      SELECT 1 FROM outbox p
+		      WHERE p.aggregate_uuid = o.aggregate_uuid
+		        AND p.processed_at IS NULL
+		        AND p.id < o.id
+		        AND p.next_attempt_at > NOW()
+		  )
+		ORDER BY o.id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var events []*model.OutboxEvent
+	for rows.Next() {
+		var event model.OutboxEvent
+		if err = rows.Scan(&event.ID, &event.EventUUID, &event.AggregateUUID, &event.EventType, &event.Payload, &event.Attempts, &event.TraceContext, &event.CreatedAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		events = append(events, &event)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	published := 0
+	blocked := make(map[string]struct{})
+	for _, event := range events {
+		if _, ok := blocked[event.AggregateUUID]; ok {
+			continue
+		}
+
+		publishErr := publisher.Publish(ctx, event)
+		if errors.Is(publishErr, model.ErrOutboxEventRejected) {
+			// Повтор не поможет: событие снимается с отправки и не держит следующие события заказа
+			_, err = tx.Exec(ctx, `
+				UPDATE outbox
+				SET attempts = attempts + 1, last_error = $2, processed_at = NOW(), rejected_at = NOW()
+				WHERE id = $1
+			`, event.ID, publishErr.Error())
+			if err != nil {
+				return published, err
+			}
+			continue
+		}
+		if publishErr != nil {
+			blocked[event.AggregateUUID] = struct{}{}
+
+			delay := publisher.RetryDelay(event.Attempts + 1)
+			_, err = tx.Exec(ctx, `
+				UPDATE outbox
+				SET attempts = attempts + 1, last_error = $2, next_attempt_at = NOW() + make_interval(secs => $3)
+				WHERE id = $1
+			`, event.ID, publishErr.Error(), delay.Seconds())
+			if err != nil {
+				return published, err
+			}
+			continue
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE outbox SET processed_at = NOW(), last_error = NULL WHERE id = $1`, event.ID)
+		if err != nil {
+			return published, err
+		}
+		published++
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
@@ -0,0 +1,14 @@
+package outbox
+
+import "github.com/jackc/pgx/v5/pgxpool"
+
+// relayLockID ключ advisory lock, гарантирующий единственный активный relay
+const relayLockID int64 = 7_240_801
+
+type repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *repository {
+	return &repository{db: db}
+}
@@ -0,0 +1,30 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// GetOutboxStats возвращает количество неотправленных событий и возраст самого старого из них
+func (r *repository) GetOutboxStats(ctx context.Context) (model.OutboxStats, error) {
+	var (
+		pending    int64
+		lagSeconds float64
+	)
+
+	err := r.db.QueryRow(ctx, `
+		SELECT COUNT(*), COALESCE(EXTRACT(EPOCH FROM NOW() - MIN(created_at)), 0)::FLOAT8
+		FROM outbox
+		WHERE processed_at IS NULL
+	`).Scan(&pending, &lagSeconds)
+	if err != nil {
+		return model.OutboxStats{}, err
+	}
+
+	return model.OutboxStats{
+		Pending: pending,
+		Lag:     time.Duration(lagSeconds * float64(time.Second)),
+	}, nil
+}
@@ -2,12 +2,52 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	serviceModel "github.com/space-wanderer/microservices/order/internal/model"
 	"github.com/space-wanderer/microservices/order/internal/repository/model"
 )
 
 type OrderRepository interface {
-	CreateOrder(ctx context.Context, order *model.Order) (string, error)
+	// CreateOrder сохраняет заказ и, если передан transition, первую запись истории статусов
+	CreateOrder(ctx context.Context, order *model.Order, transition *model.StatusTransition) (string, error)
 	GetOrderByUuid(ctx context.Context, uuid string) (*model.Order, error)
-	UpdateOrder(ctx context.Context, order *model.Order) error
+	ListOrders(ctx context.Context, filter *model.OrdersFilter, page *model.OrdersPage) ([]*model.Order, bool, error)
+	// UpdateOrder обновляет заказ, только если его статус все еще равен transition.From,
+	// иначе возвращает model.ErrOrderStatusChanged. Переход сохраняется в историю статусов.
+	// Без transition проверка статуса снимается и история не пишется
+	UpdateOrder(ctx context.Context, order *model.Order, transition *model.StatusTransition) error
+	// UpdateOrderWithOutbox обновляет заказ и сохраняет события outbox, только если статус заказа
+	// все еще равен transition.From, иначе возвращает model.ErrOrderStatusChanged
+	UpdateOrderWithOutbox(ctx context.Context, order *model.Order, transition *model.StatusTransition, events []*model.OutboxEvent) error
+	// GetStatusHistory возвращает историю статусов заказа в порядке смены
+	GetStatusHistory(ctx context.Context, orderUUID string) ([]*model.StatusTransition, error)
+	// ExpireOrders блокирует до limit неоплаченных заказов, созданных раньше createdBefore,
+	// и снимает их через expirer. Заказы, заблокированные другим экземпляром сервиса, пропускаются
+	ExpireOrders(ctx context.Context, createdBefore time.Time, limit int, expirer OrderExpirer) (int, error)
+}
+
+// OrderExpirer переводит просроченный заказ в конечный статус и возвращает смену статуса
+// и событие outbox. Ошибка оставляет заказ неоплаченным до следующего прохода
+type OrderExpirer interface {
+	Expire(ctx context.Context, order *model.Order) (*model.StatusTransition, *model.OutboxEvent, error)
+}
+
+type IdempotencyRepository interface {
+	AcquireIdempotencyKey(ctx context.Context, record *serviceModel.IdempotencyRecord, lockTTL time.Duration) (*serviceModel.IdempotencyRecord, bool, error)
+	CompleteIdempotencyKey(ctx context.Context, userUUID string, operation serviceModel.IdempotentOperation, key string, response []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, userUUID string, operation serviceModel.IdempotentOperation, key string) error
+	// DeleteExpiredIdempotencyKeys удаляет пачку ключей старше createdBefore и возвращает их количество
+	DeleteExpiredIdempotencyKeys(ctx context.Context, createdBefore time.Time, limit int) (int, error)
+}
+
+type OutboxRepository interface {
+	ProcessOutboxBatch(ctx context.Context, limit int, publisher OutboxPublisher) (int, error)
+	GetOutboxStats(ctx context.Context) (model.OutboxStats, error)
+}
+
+// OutboxPublisher публикует события outbox и определяет задержку перед повторной отправкой
+type OutboxPublisher interface {
+	Publish(ctx context.Context, event *model.OutboxEvent) error
+	RetryDelay(attempts int) time.Duration
 }
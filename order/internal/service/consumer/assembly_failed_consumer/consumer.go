@@ -0,0 +1,38 @@
+package assembly_failed_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/space-wanderer/microservices/order/internal/converter/kafka"
+	orderService "github.com/space-wanderer/microservices/order/internal/service"
+	"github.com/space-wanderer/microservices/platform/pkg/kafka"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+type Service struct {
+	assemblyFailedConsumer    kafka.Consumer
+	shipAssemblyFailedDecoder kafkaConverter.ShipAssemblyFailedDecoder
+	orderService              orderService.OrderService
+}
+
+func NewService(assemblyFailedConsumer kafka.Consumer, shipAssemblyFailedDecoder kafkaConverter.ShipAssemblyFailedDecoder, orderService orderService.OrderService) *Service {
+	return &Service{
+		assemblyFailedConsumer:    assemblyFailedConsumer,
+		shipAssemblyFailedDecoder: shipAssemblyFailedDecoder,
+		orderService:              orderService,
+	}
+}
+
+func (s *Service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting assembly failed consumer")
+
+	err := s.assemblyFailedConsumer.Consume(ctx, s.AssemblyFailedHandler)
+	if err != nil {
+		logger.Error(ctx, "failed to consume assembly failed events", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package assembly_failed_consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+func (s *Service) AssemblyFailedHandler(ctx context.Context, msg consumer.Message) error {
+	event := s.shipAssemblyFailedDecoder.Decode(msg.Value)
+
+	logger.Info(ctx, "Processing ShipAssemblyFailed message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("stage", event.Stage),
+		zap.String("reason", event.Reason),
+	)
+
+	err := s.orderService.UpdateOrderStatus(ctx, event.OrderUUID, model.StatusAssemblyFailed, model.ActorAssembly, assemblyFailedReason(event))
+	if errors.Is(err, model.ErrInvalidStatusTransition) {
+		// Заказ отменен до завершения сборки: повторная доставка события ничего не изменит
+		logger.Warn(ctx, "Skip ShipAssemblyFailed: order status does not allow it",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.Error(err))
+		return nil
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to update order status to ASSEMBLY_FAILED",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Order status updated to ASSEMBLY_FAILED",
+		zap.String("order_uuid", event.OrderUUID))
+
+	return nil
+}
+
+// assemblyFailedReason - причина перевода заказа в ASSEMBLY_FAILED, сохраняемая в историю статусов
+func assemblyFailedReason(event model.ShipAssemblyFailedEvent) string {
+	if event.Stage == "" {
+		return "assembly failed: " + event.Reason
+	}
+	return fmt.Sprintf("assembly failed at stage %s: %s", event.Stage, event.Reason)
+}
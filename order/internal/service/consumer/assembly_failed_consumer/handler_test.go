@@ -0,0 +1,94 @@
+package assembly_failed_consumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/space-wanderer/microservices/order/internal/converter/kafka/decoder"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	"github.com/space-wanderer/microservices/order/internal/service/mocks"
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	eventsV1 "github.com/space-wanderer/microservices/shared/pkg/proto/events/v1"
+)
+
+type AssemblyFailedHandlerTestSuite struct {
+	suite.Suite
+	orderService *mocks.OrderService
+	service      *Service
+}
+
+func (s *AssemblyFailedHandlerTestSuite) SetupTest() {
+	logger.SetNopLogger()
+	s.orderService = mocks.NewOrderService(s.T())
+	s.service = NewService(nil, decoder.NewShipAssemblyFailedDecoder(), s.orderService)
+}
+
+func (s *AssemblyFailedHandlerTestSuite) TearDownTest() {
+	s.orderService.AssertExpectations(s.T())
+}
+
+func TestAssemblyFailedHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AssemblyFailedHandlerTestSuite))
+}
+
+func (s *AssemblyFailedHandlerTestSuite) assemblyFailedMessage(orderUUID, stage, reason string) consumer.Message {
+	value, err := proto.Marshal(&eventsV1.ShipAssemblyFailedEvent{
+		EventUuid: "650e8400-e29b-41d4-a716-446655440000",
+		OrderUuid: orderUUID,
+		Stage:     stage,
+		Reason:    reason,
+	})
+	s.Require().NoError(err)
+	return consumer.Message{Topic: "order.assembly-failed", Value: value}
+}
+
+func (s *AssemblyFailedHandlerTestSuite) TestAssemblyFailedHandler_UpdatesStatus() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	msg := s.assemblyFailedMessage(orderUUID, "ENGINES", "engine mount misaligned")
+	s.orderService.On("UpdateOrderStatus", ctx, orderUUID, model.StatusAssemblyFailed, model.ActorAssembly,
+		"assembly failed at stage ENGINES: engine mount misaligned").Return(nil).Once()
+
+	// Act
+	err := s.service.AssemblyFailedHandler(ctx, msg)
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *AssemblyFailedHandlerTestSuite) TestAssemblyFailedHandler_InvalidTransitionSkipped() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	msg := s.assemblyFailedMessage(orderUUID, "HULL", "hull breach")
+	s.orderService.On("UpdateOrderStatus", ctx, orderUUID, model.StatusAssemblyFailed, model.ActorAssembly,
+		"assembly failed at stage HULL: hull breach").
+		Return(&model.StatusTransitionError{From: model.StatusRefundPending, To: model.StatusAssemblyFailed}).Once()
+
+	// Act
+	err := s.service.AssemblyFailedHandler(ctx, msg)
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *AssemblyFailedHandlerTestSuite) TestAssemblyFailedHandler_UpdateError() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	msg := s.assemblyFailedMessage(orderUUID, "FUEL", "fuel line leak")
+	s.orderService.On("UpdateOrderStatus", ctx, orderUUID, model.StatusAssemblyFailed, model.ActorAssembly,
+		"assembly failed at stage FUEL: fuel line leak").Return(assert.AnError).Once()
+
+	// Act
+	err := s.service.AssemblyFailedHandler(ctx, msg)
+
+	// Assert
+	assert.ErrorIs(s.T(), err, assert.AnError)
+}
@@ -2,6 +2,7 @@ package order_consumer
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
 )
 
+// shipAssembledReason - причина перевода заказа в ASSEMBLED, сохраняемая в историю статусов
+const shipAssembledReason = "ship assembled"
+
 func (s *Service) OrderHandler(ctx context.Context, msg consumer.Message) error {
 	event := s.shipAssembledDecoder.Decode(msg.Value)
 
@@ -24,7 +28,14 @@ func (s *Service) OrderHandler(ctx context.Context, msg consumer.Message) error
 	)
 
 	// Обновляем статус заказа на ASSEMBLED
-	err := s.orderService.UpdateOrderStatus(ctx, event.OrderUUID, model.StatusAssembled)
+	err := s.orderService.UpdateOrderStatus(ctx, event.OrderUUID, model.StatusAssembled, model.ActorAssembly, shipAssembledReason)
+	if errors.Is(err, model.ErrInvalidStatusTransition) {
+		// Заказ отменен или не оплачен: повторная доставка события ничего не изменит
+		logger.Warn(ctx, "Skip ShipAssembled: order status does not allow assembling",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.Error(err))
+		return nil
+	}
 	if err != nil {
 		logger.Error(ctx, "Failed to update order status to ASSEMBLED",
 			zap.String("order_uuid", event.OrderUUID),
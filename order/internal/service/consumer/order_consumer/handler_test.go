@@ -0,0 +1,111 @@
+package order_consumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/space-wanderer/microservices/order/internal/converter/kafka/decoder"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	"github.com/space-wanderer/microservices/order/internal/service/mocks"
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	kafkaMiddleware "github.com/space-wanderer/microservices/platform/pkg/middleware/kafka"
+	eventsV1 "github.com/space-wanderer/microservices/shared/pkg/proto/events/v1"
+)
+
+type OrderHandlerTestSuite struct {
+	suite.Suite
+	orderService *mocks.OrderService
+	service      *Service
+	handler      consumer.MessageHandler
+}
+
+func (s *OrderHandlerTestSuite) SetupTest() {
+	logger.SetNopLogger()
+
+	s.orderService = mocks.NewOrderService(s.T())
+	shipAssembledDecoder := decoder.NewShipAssembledDecoder()
+	s.service = NewService(nil, shipAssembledDecoder, s.orderService)
+
+	eventID := func(msg consumer.Message) string {
+		return shipAssembledDecoder.Decode(msg.Value).EventUUID
+	}
+	inbox := kafkaMiddleware.Inbox("order-group", kafkaMiddleware.NewLRUInbox(10), eventID, logger.Logger())
+	s.handler = inbox(s.service.OrderHandler)
+}
+
+func (s *OrderHandlerTestSuite) TearDownTest() {
+	s.orderService.AssertExpectations(s.T())
+}
+
+func TestOrderHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderHandlerTestSuite))
+}
+
+func (s *OrderHandlerTestSuite) shipAssembledMessage(eventUUID, orderUUID string) consumer.Message {
+	value, err := proto.Marshal(&eventsV1.ShipAssembledEvent{
+		EventUuid: eventUUID,
+		OrderUuid: orderUUID,
+	})
+	s.Require().NoError(err)
+
+	return consumer.Message{Topic: "order.assembled", Value: value}
+}
+
+func (s *OrderHandlerTestSuite) TestOrderHandler_RedeliveredEventProcessedOnce() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	msg := s.shipAssembledMessage("650e8400-e29b-41d4-a716-446655440000", orderUUID)
+
+	s.orderService.On("UpdateOrderStatus", ctx, orderUUID, model.StatusAssembled, model.ActorAssembly, shipAssembledReason).
+		Return(nil).Once()
+
+	// Act
+	firstErr := s.handler(ctx, msg)
+	secondErr := s.handler(ctx, msg)
+
+	// Assert
+	assert.NoError(s.T(), firstErr)
+	assert.NoError(s.T(), secondErr)
+}
+
+func (s *OrderHandlerTestSuite) TestOrderHandler_FailedEventNotMarkedProcessed() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	msg := s.shipAssembledMessage("650e8400-e29b-41d4-a716-446655440001", orderUUID)
+
+	s.orderService.On("UpdateOrderStatus", ctx, orderUUID, model.StatusAssembled, model.ActorAssembly, shipAssembledReason).
+		Return(assert.AnError).Once()
+	s.orderService.On("UpdateOrderStatus", ctx, orderUUID, model.StatusAssembled, model.ActorAssembly, shipAssembledReason).
+		Return(nil).Once()
+
+	// Act
+	firstErr := s.handler(ctx, msg)
+	secondErr := s.handler(ctx, msg)
+
+	// Assert: после ошибки повторная доставка обрабатывается снова
+	assert.ErrorIs(s.T(), firstErr, assert.AnError)
+	assert.NoError(s.T(), secondErr)
+}
+
+func (s *OrderHandlerTestSuite) TestOrderHandler_InvalidTransitionSkipped() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	msg := s.shipAssembledMessage("650e8400-e29b-41d4-a716-446655440002", orderUUID)
+
+	s.orderService.On("UpdateOrderStatus", ctx, orderUUID, model.StatusAssembled, model.ActorAssembly, shipAssembledReason).
+		Return(&model.StatusTransitionError{From: model.StatusCanceled, To: model.StatusAssembled}).Once()
+
+	// Act
+	err := s.handler(ctx, msg)
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
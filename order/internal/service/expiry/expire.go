@@ -0,0 +1,62 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// reasonPaymentExpired - причина смены статуса, сохраняемая в историю статусов заказа
+const reasonPaymentExpired = "payment window expired"
+
+// Expire переводит заблокированный репозиторием заказ в EXPIRED и готовит событие OrderExpired.
+// Детали возвращаются на склад до смены статуса: если транзакция не завершится,
+// следующий проход повторит снятие, а уже снятый резерв не считается ошибкой
+func (s *service) Expire(ctx context.Context, repoOrder *repoModel.Order) (*repoModel.StatusTransition, *repoModel.OutboxEvent, error) {
+	order := converter.ConvertRepoOrderToModelOrder(repoOrder)
+
+	if err := model.ValidateTransition(order.Status, model.StatusExpired); err != nil {
+		return nil, nil, err
+	}
+
+	err := s.inventoryClient.ReleaseReservation(ctx, order.OrderUUID)
+	if err != nil && !errors.Is(err, model.ErrReservationNotFound) {
+		return nil, nil, fmt.Errorf("failed to release reservation: %w", err)
+	}
+
+	event := model.OrderExpiredEvent{
+		EventUUID:        uuid.New().String(),
+		OrderUUID:        order.OrderUUID,
+		UserUUID:         order.UserUUID,
+		PaymentWindowSec: int64(s.paymentWindow.Seconds()),
+	}
+
+	payload, err := s.orderExpiredEncoder.Encode(event)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode order expired event: %w", err)
+	}
+
+	transition := converter.ConvertStatusChangeToRepoStatusTransition(model.StatusChange{
+		OrderUUID: order.OrderUUID,
+		From:      order.Status,
+		To:        model.StatusExpired,
+		Actor:     model.ActorSystem,
+		Reason:    reasonPaymentExpired,
+	})
+	order.Status = model.StatusExpired
+	*repoOrder = *converter.ConvertModelOrderToRepoOrder(order)
+
+	logger.Info(ctx, "⌛ Order payment window expired",
+		zap.String("order_uuid", order.OrderUUID),
+		zap.Time("created_at", order.CreatedAt))
+
+	return transition, converter.ConvertOrderExpiredEventToOutboxEvent(event, payload), nil
+}
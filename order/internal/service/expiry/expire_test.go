@@ -0,0 +1,120 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+const (
+	testOrderUUID = "550e8400-e29b-41d4-a716-446655440000"
+	testUserUUID  = "550e8400-e29b-41d4-a716-446655440001"
+)
+
+func pendingRepoOrder() *repoModel.Order {
+	return &repoModel.Order{
+		OrderUUID:     testOrderUUID,
+		UserUUID:      testUserUUID,
+		PartUuids:     []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice:    decimal.RequireFromString("150.5"),
+		PaymentMethod: repoModel.PaymentMethodCard,
+		Status:        repoModel.StatusPendingPayment,
+	}
+}
+
+func (s *ServiceSuite) TestExpire_Success() {
+	// Arrange
+	ctx := context.Background()
+	order := pendingRepoOrder()
+	payload := []byte("order-expired-event")
+
+	s.inventoryClient.On("ReleaseReservation", ctx, testOrderUUID).Return(nil)
+	s.orderExpiredEncoder.On("Encode", mock.MatchedBy(func(event model.OrderExpiredEvent) bool {
+		return event.EventUUID != "" &&
+			event.OrderUUID == testOrderUUID &&
+			event.UserUUID == testUserUUID &&
+			event.PaymentWindowSec == 1800
+	})).Return(payload, nil)
+
+	// Act
+	transition, event, err := s.service.Expire(ctx, order)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(repoModel.StatusExpired, order.Status)
+	s.Equal(repoModel.StatusPendingPayment, transition.From)
+	s.Equal(repoModel.StatusExpired, transition.To)
+	s.Equal(string(model.ActorSystem), transition.Actor)
+	s.Equal(reasonPaymentExpired, transition.Reason)
+	s.Equal(repoModel.OutboxEventTypeOrderExpired, event.EventType)
+	s.Equal(testOrderUUID, event.AggregateUUID)
+	s.Equal(payload, event.Payload)
+}
+
+func (s *ServiceSuite) TestExpire_ReservationAlreadyReleased() {
+	// Arrange
+	ctx := context.Background()
+	order := pendingRepoOrder()
+
+	s.inventoryClient.On("ReleaseReservation", ctx, testOrderUUID).Return(model.ErrReservationNotFound)
+	s.orderExpiredEncoder.On("Encode", mock.Anything).Return([]byte("payload"), nil)
+
+	// Act
+	_, _, err := s.service.Expire(ctx, order)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(repoModel.StatusExpired, order.Status)
+}
+
+func (s *ServiceSuite) TestExpire_ReleaseError() {
+	// Arrange
+	ctx := context.Background()
+	order := pendingRepoOrder()
+
+	s.inventoryClient.On("ReleaseReservation", ctx, testOrderUUID).Return(errors.New("inventory unavailable"))
+
+	// Act
+	transition, event, err := s.service.Expire(ctx, order)
+
+	// Assert
+	s.Require().Error(err)
+	s.Nil(transition)
+	s.Nil(event)
+	s.Equal(repoModel.StatusPendingPayment, order.Status)
+}
+
+func (s *ServiceSuite) TestExpire_NotPendingPayment() {
+	// Arrange
+	ctx := context.Background()
+	order := pendingRepoOrder()
+	order.Status = repoModel.StatusPaid
+
+	// Act
+	_, _, err := s.service.Expire(ctx, order)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrInvalidStatusTransition)
+	s.Equal(repoModel.StatusPaid, order.Status)
+}
+
+func (s *ServiceSuite) TestExpire_EncodeError() {
+	// Arrange
+	ctx := context.Background()
+	order := pendingRepoOrder()
+
+	s.inventoryClient.On("ReleaseReservation", ctx, testOrderUUID).Return(nil)
+	s.orderExpiredEncoder.On("Encode", mock.Anything).Return(nil, errors.New("encode error"))
+
+	// Act
+	_, _, err := s.service.Expire(ctx, order)
+
+	// Assert
+	s.Require().Error(err)
+	s.Equal(repoModel.StatusPendingPayment, order.Status)
+}
@@ -0,0 +1,13 @@
+package expiry
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var ordersExpired = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "order",
+	Subsystem: "orders",
+	Name:      "expired_total",
+	Help:      "Количество заказов, снятых по истечении срока оплаты",
+})
@@ -0,0 +1,37 @@
+package expiry
+
+import (
+	"time"
+
+	"github.com/space-wanderer/microservices/order/internal/client/grpc"
+	kafkaConverter "github.com/space-wanderer/microservices/order/internal/converter/kafka"
+	"github.com/space-wanderer/microservices/order/internal/repository"
+)
+
+type service struct {
+	orderRepository     repository.OrderRepository
+	inventoryClient     grpc.InventoryClient
+	orderExpiredEncoder kafkaConverter.OrderExpiredEncoder
+
+	paymentWindow time.Duration
+	sweepInterval time.Duration
+	batchSize     int
+}
+
+func NewService(
+	orderRepository repository.OrderRepository,
+	inventoryClient grpc.InventoryClient,
+	orderExpiredEncoder kafkaConverter.OrderExpiredEncoder,
+	paymentWindow time.Duration,
+	sweepInterval time.Duration,
+	batchSize int,
+) *service {
+	return &service{
+		orderRepository:     orderRepository,
+		inventoryClient:     inventoryClient,
+		orderExpiredEncoder: orderExpiredEncoder,
+		paymentWindow:       paymentWindow,
+		sweepInterval:       sweepInterval,
+		batchSize:           batchSize,
+	}
+}
@@ -0,0 +1,49 @@
+package expiry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	grpcMocks "github.com/space-wanderer/microservices/order/internal/client/grpc/mocks"
+	repoMocks "github.com/space-wanderer/microservices/order/internal/repository/mocks"
+	serviceMocks "github.com/space-wanderer/microservices/order/internal/service/mocks"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	orderRepository     *repoMocks.OrderRepository
+	inventoryClient     *grpcMocks.InventoryClient
+	orderExpiredEncoder *serviceMocks.MockOrderExpiredEncoder
+	service             *service
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.Require().NoError(logger.Init("error", true))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.orderRepository = repoMocks.NewOrderRepository(s.T())
+	s.inventoryClient = grpcMocks.NewInventoryClient(s.T())
+	s.orderExpiredEncoder = serviceMocks.NewMockOrderExpiredEncoder(s.T())
+	s.service = NewService(
+		s.orderRepository,
+		s.inventoryClient,
+		s.orderExpiredEncoder,
+		30*time.Minute,
+		10*time.Millisecond,
+		10,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.orderRepository.AssertExpectations(s.T())
+	s.inventoryClient.AssertExpectations(s.T())
+	s.orderExpiredEncoder.AssertExpectations(s.T())
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
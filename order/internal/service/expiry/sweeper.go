@@ -0,0 +1,50 @@
+package expiry
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// RunSweeper периодически снимает заказы, не оплаченные в течение срока оплаты, до отмены контекста
+func (s *service) RunSweeper(ctx context.Context) error {
+	logger.Info(ctx, "Starting order expiry sweeper",
+		zap.Duration("payment_window", s.paymentWindow),
+		zap.Duration("sweep_interval", s.sweepInterval),
+		zap.Int("batch_size", s.batchSize))
+
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		s.sweepOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "🛑 Order expiry sweeper stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) sweepOnce(ctx context.Context) {
+	createdBefore := time.Now().Add(-s.paymentWindow)
+
+	// Снимаем пачки подряд, пока просроченные заказы не закончатся
+	for ctx.Err() == nil {
+		expired, err := s.orderRepository.ExpireOrders(ctx, createdBefore, s.batchSize, s)
+		if err != nil {
+			logger.Error(ctx, "❌ Failed to expire orders", zap.Error(err))
+			return
+		}
+
+		ordersExpired.Add(float64(expired))
+		if expired < s.batchSize {
+			return
+		}
+	}
+}
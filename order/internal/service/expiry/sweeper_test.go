@@ -0,0 +1,72 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ServiceSuite) TestRunSweeper_StopsOnContextCancel() {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	s.orderRepository.On("ExpireOrders", mock.Anything, mock.AnythingOfType("time.Time"), 10, s.service).Return(0, nil)
+
+	done := make(chan error)
+	go func() {
+		done <- s.service.RunSweeper(ctx)
+	}()
+
+	// Act
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+
+	// Assert
+	select {
+	case err := <-done:
+		s.NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("sweeper did not stop after context cancel")
+	}
+}
+
+func (s *ServiceSuite) TestSweepOnce_UsesPaymentWindow() {
+	// Arrange
+	ctx := context.Background()
+	before := time.Now().Add(-30 * time.Minute)
+	s.orderRepository.On("ExpireOrders", ctx, mock.MatchedBy(func(createdBefore time.Time) bool {
+		return !createdBefore.Before(before) && createdBefore.Before(time.Now().Add(-29*time.Minute))
+	}), 10, s.service).Return(0, nil).Once()
+
+	// Act
+	s.service.sweepOnce(ctx)
+
+	// Assert
+	s.orderRepository.AssertNumberOfCalls(s.T(), "ExpireOrders", 1)
+}
+
+func (s *ServiceSuite) TestSweepOnce_DrainsFullBatches() {
+	// Arrange
+	ctx := context.Background()
+	s.orderRepository.On("ExpireOrders", ctx, mock.AnythingOfType("time.Time"), 10, s.service).Return(10, nil).Twice()
+	s.orderRepository.On("ExpireOrders", ctx, mock.AnythingOfType("time.Time"), 10, s.service).Return(4, nil).Once()
+
+	// Act
+	s.service.sweepOnce(ctx)
+
+	// Assert
+	s.orderRepository.AssertNumberOfCalls(s.T(), "ExpireOrders", 3)
+}
+
+func (s *ServiceSuite) TestSweepOnce_RepositoryError() {
+	// Arrange
+	ctx := context.Background()
+	s.orderRepository.On("ExpireOrders", ctx, mock.AnythingOfType("time.Time"), 10, s.service).Return(0, errors.New("database error")).Once()
+
+	// Act
+	s.service.sweepOnce(ctx)
+
+	// Assert
+	s.orderRepository.AssertNumberOfCalls(s.T(), "ExpireOrders", 1)
+}
@@ -0,0 +1,49 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// RunCleanup периодически удаляет ключи идемпотентности старше keyTTL до отмены контекста
+func (s *service) RunCleanup(ctx context.Context) error {
+	logger.Info(ctx, "Starting idempotency keys cleanup",
+		zap.Duration("key_ttl", s.keyTTL),
+		zap.Duration("cleanup_interval", s.cleanupInterval),
+		zap.Int("batch_size", s.batchSize))
+
+	ticker := time.NewTicker(s.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		s.cleanupOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "🛑 Idempotency keys cleanup stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) cleanupOnce(ctx context.Context) {
+	createdBefore := time.Now().Add(-s.keyTTL)
+
+	// Удаляем пачки подряд, пока устаревшие ключи не закончатся
+	for ctx.Err() == nil {
+		deleted, err := s.idempotencyRepository.DeleteExpiredIdempotencyKeys(ctx, createdBefore, s.batchSize)
+		if err != nil {
+			logger.Error(ctx, "❌ Failed to delete expired idempotency keys", zap.Error(err))
+			return
+		}
+
+		if deleted < s.batchSize {
+			return
+		}
+	}
+}
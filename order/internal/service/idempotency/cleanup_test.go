@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ServiceSuite) TestRunCleanup_StopsOnContextCancel() {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	s.idempotencyRepository.On("DeleteExpiredIdempotencyKeys", mock.Anything, mock.AnythingOfType("time.Time"), 10).Return(0, nil)
+
+	done := make(chan error)
+	go func() {
+		done <- s.service.RunCleanup(ctx)
+	}()
+
+	// Act
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+
+	// Assert
+	select {
+	case err := <-done:
+		s.NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("cleanup did not stop after context cancel")
+	}
+}
+
+func (s *ServiceSuite) TestCleanupOnce_UsesKeyTTL() {
+	// Arrange
+	ctx := context.Background()
+	before := time.Now().Add(-24 * time.Hour)
+	s.idempotencyRepository.On("DeleteExpiredIdempotencyKeys", ctx, mock.MatchedBy(func(createdBefore time.Time) bool {
+		return !createdBefore.Before(before) && createdBefore.Before(time.Now().Add(-23*time.Hour))
+	}), 10).Return(3, nil).Once()
+
+	// Act
+	s.service.cleanupOnce(ctx)
+
+	// Assert
+	s.idempotencyRepository.AssertNumberOfCalls(s.T(), "DeleteExpiredIdempotencyKeys", 1)
+}
+
+func (s *ServiceSuite) TestCleanupOnce_DrainsFullBatches() {
+	// Arrange
+	ctx := context.Background()
+	s.idempotencyRepository.On("DeleteExpiredIdempotencyKeys", ctx, mock.AnythingOfType("time.Time"), 10).Return(10, nil).Twice()
+	s.idempotencyRepository.On("DeleteExpiredIdempotencyKeys", ctx, mock.AnythingOfType("time.Time"), 10).Return(0, nil).Once()
+
+	// Act
+	s.service.cleanupOnce(ctx)
+
+	// Assert
+	s.idempotencyRepository.AssertNumberOfCalls(s.T(), "DeleteExpiredIdempotencyKeys", 3)
+}
+
+func (s *ServiceSuite) TestCleanupOnce_RepositoryError() {
+	// Arrange
+	ctx := context.Background()
+	s.idempotencyRepository.On("DeleteExpiredIdempotencyKeys", ctx, mock.AnythingOfType("time.Time"), 10).Return(0, errors.New("database error")).Once()
+
+	// Act
+	s.service.cleanupOnce(ctx)
+
+	// Assert
+	s.idempotencyRepository.AssertNumberOfCalls(s.T(), "DeleteExpiredIdempotencyKeys", 1)
+}
@@ -0,0 +1,107 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// Execute выполняет fn не более одного раза для ключа пользователя в рамках операции.
+// Повтор с тем же запросом получает сохраненный ответ первого успешного вызова,
+// повтор с другим запросом отклоняется. После ошибки ключ освобождается,
+// чтобы клиент мог повторить запрос
+func (s *service) Execute(
+	ctx context.Context,
+	userUUID string,
+	operation model.IdempotentOperation,
+	key string,
+	request any,
+	fn func(ctx context.Context) (model.Order, error),
+) (model.Order, error) {
+	requestHash, err := hashRequest(request)
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	stored, acquired, err := s.idempotencyRepository.AcquireIdempotencyKey(ctx, &model.IdempotencyRecord{
+		UserUUID:    userUUID,
+		Operation:   operation,
+		Key:         key,
+		RequestHash: requestHash,
+	}, s.lockTTL)
+	if err != nil {
+		return model.Order{}, fmt.Errorf("failed to acquire idempotency key: %w", err)
+	}
+
+	if !acquired {
+		return replay(stored, requestHash)
+	}
+
+	order, err := fn(ctx)
+	if err != nil {
+		// Ключ освобождается даже при отмене запроса клиентом
+		if releaseErr := s.idempotencyRepository.ReleaseIdempotencyKey(context.WithoutCancel(ctx), userUUID, operation, key); releaseErr != nil {
+			logger.Error(ctx, "failed to release idempotency key",
+				zap.String("user_uuid", userUUID),
+				zap.String("operation", string(operation)),
+				zap.String("idempotency_key", key),
+				zap.Error(releaseErr),
+			)
+		}
+		return model.Order{}, err
+	}
+
+	response, err := json.Marshal(order)
+	if err != nil {
+		return model.Order{}, fmt.Errorf("failed to encode idempotent response: %w", err)
+	}
+
+	// Операция уже выполнена, поэтому ошибка сохранения ответа не возвращается клиенту:
+	// повтор запроса дождется истечения блокировки и будет отклонен проверками сервиса
+	if err := s.idempotencyRepository.CompleteIdempotencyKey(context.WithoutCancel(ctx), userUUID, operation, key, response); err != nil {
+		logger.Error(ctx, "failed to complete idempotency key",
+			zap.String("user_uuid", userUUID),
+			zap.String("operation", string(operation)),
+			zap.String("idempotency_key", key),
+			zap.Error(err),
+		)
+	}
+
+	return order, nil
+}
+
+// replay возвращает результат ранее выполненного запроса с тем же ключом
+func replay(stored *model.IdempotencyRecord, requestHash string) (model.Order, error) {
+	if stored.RequestHash != requestHash {
+		return model.Order{}, model.ErrIdempotencyKeyConflict
+	}
+
+	if !stored.Completed {
+		return model.Order{}, model.ErrIdempotencyKeyInUse
+	}
+
+	var order model.Order
+	if err := json.Unmarshal(stored.Response, &order); err != nil {
+		return model.Order{}, fmt.Errorf("failed to decode idempotent response: %w", err)
+	}
+
+	return order, nil
+}
+
+// hashRequest вычисляет отпечаток запроса для сравнения повторов
+func hashRequest(request any) (string, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode idempotent request: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,126 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+)
+
+type createRequest struct {
+	UserUUID  string
+	PartUuids []string
+}
+
+func (s *ServiceSuite) TestExecute_FirstRequestStoresResponse() {
+	ctx := context.Background()
+	request := createRequest{UserUUID: "user-1", PartUuids: []string{"part-1"}}
+	order := model.Order{OrderUUID: "order-1", UserUUID: "user-1", TotalPrice: decimal.RequireFromString("100")}
+	response, err := json.Marshal(order)
+	s.Require().NoError(err)
+
+	s.idempotencyRepository.On("AcquireIdempotencyKey", ctx, mock.MatchedBy(func(record *model.IdempotencyRecord) bool {
+		return record.UserUUID == "user-1" && record.Operation == model.IdempotentOperationCreateOrder &&
+			record.Key == "key-1" && record.RequestHash != ""
+	}), 30*time.Second).Return(nil, true, nil)
+	s.idempotencyRepository.On("CompleteIdempotencyKey", mock.Anything, "user-1", model.IdempotentOperationCreateOrder, "key-1", response).Return(nil)
+
+	calls := 0
+	result, err := s.service.Execute(ctx, "user-1", model.IdempotentOperationCreateOrder, "key-1", request,
+		func(context.Context) (model.Order, error) {
+			calls++
+			return order, nil
+		})
+
+	s.Require().NoError(err)
+	s.Equal(order, result)
+	s.Equal(1, calls)
+}
+
+func (s *ServiceSuite) TestExecute_RetryReplaysStoredResponse() {
+	ctx := context.Background()
+	request := createRequest{UserUUID: "user-1", PartUuids: []string{"part-1"}}
+	requestHash, err := hashRequest(request)
+	s.Require().NoError(err)
+
+	createdAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	order := model.Order{OrderUUID: "order-1", UserUUID: "user-1", TotalPrice: decimal.RequireFromString("100"), CreatedAt: createdAt}
+	response, err := json.Marshal(order)
+	s.Require().NoError(err)
+
+	s.idempotencyRepository.On("AcquireIdempotencyKey", ctx, mock.Anything, 30*time.Second).Return(&model.IdempotencyRecord{
+		Operation:   model.IdempotentOperationCreateOrder,
+		Key:         "key-1",
+		RequestHash: requestHash,
+		Response:    response,
+		Completed:   true,
+	}, false, nil)
+
+	result, err := s.service.Execute(ctx, "user-1", model.IdempotentOperationCreateOrder, "key-1", request,
+		func(context.Context) (model.Order, error) {
+			s.Fail("operation must not be executed again")
+			return model.Order{}, nil
+		})
+
+	s.Require().NoError(err)
+	s.Equal(order, result)
+}
+
+func (s *ServiceSuite) TestExecute_DifferentRequestConflicts() {
+	ctx := context.Background()
+	requestHash, err := hashRequest(createRequest{UserUUID: "user-1", PartUuids: []string{"part-1"}})
+	s.Require().NoError(err)
+
+	s.idempotencyRepository.On("AcquireIdempotencyKey", ctx, mock.Anything, 30*time.Second).Return(&model.IdempotencyRecord{
+		RequestHash: requestHash,
+		Completed:   true,
+	}, false, nil)
+
+	_, err = s.service.Execute(ctx, "user-1", model.IdempotentOperationCreateOrder, "key-1",
+		createRequest{UserUUID: "user-1", PartUuids: []string{"part-2"}},
+		func(context.Context) (model.Order, error) {
+			s.Fail("operation must not be executed")
+			return model.Order{}, nil
+		})
+
+	s.ErrorIs(err, model.ErrIdempotencyKeyConflict)
+}
+
+func (s *ServiceSuite) TestExecute_RequestInProgress() {
+	ctx := context.Background()
+	request := createRequest{UserUUID: "user-1"}
+	requestHash, err := hashRequest(request)
+	s.Require().NoError(err)
+
+	s.idempotencyRepository.On("AcquireIdempotencyKey", ctx, mock.Anything, 30*time.Second).Return(&model.IdempotencyRecord{
+		RequestHash: requestHash,
+	}, false, nil)
+
+	_, err = s.service.Execute(ctx, "user-1", model.IdempotentOperationCreateOrder, "key-1", request,
+		func(context.Context) (model.Order, error) {
+			s.Fail("operation must not be executed")
+			return model.Order{}, nil
+		})
+
+	s.ErrorIs(err, model.ErrIdempotencyKeyInUse)
+}
+
+func (s *ServiceSuite) TestExecute_FailedOperationReleasesKey() {
+	ctx := context.Background()
+	expectedErr := errors.New("payment declined")
+
+	s.idempotencyRepository.On("AcquireIdempotencyKey", ctx, mock.Anything, 30*time.Second).Return(nil, true, nil)
+	s.idempotencyRepository.On("ReleaseIdempotencyKey", mock.Anything, "user-1", model.IdempotentOperationPayOrder, "key-1").Return(nil)
+
+	_, err := s.service.Execute(ctx, "user-1", model.IdempotentOperationPayOrder, "key-1", createRequest{},
+		func(context.Context) (model.Order, error) {
+			return model.Order{}, expectedErr
+		})
+
+	s.ErrorIs(err, expectedErr)
+}
@@ -0,0 +1,35 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/space-wanderer/microservices/order/internal/repository"
+)
+
+type service struct {
+	idempotencyRepository repository.IdempotencyRepository
+	lockTTL               time.Duration
+
+	keyTTL          time.Duration
+	cleanupInterval time.Duration
+	batchSize       int
+}
+
+// NewService создает сервис идемпотентности. lockTTL - время, после которого
+// незавершенный запрос считается потерянным и ключ можно захватить повторно,
+// keyTTL - время хранения ключа, после которого он удаляется фоновой очисткой
+func NewService(
+	idempotencyRepository repository.IdempotencyRepository,
+	lockTTL time.Duration,
+	keyTTL time.Duration,
+	cleanupInterval time.Duration,
+	batchSize int,
+) *service {
+	return &service{
+		idempotencyRepository: idempotencyRepository,
+		lockTTL:               lockTTL,
+		keyTTL:                keyTTL,
+		cleanupInterval:       cleanupInterval,
+		batchSize:             batchSize,
+	}
+}
@@ -0,0 +1,30 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/space-wanderer/microservices/order/internal/repository/mocks"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	idempotencyRepository *mocks.IdempotencyRepository
+	service               *service
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.Require().NoError(logger.Init("error", true))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.idempotencyRepository = mocks.NewIdempotencyRepository(s.T())
+	s.service = NewService(s.idempotencyRepository, 30*time.Second, 24*time.Hour, 10*time.Millisecond, 10)
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
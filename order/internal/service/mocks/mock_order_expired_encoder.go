@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+)
+
+// MockOrderExpiredEncoder is a mock of OrderExpiredEncoder interface.
+type MockOrderExpiredEncoder struct {
+	mock.Mock
+}
+
+// NewMockOrderExpiredEncoder creates a new mock instance.
+func NewMockOrderExpiredEncoder(t mock.TestingT) *MockOrderExpiredEncoder {
+	mock := &MockOrderExpiredEncoder{}
+	mock.Test(t)
+	return mock
+}
+
+// Encode mocks base method.
+func (m *MockOrderExpiredEncoder) Encode(event model.OrderExpiredEvent) ([]byte, error) {
+	args := m.Called(event)
+
+	var data []byte
+	if args.Get(0) != nil {
+		data = args.Get(0).([]byte)
+	}
+
+	return data, args.Error(1)
+}
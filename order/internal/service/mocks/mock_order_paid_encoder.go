@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+)
+
+// MockOrderPaidEncoder is a mock of OrderPaidEncoder interface.
+type MockOrderPaidEncoder struct {
+	mock.Mock
+}
+
+// NewMockOrderPaidEncoder creates a new mock instance.
+func NewMockOrderPaidEncoder(t mock.TestingT) *MockOrderPaidEncoder {
+	mock := &MockOrderPaidEncoder{}
+	mock.Test(t)
+	return mock
+}
+
+// Encode mocks base method.
+func (m *MockOrderPaidEncoder) Encode(event model.OrderPaidEvent) ([]byte, error) {
+	args := m.Called(event)
+
+	var data []byte
+	if args.Get(0) != nil {
+		data = args.Get(0).([]byte)
+	}
+
+	return data, args.Error(1)
+}
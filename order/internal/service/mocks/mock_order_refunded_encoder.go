@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+)
+
+// MockOrderRefundedEncoder is a mock of OrderRefundedEncoder interface.
+type MockOrderRefundedEncoder struct {
+	mock.Mock
+}
+
+// NewMockOrderRefundedEncoder creates a new mock instance.
+func NewMockOrderRefundedEncoder(t mock.TestingT) *MockOrderRefundedEncoder {
+	mock := &MockOrderRefundedEncoder{}
+	mock.Test(t)
+	return mock
+}
+
+// Encode mocks base method.
+func (m *MockOrderRefundedEncoder) Encode(event model.OrderRefundedEvent) ([]byte, error) {
+	args := m.Called(event)
+
+	var data []byte
+	if args.Get(0) != nil {
+		data = args.Get(0).([]byte)
+	}
+
+	return data, args.Error(1)
+}
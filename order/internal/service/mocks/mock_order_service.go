@@ -193,9 +193,135 @@ func (_c *OrderService_GetOrderByUuid_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod
-func (_m *OrderService) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod model.PaymentMethod) (model.Order, error) {
-	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod)
+// GetOrderHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderService) GetOrderHistory(ctx context.Context, orderUUID string) ([]model.StatusChange, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderHistory")
+	}
+
+	var r0 []model.StatusChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.StatusChange, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.StatusChange); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.StatusChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_GetOrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderHistory'
+type OrderService_GetOrderHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderService_Expecter) GetOrderHistory(ctx interface{}, orderUUID interface{}) *OrderService_GetOrderHistory_Call {
+	return &OrderService_GetOrderHistory_Call{Call: _e.mock.On("GetOrderHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderService_GetOrderHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_GetOrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_GetOrderHistory_Call) Return(_a0 []model.StatusChange, _a1 error) *OrderService_GetOrderHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_GetOrderHistory_Call) RunAndReturn(run func(context.Context, string) ([]model.StatusChange, error)) *OrderService_GetOrderHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter, page
+func (_m *OrderService) ListOrders(ctx context.Context, filter *model.OrdersFilter, page model.OrdersPageRequest) ([]model.Order, string, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []model.Order
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.OrdersFilter, model.OrdersPageRequest) ([]model.Order, string, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.OrdersFilter, model.OrdersPageRequest) []model.Order); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.OrdersFilter, model.OrdersPageRequest) string); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.OrdersFilter, model.OrdersPageRequest) error); ok {
+		r2 = rf(ctx, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrderService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.OrdersFilter
+//   - page model.OrdersPageRequest
+func (_e *OrderService_Expecter) ListOrders(ctx interface{}, filter interface{}, page interface{}) *OrderService_ListOrders_Call {
+	return &OrderService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter, page)}
+}
+
+func (_c *OrderService_ListOrders_Call) Run(run func(ctx context.Context, filter *model.OrdersFilter, page model.OrdersPageRequest)) *OrderService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.OrdersFilter), args[2].(model.OrdersPageRequest))
+	})
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) Return(_a0 []model.Order, _a1 string, _a2 error) *OrderService_ListOrders_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) RunAndReturn(run func(context.Context, *model.OrdersFilter, model.OrdersPageRequest) ([]model.Order, string, error)) *OrderService_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod, idempotencyKey
+func (_m *OrderService) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod model.PaymentMethod, idempotencyKey string) (model.Order, error) {
+	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -203,17 +329,17 @@ func (_m *OrderService) PayOrder(ctx context.Context, orderUUID string, userUUID
 
 	var r0 model.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod) (model.Order, error)); ok {
-		return rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod, string) (model.Order, error)); ok {
+		return rf(ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod) model.Order); ok {
-		r0 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod, string) model.Order); ok {
+		r0 = rf(ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(model.Order)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PaymentMethod) error); ok {
-		r1 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PaymentMethod, string) error); ok {
+		r1 = rf(ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -231,13 +357,14 @@ type OrderService_PayOrder_Call struct {
 //   - orderUUID string
 //   - userUUID string
 //   - paymentMethod model.PaymentMethod
-func (_e *OrderService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}) *OrderService_PayOrder_Call {
-	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod)}
+//   - idempotencyKey string
+func (_e *OrderService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}, idempotencyKey interface{}) *OrderService_PayOrder_Call {
+	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod, idempotencyKey)}
 }
 
-func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod model.PaymentMethod)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod model.PaymentMethod, idempotencyKey string)) *OrderService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PaymentMethod))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PaymentMethod), args[4].(string))
 	})
 	return _c
 }
@@ -247,7 +374,57 @@ func (_c *OrderService_PayOrder_Call) Return(_a0 model.Order, _a1 error) *OrderS
 	return _c
 }
 
-func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, model.PaymentMethod) (model.Order, error)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, model.PaymentMethod, string) (model.Order, error)) *OrderService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrderStatus provides a mock function with given fields: ctx, orderUUID, status, actor, reason
+func (_m *OrderService) UpdateOrderStatus(ctx context.Context, orderUUID string, status model.Status, actor model.Actor, reason string) error {
+	ret := _m.Called(ctx, orderUUID, status, actor, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.Status, model.Actor, string) error); ok {
+		r0 = rf(ctx, orderUUID, status, actor, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderService_UpdateOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderStatus'
+type OrderService_UpdateOrderStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - status model.Status
+//   - actor model.Actor
+//   - reason string
+func (_e *OrderService_Expecter) UpdateOrderStatus(ctx interface{}, orderUUID interface{}, status interface{}, actor interface{}, reason interface{}) *OrderService_UpdateOrderStatus_Call {
+	return &OrderService_UpdateOrderStatus_Call{Call: _e.mock.On("UpdateOrderStatus", ctx, orderUUID, status, actor, reason)}
+}
+
+func (_c *OrderService_UpdateOrderStatus_Call) Run(run func(ctx context.Context, orderUUID string, status model.Status, actor model.Actor, reason string)) *OrderService_UpdateOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.Status), args[3].(model.Actor), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_UpdateOrderStatus_Call) Return(_a0 error) *OrderService_UpdateOrderStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderService_UpdateOrderStatus_Call) RunAndReturn(run func(context.Context, string, model.Status, model.Actor, string) error) *OrderService_UpdateOrderStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
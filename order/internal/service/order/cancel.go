@@ -2,12 +2,23 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
 	"github.com/space-wanderer/microservices/order/internal/converter"
 	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
 )
 
+// cancelReason - причина отмены, сохраняемая в историю статусов и передаваемая
+// в PaymentService при возврате средств за оплаченный заказ
+const cancelReason = "order canceled"
+
+// CancelOrderByUuid отменяет заказ. Неоплаченный заказ отменяется с возвратом деталей на склад,
+// оплаченный, но еще не собранный или не прошедший сборку - с возвратом средств и деталей
 func (s *service) CancelOrderByUuid(ctx context.Context, orderUUID string) (model.Order, error) {
 	repoOrder, err := s.orderRepository.GetOrderByUuid(ctx, orderUUID)
 	if err != nil {
@@ -17,18 +28,125 @@ func (s *service) CancelOrderByUuid(ctx context.Context, orderUUID string) (mode
 	// Конвертируем в модель сервиса
 	order := converter.ConvertRepoOrderToModelOrder(repoOrder)
 
-	if order.Status == model.StatusPaid {
-		return model.Order{}, model.ErrOrderCannotBeCancelled
+	switch order.Status {
+	case model.StatusCanceled:
+		// Повторная отмена возвращает уже отмененный заказ
+		return *order, nil
+	case model.StatusPaid, model.StatusAssemblyFailed, model.StatusRefundPending:
+		return s.refundOrder(ctx, order)
 	}
 
-	order.Status = model.StatusCanceled
+	transition, err := transitionOrder(order, model.StatusCanceled, model.UserActor(order.UserUUID), cancelReason)
+	if err != nil {
+		return model.Order{}, model.ErrOrderCannotBeCancelled
+	}
 
-	// Конвертируем обратно в модель репозитория и сохраняем
+	// Заказ отменяется до возврата деталей: задача вернуть детали на склад сохраняется в outbox
+	// той же транзакцией, поэтому оплата, начатая параллельно, уже не переведет заказ в PAID
 	repoOrder = converter.ConvertModelOrderToRepoOrder(order)
-	err = s.orderRepository.UpdateOrder(ctx, repoOrder)
+	releaseTask := converter.ConvertReleaseReservationToOutboxEvent(uuid.New().String(), orderUUID)
+	err = s.orderRepository.UpdateOrderWithOutbox(ctx, repoOrder, transition, []*repoModel.OutboxEvent{releaseTask})
+	if errors.Is(err, repoModel.ErrOrderStatusChanged) {
+		// Заказ успели оплатить или отменить: отменяем его заново по новому статусу.
+		// Переходы статусов не образуют циклов, поэтому повторы конечны
+		return s.CancelOrderByUuid(ctx, orderUUID)
+	}
 	if err != nil {
 		return model.Order{}, fmt.Errorf("failed to update order: %w", err)
 	}
 
+	ordersCanceled.WithLabelValues("false").Inc()
+
 	return *order, nil
 }
+
+// refundOrder отменяет оплаченный заказ с возвратом средств. Сначала заказ переводится
+// в REFUND_PENDING, чтобы сборка не могла его завершить, затем средства возвращаются
+// через PaymentService. Если возврат не удался, заказ остается в REFUND_PENDING
+// и отмену можно повторить: ключ идемпотентности не даст вернуть средства дважды
+func (s *service) refundOrder(ctx context.Context, order *model.Order) (model.Order, error) {
+	if order.TransactionUUID == nil {
+		return model.Order{}, model.ErrOrderCannotBeCancelled
+	}
+
+	actor := model.UserActor(order.UserUUID)
+
+	if order.Status != model.StatusRefundPending {
+		transition, err := transitionOrder(order, model.StatusRefundPending, actor, cancelReason)
+		if err != nil {
+			return model.Order{}, model.ErrOrderCannotBeCancelled
+		}
+		err = s.orderRepository.UpdateOrder(ctx, converter.ConvertModelOrderToRepoOrder(order), transition)
+		if errors.Is(err, repoModel.ErrOrderStatusChanged) {
+			// Заказ успели собрать или отменить параллельным запросом
+			return model.Order{}, model.ErrOrderCannotBeCancelled
+		}
+		if err != nil {
+			return model.Order{}, fmt.Errorf("failed to update order: %w", err)
+		}
+	}
+
+	refund, err := s.paymentClient.RefundPayment(ctx, *order.TransactionUUID, decimal.Zero, cancelReason, refundIdempotencyKey(order.OrderUUID))
+	if err != nil {
+		return model.Order{}, fmt.Errorf("refund processing failed: %w", err)
+	}
+
+	transition, err := transitionOrder(order, model.StatusRefunded, actor, reasonRefundCompleted)
+	if err != nil {
+		return model.Order{}, model.ErrOrderCannotBeCancelled
+	}
+
+	// Событие OrderRefunded сохраняется в outbox вместе с заказом и отправляется в Kafka relay-ем
+	orderRefundedEvent := model.OrderRefundedEvent{
+		EventUUID:       uuid.New().String(),
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        order.UserUUID,
+		TransactionUUID: refund.TransactionUUID,
+		RefundUUID:      refund.RefundUUID,
+		Amount:          refund.Amount,
+	}
+
+	payload, err := s.orderRefundedEncoder.Encode(orderRefundedEvent)
+	if err != nil {
+		return model.Order{}, fmt.Errorf("failed to encode order refunded event: %w", err)
+	}
+
+	// Подтвержденные детали заказа возвращаются на склад задачей outbox после события о возврате средств
+	repoOrder := converter.ConvertModelOrderToRepoOrder(order)
+	outboxEvents := []*repoModel.OutboxEvent{
+		converter.ConvertOrderRefundedEventToOutboxEvent(orderRefundedEvent, payload),
+		converter.ConvertReleaseReservationToOutboxEvent(uuid.New().String(), order.OrderUUID),
+	}
+	err = s.orderRepository.UpdateOrderWithOutbox(ctx, repoOrder, transition, outboxEvents)
+	if errors.Is(err, repoModel.ErrOrderStatusChanged) {
+		// Параллельная отмена уже завершила возврат и сохранила событие
+		return s.refundedOrder(ctx, order.OrderUUID)
+	}
+	if err != nil {
+		return model.Order{}, fmt.Errorf("failed to update order: %w", err)
+	}
+
+	ordersCanceled.WithLabelValues("true").Inc()
+
+	return *order, nil
+}
+
+// refundedOrder возвращает заказ, возврат по которому завершил параллельный запрос
+func (s *service) refundedOrder(ctx context.Context, orderUUID string) (model.Order, error) {
+	repoOrder, err := s.orderRepository.GetOrderByUuid(ctx, orderUUID)
+	if err != nil {
+		return model.Order{}, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	order := converter.ConvertRepoOrderToModelOrder(repoOrder)
+	if order.Status != model.StatusRefunded {
+		return model.Order{}, model.ErrOrderCannotBeCancelled
+	}
+
+	return *order, nil
+}
+
+// refundIdempotencyKey - ключ возврата по заказу: у заказа может быть только один полный возврат
+func refundIdempotencyKey(orderUUID string) string {
+	return "refund:" + orderUUID
+}
@@ -5,34 +5,40 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/space-wanderer/microservices/order/internal/client/grpc/mocks"
 	"github.com/space-wanderer/microservices/order/internal/model"
 	repoMocks "github.com/space-wanderer/microservices/order/internal/repository/mocks"
 	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	serviceMocks "github.com/space-wanderer/microservices/order/internal/service/mocks"
 )
 
 type CancelOrderTestSuite struct {
 	suite.Suite
-	orderRepository *repoMocks.OrderRepository
-	inventoryClient *mocks.InventoryClient
-	paymentClient   *mocks.PaymentClient
-	service         *service
+	orderRepository      *repoMocks.OrderRepository
+	inventoryClient      *mocks.InventoryClient
+	paymentClient        *mocks.PaymentClient
+	orderRefundedEncoder *serviceMocks.MockOrderRefundedEncoder
+	service              *service
 }
 
 func (s *CancelOrderTestSuite) SetupTest() {
 	s.orderRepository = repoMocks.NewOrderRepository(s.T())
 	s.inventoryClient = mocks.NewInventoryClient(s.T())
 	s.paymentClient = mocks.NewPaymentClient(s.T())
-	s.service = NewOrderService(s.orderRepository, s.inventoryClient, s.paymentClient, nil)
+	s.orderRefundedEncoder = serviceMocks.NewMockOrderRefundedEncoder(s.T())
+	s.service = NewOrderService(s.orderRepository, s.inventoryClient, s.paymentClient, nil, s.orderRefundedEncoder)
 }
 
 func (s *CancelOrderTestSuite) TearDownTest() {
 	s.orderRepository.AssertExpectations(s.T())
 	s.inventoryClient.AssertExpectations(s.T())
 	s.paymentClient.AssertExpectations(s.T())
+	s.orderRefundedEncoder.AssertExpectations(s.T())
 }
 
 func TestCancelOrderTestSuite(t *testing.T) {
@@ -49,7 +55,7 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_Success() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPendingPayment,
@@ -59,7 +65,7 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_Success() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   model.PaymentMethodCard,
 		Status:          model.StatusCanceled,
@@ -69,14 +75,14 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_Success() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusCanceled,
 	}
 
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
-	s.orderRepository.On("UpdateOrder", ctx, expectedRepoOrder).Return(nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, expectedRepoOrder, matchTransition(repoModel.StatusPendingPayment, repoModel.StatusCanceled), matchReleaseTask(orderUUID)).Return(nil)
 
 	// Act
 	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
@@ -103,7 +109,7 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_GetOrderError() {
 	assert.Equal(s.T(), model.Order{}, result)
 }
 
-func (s *CancelOrderTestSuite) TestCancelOrderByUuid_OrderAlreadyPaid() {
+func (s *CancelOrderTestSuite) TestCancelOrderByUuid_OrderAssembled() {
 	// Arrange
 	ctx := context.Background()
 	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
@@ -114,10 +120,10 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_OrderAlreadyPaid() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   repoModel.PaymentMethodCard,
-		Status:          repoModel.StatusPaid, // Уже оплачен
+		Status:          repoModel.StatusAssembled, // Уже собран
 	}
 
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
@@ -142,7 +148,7 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_UpdateOrderError() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPendingPayment,
@@ -152,14 +158,14 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_UpdateOrderError() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusCanceled,
 	}
 
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
-	s.orderRepository.On("UpdateOrder", ctx, expectedRepoOrder).Return(expectedError)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, expectedRepoOrder, matchTransition(repoModel.StatusPendingPayment, repoModel.StatusCanceled), matchReleaseTask(orderUUID)).Return(expectedError)
 
 	// Act
 	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
@@ -180,7 +186,7 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_AlreadyCanceled() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusCanceled, // Уже отменен
@@ -190,31 +196,22 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_AlreadyCanceled() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   model.PaymentMethodCard,
 		Status:          model.StatusCanceled,
 	}
 
-	expectedRepoOrder := &repoModel.Order{
-		OrderUUID:       orderUUID,
-		UserUUID:        userUUID,
-		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
-		TransactionUUID: nil,
-		PaymentMethod:   repoModel.PaymentMethodCard,
-		Status:          repoModel.StatusCanceled,
-	}
-
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
-	s.orderRepository.On("UpdateOrder", ctx, expectedRepoOrder).Return(nil)
 
 	// Act
 	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
 
-	// Assert
+	// Assert: повторная отмена не трогает резерв и не пишет историю
 	assert.NoError(s.T(), err)
 	assert.Equal(s.T(), expectedModelOrder, result)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReleaseReservation", mock.Anything, mock.Anything)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrderWithOutbox", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (s *CancelOrderTestSuite) TestCancelOrderByUuid_EmptyUUID() {
@@ -244,7 +241,7 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_WithTransactionUUID() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   repoModel.PaymentMethodSBP,
 		Status:          repoModel.StatusPendingPayment,
@@ -254,7 +251,7 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_WithTransactionUUID() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   model.PaymentMethodSBP,
 		Status:          model.StatusCanceled,
@@ -264,14 +261,14 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_WithTransactionUUID() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   repoModel.PaymentMethodSBP,
 		Status:          repoModel.StatusCanceled,
 	}
 
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
-	s.orderRepository.On("UpdateOrder", ctx, expectedRepoOrder).Return(nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, expectedRepoOrder, matchTransition(repoModel.StatusPendingPayment, repoModel.StatusCanceled), matchReleaseTask(orderUUID)).Return(nil)
 
 	// Act
 	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
@@ -280,3 +277,247 @@ func (s *CancelOrderTestSuite) TestCancelOrderByUuid_WithTransactionUUID() {
 	assert.NoError(s.T(), err)
 	assert.Equal(s.T(), expectedModelOrder, result)
 }
+
+func (s *CancelOrderTestSuite) TestCancelOrderByUuid_PaidConcurrently() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+
+	pendingOrder := &repoModel.Order{
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		TotalPrice:    decimal.RequireFromString("150.5"),
+		PaymentMethod: repoModel.PaymentMethodCard,
+		Status:        repoModel.StatusPendingPayment,
+	}
+	paidOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusPaid,
+	}
+
+	// Заказ оплатили между чтением и отменой: он отменяется заново, уже с возвратом средств
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(pendingOrder, nil).Once()
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"),
+		matchTransition(repoModel.StatusPendingPayment, repoModel.StatusCanceled), matchReleaseTask(orderUUID)).
+		Return(repoModel.ErrOrderStatusChanged)
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(paidOrder, nil).Once()
+	s.orderRepository.On("UpdateOrder", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPaid, repoModel.StatusRefundPending)).Return(nil)
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, cancelReason, "refund:"+orderUUID).
+		Return(model.Refund{RefundUUID: "550e8400-e29b-41d4-a716-446655440004", TransactionUUID: transactionUUID, Amount: decimal.RequireFromString("150.5")}, nil)
+	s.orderRefundedEncoder.On("Encode", mock.AnythingOfType("model.OrderRefundedEvent")).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"),
+		matchTransition(repoModel.StatusRefundPending, repoModel.StatusRefunded), mock.AnythingOfType("[]*model.OutboxEvent")).Return(nil)
+
+	// Act
+	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
+
+	// Assert: детали не возвращаются на склад в обход оплаты
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.StatusRefunded, result.Status)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReleaseReservation", mock.Anything, mock.Anything)
+}
+
+func (s *CancelOrderTestSuite) TestCancelOrderByUuid_PaidOrderRefunded() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	refundUUID := "550e8400-e29b-41d4-a716-446655440004"
+	payload := []byte("order-refunded-event")
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusPaid,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	s.orderRepository.On("UpdateOrder", ctx, mock.MatchedBy(func(order *repoModel.Order) bool {
+		return order.Status == repoModel.StatusRefundPending
+	}), matchTransition(repoModel.StatusPaid, repoModel.StatusRefundPending)).Return(nil)
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, cancelReason, "refund:"+orderUUID).
+		Return(model.Refund{RefundUUID: refundUUID, TransactionUUID: transactionUUID, Amount: decimal.RequireFromString("150.5")}, nil)
+	s.orderRefundedEncoder.On("Encode", mock.MatchedBy(func(event model.OrderRefundedEvent) bool {
+		return event.OrderUUID == orderUUID &&
+			event.UserUUID == userUUID &&
+			event.TransactionUUID == transactionUUID &&
+			event.RefundUUID == refundUUID &&
+			event.Amount.Equal(decimal.RequireFromString("150.5"))
+	})).Return(payload, nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.MatchedBy(func(order *repoModel.Order) bool {
+		return order.Status == repoModel.StatusRefunded
+	}), matchTransition(repoModel.StatusRefundPending, repoModel.StatusRefunded), mock.MatchedBy(func(events []*repoModel.OutboxEvent) bool {
+		return len(events) == 2 &&
+			events[0].AggregateUUID == orderUUID &&
+			events[0].EventType == repoModel.OutboxEventTypeOrderRefunded &&
+			string(events[0].Payload) == string(payload) &&
+			events[1].AggregateUUID == orderUUID &&
+			events[1].EventType == repoModel.OutboxEventTypeReleaseReservation
+	})).Return(nil)
+
+	// Act
+	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.StatusRefunded, result.Status)
+}
+
+func (s *CancelOrderTestSuite) TestCancelOrderByUuid_AssemblyFailedOrderRefunded() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusAssemblyFailed,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	s.orderRepository.On("UpdateOrder", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusAssemblyFailed, repoModel.StatusRefundPending)).
+		Return(nil)
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, cancelReason, "refund:"+orderUUID).
+		Return(model.Refund{RefundUUID: "550e8400-e29b-41d4-a716-446655440004", TransactionUUID: transactionUUID, Amount: decimal.RequireFromString("150.5")}, nil)
+	s.orderRefundedEncoder.On("Encode", mock.AnythingOfType("model.OrderRefundedEvent")).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"),
+		matchTransition(repoModel.StatusRefundPending, repoModel.StatusRefunded), mock.AnythingOfType("[]*model.OutboxEvent")).Return(nil)
+
+	// Act
+	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.StatusRefunded, result.Status)
+}
+
+func (s *CancelOrderTestSuite) TestCancelOrderByUuid_RefundFailedKeepsRefundPending() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusPaid,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	s.orderRepository.On("UpdateOrder", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPaid, repoModel.StatusRefundPending)).Return(nil)
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, cancelReason, "refund:"+orderUUID).
+		Return(model.Refund{}, model.ErrPaymentUnavailable)
+
+	// Act
+	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
+
+	// Assert: заказ остается в REFUND_PENDING, событие не сохраняется
+	assert.ErrorIs(s.T(), err, model.ErrPaymentUnavailable)
+	assert.Equal(s.T(), model.Order{}, result)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrderWithOutbox", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *CancelOrderTestSuite) TestCancelOrderByUuid_RetryRefundPending() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusRefundPending,
+	}
+
+	// Повтор отмены сразу обращается к PaymentService с тем же ключом
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, cancelReason, "refund:"+orderUUID).
+		Return(model.Refund{RefundUUID: "550e8400-e29b-41d4-a716-446655440004", TransactionUUID: transactionUUID, Amount: decimal.RequireFromString("150.5")}, nil)
+	s.orderRefundedEncoder.On("Encode", mock.AnythingOfType("model.OrderRefundedEvent")).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusRefundPending, repoModel.StatusRefunded), mock.AnythingOfType("[]*model.OutboxEvent")).
+		Return(nil)
+
+	// Act
+	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.StatusRefunded, result.Status)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *CancelOrderTestSuite) TestCancelOrderByUuid_PaidOrderAssembledConcurrently() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusPaid,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	s.orderRepository.On("UpdateOrder", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPaid, repoModel.StatusRefundPending)).
+		Return(repoModel.ErrOrderStatusChanged)
+
+	// Act
+	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrOrderCannotBeCancelled)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *CancelOrderTestSuite) TestCancelOrderByUuid_RefundedOrder() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(&repoModel.Order{
+		OrderUUID: orderUUID,
+		Status:    repoModel.StatusRefunded,
+	}, nil)
+
+	// Act
+	result, err := s.service.CancelOrderByUuid(ctx, orderUUID)
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrOrderCannotBeCancelled)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+// matchReleaseTask проверяет, что вместе с отменой сохраняется только задача вернуть детали заказа на склад
+func matchReleaseTask(orderUUID string) interface{} {
+	return mock.MatchedBy(func(events []*repoModel.OutboxEvent) bool {
+		return len(events) == 1 &&
+			events[0].AggregateUUID == orderUUID &&
+			events[0].EventType == repoModel.OutboxEventTypeReleaseReservation &&
+			string(events[0].Payload) == orderUUID
+	})
+}
@@ -2,65 +2,191 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
 
 	"github.com/space-wanderer/microservices/order/internal/converter"
 	"github.com/space-wanderer/microservices/order/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
 )
 
+// partsLookupTimeout ограничивает запрос цен деталей в inventory при создании заказа
+const partsLookupTimeout = 3 * time.Second
+
+// maxTotalPrice - наибольшая сумма, которая помещается в колонку total_price DECIMAL(10,2)
+var maxTotalPrice = decimal.RequireFromString("99999999.99")
+
 func (s *service) CreateOrder(ctx context.Context, req model.Order) (model.Order, error) {
-	repoOrder := converter.ConvertModelOrderToRepoOrder(&req)
-	orderUUID, err := s.orderRepository.CreateOrder(ctx, repoOrder)
+	items, err := orderItems(req)
 	if err != nil {
 		return model.Order{}, err
 	}
 
-	// Конвертируем []string в []uuid.UUID через converter
-	partUUIDs, err := converter.ConvertStringSliceToUUIDSlice(req.PartUuids)
+	items, totalPrice, err := s.priceOrderItems(ctx, items)
 	if err != nil {
-		return model.Order{}, fmt.Errorf("ошибка при конвертации UUID: %w", err)
+		if errors.Is(err, model.ErrPartsNotFound) || errors.Is(err, model.ErrOrderTotalTooLarge) {
+			return model.Order{}, err
+		}
+		return model.Order{}, fmt.Errorf("ошибка при получении информации о деталях: %w", err)
 	}
 
-	totalPrice, err := s.calculateOrderPrice(ctx, partUUIDs)
+	// UUID заказа нужен до сохранения: по нему в inventory создается резерв. Если сервис упадет
+	// между резервом и сохранением заказа, резерв без заказа снимет InventoryService по сроку жизни
+	req.OrderUUID = uuid.New().String()
+	req.Items = items
+	req.PartUuids = itemPartUUIDs(items)
+	req.TotalPrice = totalPrice
+
+	err = s.inventoryClient.ReserveParts(ctx, req.OrderUUID, reservationItems(items))
 	if err != nil {
-		// Проверяем, является ли это business error
-		if model.ErrOrderNotFound.Error() == err.Error() {
+		if errors.Is(err, model.ErrInsufficientStock) {
 			return model.Order{}, err
 		}
-		return model.Order{}, fmt.Errorf("ошибка при получении информации о деталях: %w", err)
+		return model.Order{}, fmt.Errorf("failed to reserve parts: %w", err)
+	}
+
+	// Первая запись истории статусов сохраняется вместе с заказом
+	transition := converter.ConvertStatusChangeToRepoStatusTransition(model.StatusChange{
+		OrderUUID: req.OrderUUID,
+		To:        req.Status,
+		Actor:     model.UserActor(req.UserUUID),
+		Reason:    reasonOrderCreated,
+	})
+
+	repoOrder := converter.ConvertModelOrderToRepoOrder(&req)
+	orderUUID, err := s.orderRepository.CreateOrder(ctx, repoOrder, transition)
+	if err != nil {
+		// Заказ не сохранился, поэтому резерв возвращаем на склад
+		if releaseErr := s.inventoryClient.ReleaseReservation(ctx, req.OrderUUID); releaseErr != nil {
+			logger.Error(ctx, "failed to release reservation",
+				zap.String("order_uuid", req.OrderUUID),
+				zap.Error(releaseErr),
+			)
+		}
+		return model.Order{}, err
 	}
 
+	ordersCreated.Inc()
+
 	return model.Order{
 		OrderUUID:  orderUUID,
 		TotalPrice: totalPrice,
 	}, nil
 }
 
-func (s *service) calculateOrderPrice(ctx context.Context, partUuids []uuid.UUID) (float32, error) {
-	var totalPrice float32
-
-	for _, partUUID := range partUuids {
-		filter := model.PartsFilter{
-			Uuids: []string{partUUID.String()},
+// orderItems собирает позиции заказа: из items, а для старых клиентов — из part_uuids по одной детали.
+// UUID приводятся к каноническому виду, как в ответах inventory, повторы объединяются в одну позицию.
+// Количество детали, в том числе суммарное, не превышает MaxOrderItemQuantity
+func orderItems(req model.Order) ([]model.OrderItem, error) {
+	source := req.Items
+	if len(source) == 0 {
+		source = make([]model.OrderItem, 0, len(req.PartUuids))
+		for _, partUUID := range req.PartUuids {
+			source = append(source, model.OrderItem{PartUUID: partUUID, Quantity: 1})
 		}
+	}
 
-		ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
-		defer cancel()
+	if len(source) == 0 {
+		return nil, model.ErrInvalidOrderItems
+	}
 
-		parts, err := s.inventoryClient.ListParts(ctx, filter)
+	items := make([]model.OrderItem, 0, len(source))
+	index := make(map[string]int, len(source))
+	for _, item := range source {
+		partUUID, err := uuid.Parse(item.PartUUID)
 		if err != nil {
-			return 0, err
+			return nil, fmt.Errorf("%w: invalid part uuid %q", model.ErrInvalidOrderItems, item.PartUUID)
+		}
+		if item.Quantity <= 0 || item.Quantity > model.MaxOrderItemQuantity {
+			return nil, fmt.Errorf("%w: quantity of part %s is %d", model.ErrInvalidOrderItems, partUUID, item.Quantity)
+		}
+
+		if i, ok := index[partUUID.String()]; ok {
+			// Обе величины не больше MaxOrderItemQuantity, поэтому сравнение не переполняется
+			if items[i].Quantity > model.MaxOrderItemQuantity-item.Quantity {
+				return nil, fmt.Errorf("%w: total quantity of part %s exceeds %d", model.ErrInvalidOrderItems, partUUID, model.MaxOrderItemQuantity)
+			}
+			items[i].Quantity += item.Quantity
+			continue
 		}
+		index[partUUID.String()] = len(items)
+		items = append(items, model.OrderItem{PartUUID: partUUID.String(), Quantity: item.Quantity})
+	}
+
+	return items, nil
+}
+
+// itemPartUUIDs собирает UUID деталей для колонки part_uuids. Позиции уже объединены по детали,
+// поэтому UUID не повторяются, а количество хранится только в позициях
+func itemPartUUIDs(items []model.OrderItem) []string {
+	partUUIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		partUUIDs = append(partUUIDs, item.PartUUID)
+	}
+	return partUUIDs
+}
+
+// reservationItems переводит позиции заказа в позиции резерва inventory
+func reservationItems(items []model.OrderItem) []model.ReservationItem {
+	reservation := make([]model.ReservationItem, 0, len(items))
+	for _, item := range items {
+		reservation = append(reservation, model.ReservationItem{PartUUID: item.PartUUID, Quantity: item.Quantity})
+	}
+	return reservation
+}
+
+// priceOrderItems запрашивает все детали заказа одним ListParts, сохраняет в позициях цену и название
+// детали на момент оформления и считает итог. Цена округляется до копеек, как в колонках DECIMAL(10,2)
+func (s *service) priceOrderItems(ctx context.Context, items []model.OrderItem) ([]model.OrderItem, decimal.Decimal, error) {
+	partUUIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		partUUIDs = append(partUUIDs, item.PartUUID)
+	}
 
-		if len(parts) == 0 {
-			return 0, model.ErrOrderNotFound
+	ctx, cancel := context.WithTimeout(ctx, partsLookupTimeout)
+	defer cancel()
+
+	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{Uuids: partUUIDs})
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	partsByUUID := make(map[string]*model.Part, len(parts))
+	for _, part := range parts {
+		partsByUUID[part.UUID] = part
+	}
+
+	priced := make([]model.OrderItem, 0, len(items))
+	totalPrice := decimal.Zero
+	var missing []string
+	for _, item := range items {
+		part, ok := partsByUUID[item.PartUUID]
+		if !ok {
+			missing = append(missing, item.PartUUID)
+			continue
 		}
 
-		totalPrice += float32(parts[0].Price)
+		unitPrice := decimal.NewFromFloat(part.Price).Round(2)
+		item.UnitPrice = &unitPrice
+		item.PartName = part.Name
+		priced = append(priced, item)
+
+		totalPrice = totalPrice.Add(unitPrice.Mul(decimal.NewFromInt(item.Quantity)))
+	}
+
+	if len(missing) > 0 {
+		return nil, decimal.Zero, fmt.Errorf("%w: %s", model.ErrPartsNotFound, strings.Join(missing, ", "))
+	}
+
+	if totalPrice.GreaterThan(maxTotalPrice) {
+		return nil, decimal.Zero, fmt.Errorf("%w: %s exceeds %s", model.ErrOrderTotalTooLarge, totalPrice.StringFixed(2), maxTotalPrice.StringFixed(2))
 	}
 
-	return totalPrice, nil
+	return priced, totalPrice, nil
 }
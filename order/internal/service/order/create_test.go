@@ -3,8 +3,10 @@ package order
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -27,7 +29,7 @@ func (s *CreateOrderTestSuite) SetupTest() {
 	s.orderRepository = repoMocks.NewOrderRepository(s.T())
 	s.inventoryClient = mocks.NewInventoryClient(s.T())
 	s.paymentClient = mocks.NewPaymentClient(s.T())
-	s.service = NewOrderService(s.orderRepository, s.inventoryClient, s.paymentClient, nil)
+	s.service = NewOrderService(s.orderRepository, s.inventoryClient, s.paymentClient, nil, nil)
 }
 
 func (s *CreateOrderTestSuite) TearDownTest() {
@@ -49,37 +51,45 @@ func (s *CreateOrderTestSuite) TestCreateOrder_Success() {
 		OrderUUID:       "",
 		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      0,
+		TotalPrice:      decimal.Zero,
 		TransactionUUID: nil,
 		PaymentMethod:   model.PaymentMethodCard,
 		Status:          model.StatusPendingPayment,
 	}
 
-	expectedRepoOrder := &repoModel.Order{
-		OrderUUID:       "",
-		UserUUID:        req.UserUUID,
-		PartUuids:       req.PartUuids,
-		TotalPrice:      0,
-		TransactionUUID: nil,
-		PaymentMethod:   repoModel.PaymentMethodCard,
-		Status:          repoModel.StatusPendingPayment,
-	}
-
 	expectedPart := &model.Part{
 		UUID:  "550e8400-e29b-41d4-a716-446655440002",
 		Name:  "Test Part",
 		Price: 150.5,
 	}
 
+	expectedRepoOrder := repoModel.Order{
+		UserUUID:  req.UserUUID,
+		PartUuids: req.PartUuids,
+		Items: []repoModel.OrderItem{
+			{PartUUID: expectedPart.UUID, Quantity: 1, UnitPrice: decimalPtr("150.50"), PartName: expectedPart.Name},
+		},
+		TotalPrice:      decimal.RequireFromString("150.50"),
+		TransactionUUID: nil,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusPendingPayment,
+	}
+
 	expectedResult := model.Order{
 		OrderUUID:  orderUUID,
-		TotalPrice: float32(expectedPart.Price),
+		TotalPrice: decimal.RequireFromString("150.50"),
 	}
 
-	s.orderRepository.On("CreateOrder", ctx, expectedRepoOrder).Return(orderUUID, nil)
+	var reservedOrderUUID string
 	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
 		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002"},
 	}).Return([]*model.Part{expectedPart}, nil)
+	s.inventoryClient.On("ReserveParts", ctx, mock.AnythingOfType("string"), []model.ReservationItem{
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 1},
+	}).Run(func(args mock.Arguments) {
+		reservedOrderUUID = args.String(1)
+	}).Return(nil)
+	s.orderRepository.On("CreateOrder", ctx, matchRepoOrder(expectedRepoOrder), matchTransition("", repoModel.StatusPendingPayment)).Return(orderUUID, nil)
 
 	// Act
 	result, err := s.service.CreateOrder(ctx, req)
@@ -87,6 +97,7 @@ func (s *CreateOrderTestSuite) TestCreateOrder_Success() {
 	// Assert
 	assert.NoError(s.T(), err)
 	assert.Equal(s.T(), expectedResult, result)
+	assert.NotEmpty(s.T(), reservedOrderUUID)
 }
 
 func (s *CreateOrderTestSuite) TestCreateOrder_RepositoryError() {
@@ -98,23 +109,41 @@ func (s *CreateOrderTestSuite) TestCreateOrder_RepositoryError() {
 		OrderUUID:       "",
 		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      0,
+		TotalPrice:      decimal.Zero,
 		TransactionUUID: nil,
 		PaymentMethod:   model.PaymentMethodCard,
 		Status:          model.StatusPendingPayment,
 	}
 
-	expectedRepoOrder := &repoModel.Order{
-		OrderUUID:       "",
-		UserUUID:        req.UserUUID,
-		PartUuids:       req.PartUuids,
-		TotalPrice:      0,
+	expectedPart := &model.Part{
+		UUID:  "550e8400-e29b-41d4-a716-446655440002",
+		Name:  "Test Part",
+		Price: 150.5,
+	}
+
+	expectedRepoOrder := repoModel.Order{
+		UserUUID:  req.UserUUID,
+		PartUuids: req.PartUuids,
+		Items: []repoModel.OrderItem{
+			{PartUUID: expectedPart.UUID, Quantity: 1, UnitPrice: decimalPtr("150.50"), PartName: expectedPart.Name},
+		},
+		TotalPrice:      decimal.RequireFromString("150.50"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPendingPayment,
 	}
 
-	s.orderRepository.On("CreateOrder", ctx, expectedRepoOrder).Return("", expectedError)
+	var reservedOrderUUID string
+	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
+		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002"},
+	}).Return([]*model.Part{expectedPart}, nil)
+	s.inventoryClient.On("ReserveParts", ctx, mock.AnythingOfType("string"), mock.Anything).Run(func(args mock.Arguments) {
+		reservedOrderUUID = args.String(1)
+	}).Return(nil)
+	s.orderRepository.On("CreateOrder", ctx, matchRepoOrder(expectedRepoOrder), matchTransition("", repoModel.StatusPendingPayment)).Return("", expectedError)
+	s.inventoryClient.On("ReleaseReservation", ctx, mock.MatchedBy(func(orderUUID string) bool {
+		return orderUUID == reservedOrderUUID
+	})).Return(nil)
 
 	// Act
 	result, err := s.service.CreateOrder(ctx, req)
@@ -128,30 +157,18 @@ func (s *CreateOrderTestSuite) TestCreateOrder_RepositoryError() {
 func (s *CreateOrderTestSuite) TestCreateOrder_InventoryClientError() {
 	// Arrange
 	ctx := context.Background()
-	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
 	expectedError := errors.New("inventory service error")
 
 	req := model.Order{
 		OrderUUID:       "",
 		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      0,
+		TotalPrice:      decimal.Zero,
 		TransactionUUID: nil,
 		PaymentMethod:   model.PaymentMethodCard,
 		Status:          model.StatusPendingPayment,
 	}
 
-	expectedRepoOrder := &repoModel.Order{
-		OrderUUID:       "",
-		UserUUID:        req.UserUUID,
-		PartUuids:       req.PartUuids,
-		TotalPrice:      0,
-		TransactionUUID: nil,
-		PaymentMethod:   repoModel.PaymentMethodCard,
-		Status:          repoModel.StatusPendingPayment,
-	}
-
-	s.orderRepository.On("CreateOrder", ctx, expectedRepoOrder).Return(orderUUID, nil)
 	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
 		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002"},
 	}).Return([]*model.Part(nil), expectedError)
@@ -168,40 +185,264 @@ func (s *CreateOrderTestSuite) TestCreateOrder_InventoryClientError() {
 func (s *CreateOrderTestSuite) TestCreateOrder_PartNotFound() {
 	// Arrange
 	ctx := context.Background()
-	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
 
 	req := model.Order{
 		OrderUUID:       "",
 		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      0,
+		TotalPrice:      decimal.Zero,
 		TransactionUUID: nil,
 		PaymentMethod:   model.PaymentMethodCard,
 		Status:          model.StatusPendingPayment,
 	}
 
-	expectedRepoOrder := &repoModel.Order{
+	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
+		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002"},
+	}).Return([]*model.Part{}, nil)
+
+	// Act
+	result, err := s.service.CreateOrder(ctx, req)
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrPartsNotFound)
+	assert.Contains(s.T(), err.Error(), "550e8400-e29b-41d4-a716-446655440002")
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *CreateOrderTestSuite) TestCreateOrder_ReportsOnlyMissingParts() {
+	// Arrange
+	ctx := context.Background()
+
+	req := model.Order{
+		UserUUID: "550e8400-e29b-41d4-a716-446655440001",
+		PartUuids: []string{
+			"550e8400-e29b-41d4-a716-446655440002",
+			"550e8400-e29b-41d4-a716-446655440003",
+			"550e8400-e29b-41d4-a716-446655440004",
+		},
+		PaymentMethod: model.PaymentMethodCard,
+		Status:        model.StatusPendingPayment,
+	}
+
+	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
+		Uuids: req.PartUuids,
+	}).Return([]*model.Part{{UUID: "550e8400-e29b-41d4-a716-446655440003", Price: 10}}, nil)
+
+	// Act
+	result, err := s.service.CreateOrder(ctx, req)
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrPartsNotFound)
+	assert.Contains(s.T(), err.Error(), "550e8400-e29b-41d4-a716-446655440002, 550e8400-e29b-41d4-a716-446655440004")
+	assert.NotContains(s.T(), err.Error(), "550e8400-e29b-41d4-a716-446655440003")
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *CreateOrderTestSuite) TestCreateOrder_ExactDecimalTotal() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+
+	// В float32 сумма 0.1 * 3 + 0.2 теряет копейки
+	req := model.Order{
+		UserUUID: "550e8400-e29b-41d4-a716-446655440001",
+		PartUuids: []string{
+			"550E8400-E29B-41D4-A716-446655440002",
+			"550e8400-e29b-41d4-a716-446655440002",
+			"550e8400-e29b-41d4-a716-446655440002",
+			"550e8400-e29b-41d4-a716-446655440003",
+		},
+		PaymentMethod: model.PaymentMethodCard,
+		Status:        model.StatusPendingPayment,
+	}
+
+	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
+		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002", "550e8400-e29b-41d4-a716-446655440003"},
+	}).Return([]*model.Part{
+		{UUID: "550e8400-e29b-41d4-a716-446655440002", Price: 0.1},
+		{UUID: "550e8400-e29b-41d4-a716-446655440003", Price: 0.2},
+	}, nil)
+	s.inventoryClient.On("ReserveParts", ctx, mock.AnythingOfType("string"), []model.ReservationItem{
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 3},
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440003", Quantity: 1},
+	}).Return(nil)
+	s.orderRepository.On("CreateOrder", ctx, mock.MatchedBy(func(order *repoModel.Order) bool {
+		return order.TotalPrice.StringFixed(2) == "0.50"
+	}), mock.Anything).Return(orderUUID, nil)
+
+	// Act
+	result, err := s.service.CreateOrder(ctx, req)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "0.50", result.TotalPrice.StringFixed(2))
+}
+
+func (s *CreateOrderTestSuite) TestCreateOrder_ItemsWithQuantities() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+
+	req := model.Order{
+		UserUUID: "550e8400-e29b-41d4-a716-446655440001",
+		Items: []model.OrderItem{
+			{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 3},
+			{PartUUID: "550e8400-e29b-41d4-a716-446655440003", Quantity: 1},
+			{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 2},
+		},
+		PaymentMethod: model.PaymentMethodCard,
+		Status:        model.StatusPendingPayment,
+	}
+
+	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
+		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002", "550e8400-e29b-41d4-a716-446655440003"},
+	}).Return([]*model.Part{
+		{UUID: "550e8400-e29b-41d4-a716-446655440002", Name: "Крыло", Price: 10.25},
+		{UUID: "550e8400-e29b-41d4-a716-446655440003", Name: "Иллюминатор", Price: 99.99},
+	}, nil)
+	s.inventoryClient.On("ReserveParts", ctx, mock.AnythingOfType("string"), []model.ReservationItem{
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 5},
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440003", Quantity: 1},
+	}).Return(nil)
+	s.orderRepository.On("CreateOrder", ctx, mock.MatchedBy(func(order *repoModel.Order) bool {
+		return len(order.Items) == 2 &&
+			order.Items[0].Quantity == 5 && order.Items[0].PartName == "Крыло" && order.Items[0].UnitPrice.StringFixed(2) == "10.25" &&
+			order.Items[1].Quantity == 1 && order.Items[1].PartName == "Иллюминатор" &&
+			len(order.PartUuids) == 2 &&
+			order.TotalPrice.StringFixed(2) == "151.24"
+	}), mock.Anything).Return(orderUUID, nil)
+
+	// Act
+	result, err := s.service.CreateOrder(ctx, req)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "151.24", result.TotalPrice.StringFixed(2))
+}
+
+func (s *CreateOrderTestSuite) TestCreateOrder_InvalidItemQuantity() {
+	// Arrange
+	ctx := context.Background()
+
+	req := model.Order{
+		UserUUID: "550e8400-e29b-41d4-a716-446655440001",
+		Items: []model.OrderItem{
+			{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 0},
+		},
+		Status: model.StatusPendingPayment,
+	}
+
+	// Act
+	result, err := s.service.CreateOrder(ctx, req)
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrInvalidOrderItems)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *CreateOrderTestSuite) TestCreateOrder_QuantityLimit() {
+	tests := []struct {
+		name  string
+		items []model.OrderItem
+	}{
+		{
+			name: "Количество позиции больше максимального",
+			items: []model.OrderItem{
+				{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: model.MaxOrderItemQuantity + 1},
+			},
+		},
+		{
+			name: "Суммарное количество повторяющихся позиций больше максимального",
+			items: []model.OrderItem{
+				{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: model.MaxOrderItemQuantity},
+				{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 1},
+			},
+		},
+		{
+			name: "Переполнение int64 при объединении позиций",
+			items: []model.OrderItem{
+				{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: math.MaxInt64},
+				{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: math.MaxInt64},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result, err := s.service.CreateOrder(context.Background(), model.Order{
+				UserUUID: "550e8400-e29b-41d4-a716-446655440001",
+				Items:    tt.items,
+				Status:   model.StatusPendingPayment,
+			})
+
+			assert.ErrorIs(s.T(), err, model.ErrInvalidOrderItems)
+			assert.Equal(s.T(), model.Order{}, result)
+		})
+	}
+}
+
+func (s *CreateOrderTestSuite) TestCreateOrder_TotalPriceTooLarge() {
+	// Arrange
+	ctx := context.Background()
+
+	req := model.Order{
+		UserUUID: "550e8400-e29b-41d4-a716-446655440001",
+		Items: []model.OrderItem{
+			{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: model.MaxOrderItemQuantity},
+		},
+		Status: model.StatusPendingPayment,
+	}
+
+	// 10000 * 10000.00 не помещается в DECIMAL(10,2)
+	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
+		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002"},
+	}).Return([]*model.Part{{UUID: "550e8400-e29b-41d4-a716-446655440002", Price: 10000}}, nil)
+
+	// Act
+	result, err := s.service.CreateOrder(ctx, req)
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrOrderTotalTooLarge)
+	assert.Equal(s.T(), model.Order{}, result)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReserveParts", mock.Anything, mock.Anything, mock.Anything)
+	s.orderRepository.AssertNotCalled(s.T(), "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *CreateOrderTestSuite) TestCreateOrder_NoItems() {
+	// Act
+	result, err := s.service.CreateOrder(context.Background(), model.Order{UserUUID: "550e8400-e29b-41d4-a716-446655440001"})
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrInvalidOrderItems)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *CreateOrderTestSuite) TestCreateOrder_InsufficientStock() {
+	// Arrange
+	ctx := context.Background()
+
+	req := model.Order{
 		OrderUUID:       "",
-		UserUUID:        req.UserUUID,
-		PartUuids:       req.PartUuids,
-		TotalPrice:      0,
+		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
+		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice:      decimal.Zero,
 		TransactionUUID: nil,
-		PaymentMethod:   repoModel.PaymentMethodCard,
-		Status:          repoModel.StatusPendingPayment,
+		PaymentMethod:   model.PaymentMethodCard,
+		Status:          model.StatusPendingPayment,
 	}
 
-	s.orderRepository.On("CreateOrder", ctx, expectedRepoOrder).Return(orderUUID, nil)
 	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
 		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002"},
-	}).Return([]*model.Part{}, nil)
+	}).Return([]*model.Part{{UUID: "550e8400-e29b-41d4-a716-446655440002", Price: 150.5}}, nil)
+	s.inventoryClient.On("ReserveParts", ctx, mock.AnythingOfType("string"), mock.Anything).Return(model.ErrInsufficientStock)
 
 	// Act
 	result, err := s.service.CreateOrder(ctx, req)
 
 	// Assert
-	assert.Error(s.T(), err)
-	assert.Equal(s.T(), model.ErrOrderNotFound, err)
+	assert.ErrorIs(s.T(), err, model.ErrInsufficientStock)
 	assert.Equal(s.T(), model.Order{}, result)
+	s.orderRepository.AssertNotCalled(s.T(), "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (s *CreateOrderTestSuite) TestCreateOrder_MultipleParts() {
@@ -215,23 +456,14 @@ func (s *CreateOrderTestSuite) TestCreateOrder_MultipleParts() {
 		PartUuids: []string{
 			"550e8400-e29b-41d4-a716-446655440002",
 			"550e8400-e29b-41d4-a716-446655440003",
+			"550e8400-e29b-41d4-a716-446655440002",
 		},
-		TotalPrice:      0,
+		TotalPrice:      decimal.Zero,
 		TransactionUUID: nil,
 		PaymentMethod:   model.PaymentMethodCard,
 		Status:          model.StatusPendingPayment,
 	}
 
-	expectedRepoOrder := &repoModel.Order{
-		OrderUUID:       "",
-		UserUUID:        req.UserUUID,
-		PartUuids:       req.PartUuids,
-		TotalPrice:      0,
-		TransactionUUID: nil,
-		PaymentMethod:   repoModel.PaymentMethodCard,
-		Status:          repoModel.StatusPendingPayment,
-	}
-
 	expectedParts := []*model.Part{
 		{
 			UUID:  "550e8400-e29b-41d4-a716-446655440002",
@@ -245,24 +477,42 @@ func (s *CreateOrderTestSuite) TestCreateOrder_MultipleParts() {
 		},
 	}
 
-	expectedTotalPrice := float32(expectedParts[0].Price + expectedParts[1].Price)
+	// 150.50 * 2 + 250.75
+	expectedTotalPrice := decimal.RequireFromString("551.75")
+
+	// Повторяющиеся детали объединяются в одну позицию со снимком цены и названия
+	expectedRepoOrder := repoModel.Order{
+		UserUUID: req.UserUUID,
+		PartUuids: []string{
+			"550e8400-e29b-41d4-a716-446655440002",
+			"550e8400-e29b-41d4-a716-446655440003",
+		},
+		Items: []repoModel.OrderItem{
+			{PartUUID: expectedParts[0].UUID, Quantity: 2, UnitPrice: decimalPtr("150.50"), PartName: expectedParts[0].Name},
+			{PartUUID: expectedParts[1].UUID, Quantity: 1, UnitPrice: decimalPtr("250.75"), PartName: expectedParts[1].Name},
+		},
+		TotalPrice:      expectedTotalPrice,
+		TransactionUUID: nil,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusPendingPayment,
+	}
 
 	expectedResult := model.Order{
 		OrderUUID:  orderUUID,
 		TotalPrice: expectedTotalPrice,
 	}
 
-	s.orderRepository.On("CreateOrder", ctx, expectedRepoOrder).Return(orderUUID, nil)
-
-	// Первый вызов для первой детали
+	// Все детали запрашиваются одним вызовом, повторяющиеся UUID — один раз
 	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
-		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002"},
-	}).Return([]*model.Part{expectedParts[0]}, nil)
+		Uuids: []string{"550e8400-e29b-41d4-a716-446655440002", "550e8400-e29b-41d4-a716-446655440003"},
+	}).Return(expectedParts, nil)
 
-	// Второй вызов для второй детали
-	s.inventoryClient.On("ListParts", mock.AnythingOfType("*context.timerCtx"), model.PartsFilter{
-		Uuids: []string{"550e8400-e29b-41d4-a716-446655440003"},
-	}).Return([]*model.Part{expectedParts[1]}, nil)
+	// Повторяющиеся детали резервируются одной позицией
+	s.inventoryClient.On("ReserveParts", ctx, mock.AnythingOfType("string"), []model.ReservationItem{
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 2},
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440003", Quantity: 1},
+	}).Return(nil)
+	s.orderRepository.On("CreateOrder", ctx, matchRepoOrder(expectedRepoOrder), matchTransition("", repoModel.StatusPendingPayment)).Return(orderUUID, nil)
 
 	// Act
 	result, err := s.service.CreateOrder(ctx, req)
@@ -270,5 +520,32 @@ func (s *CreateOrderTestSuite) TestCreateOrder_MultipleParts() {
 	// Assert
 	assert.NoError(s.T(), err)
 	assert.Equal(s.T(), expectedResult, result)
-	assert.Equal(s.T(), expectedTotalPrice, result.TotalPrice)
+	assert.True(s.T(), expectedTotalPrice.Equal(result.TotalPrice))
+}
+
+// matchRepoOrder сравнивает заказ без учета сгенерированного сервисом UUID
+func matchRepoOrder(expected repoModel.Order) interface{} {
+	return mock.MatchedBy(func(order *repoModel.Order) bool {
+		if order.OrderUUID == "" {
+			return false
+		}
+		if !expected.TotalPrice.Equal(order.TotalPrice) {
+			return false
+		}
+		expected.OrderUUID = order.OrderUUID
+		expected.TotalPrice = order.TotalPrice
+		return assert.ObjectsAreEqual(&expected, order)
+	})
+}
+
+func decimalPtr(value string) *decimal.Decimal {
+	d := decimal.RequireFromString(value)
+	return &d
+}
+
+// matchTransition проверяет смену статуса, переданную в репозиторий
+func matchTransition(from, to repoModel.Status) interface{} {
+	return mock.MatchedBy(func(transition *repoModel.StatusTransition) bool {
+		return transition != nil && transition.From == from && transition.To == to && transition.Actor != ""
+	})
 }
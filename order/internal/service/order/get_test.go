@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -26,7 +27,7 @@ func (s *GetOrderTestSuite) SetupTest() {
 	s.orderRepository = repoMocks.NewOrderRepository(s.T())
 	s.inventoryClient = mocks.NewInventoryClient(s.T())
 	s.paymentClient = mocks.NewPaymentClient(s.T())
-	s.service = NewOrderService(s.orderRepository, s.inventoryClient, s.paymentClient, nil)
+	s.service = NewOrderService(s.orderRepository, s.inventoryClient, s.paymentClient, nil, nil)
 }
 
 func (s *GetOrderTestSuite) TearDownTest() {
@@ -48,7 +49,7 @@ func (s *GetOrderTestSuite) TestGetOrderByUuid_Success() {
 		OrderUUID:       orderUUID,
 		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPendingPayment,
@@ -117,7 +118,7 @@ func (s *GetOrderTestSuite) TestGetOrderByUuid_WithTransactionUUID() {
 		OrderUUID:       orderUUID,
 		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      250.75,
+		TotalPrice:      decimal.RequireFromString("250.75"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   repoModel.PaymentMethodSBP,
 		Status:          repoModel.StatusPaid,
@@ -158,7 +159,7 @@ func (s *GetOrderTestSuite) TestGetOrderByUuid_MultipleParts() {
 		OrderUUID:       orderUUID,
 		UserUUID:        "550e8400-e29b-41d4-a716-446655440001",
 		PartUuids:       partUUIDs,
-		TotalPrice:      450.25,
+		TotalPrice:      decimal.RequireFromString("450.25"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCreditCard,
 		Status:          repoModel.StatusPendingPayment,
@@ -0,0 +1,23 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	"github.com/space-wanderer/microservices/order/internal/model"
+)
+
+// GetOrderHistory возвращает историю статусов заказа от самой ранней смены к последней
+func (s *service) GetOrderHistory(ctx context.Context, orderUUID string) ([]model.StatusChange, error) {
+	if _, err := s.orderRepository.GetOrderByUuid(ctx, orderUUID); err != nil {
+		return nil, model.ErrOrderNotFound
+	}
+
+	history, err := s.orderRepository.GetStatusHistory(ctx, orderUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order status history: %w", err)
+	}
+
+	return converter.ConvertRepoStatusHistoryToModel(history), nil
+}
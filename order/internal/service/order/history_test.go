@@ -0,0 +1,140 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoMocks "github.com/space-wanderer/microservices/order/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+type OrderHistoryTestSuite struct {
+	suite.Suite
+	orderRepository *repoMocks.OrderRepository
+	service         *service
+}
+
+func (s *OrderHistoryTestSuite) SetupTest() {
+	s.orderRepository = repoMocks.NewOrderRepository(s.T())
+	s.service = NewOrderService(s.orderRepository, nil, nil, nil, nil)
+}
+
+func (s *OrderHistoryTestSuite) TearDownTest() {
+	s.orderRepository.AssertExpectations(s.T())
+}
+
+func TestOrderHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderHistoryTestSuite))
+}
+
+func (s *OrderHistoryTestSuite) TestUpdateOrderStatus_Assembled() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(&repoModel.Order{
+		OrderUUID: orderUUID,
+		Status:    repoModel.StatusPaid,
+	}, nil)
+	s.orderRepository.On("UpdateOrder", ctx, &repoModel.Order{
+		OrderUUID:     orderUUID,
+		PaymentMethod: repoModel.PaymentMethodUnknown,
+		Status:        repoModel.StatusAssembled,
+	}, &repoModel.StatusTransition{
+		OrderUUID: orderUUID,
+		From:      repoModel.StatusPaid,
+		To:        repoModel.StatusAssembled,
+		Actor:     string(model.ActorAssembly),
+		Reason:    "ship assembled",
+	}).Return(nil)
+
+	// Act
+	err := s.service.UpdateOrderStatus(ctx, orderUUID, model.StatusAssembled, model.ActorAssembly, "ship assembled")
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *OrderHistoryTestSuite) TestUpdateOrderStatus_SameStatus() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(&repoModel.Order{
+		OrderUUID: orderUUID,
+		Status:    repoModel.StatusAssembled,
+	}, nil)
+
+	// Act
+	err := s.service.UpdateOrderStatus(ctx, orderUUID, model.StatusAssembled, model.ActorAssembly, "ship assembled")
+
+	// Assert: повторное событие не пишет историю
+	assert.NoError(s.T(), err)
+}
+
+func (s *OrderHistoryTestSuite) TestUpdateOrderStatus_InvalidTransition() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(&repoModel.Order{
+		OrderUUID: orderUUID,
+		Status:    repoModel.StatusCanceled,
+	}, nil)
+
+	// Act
+	err := s.service.UpdateOrderStatus(ctx, orderUUID, model.StatusAssembled, model.ActorAssembly, "ship assembled")
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrInvalidStatusTransition)
+	var transitionErr *model.StatusTransitionError
+	assert.ErrorAs(s.T(), err, &transitionErr)
+	assert.Equal(s.T(), model.StatusCanceled, transitionErr.From)
+	assert.Equal(s.T(), model.StatusAssembled, transitionErr.To)
+}
+
+func (s *OrderHistoryTestSuite) TestGetOrderHistory_Success() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userActor := "user:550e8400-e29b-41d4-a716-446655440001"
+	createdAt := time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(&repoModel.Order{OrderUUID: orderUUID}, nil)
+	s.orderRepository.On("GetStatusHistory", ctx, orderUUID).Return([]*repoModel.StatusTransition{
+		{OrderUUID: orderUUID, To: repoModel.StatusPendingPayment, Actor: userActor, Reason: "order created", CreatedAt: createdAt},
+		{OrderUUID: orderUUID, From: repoModel.StatusPendingPayment, To: repoModel.StatusCanceled, Actor: userActor, Reason: "order canceled", CreatedAt: createdAt.Add(time.Minute)},
+	}, nil)
+
+	// Act
+	history, err := s.service.GetOrderHistory(ctx, orderUUID)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []model.StatusChange{
+		{OrderUUID: orderUUID, To: model.StatusPendingPayment, Actor: model.Actor(userActor), Reason: "order created", CreatedAt: createdAt},
+		{OrderUUID: orderUUID, From: model.StatusPendingPayment, To: model.StatusCanceled, Actor: model.Actor(userActor), Reason: "order canceled", CreatedAt: createdAt.Add(time.Minute)},
+	}, history)
+}
+
+func (s *OrderHistoryTestSuite) TestGetOrderHistory_OrderNotFound() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(nil, errors.New("order not found"))
+
+	// Act
+	history, err := s.service.GetOrderHistory(ctx, orderUUID)
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrOrderNotFound)
+	assert.Nil(s.T(), history)
+	s.orderRepository.AssertNotCalled(s.T(), "GetStatusHistory", ctx, orderUUID)
+}
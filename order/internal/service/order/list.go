@@ -0,0 +1,41 @@
+package order
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	"github.com/space-wanderer/microservices/order/internal/model"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+func (s *service) ListOrders(ctx context.Context, filter *model.OrdersFilter, page model.OrdersPageRequest) ([]model.Order, string, error) {
+	if filter != nil && filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, "", model.ErrInvalidCreatedAtRange
+	}
+
+	repoFilter := converter.ConvertModelOrdersFilterToRepoOrdersFilter(filter)
+
+	repoPage, err := newRepoPage(repoFilter, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	orders, hasMore, err := s.orderRepository.ListOrders(ctx, repoFilter, repoPage)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if hasMore && len(orders) > 0 {
+		nextPageToken, err = encodePageToken(repoFilter, repoPage, orders[len(orders)-1])
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return converter.ConvertRepoOrdersToModelOrders(orders), nextPageToken, nil
+}
@@ -0,0 +1,210 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/space-wanderer/microservices/order/internal/client/grpc/mocks"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoMocks "github.com/space-wanderer/microservices/order/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+type ListOrdersTestSuite struct {
+	suite.Suite
+	orderRepository *repoMocks.OrderRepository
+	service         *service
+}
+
+func (s *ListOrdersTestSuite) SetupTest() {
+	s.orderRepository = repoMocks.NewOrderRepository(s.T())
+	s.service = NewOrderService(s.orderRepository, mocks.NewInventoryClient(s.T()), mocks.NewPaymentClient(s.T()), nil, nil)
+}
+
+func (s *ListOrdersTestSuite) TearDownTest() {
+	s.orderRepository.AssertExpectations(s.T())
+}
+
+func TestListOrdersTestSuite(t *testing.T) {
+	suite.Run(t, new(ListOrdersTestSuite))
+}
+
+func (s *ListOrdersTestSuite) TestListOrders_Pagination() {
+	ctx := context.Background()
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	createdAt := time.Date(2025, 8, 15, 12, 0, 0, 0, time.UTC)
+
+	filter := &model.OrdersFilter{
+		UserUUID: userUUID,
+		Statuses: []model.Status{model.StatusPaid, model.StatusAssembled},
+	}
+	expectedFilter := &repoModel.OrdersFilter{
+		UserUUID: userUUID,
+		Statuses: []repoModel.Status{repoModel.StatusPaid, repoModel.StatusAssembled},
+	}
+
+	s.orderRepository.EXPECT().
+		ListOrders(ctx, expectedFilter, &repoModel.OrdersPage{
+			Size:       2,
+			OrderBy:    repoModel.OrdersOrderByCreatedAt,
+			Descending: true,
+		}).
+		Return([]*repoModel.Order{
+			{OrderUUID: "550e8400-e29b-41d4-a716-446655440010", UserUUID: userUUID, Status: repoModel.StatusPaid, CreatedAt: createdAt.Add(time.Hour)},
+			{OrderUUID: "550e8400-e29b-41d4-a716-446655440011", UserUUID: userUUID, Status: repoModel.StatusAssembled, CreatedAt: createdAt},
+		}, true, nil).
+		Once()
+
+	orders, nextPageToken, err := s.service.ListOrders(ctx, filter, model.OrdersPageRequest{Size: 2, Descending: true})
+	s.Require().NoError(err)
+	s.Require().Len(orders, 2)
+	s.Equal(model.StatusAssembled, orders[1].Status)
+	s.Equal(createdAt, orders[1].CreatedAt)
+	s.Require().NotEmpty(nextPageToken)
+
+	// Вторая страница продолжается строго после последнего заказа первой
+	s.orderRepository.EXPECT().
+		ListOrders(ctx, expectedFilter, &repoModel.OrdersPage{
+			Size:       2,
+			OrderBy:    repoModel.OrdersOrderByCreatedAt,
+			Descending: true,
+			After: &repoModel.OrdersCursor{
+				OrderUUID: "550e8400-e29b-41d4-a716-446655440011",
+				CreatedAt: createdAt,
+			},
+		}).
+		Return([]*repoModel.Order{}, false, nil).
+		Once()
+
+	orders, nextPageToken, err = s.service.ListOrders(ctx, filter, model.OrdersPageRequest{Size: 2, Token: nextPageToken, Descending: true})
+	s.Require().NoError(err)
+	s.Empty(orders)
+	s.Empty(nextPageToken)
+}
+
+func (s *ListOrdersTestSuite) TestListOrders_TotalPriceCursor() {
+	ctx := context.Background()
+
+	s.orderRepository.EXPECT().
+		ListOrders(ctx, mock.Anything, mock.Anything).
+		Return([]*repoModel.Order{{OrderUUID: "550e8400-e29b-41d4-a716-446655440010", TotalPrice: decimal.RequireFromString("150.5")}}, true, nil).
+		Once()
+
+	_, nextPageToken, err := s.service.ListOrders(ctx, &model.OrdersFilter{}, model.OrdersPageRequest{Size: 1, OrderBy: model.OrdersOrderByTotalPrice})
+	s.Require().NoError(err)
+
+	s.orderRepository.EXPECT().
+		ListOrders(ctx, mock.Anything, mock.MatchedBy(func(page *repoModel.OrdersPage) bool {
+			return page.OrderBy == repoModel.OrdersOrderByTotalPrice && page.After != nil && page.After.TotalPrice == "150.50"
+		})).
+		Return([]*repoModel.Order{}, false, nil).
+		Once()
+
+	_, _, err = s.service.ListOrders(ctx, &model.OrdersFilter{}, model.OrdersPageRequest{Size: 1, Token: nextPageToken, OrderBy: model.OrdersOrderByTotalPrice})
+	s.NoError(err)
+}
+
+func (s *ListOrdersTestSuite) TestListOrders_PageSize() {
+	tests := []struct {
+		name         string
+		size         int32
+		expectedSize int64
+	}{
+		{name: "Размер по умолчанию", size: 0, expectedSize: defaultPageSize},
+		{name: "Размер из запроса", size: 10, expectedSize: 10},
+		{name: "Размер ограничен максимумом", size: 10000, expectedSize: maxPageSize},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.orderRepository.EXPECT().
+				ListOrders(mock.Anything, mock.Anything, mock.MatchedBy(func(page *repoModel.OrdersPage) bool {
+					return page.Size == tt.expectedSize
+				})).
+				Return([]*repoModel.Order{}, false, nil).
+				Once()
+
+			_, _, err := s.service.ListOrders(context.Background(), &model.OrdersFilter{}, model.OrdersPageRequest{Size: tt.size})
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *ListOrdersTestSuite) TestListOrders_InvalidRequest() {
+	from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	filter := &model.OrdersFilter{UserUUID: "550e8400-e29b-41d4-a716-446655440001"}
+
+	token, err := encodePageToken(
+		&repoModel.OrdersFilter{UserUUID: filter.UserUUID},
+		&repoModel.OrdersPage{OrderBy: repoModel.OrdersOrderByCreatedAt},
+		&repoModel.Order{OrderUUID: "550e8400-e29b-41d4-a716-446655440010", CreatedAt: from},
+	)
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name          string
+		filter        *model.OrdersFilter
+		page          model.OrdersPageRequest
+		expectedError error
+	}{
+		{
+			name:          "Начало периода позже конца",
+			filter:        &model.OrdersFilter{CreatedFrom: &from, CreatedTo: &to},
+			expectedError: model.ErrInvalidCreatedAtRange,
+		},
+		{
+			name:          "Отрицательный размер страницы",
+			filter:        filter,
+			page:          model.OrdersPageRequest{Size: -1},
+			expectedError: model.ErrInvalidPageSize,
+		},
+		{
+			name:          "Поврежденный токен",
+			filter:        filter,
+			page:          model.OrdersPageRequest{Token: "not-a-token"},
+			expectedError: model.ErrInvalidPageToken,
+		},
+		{
+			name:          "Токен от другой сортировки",
+			filter:        filter,
+			page:          model.OrdersPageRequest{Token: token, OrderBy: model.OrdersOrderByTotalPrice},
+			expectedError: model.ErrInvalidPageToken,
+		},
+		{
+			name:          "Токен от другого фильтра",
+			filter:        &model.OrdersFilter{UserUUID: "550e8400-e29b-41d4-a716-446655440002"},
+			page:          model.OrdersPageRequest{Token: token},
+			expectedError: model.ErrInvalidPageToken,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			orders, nextPageToken, err := s.service.ListOrders(context.Background(), tt.filter, tt.page)
+
+			s.ErrorIs(err, tt.expectedError)
+			s.Nil(orders)
+			s.Empty(nextPageToken)
+		})
+	}
+}
+
+func (s *ListOrdersTestSuite) TestListOrders_RepositoryError() {
+	repoErr := errors.New("database error")
+	s.orderRepository.EXPECT().
+		ListOrders(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, false, repoErr).
+		Once()
+
+	orders, _, err := s.service.ListOrders(context.Background(), &model.OrdersFilter{}, model.OrdersPageRequest{})
+
+	s.ErrorIs(err, repoErr)
+	s.Nil(orders)
+}
@@ -0,0 +1,29 @@
+package order
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	ordersCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "order",
+		Subsystem: "orders",
+		Name:      "created_total",
+		Help:      "Количество созданных заказов",
+	})
+
+	ordersPaid = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "order",
+		Subsystem: "orders",
+		Name:      "paid_total",
+		Help:      "Количество оплаченных заказов по способам оплаты",
+	}, []string{"payment_method"})
+
+	ordersCanceled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "order",
+		Subsystem: "orders",
+		Name:      "canceled_total",
+		Help:      "Количество отмененных заказов; refunded=true — отмена оплаченного заказа с возвратом средств",
+	}, []string{"refunded"})
+)
@@ -0,0 +1,101 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	"github.com/space-wanderer/microservices/platform/pkg/pagetoken"
+)
+
+// pageQuery - параметры запроса, от которых зависит порядок выдачи. Токен страницы
+// выдается для них, и продолжение с другими параметрами отклоняется
+type pageQuery struct {
+	Filter     *repoModel.OrdersFilter `json:"f"`
+	OrderBy    repoModel.OrdersOrderBy `json:"o"`
+	Descending bool                    `json:"d,omitempty"`
+}
+
+// pageCursor - ключ последнего заказа страницы, сохраняемый в токене
+type pageCursor struct {
+	OrderUUID  string `json:"u"`
+	CreatedAt  int64  `json:"c,omitempty"`
+	TotalPrice string `json:"p,omitempty"`
+}
+
+// newRepoPage проверяет параметры страницы и разбирает токен продолжения
+func newRepoPage(filter *repoModel.OrdersFilter, page model.OrdersPageRequest) (*repoModel.OrdersPage, error) {
+	if page.Size < 0 {
+		return nil, model.ErrInvalidPageSize
+	}
+
+	size := int64(page.Size)
+	switch {
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	repoPage := &repoModel.OrdersPage{
+		Size:       size,
+		OrderBy:    convertOrderBy(page.OrderBy),
+		Descending: page.Descending,
+	}
+
+	if page.Token == "" {
+		return repoPage, nil
+	}
+
+	var cursor pageCursor
+	err := pagetoken.Decode(page.Token, pageQuery{Filter: filter, OrderBy: repoPage.OrderBy, Descending: repoPage.Descending}, &cursor)
+	if errors.Is(err, pagetoken.ErrInvalidToken) {
+		return nil, model.ErrInvalidPageToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cursor.OrderUUID == "" {
+		return nil, model.ErrInvalidPageToken
+	}
+
+	if repoPage.OrderBy == repoModel.OrdersOrderByTotalPrice {
+		if _, err := decimal.NewFromString(cursor.TotalPrice); err != nil {
+			return nil, model.ErrInvalidPageToken
+		}
+	}
+
+	repoPage.After = &repoModel.OrdersCursor{
+		OrderUUID:  cursor.OrderUUID,
+		CreatedAt:  time.Unix(0, cursor.CreatedAt).UTC(),
+		TotalPrice: cursor.TotalPrice,
+	}
+
+	return repoPage, nil
+}
+
+// encodePageToken строит токен страницы, следующей за заказом last
+func encodePageToken(filter *repoModel.OrdersFilter, page *repoModel.OrdersPage, last *repoModel.Order) (string, error) {
+	cursor := pageCursor{OrderUUID: last.OrderUUID}
+
+	// В токен попадает только значение поля сортировки.
+	// Цена округляется до точности колонки total_price DECIMAL(10,2)
+	switch page.OrderBy {
+	case repoModel.OrdersOrderByTotalPrice:
+		cursor.TotalPrice = last.TotalPrice.StringFixed(2)
+	default:
+		cursor.CreatedAt = last.CreatedAt.UnixNano()
+	}
+
+	return pagetoken.Encode(pageQuery{Filter: filter, OrderBy: page.OrderBy, Descending: page.Descending}, cursor)
+}
+
+func convertOrderBy(orderBy model.OrdersOrderBy) repoModel.OrdersOrderBy {
+	if orderBy == model.OrdersOrderByTotalPrice {
+		return repoModel.OrdersOrderByTotalPrice
+	}
+	return repoModel.OrdersOrderByCreatedAt
+}
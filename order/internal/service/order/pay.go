@@ -2,15 +2,25 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
 
 	"github.com/space-wanderer/microservices/order/internal/converter"
 	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
 )
 
-func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID string, paymentMethod model.PaymentMethod) (model.Order, error) {
+// orphanPaymentReason - причина возврата платежа, который не удалось закрепить за заказом
+const orphanPaymentReason = "order was not paid by this transaction"
+
+// PayOrder оплачивает заказ. idempotencyKey передается в PaymentService,
+// поэтому повтор запроса с тем же ключом не списывает средства повторно
+func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID string, paymentMethod model.PaymentMethod, idempotencyKey string) (model.Order, error) {
 	repoOrder, err := s.orderRepository.GetOrderByUuid(ctx, orderUUID)
 	if err != nil {
 		return model.Order{}, model.ErrOrderNotFound
@@ -19,42 +29,121 @@ func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID string, paym
 	// Конвертируем в модель сервиса
 	order := converter.ConvertRepoOrderToModelOrder(repoOrder)
 
-	// Проверяем статус заказа
-	if order.Status != model.StatusPendingPayment {
+	// Если плательщик не передан, платит владелец заказа
+	if userUUID == "" {
+		userUUID = order.UserUUID
+	}
+
+	// Снятый по сроку оплаты заказ оплатить нельзя, его нужно оформить заново
+	if order.Status == model.StatusExpired {
+		return model.Order{}, model.ErrOrderExpired
+	}
+
+	if order.Status == model.StatusPaid {
 		return model.Order{}, model.ErrOrderAlreadyPaid
 	}
 
+	// Проверяем статус заказа до списания средств: из остальных статусов оплата недопустима
+	transition, err := transitionOrder(order, model.StatusPaid, model.UserActor(userUUID), reasonOrderPaid)
+	if err != nil {
+		return model.Order{}, err
+	}
+
 	// Обрабатываем платеж через PaymentService
-	transactionUUID, err := s.paymentClient.PayOrder(ctx, orderUUID, userUUID, string(paymentMethod))
+	transactionUUID, err := s.paymentClient.PayOrder(ctx, orderUUID, userUUID, string(paymentMethod), order.TotalPrice, paymentIdempotencyKey(orderUUID, idempotencyKey))
 	if err != nil {
 		return model.Order{}, fmt.Errorf("payment processing failed: %w", err)
 	}
 
 	// Обновляем заказ после успешного платежа
-	order.Status = model.StatusPaid
 	order.TransactionUUID = &transactionUUID
 	order.PaymentMethod = paymentMethod
 
-	// Конвертируем обратно в модель репозитория и сохраняем
-	repoOrder = converter.ConvertModelOrderToRepoOrder(order)
-	err = s.orderRepository.UpdateOrder(ctx, repoOrder)
-	if err != nil {
-		return model.Order{}, fmt.Errorf("failed to update order: %w", err)
-	}
-
-	// Отправляем событие OrderPaid в Kafka
+	// Событие OrderPaid сохраняется в outbox вместе с заказом и отправляется в Kafka relay-ем
 	orderPaidEvent := model.OrderPaidEvent{
 		EventUUID:       uuid.New().String(),
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PaymentMethod:   string(paymentMethod),
 		TransactionUUID: transactionUUID,
+		Items:           order.Items,
+	}
+
+	payload, err := s.orderPaidEncoder.Encode(orderPaidEvent)
+	if err != nil {
+		return model.Order{}, fmt.Errorf("failed to encode order paid event: %w", err)
 	}
 
-	// Отправляем событие OrderPaid в Kafka
-	// nolint:gosec // Игнорируем ошибку Kafka producer для упрощения
-	_ = s.orderPaidProducer.ProduceOrderPaidEvent(ctx, orderPaidEvent)
-	// TODO: Добавить proper error handling и logging
+	// Конвертируем обратно в модель репозитория и сохраняем вместе с событием
+	repoOrder = converter.ConvertModelOrderToRepoOrder(order)
+	// Резерв подтверждается той же транзакцией через outbox: если InventoryService недоступен,
+	// relay повторит подтверждение, и детали не останутся в резерве навсегда
+	outboxEvents := []*repoModel.OutboxEvent{
+		converter.ConvertOrderPaidEventToOutboxEvent(orderPaidEvent, payload),
+		converter.ConvertCommitReservationToOutboxEvent(uuid.New().String(), orderUUID),
+	}
+	err = s.orderRepository.UpdateOrderWithOutbox(ctx, repoOrder, transition, outboxEvents)
+	if errors.Is(err, repoModel.ErrOrderStatusChanged) {
+		// Пока шел платеж, заказ оплатил параллельный запрос или снял воркер истечения срока
+		return s.paidOrder(ctx, orderUUID, transactionUUID)
+	}
+	if err != nil {
+		return model.Order{}, fmt.Errorf("failed to update order: %w", err)
+	}
+
+	ordersPaid.WithLabelValues(string(paymentMethod)).Inc()
 
 	return *order, nil
 }
+
+// paidOrder возвращает заказ, статус которого изменился во время платежа. Если заказ оплачен
+// той же транзакцией, запрос считается успешным повтором. Иначе списанные средства
+// не закреплены за заказом и возвращаются покупателю, а ошибка зависит от нового статуса:
+// ErrOrderAlreadyPaid только для заказа, оплаченного другой транзакцией
+func (s *service) paidOrder(ctx context.Context, orderUUID, transactionUUID string) (model.Order, error) {
+	repoOrder, err := s.orderRepository.GetOrderByUuid(ctx, orderUUID)
+	if err != nil {
+		return model.Order{}, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	order := converter.ConvertRepoOrderToModelOrder(repoOrder)
+	if order.Status == model.StatusPaid && order.TransactionUUID != nil && *order.TransactionUUID == transactionUUID {
+		return *order, nil
+	}
+
+	if err := s.refundOrphanPayment(ctx, orderUUID, transactionUUID); err != nil {
+		return model.Order{}, err
+	}
+
+	switch order.Status {
+	case model.StatusPaid:
+		return model.Order{}, model.ErrOrderAlreadyPaid
+	case model.StatusExpired:
+		return model.Order{}, model.ErrOrderExpired
+	}
+	return model.Order{}, &model.StatusTransitionError{From: order.Status, To: model.StatusPaid}
+}
+
+// refundOrphanPayment возвращает полную сумму транзакции, которую не удалось закрепить за заказом.
+// Ключ возврата привязан к транзакции, а не к заказу, чтобы не пересекаться с возвратом при отмене
+func (s *service) refundOrphanPayment(ctx context.Context, orderUUID, transactionUUID string) error {
+	_, err := s.paymentClient.RefundPayment(ctx, transactionUUID, decimal.Zero, orphanPaymentReason, "refund:"+transactionUUID)
+	if err != nil {
+		logger.Error(ctx, "failed to refund payment not bound to order",
+			zap.String("order_uuid", orderUUID),
+			zap.String("transaction_uuid", transactionUUID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("refund processing failed: %w", err)
+	}
+	return nil
+}
+
+// paymentIdempotencyKey ограничивает ключ клиента заказом, чтобы один ключ,
+// переданный для разных заказов, не склеивал их платежи
+func paymentIdempotencyKey(orderUUID, idempotencyKey string) string {
+	if idempotencyKey == "" {
+		return ""
+	}
+	return orderUUID + ":" + idempotencyKey
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -14,30 +15,35 @@ import (
 	repoMocks "github.com/space-wanderer/microservices/order/internal/repository/mocks"
 	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
 	serviceMocks "github.com/space-wanderer/microservices/order/internal/service/mocks"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
 )
 
 type PayOrderTestSuite struct {
 	suite.Suite
-	orderRepository   *repoMocks.OrderRepository
-	inventoryClient   *grpcMocks.InventoryClient
-	paymentClient     *grpcMocks.PaymentClient
-	orderPaidProducer *serviceMocks.MockOrderPaidProducer
-	service           *service
+	orderRepository  *repoMocks.OrderRepository
+	inventoryClient  *grpcMocks.InventoryClient
+	paymentClient    *grpcMocks.PaymentClient
+	orderPaidEncoder *serviceMocks.MockOrderPaidEncoder
+	service          *service
+}
+
+func (s *PayOrderTestSuite) SetupSuite() {
+	s.Require().NoError(logger.Init("error", true))
 }
 
 func (s *PayOrderTestSuite) SetupTest() {
 	s.orderRepository = repoMocks.NewOrderRepository(s.T())
 	s.inventoryClient = grpcMocks.NewInventoryClient(s.T())
 	s.paymentClient = grpcMocks.NewPaymentClient(s.T())
-	s.orderPaidProducer = serviceMocks.NewMockOrderPaidProducer(s.T())
-	s.service = NewOrderService(s.orderRepository, s.inventoryClient, s.paymentClient, s.orderPaidProducer)
+	s.orderPaidEncoder = serviceMocks.NewMockOrderPaidEncoder(s.T())
+	s.service = NewOrderService(s.orderRepository, s.inventoryClient, s.paymentClient, s.orderPaidEncoder, nil)
 }
 
 func (s *PayOrderTestSuite) TearDownTest() {
 	s.orderRepository.AssertExpectations(s.T())
 	s.inventoryClient.AssertExpectations(s.T())
 	s.paymentClient.AssertExpectations(s.T())
-	s.orderPaidProducer.AssertExpectations(s.T())
+	s.orderPaidEncoder.AssertExpectations(s.T())
 }
 
 func TestPayOrderTestSuite(t *testing.T) {
@@ -51,12 +57,13 @@ func (s *PayOrderTestSuite) TestPayOrder_Success() {
 	userUUID := "550e8400-e29b-41d4-a716-446655440001"
 	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
 	paymentMethod := model.PaymentMethodCard
+	payload := []byte("order-paid-event")
 
 	repoOrder := &repoModel.Order{
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPendingPayment,
@@ -66,7 +73,7 @@ func (s *PayOrderTestSuite) TestPayOrder_Success() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   paymentMethod,
 		Status:          model.StatusPaid,
@@ -76,19 +83,28 @@ func (s *PayOrderTestSuite) TestPayOrder_Success() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPaid,
 	}
 
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
-	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod)).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", ctx, expectedRepoOrder).Return(nil)
-	s.orderPaidProducer.On("ProduceOrderPaidEvent", ctx, mock.AnythingOfType("model.OrderPaidEvent")).Return(nil)
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), repoOrder.TotalPrice, "").Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return(payload, nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, expectedRepoOrder, matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.MatchedBy(func(events []*repoModel.OutboxEvent) bool {
+		return len(events) == 2 &&
+			events[0].AggregateUUID == orderUUID &&
+			events[0].EventType == repoModel.OutboxEventTypeOrderPaid &&
+			events[0].EventUUID != "" &&
+			string(events[0].Payload) == string(payload) &&
+			events[1].AggregateUUID == orderUUID &&
+			events[1].EventType == repoModel.OutboxEventTypeCommitReservation &&
+			events[1].EventUUID != "" && events[1].EventUUID != events[0].EventUUID
+	})).Return(nil)
 
 	// Act
-	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod)
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
 
 	// Assert
 	assert.NoError(s.T(), err)
@@ -106,7 +122,7 @@ func (s *PayOrderTestSuite) TestPayOrder_GetOrderError() {
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(nil, expectedError)
 
 	// Act
-	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod)
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
 
 	// Assert
 	assert.Error(s.T(), err)
@@ -125,7 +141,7 @@ func (s *PayOrderTestSuite) TestPayOrder_OrderNotPendingPayment() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPaid, // Уже оплачен
@@ -134,7 +150,7 @@ func (s *PayOrderTestSuite) TestPayOrder_OrderNotPendingPayment() {
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
 
 	// Act
-	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod)
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
 
 	// Assert
 	assert.Error(s.T(), err)
@@ -154,17 +170,17 @@ func (s *PayOrderTestSuite) TestPayOrder_PaymentClientError() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPendingPayment,
 	}
 
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
-	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod)).Return("", expectedError)
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), repoOrder.TotalPrice, "").Return("", expectedError)
 
 	// Act
-	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod)
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
 
 	// Assert
 	assert.Error(s.T(), err)
@@ -172,6 +188,34 @@ func (s *PayOrderTestSuite) TestPayOrder_PaymentClientError() {
 	assert.Equal(s.T(), model.Order{}, result)
 }
 
+func (s *PayOrderTestSuite) TestPayOrder_PaymentDeclined() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	paymentMethod := model.PaymentMethodInvestorMoney
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		PartUuids:  []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	// Без явного плательщика платит владелец заказа
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), repoOrder.TotalPrice, "").
+		Return("", model.ErrPaymentMethodNotAllowed)
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, "", paymentMethod, "")
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrPaymentMethodNotAllowed)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
 func (s *PayOrderTestSuite) TestPayOrder_UpdateOrderError() {
 	// Arrange
 	ctx := context.Background()
@@ -185,7 +229,7 @@ func (s *PayOrderTestSuite) TestPayOrder_UpdateOrderError() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPendingPayment,
@@ -195,18 +239,19 @@ func (s *PayOrderTestSuite) TestPayOrder_UpdateOrderError() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPaid,
 	}
 
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
-	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod)).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", ctx, expectedRepoOrder).Return(expectedError)
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), repoOrder.TotalPrice, "").Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, expectedRepoOrder, matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.AnythingOfType("[]*model.OutboxEvent")).Return(expectedError)
 
 	// Act
-	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod)
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
 
 	// Assert
 	assert.Error(s.T(), err)
@@ -226,7 +271,7 @@ func (s *PayOrderTestSuite) TestPayOrder_DifferentPaymentMethod() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusPendingPayment,
@@ -236,7 +281,7 @@ func (s *PayOrderTestSuite) TestPayOrder_DifferentPaymentMethod() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   paymentMethod,
 		Status:          model.StatusPaid,
@@ -246,18 +291,19 @@ func (s *PayOrderTestSuite) TestPayOrder_DifferentPaymentMethod() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: &transactionUUID,
 		PaymentMethod:   repoModel.PaymentMethodSBP,
 		Status:          repoModel.StatusPaid,
 	}
 
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
-	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod)).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", ctx, expectedRepoOrder).Return(nil)
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), repoOrder.TotalPrice, "").Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, expectedRepoOrder, matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.AnythingOfType("[]*model.OutboxEvent")).Return(nil)
 
 	// Act
-	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod)
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
 
 	// Assert
 	assert.NoError(s.T(), err)
@@ -275,7 +321,7 @@ func (s *PayOrderTestSuite) TestPayOrder_CanceledOrder() {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
-		TotalPrice:      150.5,
+		TotalPrice:      decimal.RequireFromString("150.5"),
 		TransactionUUID: nil,
 		PaymentMethod:   repoModel.PaymentMethodCard,
 		Status:          repoModel.StatusCanceled, // Отменен
@@ -284,10 +330,346 @@ func (s *PayOrderTestSuite) TestPayOrder_CanceledOrder() {
 	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
 
 	// Act
-	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod)
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrInvalidStatusTransition)
+	assert.NotErrorIs(s.T(), err, model.ErrOrderAlreadyPaid)
+	var transitionErr *model.StatusTransitionError
+	assert.ErrorAs(s.T(), err, &transitionErr)
+	assert.Equal(s.T(), model.StatusCanceled, transitionErr.From)
+	assert.Equal(s.T(), model.StatusPaid, transitionErr.To)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_EventCarriesItems() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	items := []repoModel.OrderItem{
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440002", Quantity: 2},
+		{PartUUID: "550e8400-e29b-41d4-a716-446655440005", Quantity: 1},
+	}
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		TotalPrice:    decimal.RequireFromString("150.5"),
+		PaymentMethod: repoModel.PaymentMethodCard,
+		Status:        repoModel.StatusPendingPayment,
+		Items:         items,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(model.PaymentMethodCard), decimal.RequireFromString("150.5"), "").Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.MatchedBy(func(event model.OrderPaidEvent) bool {
+		return len(event.Items) == 2 &&
+			event.Items[0].PartUUID == items[0].PartUUID && event.Items[0].Quantity == 2 &&
+			event.Items[1].PartUUID == items[1].PartUUID && event.Items[1].Quantity == 1
+	})).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"), mock.Anything, mock.AnythingOfType("[]*model.OutboxEvent")).Return(nil)
+
+	// Act
+	_, err := s.service.PayOrder(ctx, orderUUID, userUUID, model.PaymentMethodCard, "")
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_ExpiredOrder() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	paymentMethod := model.PaymentMethodCard
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		PartUuids:     []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice:    decimal.RequireFromString("150.5"),
+		PaymentMethod: repoModel.PaymentMethodCard,
+		Status:        repoModel.StatusExpired, // Снят по сроку оплаты
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrOrderExpired)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_EncodeEventError() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	paymentMethod := model.PaymentMethodCard
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: nil,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), repoOrder.TotalPrice, "").Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return(nil, errors.New("marshal error"))
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
 
 	// Assert
 	assert.Error(s.T(), err)
-	assert.Equal(s.T(), model.ErrOrderAlreadyPaid, err)
+	assert.Contains(s.T(), err.Error(), "failed to encode order paid event")
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_PassesIdempotencyKeyToPayment() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	paymentMethod := model.PaymentMethodCard
+
+	repoOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		PartUuids:  []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusPendingPayment,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(repoOrder, nil)
+	// Ключ клиента привязывается к заказу
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), repoOrder.TotalPrice, orderUUID+":retry-1").
+		Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.AnythingOfType("[]*model.OutboxEvent")).Return(nil)
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "retry-1")
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &transactionUUID, result.TransactionUUID)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_ConcurrentRetryReturnsPaidOrder() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	paymentMethod := model.PaymentMethodCard
+
+	pendingOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		PartUuids:  []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusPendingPayment,
+	}
+	paidOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		PartUuids:       []string{"550e8400-e29b-41d4-a716-446655440002"},
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: &transactionUUID,
+		PaymentMethod:   repoModel.PaymentMethodCard,
+		Status:          repoModel.StatusPaid,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(pendingOrder, nil).Once()
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), pendingOrder.TotalPrice, "").
+		Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return([]byte("payload"), nil)
+	// Параллельный запрос с той же транзакцией уже перевел заказ в PAID
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.AnythingOfType("[]*model.OutboxEvent")).
+		Return(repoModel.ErrOrderStatusChanged)
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(paidOrder, nil).Once()
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.StatusPaid, result.Status)
+	assert.Equal(s.T(), &transactionUUID, result.TransactionUUID)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_ConcurrentPaymentWithOtherTransaction() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	otherTransactionUUID := "550e8400-e29b-41d4-a716-446655440004"
+	paymentMethod := model.PaymentMethodCard
+
+	pendingOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusPendingPayment,
+	}
+	paidOrder := &repoModel.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		TotalPrice:      decimal.RequireFromString("150.5"),
+		TransactionUUID: &otherTransactionUUID,
+		Status:          repoModel.StatusPaid,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(pendingOrder, nil).Once()
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), pendingOrder.TotalPrice, "").
+		Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.AnythingOfType("[]*model.OutboxEvent")).
+		Return(repoModel.ErrOrderStatusChanged)
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(paidOrder, nil).Once()
+	// Заказ оплачен другой транзакцией, поэтому второе списание возвращается
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, orphanPaymentReason, "refund:"+transactionUUID).
+		Return(model.Refund{RefundUUID: "550e8400-e29b-41d4-a716-446655440005", TransactionUUID: transactionUUID, Amount: decimal.RequireFromString("150.5")}, nil)
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrOrderAlreadyPaid)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_ExpiredDuringPayment() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	paymentMethod := model.PaymentMethodCard
+
+	pendingOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusPendingPayment,
+	}
+	expiredOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusExpired,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(pendingOrder, nil).Once()
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), pendingOrder.TotalPrice, "").
+		Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return([]byte("payload"), nil)
+	// Пока шел платеж, воркер истечения срока снял заказ
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.AnythingOfType("[]*model.OutboxEvent")).
+		Return(repoModel.ErrOrderStatusChanged)
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(expiredOrder, nil).Once()
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, orphanPaymentReason, "refund:"+transactionUUID).
+		Return(model.Refund{RefundUUID: "550e8400-e29b-41d4-a716-446655440005", TransactionUUID: transactionUUID, Amount: decimal.RequireFromString("150.5")}, nil)
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrOrderExpired)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_CanceledDuringPayment() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	paymentMethod := model.PaymentMethodCard
+
+	pendingOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusPendingPayment,
+	}
+	canceledOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusCanceled,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(pendingOrder, nil).Once()
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), pendingOrder.TotalPrice, "").
+		Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return([]byte("payload"), nil)
+	// Пока шел платеж, покупатель отменил заказ
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.AnythingOfType("[]*model.OutboxEvent")).
+		Return(repoModel.ErrOrderStatusChanged)
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(canceledOrder, nil).Once()
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, orphanPaymentReason, "refund:"+transactionUUID).
+		Return(model.Refund{RefundUUID: "550e8400-e29b-41d4-a716-446655440005", TransactionUUID: transactionUUID, Amount: decimal.RequireFromString("150.5")}, nil)
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
+
+	// Assert
+	assert.NotErrorIs(s.T(), err, model.ErrOrderAlreadyPaid)
+	var transitionErr *model.StatusTransitionError
+	assert.ErrorAs(s.T(), err, &transitionErr)
+	assert.Equal(s.T(), model.StatusCanceled, transitionErr.From)
+	assert.Equal(s.T(), model.StatusPaid, transitionErr.To)
+	assert.Equal(s.T(), model.Order{}, result)
+}
+
+func (s *PayOrderTestSuite) TestPayOrder_ExpiredDuringPaymentRefundError() {
+	// Arrange
+	ctx := context.Background()
+	orderUUID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionUUID := "550e8400-e29b-41d4-a716-446655440003"
+	paymentMethod := model.PaymentMethodCard
+
+	pendingOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusPendingPayment,
+	}
+	expiredOrder := &repoModel.Order{
+		OrderUUID:  orderUUID,
+		UserUUID:   userUUID,
+		TotalPrice: decimal.RequireFromString("150.5"),
+		Status:     repoModel.StatusExpired,
+	}
+
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(pendingOrder, nil).Once()
+	s.paymentClient.On("PayOrder", ctx, orderUUID, userUUID, string(paymentMethod), pendingOrder.TotalPrice, "").
+		Return(transactionUUID, nil)
+	s.orderPaidEncoder.On("Encode", mock.AnythingOfType("model.OrderPaidEvent")).Return([]byte("payload"), nil)
+	s.orderRepository.On("UpdateOrderWithOutbox", ctx, mock.AnythingOfType("*model.Order"), matchTransition(repoModel.StatusPendingPayment, repoModel.StatusPaid), mock.AnythingOfType("[]*model.OutboxEvent")).
+		Return(repoModel.ErrOrderStatusChanged)
+	s.orderRepository.On("GetOrderByUuid", ctx, orderUUID).Return(expiredOrder, nil).Once()
+	s.paymentClient.On("RefundPayment", ctx, transactionUUID, decimal.Zero, orphanPaymentReason, "refund:"+transactionUUID).
+		Return(model.Refund{}, model.ErrPaymentUnavailable)
+
+	// Act
+	result, err := s.service.PayOrder(ctx, orderUUID, userUUID, paymentMethod, "")
+
+	// Assert
+	assert.ErrorIs(s.T(), err, model.ErrPaymentUnavailable)
 	assert.Equal(s.T(), model.Order{}, result)
 }
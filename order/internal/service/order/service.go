@@ -12,22 +12,30 @@ import (
 )
 
 type service struct {
-	orderRepository   repository.OrderRepository
-	inventoryClient   grpc.InventoryClient
-	paymentClient     grpc.PaymentClient
-	orderPaidProducer kafkaConverter.OrderPaidProducer
+	orderRepository      repository.OrderRepository
+	inventoryClient      grpc.InventoryClient
+	paymentClient        grpc.PaymentClient
+	orderPaidEncoder     kafkaConverter.OrderPaidEncoder
+	orderRefundedEncoder kafkaConverter.OrderRefundedEncoder
 }
 
-func NewOrderService(orderRepository repository.OrderRepository, inventoryClient grpc.InventoryClient, paymentClient grpc.PaymentClient, orderPaidProducer kafkaConverter.OrderPaidProducer) *service {
+func NewOrderService(
+	orderRepository repository.OrderRepository,
+	inventoryClient grpc.InventoryClient,
+	paymentClient grpc.PaymentClient,
+	orderPaidEncoder kafkaConverter.OrderPaidEncoder,
+	orderRefundedEncoder kafkaConverter.OrderRefundedEncoder,
+) *service {
 	return &service{
-		orderRepository:   orderRepository,
-		inventoryClient:   inventoryClient,
-		paymentClient:     paymentClient,
-		orderPaidProducer: orderPaidProducer,
+		orderRepository:      orderRepository,
+		inventoryClient:      inventoryClient,
+		paymentClient:        paymentClient,
+		orderPaidEncoder:     orderPaidEncoder,
+		orderRefundedEncoder: orderRefundedEncoder,
 	}
 }
 
-func (s *service) UpdateOrderStatus(ctx context.Context, orderUUID string, status model.Status) error {
+func (s *service) UpdateOrderStatus(ctx context.Context, orderUUID string, status model.Status, actor model.Actor, reason string) error {
 	// Получаем заказ из репозитория
 	repoOrder, err := s.orderRepository.GetOrderByUuid(ctx, orderUUID)
 	if err != nil {
@@ -37,13 +45,20 @@ func (s *service) UpdateOrderStatus(ctx context.Context, orderUUID string, statu
 	// Конвертируем в модель сервиса
 	order := converter.ConvertRepoOrderToModelOrder(repoOrder)
 
-	// Обновляем статус
-	order.Status = status
+	// Повторное событие о том же статусе ничего не меняет
+	if order.Status == status {
+		return nil
+	}
+
+	transition, err := transitionOrder(order, status, actor, reason)
+	if err != nil {
+		return err
+	}
 
 	// Конвертируем обратно в модель репозитория и сохраняем
 	repoOrder = converter.ConvertModelOrderToRepoOrder(order)
 
-	err = s.orderRepository.UpdateOrder(ctx, repoOrder)
+	err = s.orderRepository.UpdateOrder(ctx, repoOrder, transition)
 	if err != nil {
 		return fmt.Errorf("failed to update order status: %w", err)
 	}
@@ -0,0 +1,33 @@
+package order
+
+import (
+	"github.com/space-wanderer/microservices/order/internal/converter"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// Причины смены статуса, сохраняемые в историю статусов заказа
+const (
+	reasonOrderCreated    = "order created"
+	reasonOrderPaid       = "payment completed"
+	reasonRefundCompleted = "refund completed"
+)
+
+// transitionOrder переводит заказ в статус to, если его допускает таблица переходов, и возвращает
+// смену статуса, которую репозиторий применит условно и сохранит в историю статусов
+func transitionOrder(order *model.Order, to model.Status, actor model.Actor, reason string) (*repoModel.StatusTransition, error) {
+	if err := model.ValidateTransition(order.Status, to); err != nil {
+		return nil, err
+	}
+
+	transition := converter.ConvertStatusChangeToRepoStatusTransition(model.StatusChange{
+		OrderUUID: order.OrderUUID,
+		From:      order.Status,
+		To:        to,
+		Actor:     actor,
+		Reason:    reason,
+	})
+	order.Status = to
+
+	return transition, nil
+}
@@ -0,0 +1,65 @@
+package outbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	relayLag = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "order",
+		Subsystem: "outbox",
+		Name:      "relay_lag_seconds",
+		Help:      "Возраст самого старого неотправленного события outbox",
+	})
+
+	relayPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "order",
+		Subsystem: "outbox",
+		Name:      "pending_events",
+		Help:      "Количество неотправленных событий outbox",
+	})
+
+	publishedEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "order",
+		Subsystem: "outbox",
+		Name:      "published_total",
+		Help:      "Количество событий outbox, отправленных в Kafka",
+	}, []string{"event_type"})
+
+	publishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "order",
+		Subsystem: "outbox",
+		Name:      "publish_failures_total",
+		Help:      "Количество неудачных попыток отправки событий outbox",
+	}, []string{"event_type"})
+
+	publishDelay = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "order",
+		Subsystem: "outbox",
+		Name:      "publish_delay_seconds",
+		Help:      "Задержка между записью события в outbox и его отправкой в Kafka",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"event_type"})
+
+	tasksCompleted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "order",
+		Subsystem: "outbox",
+		Name:      "tasks_completed_total",
+		Help:      "Количество выполненных задач outbox, отправляемых вызовом другого сервиса",
+	}, []string{"event_type"})
+
+	taskFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "order",
+		Subsystem: "outbox",
+		Name:      "task_failures_total",
+		Help:      "Количество неудачных попыток выполнить задачу outbox",
+	}, []string{"event_type"})
+
+	tasksRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "order",
+		Subsystem: "outbox",
+		Name:      "tasks_rejected_total",
+		Help:      "Количество задач outbox, снятых с выполнения без повторов; требует ручного разбора",
+	}, []string{"event_type"})
+)
@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
+)
+
+// Publish отправляет событие outbox в топик, соответствующий его типу, или выполняет задачу outbox.
+// Ключом сообщения служит UUID заказа, что сохраняет порядок событий одного заказа в партиции.
+func (s *service) Publish(ctx context.Context, event *repoModel.OutboxEvent) error {
+	if sender, ok := s.senders[event.EventType]; ok {
+		return s.runTask(ctx, sender, event)
+	}
+
+	producer, ok := s.producers[event.EventType]
+	if !ok {
+		publishFailures.WithLabelValues(string(event.EventType)).Inc()
+		return fmt.Errorf("no producer for outbox event type %s", event.EventType)
+	}
+
+	// Продолжаем трассировку запроса, который записал событие
+	ctx, span := tracing.Tracer().Start(tracing.ContextWithCarrier(ctx, event.TraceContext), "outbox publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("outbox.event_uuid", event.EventUUID),
+			attribute.String("outbox.event_type", string(event.EventType)),
+		),
+	)
+	defer span.End()
+
+	if err := producer.Send(ctx, []byte(event.AggregateUUID), event.Payload); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		publishFailures.WithLabelValues(string(event.EventType)).Inc()
+		logger.Error(ctx, "❌ Failed to publish outbox event",
+			zap.String("event_uuid", event.EventUUID),
+			zap.String("event_type", string(event.EventType)),
+			zap.Int("attempts", event.Attempts),
+			zap.Error(err))
+		return err
+	}
+
+	publishedEvents.WithLabelValues(string(event.EventType)).Inc()
+	if !event.CreatedAt.IsZero() {
+		publishDelay.WithLabelValues(string(event.EventType)).Observe(time.Since(event.CreatedAt).Seconds())
+	}
+
+	return nil
+}
+
+// RetryDelay возвращает экспоненциально растущую задержку перед повторной отправкой
+func (s *service) RetryDelay(attempts int) time.Duration {
+	delay := s.retryBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= s.maxBackoff {
+			return s.maxBackoff
+		}
+	}
+
+	return min(delay, s.maxBackoff)
+}
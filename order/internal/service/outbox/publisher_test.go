@@ -0,0 +1,102 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+func (s *ServiceSuite) TestPublish_Success() {
+	// Arrange
+	ctx := context.Background()
+	event := &repoModel.OutboxEvent{
+		ID:            1,
+		EventUUID:     "550e8400-e29b-41d4-a716-446655440010",
+		AggregateUUID: "550e8400-e29b-41d4-a716-446655440000",
+		EventType:     repoModel.OutboxEventTypeOrderPaid,
+		Payload:       []byte("payload"),
+		CreatedAt:     time.Now(),
+	}
+
+	// Act
+	err := s.service.Publish(ctx, event)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(s.producer.sent, 1)
+	s.Equal([]byte(event.AggregateUUID), s.producer.sent[0].key)
+	s.Equal(event.Payload, s.producer.sent[0].value)
+}
+
+func (s *ServiceSuite) TestPublish_ProducerError() {
+	// Arrange
+	ctx := context.Background()
+	s.producer.err = errors.New("kafka unavailable")
+	event := &repoModel.OutboxEvent{
+		EventUUID:     "550e8400-e29b-41d4-a716-446655440010",
+		AggregateUUID: "550e8400-e29b-41d4-a716-446655440000",
+		EventType:     repoModel.OutboxEventTypeOrderPaid,
+		Payload:       []byte("payload"),
+	}
+
+	// Act
+	err := s.service.Publish(ctx, event)
+
+	// Assert
+	s.Require().Error(err)
+	s.Empty(s.producer.sent)
+}
+
+func (s *ServiceSuite) TestPublish_UnknownEventType() {
+	// Arrange
+	ctx := context.Background()
+	event := &repoModel.OutboxEvent{
+		EventUUID:     "550e8400-e29b-41d4-a716-446655440010",
+		AggregateUUID: "550e8400-e29b-41d4-a716-446655440000",
+		EventType:     repoModel.OutboxEventType("UNKNOWN"),
+	}
+
+	// Act
+	err := s.service.Publish(ctx, event)
+
+	// Assert
+	s.Require().Error(err)
+	s.Contains(err.Error(), "no producer for outbox event type")
+}
+
+func (s *ServiceSuite) TestPublish_RunsTaskWithoutProducer() {
+	// Arrange
+	ctx := context.Background()
+	event := reservationTask(repoModel.OutboxEventTypeCommitReservation)
+
+	// Act
+	err := s.service.Publish(ctx, event)
+
+	// Assert: задача выполняется своим исполнителем, а не отправляется в Kafka
+	s.Require().NoError(err)
+	s.Equal([]*repoModel.OutboxEvent{event}, s.sender.sent)
+	s.Empty(s.producer.sent)
+}
+
+func (s *ServiceSuite) TestPublish_TaskRejected() {
+	// Arrange
+	ctx := context.Background()
+	s.sender.err = fmt.Errorf("%w: reservation already released", repoModel.ErrOutboxEventRejected)
+
+	// Act
+	err := s.service.Publish(ctx, reservationTask(repoModel.OutboxEventTypeCommitReservation))
+
+	// Assert: relay снимет задачу с отправки по этой ошибке
+	s.Require().ErrorIs(err, repoModel.ErrOutboxEventRejected)
+}
+
+func (s *ServiceSuite) TestRetryDelay() {
+	s.Equal(time.Second, s.service.RetryDelay(1))
+	s.Equal(2*time.Second, s.service.RetryDelay(2))
+	s.Equal(8*time.Second, s.service.RetryDelay(4))
+	s.Equal(10*time.Second, s.service.RetryDelay(5))
+	s.Equal(10*time.Second, s.service.RetryDelay(100))
+}
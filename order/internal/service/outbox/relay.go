@@ -0,0 +1,54 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// RunRelay периодически отправляет события из outbox в Kafka до отмены контекста
+func (s *service) RunRelay(ctx context.Context) error {
+	logger.Info(ctx, "Starting outbox relay",
+		zap.Duration("poll_interval", s.pollInterval),
+		zap.Int("batch_size", s.batchSize))
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.relayOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "🛑 Outbox relay stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) relayOnce(ctx context.Context) {
+	// Отправляем пачки подряд, пока outbox не опустеет, чтобы не копить отставание
+	for ctx.Err() == nil {
+		published, err := s.outboxRepository.ProcessOutboxBatch(ctx, s.batchSize, s)
+		if err != nil {
+			logger.Error(ctx, "❌ Failed to process outbox batch", zap.Error(err))
+			break
+		}
+		if published < s.batchSize {
+			break
+		}
+	}
+
+	stats, err := s.outboxRepository.GetOutboxStats(ctx)
+	if err != nil {
+		logger.Error(ctx, "❌ Failed to get outbox stats", zap.Error(err))
+		return
+	}
+
+	relayLag.Set(stats.Lag.Seconds())
+	relayPending.Set(float64(stats.Pending))
+}
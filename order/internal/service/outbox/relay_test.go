@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+func (s *ServiceSuite) TestRunRelay_StopsOnContextCancel() {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	s.outboxRepository.On("ProcessOutboxBatch", mock.Anything, 10, s.service).Return(0, nil)
+	s.outboxRepository.On("GetOutboxStats", mock.Anything).Return(repoModel.OutboxStats{Pending: 0}, nil)
+
+	done := make(chan error)
+	go func() {
+		done <- s.service.RunRelay(ctx)
+	}()
+
+	// Act
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+
+	// Assert
+	select {
+	case err := <-done:
+		s.NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("relay did not stop after context cancel")
+	}
+}
+
+func (s *ServiceSuite) TestRelayOnce_DrainsFullBatches() {
+	// Arrange
+	ctx := context.Background()
+	s.outboxRepository.On("ProcessOutboxBatch", ctx, 10, s.service).Return(10, nil).Twice()
+	s.outboxRepository.On("ProcessOutboxBatch", ctx, 10, s.service).Return(3, nil).Once()
+	s.outboxRepository.On("GetOutboxStats", ctx).Return(repoModel.OutboxStats{Pending: 0}, nil)
+
+	// Act
+	s.service.relayOnce(ctx)
+
+	// Assert
+	s.outboxRepository.AssertNumberOfCalls(s.T(), "ProcessOutboxBatch", 3)
+}
+
+func (s *ServiceSuite) TestRelayOnce_ProcessError() {
+	// Arrange
+	ctx := context.Background()
+	s.outboxRepository.On("ProcessOutboxBatch", ctx, 10, s.service).Return(0, errors.New("database error")).Once()
+	s.outboxRepository.On("GetOutboxStats", ctx).Return(repoModel.OutboxStats{Pending: 5, Lag: time.Minute}, nil)
+
+	// Act
+	s.service.relayOnce(ctx)
+
+	// Assert
+	s.outboxRepository.AssertNumberOfCalls(s.T(), "ProcessOutboxBatch", 1)
+}
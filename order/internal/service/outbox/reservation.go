@@ -0,0 +1,56 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/space-wanderer/microservices/order/internal/client/grpc"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+// reservationCommitter выполняет задачи outbox COMMIT_RESERVATION: подтверждает резерв
+// деталей в InventoryService. Задача относится к заказу из AggregateUUID. Подтверждение идемпотентно,
+// поэтому повтор после неудачной попытки безопасен
+type reservationCommitter struct {
+	inventoryClient grpc.InventoryClient
+}
+
+func NewReservationCommitter(inventoryClient grpc.InventoryClient) *reservationCommitter {
+	return &reservationCommitter{inventoryClient: inventoryClient}
+}
+
+func (c *reservationCommitter) Send(ctx context.Context, event *repoModel.OutboxEvent) error {
+	err := c.inventoryClient.CommitReservation(ctx, event.AggregateUUID)
+	switch {
+	case errors.Is(err, model.ErrReservationNotFound):
+		// Заказы, созданные до появления резервов, не имеют резерва в inventory
+		return nil
+	case errors.Is(err, model.ErrReservationReleased):
+		// Резерв оплаченного заказа уже снят, например по сроку жизни, и повтор этого не исправит
+		return fmt.Errorf("%w: %w", repoModel.ErrOutboxEventRejected, err)
+	}
+
+	return err
+}
+
+// reservationReleaser выполняет задачи outbox RELEASE_RESERVATION: возвращает детали
+// отмененного заказа на склад в InventoryService. Снятие резерва идемпотентно
+type reservationReleaser struct {
+	inventoryClient grpc.InventoryClient
+}
+
+func NewReservationReleaser(inventoryClient grpc.InventoryClient) *reservationReleaser {
+	return &reservationReleaser{inventoryClient: inventoryClient}
+}
+
+func (r *reservationReleaser) Send(ctx context.Context, event *repoModel.OutboxEvent) error {
+	err := r.inventoryClient.ReleaseReservation(ctx, event.AggregateUUID)
+	// У заказа без резерва возвращать на склад нечего
+	if errors.Is(err, model.ErrReservationNotFound) {
+		return nil
+	}
+
+	return err
+}
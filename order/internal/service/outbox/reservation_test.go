@@ -0,0 +1,115 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+
+	clientMocks "github.com/space-wanderer/microservices/order/internal/client/grpc/mocks"
+	"github.com/space-wanderer/microservices/order/internal/model"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+)
+
+const testOrderUUID = "550e8400-e29b-41d4-a716-446655440000"
+
+func reservationTask(eventType repoModel.OutboxEventType) *repoModel.OutboxEvent {
+	return &repoModel.OutboxEvent{
+		EventUUID:     "550e8400-e29b-41d4-a716-446655440010",
+		AggregateUUID: testOrderUUID,
+		EventType:     eventType,
+		Payload:       []byte(testOrderUUID),
+	}
+}
+
+func (s *ServiceSuite) TestReservationCommitter_Success() {
+	// Arrange
+	ctx := context.Background()
+	inventoryClient := clientMocks.NewInventoryClient(s.T())
+	inventoryClient.On("CommitReservation", ctx, testOrderUUID).Return(nil)
+
+	// Act
+	err := NewReservationCommitter(inventoryClient).Send(ctx, reservationTask(repoModel.OutboxEventTypeCommitReservation))
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestReservationCommitter_InventoryErrorIsRetried() {
+	// Arrange
+	ctx := context.Background()
+	inventoryClient := clientMocks.NewInventoryClient(s.T())
+	inventoryClient.On("CommitReservation", ctx, testOrderUUID).Return(errors.New("inventory unavailable"))
+
+	// Act
+	err := NewReservationCommitter(inventoryClient).Send(ctx, reservationTask(repoModel.OutboxEventTypeCommitReservation))
+
+	// Assert: ошибка возвращается relay-ю, и задача остается в outbox для повтора
+	s.Require().Error(err)
+	s.NotErrorIs(err, repoModel.ErrOutboxEventRejected)
+}
+
+func (s *ServiceSuite) TestReservationCommitter_ReservationNotFound() {
+	// Arrange
+	ctx := context.Background()
+	inventoryClient := clientMocks.NewInventoryClient(s.T())
+	inventoryClient.On("CommitReservation", ctx, testOrderUUID).Return(model.ErrReservationNotFound)
+
+	// Act
+	err := NewReservationCommitter(inventoryClient).Send(ctx, reservationTask(repoModel.OutboxEventTypeCommitReservation))
+
+	// Assert: у заказов без резерва подтверждать нечего
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestReservationCommitter_ReservationReleasedIsRejected() {
+	// Arrange
+	ctx := context.Background()
+	inventoryClient := clientMocks.NewInventoryClient(s.T())
+	inventoryClient.On("CommitReservation", ctx, testOrderUUID).Return(model.ErrReservationReleased)
+
+	// Act
+	err := NewReservationCommitter(inventoryClient).Send(ctx, reservationTask(repoModel.OutboxEventTypeCommitReservation))
+
+	// Assert: повтор не поможет, задача снимается и не держит следующие события заказа
+	s.Require().ErrorIs(err, repoModel.ErrOutboxEventRejected)
+	s.ErrorIs(err, model.ErrReservationReleased)
+}
+
+func (s *ServiceSuite) TestReservationReleaser_Success() {
+	// Arrange
+	ctx := context.Background()
+	inventoryClient := clientMocks.NewInventoryClient(s.T())
+	inventoryClient.On("ReleaseReservation", ctx, testOrderUUID).Return(nil)
+
+	// Act
+	err := NewReservationReleaser(inventoryClient).Send(ctx, reservationTask(repoModel.OutboxEventTypeReleaseReservation))
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestReservationReleaser_ReservationNotFound() {
+	// Arrange
+	ctx := context.Background()
+	inventoryClient := clientMocks.NewInventoryClient(s.T())
+	inventoryClient.On("ReleaseReservation", ctx, testOrderUUID).Return(model.ErrReservationNotFound)
+
+	// Act
+	err := NewReservationReleaser(inventoryClient).Send(ctx, reservationTask(repoModel.OutboxEventTypeReleaseReservation))
+
+	// Assert
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestReservationReleaser_InventoryErrorIsRetried() {
+	// Arrange
+	ctx := context.Background()
+	inventoryClient := clientMocks.NewInventoryClient(s.T())
+	inventoryClient.On("ReleaseReservation", ctx, testOrderUUID).Return(errors.New("inventory unavailable"))
+
+	// Act
+	err := NewReservationReleaser(inventoryClient).Send(ctx, reservationTask(repoModel.OutboxEventTypeReleaseReservation))
+
+	// Assert
+	s.Require().Error(err)
+	s.NotErrorIs(err, repoModel.ErrOutboxEventRejected)
+}
@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/space-wanderer/microservices/order/internal/repository"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	platformKafka "github.com/space-wanderer/microservices/platform/pkg/kafka"
+)
+
+// TaskSender выполняет задачу outbox вызовом другого сервиса вместо отправки в Kafka.
+// Ошибка, оборачивающая repoModel.ErrOutboxEventRejected, снимает задачу с отправки без повторов
+type TaskSender interface {
+	Send(ctx context.Context, event *repoModel.OutboxEvent) error
+}
+
+type service struct {
+	outboxRepository repository.OutboxRepository
+	producers        map[repoModel.OutboxEventType]platformKafka.Producer
+	senders          map[repoModel.OutboxEventType]TaskSender
+
+	pollInterval time.Duration
+	batchSize    int
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+}
+
+func NewService(
+	outboxRepository repository.OutboxRepository,
+	producers map[repoModel.OutboxEventType]platformKafka.Producer,
+	senders map[repoModel.OutboxEventType]TaskSender,
+	pollInterval time.Duration,
+	batchSize int,
+	retryBackoff time.Duration,
+	maxBackoff time.Duration,
+) *service {
+	return &service{
+		outboxRepository: outboxRepository,
+		producers:        producers,
+		senders:          senders,
+		pollInterval:     pollInterval,
+		batchSize:        batchSize,
+		retryBackoff:     retryBackoff,
+		maxBackoff:       maxBackoff,
+	}
+}
@@ -0,0 +1,82 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/space-wanderer/microservices/order/internal/repository/mocks"
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	platformKafka "github.com/space-wanderer/microservices/platform/pkg/kafka"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+type sentMessage struct {
+	key   []byte
+	value []byte
+}
+
+// fakeProducer запоминает отправленные сообщения и возвращает заданную ошибку
+type fakeProducer struct {
+	sent []sentMessage
+	err  error
+}
+
+func (p *fakeProducer) Send(_ context.Context, key, value []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.sent = append(p.sent, sentMessage{key: key, value: value})
+	return nil
+}
+
+// fakeTaskSender запоминает выполненные задачи и возвращает заданную ошибку
+type fakeTaskSender struct {
+	sent []*repoModel.OutboxEvent
+	err  error
+}
+
+func (t *fakeTaskSender) Send(_ context.Context, event *repoModel.OutboxEvent) error {
+	if t.err != nil {
+		return t.err
+	}
+	t.sent = append(t.sent, event)
+	return nil
+}
+
+type ServiceSuite struct {
+	suite.Suite
+	outboxRepository *mocks.OutboxRepository
+	producer         *fakeProducer
+	sender           *fakeTaskSender
+	service          *service
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.Require().NoError(logger.Init("error", true))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.outboxRepository = mocks.NewOutboxRepository(s.T())
+	s.producer = &fakeProducer{}
+	s.sender = &fakeTaskSender{}
+	s.service = NewService(
+		s.outboxRepository,
+		map[repoModel.OutboxEventType]platformKafka.Producer{
+			repoModel.OutboxEventTypeOrderPaid: s.producer,
+		},
+		map[repoModel.OutboxEventType]TaskSender{
+			repoModel.OutboxEventTypeCommitReservation: s.sender,
+		},
+		10*time.Millisecond,
+		10,
+		time.Second,
+		10*time.Second,
+	)
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	repoModel "github.com/space-wanderer/microservices/order/internal/repository/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
+)
+
+// runTask выполняет задачу outbox. Метрики задач ведутся отдельно от метрик отправки в Kafka
+func (s *service) runTask(ctx context.Context, sender TaskSender, event *repoModel.OutboxEvent) error {
+	ctx, span := tracing.Tracer().Start(tracing.ContextWithCarrier(ctx, event.TraceContext), "outbox task",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("outbox.event_uuid", event.EventUUID),
+			attribute.String("outbox.event_type", string(event.EventType)),
+		),
+	)
+	defer span.End()
+
+	err := sender.Send(ctx, event)
+	if err == nil {
+		tasksCompleted.WithLabelValues(string(event.EventType)).Inc()
+		return nil
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	if errors.Is(err, repoModel.ErrOutboxEventRejected) {
+		tasksRejected.WithLabelValues(string(event.EventType)).Inc()
+		logger.Error(ctx, "🚨 Outbox task rejected and needs manual handling",
+			zap.String("event_uuid", event.EventUUID),
+			zap.String("event_type", string(event.EventType)),
+			zap.String("aggregate_uuid", event.AggregateUUID),
+			zap.Error(err))
+		return err
+	}
+
+	taskFailures.WithLabelValues(string(event.EventType)).Inc()
+	logger.Error(ctx, "❌ Failed to run outbox task",
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("event_type", string(event.EventType)),
+		zap.Int("attempts", event.Attempts),
+		zap.Error(err))
+
+	return err
+}
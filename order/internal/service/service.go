@@ -9,11 +9,31 @@ import (
 type OrderService interface {
 	CreateOrder(ctx context.Context, req model.Order) (model.Order, error)
 	GetOrderByUuid(ctx context.Context, uuid string) (model.Order, error)
-	PayOrder(ctx context.Context, orderUUID, userUUID string, paymentMethod model.PaymentMethod) (model.Order, error)
+	ListOrders(ctx context.Context, filter *model.OrdersFilter, page model.OrdersPageRequest) ([]model.Order, string, error)
+	PayOrder(ctx context.Context, orderUUID, userUUID string, paymentMethod model.PaymentMethod, idempotencyKey string) (model.Order, error)
 	CancelOrderByUuid(ctx context.Context, uuid string) (model.Order, error)
-	UpdateOrderStatus(ctx context.Context, orderUUID string, status model.Status) error
+	// UpdateOrderStatus переводит заказ в статус по таблице переходов. Недопустимый переход
+	// возвращает ошибку, оборачивающую model.ErrInvalidStatusTransition
+	UpdateOrderStatus(ctx context.Context, orderUUID string, status model.Status, actor model.Actor, reason string) error
+	GetOrderHistory(ctx context.Context, orderUUID string) ([]model.StatusChange, error)
+}
+
+// IdempotencyService защищает операции от повторного выполнения по ключу идемпотентности
+type IdempotencyService interface {
+	Execute(ctx context.Context, userUUID string, operation model.IdempotentOperation, key string, request any, fn func(ctx context.Context) (model.Order, error)) (model.Order, error)
+	// RunCleanup удаляет устаревшие ключи до отмены контекста
+	RunCleanup(ctx context.Context) error
 }
 
 type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+type OutboxRelayService interface {
+	RunRelay(ctx context.Context) error
+}
+
+// OrderExpiryService снимает заказы, не оплаченные в течение срока оплаты
+type OrderExpiryService interface {
+	RunSweeper(ctx context.Context) error
+}
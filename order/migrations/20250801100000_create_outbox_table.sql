@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_uuid VARCHAR(36) NOT NULL UNIQUE,
+    aggregate_uuid VARCHAR(36) NOT NULL, -- UUID заказа, используется как ключ сообщения в Kafka
+    event_type VARCHAR(64) NOT NULL,
+    payload BYTEA NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMP
+);
+
+CREATE INDEX idx_outbox_pending ON outbox(id) WHERE processed_at IS NULL;
+CREATE INDEX idx_outbox_aggregate_uuid ON outbox(aggregate_uuid) WHERE processed_at IS NULL;
+
+-- +goose Down
+DROP TABLE outbox;
@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE INDEX idx_orders_created_at ON orders(created_at, order_uuid);
+CREATE INDEX idx_orders_total_price ON orders(total_price, order_uuid);
+CREATE INDEX idx_orders_user_uuid_created_at ON orders(user_uuid, created_at, order_uuid);
+
+-- +goose Down
+DROP INDEX idx_orders_user_uuid_created_at;
+DROP INDEX idx_orders_total_price;
+DROP INDEX idx_orders_created_at;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE idempotency_keys (
+    operation VARCHAR(32) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL, -- sha256 тела запроса
+    response BYTEA,                    -- ответ первого успешного запроса
+    locked_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (operation, idempotency_key)
+);
+
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys(created_at);
+
+-- +goose Down
+DROP TABLE idempotency_keys;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE order_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    order_uuid VARCHAR(36) NOT NULL REFERENCES orders(order_uuid) ON DELETE CASCADE,
+    from_status VARCHAR(20), -- NULL для записи о создании заказа
+    to_status VARCHAR(20) NOT NULL,
+    actor VARCHAR(64) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_status_history_order_uuid ON order_status_history(order_uuid, created_at, id);
+
+-- +goose Down
+DROP TABLE order_status_history;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE processed_events (
+    consumer VARCHAR(128) NOT NULL, -- consumer group, обработавшая событие
+    event_uuid VARCHAR(36) NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (consumer, event_uuid)
+);
+
+CREATE INDEX idx_processed_events_processed_at ON processed_events(processed_at);
+
+-- +goose Down
+DROP TABLE processed_events;
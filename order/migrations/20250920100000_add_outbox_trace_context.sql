@@ -0,0 +1,6 @@
+-- +goose Up
+-- W3C-контекст трассировки запроса, записавшего событие; relay продолжает трассировку при отправке в Kafka
+ALTER TABLE outbox ADD COLUMN trace_context JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE outbox DROP COLUMN trace_context;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE order_items (
+    order_uuid VARCHAR(36) NOT NULL REFERENCES orders(order_uuid) ON DELETE CASCADE,
+    part_uuid VARCHAR(36) NOT NULL,
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    unit_price DECIMAL(10,2), -- NULL для позиций, перенесенных из заказов без снимка цены
+    part_name TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (order_uuid, part_uuid)
+);
+
+-- +goose Down
+DROP TABLE order_items;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Переносим part_uuids существующих заказов в позиции: повторы UUID становятся количеством.
+-- Цена за штуку известна только для заказов из одной детали, название детали не сохранялось
+INSERT INTO order_items (order_uuid, part_uuid, quantity, unit_price)
+SELECT
+    o.order_uuid,
+    p.part_uuid,
+    COUNT(*),
+    CASE
+        WHEN COUNT(*) OVER (PARTITION BY o.order_uuid) = 1 THEN ROUND(o.total_price / COUNT(*), 2)
+    END
+FROM orders o
+CROSS JOIN LATERAL unnest(o.part_uuids) AS p(part_uuid)
+GROUP BY o.order_uuid, p.part_uuid, o.total_price
+ON CONFLICT (order_uuid, part_uuid) DO NOTHING;
+
+-- +goose Down
+-- Позиции удаляются вместе с таблицей в откате предыдущей миграции, part_uuids при этом не менялись
@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE orders DROP CONSTRAINT orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('PENDING_PAYMENT', 'PAID', 'CANCELED', 'ASSEMBLED', 'REFUND_PENDING', 'REFUNDED', 'EXPIRED'));
+
+-- Частичный индекс для поиска просроченных неоплаченных заказов
+CREATE INDEX idx_orders_pending_payment_created_at ON orders(created_at) WHERE status = 'PENDING_PAYMENT';
+
+-- +goose Down
+DROP INDEX idx_orders_pending_payment_created_at;
+
+ALTER TABLE orders DROP CONSTRAINT orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('PENDING_PAYMENT', 'PAID', 'CANCELED', 'ASSEMBLED', 'REFUND_PENDING', 'REFUNDED'));
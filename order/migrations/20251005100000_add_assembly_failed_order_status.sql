@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE orders DROP CONSTRAINT orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('PENDING_PAYMENT', 'PAID', 'CANCELED', 'ASSEMBLED', 'REFUND_PENDING', 'REFUNDED', 'EXPIRED', 'ASSEMBLY_FAILED'));
+
+-- +goose Down
+ALTER TABLE orders DROP CONSTRAINT orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('PENDING_PAYMENT', 'PAID', 'CANCELED', 'ASSEMBLED', 'REFUND_PENDING', 'REFUNDED', 'EXPIRED'));
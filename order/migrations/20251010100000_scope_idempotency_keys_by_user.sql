@@ -0,0 +1,21 @@
+-- +goose Up
+-- Ключ идемпотентности действует только в пределах пользователя, чтобы чужой ключ
+-- не блокировал запрос и не возвращал ответ другого пользователя.
+-- Существующим ключам пользователь неизвестен, они доживают до очистки по TTL
+ALTER TABLE idempotency_keys ADD COLUMN user_uuid VARCHAR(36) NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys ALTER COLUMN user_uuid DROP DEFAULT;
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (user_uuid, operation, idempotency_key);
+
+-- +goose Down
+DELETE FROM idempotency_keys a
+USING idempotency_keys b
+WHERE a.operation = b.operation
+    AND a.idempotency_key = b.idempotency_key
+    AND a.created_at > b.created_at;
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (operation, idempotency_key);
+
+ALTER TABLE idempotency_keys DROP COLUMN user_uuid;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Время, когда событие снято с отправки как недоставляемое; last_error хранит причину
+ALTER TABLE outbox ADD COLUMN rejected_at TIMESTAMP;
+
+CREATE INDEX idx_outbox_rejected_at ON outbox(rejected_at) WHERE rejected_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_outbox_rejected_at;
+ALTER TABLE outbox DROP COLUMN rejected_at;
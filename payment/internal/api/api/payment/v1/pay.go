@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/space-wanderer/microservices/payment/internal/converter"
-	"github.com/space-wanderer/microservices/payment/internal/model"
 	paymentV1 "github.com/space-wanderer/microservices/shared/pkg/proto/payment/v1"
 )
 
@@ -12,13 +11,12 @@ func (a *api) PayOrder(ctx context.Context, req *paymentV1.PayOrderRequest) (*pa
 	// Конвертируем gRPC запрос во внутреннюю модель
 	payment := converter.ConvertFromGRPC(req)
 
-	transactionUUID, err := a.paymentService.PayOrder(ctx, payment)
+	// Бизнес-ошибки переводятся в gRPC статусы интерсептором
+	transaction, err := a.paymentService.PayOrder(ctx, payment)
 	if err != nil {
-		return nil, model.ErrPayment
+		return nil, err
 	}
 
 	// Возвращаем gRPC ответ
-	return &paymentV1.PayOrderResponse{
-		TransactionUuid: transactionUUID,
-	}, nil
+	return converter.ConvertTransactionToGRPC(transaction), nil
 }
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/payment/internal/converter"
+	paymentV1 "github.com/space-wanderer/microservices/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) RefundPayment(ctx context.Context, req *paymentV1.RefundPaymentRequest) (*paymentV1.RefundPaymentResponse, error) {
+	// Бизнес-ошибки переводятся в gRPC статусы интерсептором
+	refund, err := a.paymentService.RefundPayment(ctx, converter.ConvertRefundFromGRPC(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertRefundToGRPC(refund), nil
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -14,10 +16,24 @@ import (
 	"github.com/space-wanderer/microservices/payment/internal/config"
 	"github.com/space-wanderer/microservices/platform/pkg/closer"
 	"github.com/space-wanderer/microservices/platform/pkg/grpc/health"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/mtls"
 	"github.com/space-wanderer/microservices/platform/pkg/logger"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
+	"github.com/space-wanderer/microservices/shared/pkg/interceptors"
 	paymentV1 "github.com/space-wanderer/microservices/shared/pkg/proto/payment/v1"
 )
 
+// grpcAllowList ограничивает вызовы RPC при включенном mTLS: списывать и возвращать деньги может только order
+func grpcAllowList() mtls.AllowList {
+	cfg := config.AppConfig().GRPCAllowList
+
+	return mtls.AllowList{
+		paymentV1.PaymentService_PayOrder_FullMethodName:      cfg.PaymentClients(),
+		paymentV1.PaymentService_RefundPayment_FullMethodName: cfg.PaymentClients(),
+	}
+}
+
 type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
@@ -44,7 +60,11 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDi,
 		a.initLogger,
 		a.initCloser,
+		a.initTracing,
+		a.initMetrics,
+		a.initHealth,
 		a.initListener,
+		a.initMigrations,
 		a.initGRPCServer,
 	}
 	for _, f := range inits {
@@ -76,6 +96,55 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx,
+		config.AppConfig().Tracing.ServiceName(),
+		config.AppConfig().Tracing.CollectorEndpoint(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
+
+	closer.AddNamed("Tracer provider", shutdown)
+
+	return nil
+}
+
+func (a *App) initMetrics(ctx context.Context) error {
+	listener, err := net.Listen("tcp", config.AppConfig().Metrics.Address())
+	if err != nil {
+		return fmt.Errorf("failed to listen metrics address: %w", err)
+	}
+
+	server := metrics.NewServer(config.AppConfig().Metrics.Address())
+	go func() {
+		logger.Info(ctx, fmt.Sprintf("Metrics server listening on %s", config.AppConfig().Metrics.Address()))
+		if serveErr := server.Serve(listener); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			logger.Error(ctx, "Metrics server error", zap.Error(serveErr))
+		}
+	}()
+
+	closer.AddNamed("Metrics server", server.Shutdown)
+
+	return nil
+}
+
+func (a *App) initHealth(ctx context.Context) error {
+	registry := a.diContainer.HealthRegistry(ctx)
+
+	healthCtx, cancel := context.WithCancel(ctx)
+	go registry.Run(healthCtx)
+
+	// Статус NOT_SERVING выставляется до остановки серверов, чтобы клиенты успели переключиться
+	closer.AddBeforeShutdown("Health status", registry.Shutdown)
+	closer.AddNamed("Health checks", func(context.Context) error {
+		cancel()
+		return nil
+	})
+
+	return nil
+}
+
 func (a *App) initListener(_ context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().PaymentGRPC.Address())
 	if err != nil {
@@ -97,8 +166,41 @@ func (a *App) initListener(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initMigrations(ctx context.Context) error {
+	migrator := a.diContainer.PGMigrator(ctx)
+	if migrator == nil {
+		return fmt.Errorf("failed to create migrator")
+	}
+
+	err := migrator.Up()
+	if err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return nil
+}
+
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	creds := insecure.NewCredentials()
+	unary := []grpc.UnaryServerInterceptor{
+		interceptors.UnaryTracingServerInterceptor(),
+		metrics.UnaryServerInterceptor(),
+	}
+
+	if config.AppConfig().GRPCTLS.Enabled() {
+		reloader := a.diContainer.TLSReloader(ctx)
+		if reloader == nil {
+			return fmt.Errorf("failed to load gRPC TLS certificates")
+		}
+
+		creds = reloader.ServerCredentials()
+		unary = append(unary, mtls.UnaryAllowListInterceptor(grpcAllowList()))
+	}
+
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(append(unary, interceptors.UnaryErrorInterceptor())...),
+	)
 	closer.AddNamed("GRPC Server", func(ctx context.Context) error {
 		a.grpcServer.GracefulStop()
 		return nil
@@ -106,7 +208,7 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 
 	reflection.Register(a.grpcServer)
 
-	health.RegisterServer(a.grpcServer)
+	health.RegisterServer(a.grpcServer, a.diContainer.HealthRegistry(ctx))
 
 	api := paymentV1API.NewAPI(a.diContainer.PaymentService(ctx))
 	paymentV1.RegisterPaymentServiceServer(a.grpcServer, api)
@@ -2,13 +2,44 @@ package app
 
 import (
 	"context"
+	"log"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/shopspring/decimal"
+
+	"github.com/space-wanderer/microservices/payment/internal/config"
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/provider"
+	"github.com/space-wanderer/microservices/payment/internal/provider/fake"
+	"github.com/space-wanderer/microservices/payment/internal/repository"
+	refundRepository "github.com/space-wanderer/microservices/payment/internal/repository/refund"
+	transactionRepository "github.com/space-wanderer/microservices/payment/internal/repository/transaction"
 	"github.com/space-wanderer/microservices/payment/internal/service"
 	"github.com/space-wanderer/microservices/payment/internal/service/payment"
+	"github.com/space-wanderer/microservices/platform/pkg/closer"
+	"github.com/space-wanderer/microservices/platform/pkg/grpc/mtls"
+	platformHealth "github.com/space-wanderer/microservices/platform/pkg/health"
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	migrator "github.com/space-wanderer/microservices/platform/pkg/migrator/pg"
+	paymentV1 "github.com/space-wanderer/microservices/shared/pkg/proto/payment/v1"
 )
 
 type diContainer struct {
 	paymentService service.PaymentService
+
+	transactionRepository repository.TransactionRepository
+	refundRepository      repository.RefundRepository
+
+	paymentProviders provider.Registry
+
+	pgPool *pgxpool.Pool
+
+	pgMigrator *migrator.Migrator
+
+	healthRegistry *platformHealth.Registry
+
+	tlsReloader *mtls.Reloader
 }
 
 func NewDiContainer() *diContainer {
@@ -17,7 +48,107 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) PaymentService(ctx context.Context) service.PaymentService {
 	if d.paymentService == nil {
-		d.paymentService = payment.NewService()
+		d.paymentService = payment.NewService(d.TransactionRepository(ctx), d.RefundRepository(ctx), d.PaymentProviders(ctx), d.PaymentRules(ctx))
 	}
 	return d.paymentService
 }
+
+func (d *diContainer) TransactionRepository(ctx context.Context) repository.TransactionRepository {
+	if d.transactionRepository == nil {
+		d.transactionRepository = transactionRepository.NewRepository(d.PGPool(ctx))
+	}
+	return d.transactionRepository
+}
+
+func (d *diContainer) RefundRepository(ctx context.Context) repository.RefundRepository {
+	if d.refundRepository == nil {
+		d.refundRepository = refundRepository.NewRepository(d.PGPool(ctx))
+	}
+	return d.refundRepository
+}
+
+// PaymentProviders сопоставляет способы оплаты с адаптерами провайдеров.
+// Пока внешний эквайринг не подключен, все способы обслуживает провайдер внутри процесса
+func (d *diContainer) PaymentProviders(ctx context.Context) provider.Registry {
+	if d.paymentProviders == nil {
+		fakeProvider := fake.New()
+		d.paymentProviders = provider.Registry{
+			model.PaymentMethodCard:          fakeProvider,
+			model.PaymentMethodSBP:           fakeProvider,
+			model.PaymentMethodCreditCard:    fakeProvider,
+			model.PaymentMethodInvestorMoney: fakeProvider,
+		}
+	}
+	return d.paymentProviders
+}
+
+func (d *diContainer) PaymentRules(ctx context.Context) model.PaymentRules {
+	cfg := config.AppConfig().PaymentRules
+
+	maxAmount := make(map[model.PaymentMethod]decimal.Decimal, len(cfg.MaxAmount()))
+	for method, amount := range cfg.MaxAmount() {
+		maxAmount[model.PaymentMethod(method)] = decimal.NewFromFloat(amount)
+	}
+
+	return model.PaymentRules{
+		InvestorWhitelist: cfg.InvestorWhitelist(),
+		MaxAmount:         maxAmount,
+	}
+}
+
+func (d *diContainer) PGPool(ctx context.Context) *pgxpool.Pool {
+	if d.pgPool == nil {
+		pgPool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
+		if err != nil {
+			log.Printf("❌ Ошибка подключения к PostgreSQL: %v", err)
+			return nil
+		}
+		if err = metrics.RegisterPgxPool(pgPool); err != nil {
+			log.Printf("❌ Ошибка регистрации метрик пула PostgreSQL: %v", err)
+		}
+		d.pgPool = pgPool
+	}
+	return d.pgPool
+}
+
+func (d *diContainer) PGMigrator(ctx context.Context) *migrator.Migrator {
+	if d.pgMigrator == nil {
+		db := stdlib.OpenDBFromPool(d.PGPool(ctx))
+		d.pgMigrator = migrator.NewMigrator(db, config.AppConfig().Postgres.MigrationDir())
+	}
+	return d.pgMigrator
+}
+
+// HealthRegistry создает реестр health-проверок зависимостей сервиса
+func (d *diContainer) HealthRegistry(ctx context.Context) *platformHealth.Registry {
+	if d.healthRegistry == nil {
+		cfg := config.AppConfig().Health
+		registry := platformHealth.NewRegistry(cfg.CheckInterval(), cfg.CheckTimeout())
+		registry.Register("postgres", platformHealth.PgxPoolChecker(d.PGPool(ctx)))
+		registry.RegisterService(paymentV1.PaymentService_ServiceDesc.ServiceName, "postgres")
+		d.healthRegistry = registry
+	}
+	return d.healthRegistry
+}
+
+// TLSReloader загружает сертификаты mTLS и перечитывает их при изменении файлов
+func (d *diContainer) TLSReloader(ctx context.Context) *mtls.Reloader {
+	if d.tlsReloader == nil {
+		cfg := config.AppConfig().GRPCTLS
+		reloader, err := mtls.NewReloader(cfg.CertFile(), cfg.KeyFile(), cfg.CAFile())
+		if err != nil {
+			log.Printf("❌ Ошибка загрузки TLS-сертификатов: %v", err)
+			return nil
+		}
+
+		reloadCtx, cancel := context.WithCancel(ctx)
+		go reloader.Run(reloadCtx, cfg.ReloadInterval())
+		closer.AddNamed("TLS reloader", func(context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.tlsReloader = reloader
+	}
+	return d.tlsReloader
+}
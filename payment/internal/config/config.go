@@ -11,8 +11,15 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger      LoggerConfig
-	PaymentGRPC PaymentConfig
+	Logger        LoggerConfig
+	Tracing       TracingConfig
+	Metrics       MetricsConfig
+	Health        HealthConfig
+	GRPCTLS       GRPCTLSConfig
+	GRPCAllowList GRPCAllowListConfig
+	PaymentGRPC   PaymentConfig
+	Postgres      PostgresConfig
+	PaymentRules  PaymentRulesConfig
 }
 
 func Load(path ...string) error {
@@ -26,14 +33,56 @@ func Load(path ...string) error {
 		return err
 	}
 
+	tracingCfg, err := env.NewTracingConfig()
+	if err != nil {
+		return err
+	}
+
+	metricsCfg, err := env.NewMetricsConfig()
+	if err != nil {
+		return err
+	}
+
+	healthCfg, err := env.NewHealthConfig()
+	if err != nil {
+		return err
+	}
+
+	grpcTLSCfg, err := env.NewGRPCTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	grpcAllowListCfg, err := env.NewGRPCAllowListConfig()
+	if err != nil {
+		return err
+	}
+
 	paymentGRPCCfg, err := env.NewPaymentGRPCConfig()
 	if err != nil {
 		return err
 	}
 
+	postgresCfg, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+
+	paymentRulesCfg, err := env.NewPaymentRulesConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:      loggerCfg,
-		PaymentGRPC: paymentGRPCCfg,
+		Logger:        loggerCfg,
+		Tracing:       tracingCfg,
+		Metrics:       metricsCfg,
+		Health:        healthCfg,
+		GRPCTLS:       grpcTLSCfg,
+		GRPCAllowList: grpcAllowListCfg,
+		PaymentGRPC:   paymentGRPCCfg,
+		Postgres:      postgresCfg,
+		PaymentRules:  paymentRulesCfg,
 	}
 
 	return nil
@@ -0,0 +1,33 @@
+package env
+
+import (
+	"errors"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type grpcAllowListEnvConfig struct {
+	PaymentClients []string `env:"GRPC_ALLOW_PAYMENT_CLIENTS,required" envSeparator:","`
+}
+
+type grpcAllowListConfig struct {
+	raw grpcAllowListEnvConfig
+}
+
+func NewGRPCAllowListConfig() (*grpcAllowListConfig, error) {
+	var raw grpcAllowListEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if len(raw.PaymentClients) == 0 {
+		return nil, errors.New("GRPC_ALLOW_PAYMENT_CLIENTS must not be empty")
+	}
+
+	return &grpcAllowListConfig{raw: raw}, nil
+}
+
+// PaymentClients возвращает идентичности клиентов, которым разрешено списывать и возвращать деньги
+func (cfg *grpcAllowListConfig) PaymentClients() []string {
+	return cfg.raw.PaymentClients
+}
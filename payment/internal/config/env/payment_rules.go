@@ -0,0 +1,31 @@
+package env
+
+import "github.com/caarlos0/env/v11"
+
+type paymentRulesEnvConfig struct {
+	InvestorWhitelist []string           `env:"INVESTOR_MONEY_WHITELIST" envSeparator:","`
+	MaxAmount         map[string]float64 `env:"PAYMENT_MAX_AMOUNTS" envSeparator:"," envKeyValSeparator:":"`
+}
+
+type paymentRulesConfig struct {
+	raw paymentRulesEnvConfig
+}
+
+func NewPaymentRulesConfig() (*paymentRulesConfig, error) {
+	var raw paymentRulesEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentRulesConfig{raw: raw}, nil
+}
+
+// InvestorWhitelist возвращает UUID пользователей, которым разрешена оплата деньгами инвестора
+func (cfg *paymentRulesConfig) InvestorWhitelist() []string {
+	return cfg.raw.InvestorWhitelist
+}
+
+// MaxAmount возвращает лимит одного платежа по способам оплаты, например SBP:1000000
+func (cfg *paymentRulesConfig) MaxAmount() map[string]float64 {
+	return cfg.raw.MaxAmount
+}
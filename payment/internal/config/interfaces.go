@@ -1,10 +1,50 @@
 package config
 
+import "time"
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
 }
 
+type TracingConfig interface {
+	CollectorEndpoint() string
+	ServiceName() string
+}
+
+type MetricsConfig interface {
+	Address() string
+}
+
+type HealthConfig interface {
+	CheckInterval() time.Duration
+	CheckTimeout() time.Duration
+}
+
+type GRPCTLSConfig interface {
+	Enabled() bool
+	CertFile() string
+	KeyFile() string
+	CAFile() string
+	ReloadInterval() time.Duration
+}
+
+// GRPCAllowListConfig - клиенты, которым при включенном mTLS разрешены RPC с ограниченным доступом
+type GRPCAllowListConfig interface {
+	PaymentClients() []string
+}
+
 type PaymentConfig interface {
 	Address() string
 }
+
+type PostgresConfig interface {
+	URI() string
+	Database() string
+	MigrationDir() string
+}
+
+type PaymentRulesConfig interface {
+	InvestorWhitelist() []string
+	MaxAmount() map[string]float64
+}
@@ -0,0 +1,17 @@
+package converter
+
+import "github.com/shopspring/decimal"
+
+// minorUnitsExp - порядок младшей денежной единицы: суммы в API передаются в копейках
+const minorUnitsExp = -2
+
+// amountFromMinor переводит сумму из копеек в рубли без потери точности
+func amountFromMinor(amount int64) decimal.Decimal {
+	return decimal.New(amount, minorUnitsExp)
+}
+
+// amountToMinor переводит сумму в копейки. Суммы хранятся с точностью до копейки,
+// поэтому округление не меняет значения
+func amountToMinor(amount decimal.Decimal) int64 {
+	return amount.Shift(-minorUnitsExp).Round(0).IntPart()
+}
@@ -8,9 +8,19 @@ import (
 // convertFromGRPC конвертирует gRPC запрос во внутреннюю модель
 func ConvertFromGRPC(req *paymentV1.PayOrderRequest) model.Pay {
 	return model.Pay{
-		OrderUuid:     req.OrderUuid,
-		UserUuid:      req.UserUuid,
-		PaymentMethod: convertPaymentMethod(req.PaymentMethod),
+		OrderUuid:      req.OrderUuid,
+		UserUuid:       req.UserUuid,
+		PaymentMethod:  convertPaymentMethod(req.PaymentMethod),
+		Amount:         amountFromMinor(req.GetAmountMinor()),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	}
+}
+
+// ConvertTransactionToGRPC конвертирует транзакцию в gRPC ответ на оплату
+func ConvertTransactionToGRPC(transaction model.Transaction) *paymentV1.PayOrderResponse {
+	return &paymentV1.PayOrderResponse{
+		TransactionUuid: transaction.UUID,
+		Status:          convertTransactionStatus(transaction.Status),
 	}
 }
 
@@ -29,3 +39,21 @@ func convertPaymentMethod(method paymentV1.PaymentMethod) model.PaymentMethod {
 		return model.PaymentMethodUnknown
 	}
 }
+
+// convertTransactionStatus конвертирует внутренний статус транзакции в gRPC
+func convertTransactionStatus(status model.TransactionStatus) paymentV1.TransactionStatus {
+	switch status {
+	case model.TransactionStatusPending:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_PENDING
+	case model.TransactionStatusAuthorized:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED
+	case model.TransactionStatusCaptured:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_CAPTURED
+	case model.TransactionStatusDeclined:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_DECLINED
+	case model.TransactionStatusFailed:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_FAILED
+	default:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+	}
+}
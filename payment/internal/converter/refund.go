@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	paymentV1 "github.com/space-wanderer/microservices/shared/pkg/proto/payment/v1"
+)
+
+// ConvertRefundFromGRPC конвертирует gRPC запрос на возврат во внутреннюю модель
+func ConvertRefundFromGRPC(req *paymentV1.RefundPaymentRequest) model.RefundRequest {
+	return model.RefundRequest{
+		TransactionUUID: req.GetTransactionUuid(),
+		Amount:          amountFromMinor(req.GetAmountMinor()),
+		Reason:          req.GetReason(),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+	}
+}
+
+// ConvertRefundToGRPC конвертирует возврат в gRPC ответ
+func ConvertRefundToGRPC(refund model.Refund) *paymentV1.RefundPaymentResponse {
+	return &paymentV1.RefundPaymentResponse{
+		RefundUuid:      refund.UUID,
+		TransactionUuid: refund.TransactionUUID,
+		Status:          convertRefundStatus(refund.Status),
+		AmountMinor:     amountToMinor(refund.Amount),
+	}
+}
+
+// convertRefundStatus конвертирует внутренний статус возврата в gRPC
+func convertRefundStatus(status model.RefundStatus) paymentV1.RefundStatus {
+	switch status {
+	case model.RefundStatusPending:
+		return paymentV1.RefundStatus_REFUND_STATUS_PENDING
+	case model.RefundStatusSucceeded:
+		return paymentV1.RefundStatus_REFUND_STATUS_SUCCEEDED
+	case model.RefundStatusFailed:
+		return paymentV1.RefundStatus_REFUND_STATUS_FAILED
+	default:
+		return paymentV1.RefundStatus_REFUND_STATUS_UNSPECIFIED
+	}
+}
@@ -6,4 +6,25 @@ import (
 	sharedErrors "github.com/space-wanderer/microservices/shared/pkg/errors"
 )
 
-var ErrPayment = sharedErrors.NewInvalidArgumentError(errors.New("payment error"))
+var (
+	ErrInvalidOrderUUID         = sharedErrors.NewInvalidArgumentError(errors.New("invalid order uuid"))
+	ErrInvalidUserUUID          = sharedErrors.NewInvalidArgumentError(errors.New("invalid user uuid"))
+	ErrInvalidAmount            = sharedErrors.NewInvalidArgumentError(errors.New("invalid payment amount"))
+	ErrUnsupportedPaymentMethod = sharedErrors.NewInvalidArgumentError(errors.New("unsupported payment method"))
+	ErrOrderAlreadyPaid         = sharedErrors.NewFailedPreconditionError(errors.New("order already paid"))
+	ErrTransactionNotFound      = sharedErrors.NewNotFoundError(errors.New("transaction not found"))
+	ErrPaymentInProgress        = sharedErrors.NewUnavailableError(errors.New("payment for the order is already in progress"))
+	ErrProviderUnavailable      = sharedErrors.NewUnavailableError(errors.New("payment provider unavailable"))
+	ErrPaymentMethodNotAllowed  = sharedErrors.NewPermissionDeniedError(errors.New("payment method not allowed for the user"))
+	ErrPaymentDeclined          = sharedErrors.NewPaymentDeclinedError(errors.New("payment declined"))
+	ErrInsufficientFunds        = sharedErrors.NewPaymentDeclinedError(errors.New("payment declined: insufficient funds"))
+	ErrLimitExceeded            = sharedErrors.NewPaymentDeclinedError(errors.New("payment declined: amount limit exceeded"))
+	ErrFraudSuspected           = sharedErrors.NewPaymentDeclinedError(errors.New("payment declined: fraud suspected"))
+	ErrIdempotencyKeyConflict   = sharedErrors.NewInvalidArgumentError(errors.New("idempotency key reused with different payment request"))
+	ErrInvalidTransactionUUID   = sharedErrors.NewInvalidArgumentError(errors.New("invalid transaction uuid"))
+	ErrInvalidRefundAmount      = sharedErrors.NewInvalidArgumentError(errors.New("invalid refund amount"))
+	ErrTransactionNotRefundable = sharedErrors.NewFailedPreconditionError(errors.New("only captured transactions can be refunded"))
+	ErrRefundAmountExceeded     = sharedErrors.NewFailedPreconditionError(errors.New("refund amount exceeds refundable balance"))
+	ErrRefundNotFound           = sharedErrors.NewNotFoundError(errors.New("refund not found"))
+	ErrRefundInProgress         = sharedErrors.NewUnavailableError(errors.New("refund with this idempotency key is already in progress"))
+)
@@ -1,9 +1,17 @@
 package model
 
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
 type Pay struct {
 	OrderUuid       string
 	UserUuid        string
 	PaymentMethod   PaymentMethod
+	Amount          decimal.Decimal
+	IdempotencyKey  string
 	TransactionUuid string
 }
 
@@ -16,3 +24,49 @@ const (
 	PaymentMethodCreditCard    PaymentMethod = "CREDIT_CARD"
 	PaymentMethodInvestorMoney PaymentMethod = "INVESTOR_MONEY"
 )
+
+// Transaction - платежная транзакция по заказу
+type Transaction struct {
+	UUID              string
+	OrderUUID         string
+	UserUUID          string
+	PaymentMethod     PaymentMethod
+	Amount            decimal.Decimal
+	Status            TransactionStatus
+	DeclineReason     DeclineReason
+	Provider          string
+	ProviderReference string
+	IdempotencyKey    string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// TransactionStatus - статус платежной транзакции
+type TransactionStatus string
+
+const (
+	TransactionStatusPending    TransactionStatus = "PENDING"
+	TransactionStatusAuthorized TransactionStatus = "AUTHORIZED"
+	TransactionStatusCaptured   TransactionStatus = "CAPTURED"
+	TransactionStatusDeclined   TransactionStatus = "DECLINED"
+	TransactionStatusFailed     TransactionStatus = "FAILED"
+)
+
+// DeclineReason - причина отказа в платеже
+type DeclineReason string
+
+const (
+	DeclineReasonInsufficientFunds DeclineReason = "INSUFFICIENT_FUNDS"
+	DeclineReasonLimitExceeded     DeclineReason = "LIMIT_EXCEEDED"
+	DeclineReasonMethodNotAllowed  DeclineReason = "METHOD_NOT_ALLOWED"
+	DeclineReasonFraudSuspected    DeclineReason = "FRAUD_SUSPECTED"
+	DeclineReasonDoNotHonor        DeclineReason = "DO_NOT_HONOR"
+)
+
+// PaymentRules - правила приема платежей по способам оплаты
+type PaymentRules struct {
+	// InvestorWhitelist - пользователи, которым разрешена оплата деньгами инвестора
+	InvestorWhitelist []string
+	// MaxAmount - максимальная сумма одного платежа, способы без лимита не указываются
+	MaxAmount map[PaymentMethod]decimal.Decimal
+}
@@ -0,0 +1,19 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// AuthorizationRequest - запрос на блокировку средств у платежного провайдера
+type AuthorizationRequest struct {
+	TransactionUUID string
+	UserUUID        string
+	PaymentMethod   PaymentMethod
+	Amount          decimal.Decimal
+}
+
+// Authorization - ответ провайдера на запрос блокировки средств.
+// Reference - идентификатор операции у провайдера, заполнен только для одобренного платежа
+type Authorization struct {
+	Approved      bool
+	Reference     string
+	DeclineReason DeclineReason
+}
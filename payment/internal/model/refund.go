@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RefundRequest - запрос на возврат средств по транзакции.
+// Нулевая сумма означает возврат всего невозвращенного остатка
+type RefundRequest struct {
+	TransactionUUID string
+	Amount          decimal.Decimal
+	Reason          string
+	IdempotencyKey  string
+}
+
+// Refund - возврат средств по проведенной транзакции
+type Refund struct {
+	UUID              string
+	TransactionUUID   string
+	OrderUUID         string
+	Amount            decimal.Decimal
+	Status            RefundStatus
+	Reason            string
+	ProviderReference string
+	IdempotencyKey    string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// RefundStatus - статус возврата средств
+type RefundStatus string
+
+const (
+	RefundStatusPending   RefundStatus = "PENDING"
+	RefundStatusSucceeded RefundStatus = "SUCCEEDED"
+	RefundStatusFailed    RefundStatus = "FAILED"
+)
@@ -0,0 +1,147 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+)
+
+// ProviderName - имя провайдера, под которым сохраняются его транзакции
+const ProviderName = "fake"
+
+// ErrUnavailable возвращается, когда провайдер переведен в режим недоступности
+var ErrUnavailable = errors.New("fake provider unavailable")
+
+// Provider - платежный провайдер, работающий внутри процесса.
+// Одобряет все платежи, кроме тех, для которых задан отказ, и нужен для тестов
+// и локального запуска без внешнего эквайринга
+type Provider struct {
+	mu             sync.Mutex
+	declines       map[string]model.DeclineReason
+	authorizations map[string]decimal.Decimal
+	refunds        map[string]decimal.Decimal
+	unavailable    bool
+}
+
+func New() *Provider {
+	return &Provider{
+		declines:       make(map[string]model.DeclineReason),
+		authorizations: make(map[string]decimal.Decimal),
+		refunds:        make(map[string]decimal.Decimal),
+	}
+}
+
+// Decline задает причину отказа для всех платежей пользователя
+func (p *Provider) Decline(userUUID string, reason model.DeclineReason) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.declines[userUUID] = reason
+}
+
+// SetUnavailable переводит провайдер в режим, в котором все вызовы завершаются ошибкой
+func (p *Provider) SetUnavailable(unavailable bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.unavailable = unavailable
+}
+
+// Authorized возвращает заблокированную сумму по операции
+func (p *Provider) Authorized(reference string) (decimal.Decimal, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	amount, ok := p.authorizations[reference]
+	return amount, ok
+}
+
+// Refunded возвращает сумму, возвращенную по операции
+func (p *Provider) Refunded(reference string) decimal.Decimal {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.refunds[reference]
+}
+
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+func (p *Provider) Authorize(_ context.Context, req model.AuthorizationRequest) (model.Authorization, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.unavailable {
+		return model.Authorization{}, ErrUnavailable
+	}
+
+	if reason, ok := p.declines[req.UserUUID]; ok {
+		return model.Authorization{DeclineReason: reason}, nil
+	}
+
+	reference := uuid.New().String()
+	p.authorizations[reference] = req.Amount
+
+	return model.Authorization{Approved: true, Reference: reference}, nil
+}
+
+func (p *Provider) Capture(_ context.Context, reference string, amount decimal.Decimal) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.unavailable {
+		return ErrUnavailable
+	}
+
+	authorized, ok := p.authorizations[reference]
+	if !ok {
+		return fmt.Errorf("authorization %s not found", reference)
+	}
+
+	if amount.GreaterThan(authorized) {
+		return fmt.Errorf("capture amount %s exceeds authorized %s", amount, authorized)
+	}
+
+	return nil
+}
+
+func (p *Provider) Void(_ context.Context, reference string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.unavailable {
+		return ErrUnavailable
+	}
+
+	delete(p.authorizations, reference)
+	return nil
+}
+
+func (p *Provider) Refund(_ context.Context, reference string, amount decimal.Decimal) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.unavailable {
+		return "", ErrUnavailable
+	}
+
+	authorized, ok := p.authorizations[reference]
+	if !ok {
+		return "", fmt.Errorf("authorization %s not found", reference)
+	}
+
+	refunded := p.refunds[reference].Add(amount)
+	if refunded.GreaterThan(authorized) {
+		return "", fmt.Errorf("refund amount %s exceeds captured %s", refunded, authorized)
+	}
+
+	p.refunds[reference] = refunded
+	return uuid.New().String(), nil
+}
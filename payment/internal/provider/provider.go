@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+)
+
+// Provider - адаптер платежного провайдера.
+// Платеж проходит в два шага: блокировка средств (Authorize) и их списание (Capture).
+// Void снимает блокировку, если списание не удалось. Refund возвращает списанные средства
+// и отдает идентификатор операции возврата у провайдера
+type Provider interface {
+	Name() string
+	Authorize(ctx context.Context, req model.AuthorizationRequest) (model.Authorization, error)
+	Capture(ctx context.Context, reference string, amount decimal.Decimal) error
+	Void(ctx context.Context, reference string) error
+	Refund(ctx context.Context, reference string, amount decimal.Decimal) (string, error)
+}
+
+// Registry сопоставляет способ оплаты с провайдером, который его обслуживает
+type Registry map[model.PaymentMethod]Provider
+
+// Get возвращает провайдера для способа оплаты
+func (r Registry) Get(method model.PaymentMethod) (Provider, bool) {
+	p, ok := r[method]
+	return p, ok && p != nil
+}
@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	repoModel "github.com/space-wanderer/microservices/payment/internal/repository/model"
+)
+
+// ConvertModelRefundToRepoRefund конвертирует Refund из service model в repository model
+func ConvertModelRefundToRepoRefund(refund *model.Refund) *repoModel.Refund {
+	if refund == nil {
+		return nil
+	}
+
+	return &repoModel.Refund{
+		RefundUUID:        refund.UUID,
+		TransactionUUID:   refund.TransactionUUID,
+		OrderUUID:         refund.OrderUUID,
+		Amount:            refund.Amount,
+		Status:            string(refund.Status),
+		Reason:            optionalString(refund.Reason),
+		ProviderReference: optionalString(refund.ProviderReference),
+		IdempotencyKey:    optionalString(refund.IdempotencyKey),
+		CreatedAt:         refund.CreatedAt,
+		UpdatedAt:         refund.UpdatedAt,
+	}
+}
+
+// ConvertRepoRefundToModelRefund конвертирует Refund из repository model в service model
+func ConvertRepoRefundToModelRefund(refund *repoModel.Refund) *model.Refund {
+	if refund == nil {
+		return nil
+	}
+
+	result := &model.Refund{
+		UUID:            refund.RefundUUID,
+		TransactionUUID: refund.TransactionUUID,
+		OrderUUID:       refund.OrderUUID,
+		Amount:          refund.Amount,
+		Status:          model.RefundStatus(refund.Status),
+		CreatedAt:       refund.CreatedAt,
+		UpdatedAt:       refund.UpdatedAt,
+	}
+
+	if refund.Reason != nil {
+		result.Reason = *refund.Reason
+	}
+
+	if refund.ProviderReference != nil {
+		result.ProviderReference = *refund.ProviderReference
+	}
+
+	if refund.IdempotencyKey != nil {
+		result.IdempotencyKey = *refund.IdempotencyKey
+	}
+
+	return result
+}
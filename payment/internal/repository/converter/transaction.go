@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	repoModel "github.com/space-wanderer/microservices/payment/internal/repository/model"
+)
+
+// ConvertModelTransactionToRepoTransaction конвертирует Transaction из service model в repository model
+func ConvertModelTransactionToRepoTransaction(transaction *model.Transaction) *repoModel.Transaction {
+	if transaction == nil {
+		return nil
+	}
+
+	return &repoModel.Transaction{
+		TransactionUUID:   transaction.UUID,
+		OrderUUID:         transaction.OrderUUID,
+		UserUUID:          transaction.UserUUID,
+		PaymentMethod:     string(transaction.PaymentMethod),
+		Amount:            transaction.Amount,
+		Status:            string(transaction.Status),
+		DeclineReason:     optionalString(string(transaction.DeclineReason)),
+		Provider:          transaction.Provider,
+		ProviderReference: optionalString(transaction.ProviderReference),
+		IdempotencyKey:    optionalString(transaction.IdempotencyKey),
+		CreatedAt:         transaction.CreatedAt,
+		UpdatedAt:         transaction.UpdatedAt,
+	}
+}
+
+// ConvertRepoTransactionToModelTransaction конвертирует Transaction из repository model в service model
+func ConvertRepoTransactionToModelTransaction(transaction *repoModel.Transaction) *model.Transaction {
+	if transaction == nil {
+		return nil
+	}
+
+	result := &model.Transaction{
+		UUID:          transaction.TransactionUUID,
+		OrderUUID:     transaction.OrderUUID,
+		UserUUID:      transaction.UserUUID,
+		PaymentMethod: model.PaymentMethod(transaction.PaymentMethod),
+		Amount:        transaction.Amount,
+		Status:        model.TransactionStatus(transaction.Status),
+		Provider:      transaction.Provider,
+		CreatedAt:     transaction.CreatedAt,
+		UpdatedAt:     transaction.UpdatedAt,
+	}
+
+	if transaction.DeclineReason != nil {
+		result.DeclineReason = model.DeclineReason(*transaction.DeclineReason)
+	}
+
+	if transaction.ProviderReference != nil {
+		result.ProviderReference = *transaction.ProviderReference
+	}
+
+	if transaction.IdempotencyKey != nil {
+		result.IdempotencyKey = *transaction.IdempotencyKey
+	}
+
+	return result
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Refund struct {
+	RefundUUID        string
+	TransactionUUID   string
+	OrderUUID         string
+	Amount            decimal.Decimal
+	Status            string
+	Reason            *string
+	ProviderReference *string
+	IdempotencyKey    *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Transaction struct {
+	TransactionUUID   string
+	OrderUUID         string
+	UserUUID          string
+	PaymentMethod     string
+	Amount            decimal.Decimal
+	Status            string
+	DeclineReason     *string
+	Provider          string
+	ProviderReference *string
+	IdempotencyKey    *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
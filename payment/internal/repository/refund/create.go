@@ -0,0 +1,90 @@
+package refund
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/repository/converter"
+)
+
+// uniqueViolationCode - код ошибки PostgreSQL при нарушении уникального индекса
+const uniqueViolationCode = "23505"
+
+// CreateRefund сохраняет новый возврат. Транзакция оплаты блокируется на время проверки,
+// поэтому параллельные возвраты не могут в сумме превысить оплаченную сумму.
+// Если сумма превышает остаток, возвращает model.ErrRefundAmountExceeded
+func (r *repository) CreateRefund(ctx context.Context, refund *model.Refund) error {
+	repoRefund := converter.ConvertModelRefundToRepoRefund(refund)
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err = tx.Exec(ctx, `SELECT 1 FROM transactions WHERE transaction_uuid = $1 FOR UPDATE`, repoRefund.TransactionUUID); err != nil {
+		return err
+	}
+
+	remaining, err := refundableAmount(ctx, tx, repoRefund.TransactionUUID)
+	if err != nil {
+		return err
+	}
+
+	if repoRefund.Amount.GreaterThan(remaining) {
+		return model.ErrRefundAmountExceeded
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO refunds (refund_uuid, transaction_uuid, order_uuid, amount, status, reason,
+			provider_reference, idempotency_key, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, repoRefund.RefundUUID, repoRefund.TransactionUUID, repoRefund.OrderUUID, repoRefund.Amount, repoRefund.Status,
+		repoRefund.Reason, repoRefund.ProviderReference, repoRefund.IdempotencyKey, repoRefund.CreatedAt, repoRefund.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return model.ErrRefundInProgress
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetRefundableAmount возвращает сумму транзакции, которую еще можно вернуть
+func (r *repository) GetRefundableAmount(ctx context.Context, transactionUUID string) (decimal.Decimal, error) {
+	return refundableAmount(ctx, r.db, transactionUUID)
+}
+
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// refundableAmount вычисляет остаток без учета неудачных возвратов
+func refundableAmount(ctx context.Context, q querier, transactionUUID string) (decimal.Decimal, error) {
+	var remaining decimal.Decimal
+	err := q.QueryRow(ctx, `
+		SELECT (t.amount - COALESCE((
+			SELECT SUM(r.amount) FROM refunds r
+			WHERE r.transaction_uuid = t.transaction_uuid AND r.status <> 'FAILED'
+		), 0))
+		FROM transactions t
+		WHERE t.transaction_uuid = $1
+	`, transactionUUID).Scan(&remaining)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return decimal.Zero, model.ErrTransactionNotFound
+		}
+		return decimal.Zero, err
+	}
+
+	return remaining, nil
+}
@@ -0,0 +1,41 @@
+package refund
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/repository/converter"
+	repoModel "github.com/space-wanderer/microservices/payment/internal/repository/model"
+)
+
+func (r *repository) GetRefundByIdempotencyKey(ctx context.Context, idempotencyKey string) (*model.Refund, error) {
+	var refund repoModel.Refund
+	err := r.db.QueryRow(ctx, `
+		SELECT refund_uuid, transaction_uuid, order_uuid, amount, status, reason,
+			provider_reference, idempotency_key, created_at, updated_at
+		FROM refunds
+		WHERE idempotency_key = $1 AND status <> 'FAILED'
+	`, idempotencyKey).Scan(
+		&refund.RefundUUID,
+		&refund.TransactionUUID,
+		&refund.OrderUUID,
+		&refund.Amount,
+		&refund.Status,
+		&refund.Reason,
+		&refund.ProviderReference,
+		&refund.IdempotencyKey,
+		&refund.CreatedAt,
+		&refund.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrRefundNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ConvertRepoRefundToModelRefund(&refund), nil
+}
@@ -0,0 +1,28 @@
+package refund
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/repository/converter"
+)
+
+// UpdateRefund сохраняет новый статус возврата и ответ провайдера
+func (r *repository) UpdateRefund(ctx context.Context, refund *model.Refund) error {
+	repoRefund := converter.ConvertModelRefundToRepoRefund(refund)
+
+	tag, err := r.db.Exec(ctx, `
+		UPDATE refunds
+		SET status = $2, provider_reference = $3, updated_at = $4
+		WHERE refund_uuid = $1
+	`, repoRefund.RefundUUID, repoRefund.Status, repoRefund.ProviderReference, repoRefund.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrRefundNotFound
+	}
+
+	return nil
+}
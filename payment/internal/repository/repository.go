@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+)
+
+type TransactionRepository interface {
+	CreateTransaction(ctx context.Context, transaction *model.Transaction) error
+	UpdateTransaction(ctx context.Context, transaction *model.Transaction) error
+	GetTransactionByUUID(ctx context.Context, transactionUUID string) (*model.Transaction, error)
+	// GetActiveTransactionByOrder возвращает незавершенную или успешную транзакцию заказа
+	GetActiveTransactionByOrder(ctx context.Context, orderUUID string) (*model.Transaction, error)
+	// GetTransactionByIdempotencyKey возвращает попытку оплаты с ключом идемпотентности, кроме неудачных
+	GetTransactionByIdempotencyKey(ctx context.Context, idempotencyKey string) (*model.Transaction, error)
+}
+
+type RefundRepository interface {
+	// CreateRefund сохраняет возврат, если он не превышает невозвращенный остаток транзакции
+	CreateRefund(ctx context.Context, refund *model.Refund) error
+	UpdateRefund(ctx context.Context, refund *model.Refund) error
+	// GetRefundableAmount возвращает сумму транзакции за вычетом успешных и незавершенных возвратов
+	GetRefundableAmount(ctx context.Context, transactionUUID string) (decimal.Decimal, error)
+	// GetRefundByIdempotencyKey возвращает возврат с ключом идемпотентности, кроме неудачных
+	GetRefundByIdempotencyKey(ctx context.Context, idempotencyKey string) (*model.Refund, error)
+}
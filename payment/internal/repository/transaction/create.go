@@ -0,0 +1,37 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/repository/converter"
+)
+
+// uniqueViolationCode - код ошибки PostgreSQL при нарушении уникального индекса
+const uniqueViolationCode = "23505"
+
+// CreateTransaction сохраняет новую транзакцию. Если у заказа уже есть активная транзакция
+// или ключ идемпотентности занят другой попыткой, возвращает model.ErrPaymentInProgress
+func (r *repository) CreateTransaction(ctx context.Context, transaction *model.Transaction) error {
+	repoTransaction := converter.ConvertModelTransactionToRepoTransaction(transaction)
+
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO transactions (transaction_uuid, order_uuid, user_uuid, payment_method, amount, status,
+			decline_reason, provider, provider_reference, idempotency_key, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, repoTransaction.TransactionUUID, repoTransaction.OrderUUID, repoTransaction.UserUUID, repoTransaction.PaymentMethod,
+		repoTransaction.Amount, repoTransaction.Status, repoTransaction.DeclineReason, repoTransaction.Provider,
+		repoTransaction.ProviderReference, repoTransaction.IdempotencyKey, repoTransaction.CreatedAt, repoTransaction.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return model.ErrPaymentInProgress
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/repository/converter"
+	repoModel "github.com/space-wanderer/microservices/payment/internal/repository/model"
+)
+
+// transactionColumns - колонки транзакции в порядке сканирования scanTransaction
+const transactionColumns = `transaction_uuid, order_uuid, user_uuid, payment_method, amount, status,
+	decline_reason, provider, provider_reference, idempotency_key, created_at, updated_at`
+
+func (r *repository) GetTransactionByUUID(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	return scanTransaction(r.db.QueryRow(ctx, `
+		SELECT `+transactionColumns+`
+		FROM transactions
+		WHERE transaction_uuid = $1
+	`, transactionUUID))
+}
+
+func (r *repository) GetActiveTransactionByOrder(ctx context.Context, orderUUID string) (*model.Transaction, error) {
+	return scanTransaction(r.db.QueryRow(ctx, `
+		SELECT `+transactionColumns+`
+		FROM transactions
+		WHERE order_uuid = $1 AND status IN ('PENDING', 'AUTHORIZED', 'CAPTURED')
+	`, orderUUID))
+}
+
+func (r *repository) GetTransactionByIdempotencyKey(ctx context.Context, idempotencyKey string) (*model.Transaction, error) {
+	return scanTransaction(r.db.QueryRow(ctx, `
+		SELECT `+transactionColumns+`
+		FROM transactions
+		WHERE idempotency_key = $1 AND status <> 'FAILED'
+	`, idempotencyKey))
+}
+
+func scanTransaction(row pgx.Row) (*model.Transaction, error) {
+	var transaction repoModel.Transaction
+	err := row.Scan(
+		&transaction.TransactionUUID,
+		&transaction.OrderUUID,
+		&transaction.UserUUID,
+		&transaction.PaymentMethod,
+		&transaction.Amount,
+		&transaction.Status,
+		&transaction.DeclineReason,
+		&transaction.Provider,
+		&transaction.ProviderReference,
+		&transaction.IdempotencyKey,
+		&transaction.CreatedAt,
+		&transaction.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrTransactionNotFound
+		}
+		return nil, err
+	}
+
+	return converter.ConvertRepoTransactionToModelTransaction(&transaction), nil
+}
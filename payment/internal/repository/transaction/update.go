@@ -0,0 +1,29 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/repository/converter"
+)
+
+// UpdateTransaction сохраняет новый статус транзакции и ответ провайдера
+func (r *repository) UpdateTransaction(ctx context.Context, transaction *model.Transaction) error {
+	repoTransaction := converter.ConvertModelTransactionToRepoTransaction(transaction)
+
+	tag, err := r.db.Exec(ctx, `
+		UPDATE transactions
+		SET status = $2, decline_reason = $3, provider_reference = $4, updated_at = $5
+		WHERE transaction_uuid = $1
+	`, repoTransaction.TransactionUUID, repoTransaction.Status, repoTransaction.DeclineReason,
+		repoTransaction.ProviderReference, repoTransaction.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrTransactionNotFound
+	}
+
+	return nil
+}
@@ -23,22 +23,22 @@ func (_m *PaymentService) EXPECT() *PaymentService_Expecter {
 }
 
 // PayOrder provides a mock function with given fields: ctx, req
-func (_m *PaymentService) PayOrder(ctx context.Context, req model.Pay) (string, error) {
+func (_m *PaymentService) PayOrder(ctx context.Context, req model.Pay) (model.Transaction, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
 	}
 
-	var r0 string
+	var r0 model.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.Pay) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, model.Pay) (model.Transaction, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.Pay) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, model.Pay) model.Transaction); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(model.Transaction)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, model.Pay) error); ok {
@@ -69,12 +69,69 @@ func (_c *PaymentService_PayOrder_Call) Run(run func(ctx context.Context, req mo
 	return _c
 }
 
-func (_c *PaymentService_PayOrder_Call) Return(_a0 string, _a1 error) *PaymentService_PayOrder_Call {
+func (_c *PaymentService_PayOrder_Call) Return(_a0 model.Transaction, _a1 error) *PaymentService_PayOrder_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, model.Pay) (string, error)) *PaymentService_PayOrder_Call {
+func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, model.Pay) (model.Transaction, error)) *PaymentService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, req
+func (_m *PaymentService) RefundPayment(ctx context.Context, req model.RefundRequest) (model.Refund, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 model.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefundRequest) (model.Refund, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefundRequest) model.Refund); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(model.Refund)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.RefundRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentService_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req model.RefundRequest
+func (_e *PaymentService_Expecter) RefundPayment(ctx interface{}, req interface{}) *PaymentService_RefundPayment_Call {
+	return &PaymentService_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, req)}
+}
+
+func (_c *PaymentService_RefundPayment_Call) Run(run func(ctx context.Context, req model.RefundRequest)) *PaymentService_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.RefundRequest))
+	})
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) Return(_a0 model.Refund, _a1 error) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) RunAndReturn(run func(context.Context, model.RefundRequest) (model.Refund, error)) *PaymentService_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
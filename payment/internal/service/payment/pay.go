@@ -2,16 +2,181 @@ package payment
 
 import (
 	"context"
-	"log"
+	"errors"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
 )
 
-func (s *Service) PayOrder(ctx context.Context, req model.Pay) (string, error) {
-	transactionUUID := uuid.New().String()
+// PayOrder проводит оплату заказа: блокирует средства у провайдера способа оплаты и списывает их.
+// Каждая попытка сохраняется как транзакция, отказы - вместе с причиной.
+// Повторная оплата уже оплаченного заказа тем же пользователем на ту же сумму
+// возвращает существующую транзакцию. Повтор с тем же ключом идемпотентности
+// возвращает результат исходной попытки, кроме неудачной (FAILED)
+func (s *Service) PayOrder(ctx context.Context, req model.Pay) (model.Transaction, error) {
+	if err := validatePay(req); err != nil {
+		return model.Transaction{}, err
+	}
 
-	log.Printf("Оплата прошла успешно, transaction_uuid: %s", transactionUUID)
-	return transactionUUID, nil
+	provider, ok := s.providers.Get(req.PaymentMethod)
+	if !ok {
+		return model.Transaction{}, model.ErrUnsupportedPaymentMethod
+	}
+
+	if req.IdempotencyKey != "" {
+		previous, err := s.transactionRepository.GetTransactionByIdempotencyKey(ctx, req.IdempotencyKey)
+		switch {
+		case err == nil:
+			return replayTransaction(previous, req)
+		case !errors.Is(err, model.ErrTransactionNotFound):
+			return model.Transaction{}, err
+		}
+	}
+
+	existing, err := s.transactionRepository.GetActiveTransactionByOrder(ctx, req.OrderUuid)
+	switch {
+	case err == nil:
+		return existingTransaction(existing, req)
+	case !errors.Is(err, model.ErrTransactionNotFound):
+		return model.Transaction{}, err
+	}
+
+	now := s.now()
+	transaction := &model.Transaction{
+		UUID:           uuid.New().String(),
+		OrderUUID:      req.OrderUuid,
+		UserUUID:       req.UserUuid,
+		PaymentMethod:  req.PaymentMethod,
+		Amount:         req.Amount,
+		Status:         model.TransactionStatusPending,
+		Provider:       provider.Name(),
+		IdempotencyKey: req.IdempotencyKey,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	// Отказ по правилам сохраняется сразу, до обращения к провайдеру
+	if reason := s.checkRules(req); reason != "" {
+		transaction.Status = model.TransactionStatusDeclined
+		transaction.DeclineReason = reason
+		if err := s.transactionRepository.CreateTransaction(ctx, transaction); err != nil {
+			return model.Transaction{}, err
+		}
+		return model.Transaction{}, declineError(reason)
+	}
+
+	if err := s.transactionRepository.CreateTransaction(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+
+	authorization, err := provider.Authorize(ctx, model.AuthorizationRequest{
+		TransactionUUID: transaction.UUID,
+		UserUUID:        transaction.UserUUID,
+		PaymentMethod:   transaction.PaymentMethod,
+		Amount:          transaction.Amount,
+	})
+	if err != nil {
+		logger.Error(ctx, "payment authorization failed",
+			zap.String("transaction_uuid", transaction.UUID),
+			zap.String("provider", provider.Name()),
+			zap.Error(err),
+		)
+		return model.Transaction{}, s.fail(ctx, transaction, model.ErrProviderUnavailable)
+	}
+
+	if !authorization.Approved {
+		transaction.Status = model.TransactionStatusDeclined
+		transaction.DeclineReason = authorization.DeclineReason
+		if transaction.DeclineReason == "" {
+			transaction.DeclineReason = model.DeclineReasonDoNotHonor
+		}
+		if err := s.updateTransaction(ctx, transaction); err != nil {
+			return model.Transaction{}, err
+		}
+		return model.Transaction{}, declineError(transaction.DeclineReason)
+	}
+
+	transaction.Status = model.TransactionStatusAuthorized
+	transaction.ProviderReference = authorization.Reference
+	if err := s.updateTransaction(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+
+	if err := provider.Capture(ctx, authorization.Reference, transaction.Amount); err != nil {
+		logger.Error(ctx, "payment capture failed",
+			zap.String("transaction_uuid", transaction.UUID),
+			zap.String("provider", provider.Name()),
+			zap.Error(err),
+		)
+
+		// Без снятия блокировки средства пользователя остались бы замороженными
+		if voidErr := provider.Void(ctx, authorization.Reference); voidErr != nil {
+			logger.Error(ctx, "failed to void authorization",
+				zap.String("transaction_uuid", transaction.UUID),
+				zap.Error(voidErr),
+			)
+		}
+		return model.Transaction{}, s.fail(ctx, transaction, model.ErrProviderUnavailable)
+	}
+
+	transaction.Status = model.TransactionStatusCaptured
+	if err := s.updateTransaction(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+
+	logger.Info(ctx, "payment captured",
+		zap.String("transaction_uuid", transaction.UUID),
+		zap.String("order_uuid", transaction.OrderUUID),
+	)
+
+	return *transaction, nil
+}
+
+// existingTransaction решает, что делать с повторной оплатой заказа, у которого уже есть активная транзакция
+func existingTransaction(transaction *model.Transaction, req model.Pay) (model.Transaction, error) {
+	if transaction.Status != model.TransactionStatusCaptured {
+		return model.Transaction{}, model.ErrPaymentInProgress
+	}
+
+	if transaction.UserUUID != req.UserUuid || !transaction.Amount.Equal(req.Amount) {
+		return model.Transaction{}, model.ErrOrderAlreadyPaid
+	}
+
+	return *transaction, nil
+}
+
+// replayTransaction возвращает результат попытки оплаты с тем же ключом идемпотентности
+func replayTransaction(transaction *model.Transaction, req model.Pay) (model.Transaction, error) {
+	if transaction.OrderUUID != req.OrderUuid ||
+		transaction.UserUUID != req.UserUuid ||
+		transaction.PaymentMethod != req.PaymentMethod ||
+		!transaction.Amount.Equal(req.Amount) {
+		return model.Transaction{}, model.ErrIdempotencyKeyConflict
+	}
+
+	switch transaction.Status {
+	case model.TransactionStatusCaptured:
+		return *transaction, nil
+	case model.TransactionStatusDeclined:
+		return model.Transaction{}, declineError(transaction.DeclineReason)
+	default:
+		return model.Transaction{}, model.ErrPaymentInProgress
+	}
+}
+
+// fail помечает транзакцию неудачной и возвращает cause
+func (s *Service) fail(ctx context.Context, transaction *model.Transaction, cause error) error {
+	transaction.Status = model.TransactionStatusFailed
+	if err := s.updateTransaction(ctx, transaction); err != nil {
+		return err
+	}
+	return cause
+}
+
+func (s *Service) updateTransaction(ctx context.Context, transaction *model.Transaction) error {
+	transaction.UpdatedAt = s.now()
+	return s.transactionRepository.UpdateTransaction(ctx, transaction)
 }
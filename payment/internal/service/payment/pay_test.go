@@ -2,60 +2,342 @@ package payment
 
 import (
 	"context"
-	"testing"
+	"errors"
 
-	"github.com/brianvoe/gofakeit/v7"
-	"github.com/stretchr/testify/assert"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/space-wanderer/microservices/payment/internal/model"
 )
 
-func TestService_PayOrder_Integration(t *testing.T) {
-	// Тест с реальной реализацией (без моков)
-	service := NewService()
+func newPay(method model.PaymentMethod, userUUID string, amount string) model.Pay {
+	return model.Pay{
+		OrderUuid:     testOrderUUID,
+		UserUuid:      userUUID,
+		PaymentMethod: method,
+		Amount:        decimal.RequireFromString(amount),
+	}
+}
+
+// withStatus проверяет статус транзакции в момент вызова репозитория
+func withStatus(status model.TransactionStatus) interface{} {
+	return mock.MatchedBy(func(transaction *model.Transaction) bool {
+		return transaction.Status == status
+	})
+}
+
+func withDecline(reason model.DeclineReason) interface{} {
+	return mock.MatchedBy(func(transaction *model.Transaction) bool {
+		return transaction.Status == model.TransactionStatusDeclined && transaction.DeclineReason == reason
+	})
+}
+
+func (s *ServiceSuite) expectNoActiveTransaction() {
+	s.transactionRepository.EXPECT().
+		GetActiveTransactionByOrder(mock.Anything, testOrderUUID).
+		Return(nil, model.ErrTransactionNotFound).
+		Once()
+}
+
+func (s *ServiceSuite) TestPayOrder_Captured() {
+	ctx := context.Background()
+	s.expectNoActiveTransaction()
+	s.transactionRepository.EXPECT().CreateTransaction(ctx, withStatus(model.TransactionStatusPending)).Return(nil).Once()
+	s.transactionRepository.EXPECT().UpdateTransaction(ctx, withStatus(model.TransactionStatusAuthorized)).Return(nil).Once()
+	s.transactionRepository.EXPECT().UpdateTransaction(ctx, withStatus(model.TransactionStatusCaptured)).Return(nil).Once()
+
+	transaction, err := s.service.PayOrder(ctx, newPay(model.PaymentMethodCard, testUserUUID, "150.5"))
+
+	s.Require().NoError(err)
+	s.NotEmpty(transaction.UUID)
+	s.Equal(model.TransactionStatusCaptured, transaction.Status)
+	s.Equal(testOrderUUID, transaction.OrderUUID)
+	s.NotEmpty(transaction.ProviderReference)
+
+	amount, ok := s.provider.Authorized(transaction.ProviderReference)
+	s.True(ok)
+	s.True(decimal.RequireFromString("150.5").Equal(amount))
+}
+
+func (s *ServiceSuite) TestPayOrder_InvestorMoneyWhitelisted() {
+	ctx := context.Background()
+	s.expectNoActiveTransaction()
+	s.transactionRepository.EXPECT().CreateTransaction(ctx, mock.Anything).Return(nil).Once()
+	s.transactionRepository.EXPECT().UpdateTransaction(ctx, mock.Anything).Return(nil).Twice()
+
+	transaction, err := s.service.PayOrder(ctx, newPay(model.PaymentMethodInvestorMoney, testInvestorUUID, "1000000"))
 
-	req := model.Pay{
-		OrderUuid:     gofakeit.UUID(),
-		UserUuid:      gofakeit.UUID(),
-		PaymentMethod: model.PaymentMethodCard,
+	s.Require().NoError(err)
+	s.Equal(model.TransactionStatusCaptured, transaction.Status)
+}
+
+func (s *ServiceSuite) TestPayOrder_DeclinedByRules() {
+	tests := []struct {
+		name          string
+		pay           model.Pay
+		reason        model.DeclineReason
+		expectedError error
+	}{
+		{
+			name:          "Деньги инвестора для пользователя вне белого списка",
+			pay:           newPay(model.PaymentMethodInvestorMoney, testUserUUID, "100"),
+			reason:        model.DeclineReasonMethodNotAllowed,
+			expectedError: model.ErrPaymentMethodNotAllowed,
+		},
+		{
+			name:          "Превышен лимит СБП",
+			pay:           newPay(model.PaymentMethodSBP, testUserUUID, "1000.01"),
+			reason:        model.DeclineReasonLimitExceeded,
+			expectedError: model.ErrLimitExceeded,
+		},
 	}
 
-	result, err := service.PayOrder(context.Background(), req)
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			ctx := context.Background()
+			s.expectNoActiveTransaction()
+			s.transactionRepository.EXPECT().CreateTransaction(ctx, withDecline(tt.reason)).Return(nil).Once()
 
-	assert.NoError(t, err)
-	assert.NotEmpty(t, result)
-	assert.Contains(t, result, "-")
+			transaction, err := s.service.PayOrder(ctx, tt.pay)
+
+			s.ErrorIs(err, tt.expectedError)
+			s.Empty(transaction.UUID)
+		})
+	}
 }
 
-func TestService_PayOrder_WithFakeData(t *testing.T) {
-	// Тест с различными типами данных от gofakeit
-	service := NewService()
+func (s *ServiceSuite) TestPayOrder_DeclinedByProvider() {
+	ctx := context.Background()
+	s.provider.Decline(testUserUUID, model.DeclineReasonInsufficientFunds)
+
+	s.expectNoActiveTransaction()
+	s.transactionRepository.EXPECT().CreateTransaction(ctx, withStatus(model.TransactionStatusPending)).Return(nil).Once()
+	s.transactionRepository.EXPECT().UpdateTransaction(ctx, withDecline(model.DeclineReasonInsufficientFunds)).Return(nil).Once()
 
-	// Генерируем случайные данные
-	err := gofakeit.Seed(12345)
-	assert.NoError(t, err)
+	_, err := s.service.PayOrder(ctx, newPay(model.PaymentMethodCard, testUserUUID, "100"))
+
+	s.ErrorIs(err, model.ErrInsufficientFunds)
+}
+
+func (s *ServiceSuite) TestPayOrder_ProviderUnavailable() {
+	ctx := context.Background()
+	s.provider.SetUnavailable(true)
+
+	s.expectNoActiveTransaction()
+	s.transactionRepository.EXPECT().CreateTransaction(ctx, withStatus(model.TransactionStatusPending)).Return(nil).Once()
+	s.transactionRepository.EXPECT().UpdateTransaction(ctx, withStatus(model.TransactionStatusFailed)).Return(nil).Once()
+
+	_, err := s.service.PayOrder(ctx, newPay(model.PaymentMethodCard, testUserUUID, "100"))
+
+	s.ErrorIs(err, model.ErrProviderUnavailable)
+}
+
+func (s *ServiceSuite) TestPayOrder_ExistingTransaction() {
+	captured := &model.Transaction{
+		UUID:      "550e8400-e29b-41d4-a716-446655440010",
+		OrderUUID: testOrderUUID,
+		UserUUID:  testUserUUID,
+		Amount:    decimal.NewFromInt(100),
+		Status:    model.TransactionStatusCaptured,
+	}
 
-	testCases := []model.PaymentMethod{
-		model.PaymentMethodCard,
-		model.PaymentMethodSBP,
-		model.PaymentMethodCreditCard,
-		model.PaymentMethodInvestorMoney,
+	tests := []struct {
+		name          string
+		existing      *model.Transaction
+		pay           model.Pay
+		expectedError error
+	}{
+		{
+			name:     "Повтор оплаты возвращает проведенную транзакцию",
+			existing: captured,
+			pay:      newPay(model.PaymentMethodCard, testUserUUID, "100"),
+		},
+		{
+			name:     "Сумма из базы с другим количеством знаков после запятой",
+			existing: captured,
+			pay:      newPay(model.PaymentMethodCard, testUserUUID, "100.00"),
+		},
+		{
+			name:          "Оплата на сумму, отличающуюся на копейку",
+			existing:      captured,
+			pay:           newPay(model.PaymentMethodCard, testUserUUID, "100.01"),
+			expectedError: model.ErrOrderAlreadyPaid,
+		},
+		{
+			name:          "Оплата на другую сумму",
+			existing:      captured,
+			pay:           newPay(model.PaymentMethodCard, testUserUUID, "200"),
+			expectedError: model.ErrOrderAlreadyPaid,
+		},
+		{
+			name:          "Оплата еще не завершена",
+			existing:      &model.Transaction{OrderUUID: testOrderUUID, UserUUID: testUserUUID, Amount: decimal.NewFromInt(100), Status: model.TransactionStatusAuthorized},
+			pay:           newPay(model.PaymentMethodCard, testUserUUID, "100"),
+			expectedError: model.ErrPaymentInProgress,
+		},
 	}
 
-	for _, paymentMethod := range testCases {
-		t.Run(string(paymentMethod), func(t *testing.T) {
-			req := model.Pay{
-				OrderUuid:     gofakeit.UUID(),
-				UserUuid:      gofakeit.UUID(),
-				PaymentMethod: paymentMethod,
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.transactionRepository.EXPECT().
+				GetActiveTransactionByOrder(mock.Anything, testOrderUUID).
+				Return(tt.existing, nil).
+				Once()
+
+			transaction, err := s.service.PayOrder(context.Background(), tt.pay)
+
+			if tt.expectedError != nil {
+				s.ErrorIs(err, tt.expectedError)
+				return
 			}
+			s.Require().NoError(err)
+			s.Equal(tt.existing.UUID, transaction.UUID)
+		})
+	}
+}
 
-			result, err := service.PayOrder(context.Background(), req)
+func (s *ServiceSuite) TestPayOrder_InvalidRequest() {
+	tests := []struct {
+		name          string
+		pay           model.Pay
+		expectedError error
+	}{
+		{
+			name:          "Некорректный UUID заказа",
+			pay:           model.Pay{OrderUuid: "bad", UserUuid: testUserUUID, PaymentMethod: model.PaymentMethodCard, Amount: decimal.NewFromInt(100)},
+			expectedError: model.ErrInvalidOrderUUID,
+		},
+		{
+			name:          "Некорректный UUID пользователя",
+			pay:           newPay(model.PaymentMethodCard, "", "100"),
+			expectedError: model.ErrInvalidUserUUID,
+		},
+		{
+			name:          "Нулевая сумма",
+			pay:           newPay(model.PaymentMethodCard, testUserUUID, "0"),
+			expectedError: model.ErrInvalidAmount,
+		},
+		{
+			name:          "Способ оплаты без провайдера",
+			pay:           newPay(model.PaymentMethodCreditCard, testUserUUID, "100"),
+			expectedError: model.ErrUnsupportedPaymentMethod,
+		},
+		{
+			name:          "Неизвестный способ оплаты",
+			pay:           newPay(model.PaymentMethodUnknown, testUserUUID, "100"),
+			expectedError: model.ErrUnsupportedPaymentMethod,
+		},
+	}
 
-			assert.NoError(t, err)
-			assert.NotEmpty(t, result)
-			// Проверяем, что результат является валидным UUID
-			assert.Contains(t, result, "-")
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.PayOrder(context.Background(), tt.pay)
+			s.ErrorIs(err, tt.expectedError)
 		})
 	}
 }
+
+func (s *ServiceSuite) TestPayOrder_RepositoryError() {
+	repoErr := errors.New("database error")
+	s.transactionRepository.EXPECT().
+		GetActiveTransactionByOrder(mock.Anything, testOrderUUID).
+		Return(nil, repoErr).
+		Once()
+
+	_, err := s.service.PayOrder(context.Background(), newPay(model.PaymentMethodCard, testUserUUID, "100"))
+
+	s.ErrorIs(err, repoErr)
+}
+
+func (s *ServiceSuite) TestPayOrder_IdempotencyKey() {
+	const key = "550e8400-e29b-41d4-a716-446655440000:retry-1"
+
+	previous := func(status model.TransactionStatus, reason model.DeclineReason) *model.Transaction {
+		return &model.Transaction{
+			UUID:           "550e8400-e29b-41d4-a716-446655440010",
+			OrderUUID:      testOrderUUID,
+			UserUUID:       testUserUUID,
+			PaymentMethod:  model.PaymentMethodCard,
+			Amount:         decimal.NewFromInt(100),
+			Status:         status,
+			DeclineReason:  reason,
+			IdempotencyKey: key,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		previous      *model.Transaction
+		amount        string
+		expectedError error
+	}{
+		{
+			name:     "Повтор возвращает проведенную транзакцию",
+			previous: previous(model.TransactionStatusCaptured, ""),
+			amount:   "100",
+		},
+		{
+			name:          "Повтор возвращает исходный отказ",
+			previous:      previous(model.TransactionStatusDeclined, model.DeclineReasonInsufficientFunds),
+			amount:        "100",
+			expectedError: model.ErrInsufficientFunds,
+		},
+		{
+			name:          "Исходная попытка еще не завершена",
+			previous:      previous(model.TransactionStatusPending, ""),
+			amount:        "100",
+			expectedError: model.ErrPaymentInProgress,
+		},
+		{
+			name:          "Ключ с другой суммой",
+			previous:      previous(model.TransactionStatusCaptured, ""),
+			amount:        "200",
+			expectedError: model.ErrIdempotencyKeyConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.transactionRepository.EXPECT().
+				GetTransactionByIdempotencyKey(mock.Anything, key).
+				Return(tt.previous, nil).
+				Once()
+
+			pay := newPay(model.PaymentMethodCard, testUserUUID, tt.amount)
+			pay.IdempotencyKey = key
+
+			transaction, err := s.service.PayOrder(context.Background(), pay)
+
+			if tt.expectedError != nil {
+				s.ErrorIs(err, tt.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tt.previous.UUID, transaction.UUID)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestPayOrder_NewIdempotencyKeyIsStored() {
+	ctx := context.Background()
+	const key = "550e8400-e29b-41d4-a716-446655440000:retry-2"
+
+	s.transactionRepository.EXPECT().
+		GetTransactionByIdempotencyKey(ctx, key).
+		Return(nil, model.ErrTransactionNotFound).
+		Once()
+	s.expectNoActiveTransaction()
+	s.transactionRepository.EXPECT().CreateTransaction(ctx, mock.MatchedBy(func(transaction *model.Transaction) bool {
+		return transaction.IdempotencyKey == key
+	})).Return(nil).Once()
+	s.transactionRepository.EXPECT().UpdateTransaction(ctx, mock.Anything).Return(nil).Twice()
+
+	pay := newPay(model.PaymentMethodCard, testUserUUID, "100")
+	pay.IdempotencyKey = key
+
+	transaction, err := s.service.PayOrder(ctx, pay)
+
+	s.Require().NoError(err)
+	s.Equal(model.TransactionStatusCaptured, transaction.Status)
+	s.Equal(key, transaction.IdempotencyKey)
+}
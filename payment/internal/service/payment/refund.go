@@ -0,0 +1,135 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// RefundPayment возвращает средства по проведенной транзакции через провайдера, который ее провел.
+// Нулевая сумма возвращает весь невозвращенный остаток. Повтор с тем же ключом идемпотентности
+// возвращает исходный возврат, кроме неудачного (FAILED)
+func (s *Service) RefundPayment(ctx context.Context, req model.RefundRequest) (model.Refund, error) {
+	if err := validateRefund(req); err != nil {
+		return model.Refund{}, err
+	}
+
+	if req.IdempotencyKey != "" {
+		previous, err := s.refundRepository.GetRefundByIdempotencyKey(ctx, req.IdempotencyKey)
+		switch {
+		case err == nil:
+			return replayRefund(previous, req)
+		case !errors.Is(err, model.ErrRefundNotFound):
+			return model.Refund{}, err
+		}
+	}
+
+	transaction, err := s.transactionRepository.GetTransactionByUUID(ctx, req.TransactionUUID)
+	if err != nil {
+		return model.Refund{}, err
+	}
+
+	if transaction.Status != model.TransactionStatusCaptured {
+		return model.Refund{}, model.ErrTransactionNotRefundable
+	}
+
+	provider, ok := s.providers.Get(transaction.PaymentMethod)
+	if !ok {
+		return model.Refund{}, model.ErrUnsupportedPaymentMethod
+	}
+
+	amount := req.Amount
+	if amount.IsZero() {
+		amount, err = s.refundRepository.GetRefundableAmount(ctx, transaction.UUID)
+		if err != nil {
+			return model.Refund{}, err
+		}
+		if !amount.IsPositive() {
+			return model.Refund{}, model.ErrRefundAmountExceeded
+		}
+	}
+
+	now := s.now()
+	refund := &model.Refund{
+		UUID:            uuid.New().String(),
+		TransactionUUID: transaction.UUID,
+		OrderUUID:       transaction.OrderUUID,
+		Amount:          amount,
+		Status:          model.RefundStatusPending,
+		Reason:          req.Reason,
+		IdempotencyKey:  req.IdempotencyKey,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	// Остаток проверяется повторно при сохранении, под блокировкой транзакции
+	if err := s.refundRepository.CreateRefund(ctx, refund); err != nil {
+		return model.Refund{}, err
+	}
+
+	reference, err := provider.Refund(ctx, transaction.ProviderReference, refund.Amount)
+	if err != nil {
+		logger.Error(ctx, "payment refund failed",
+			zap.String("refund_uuid", refund.UUID),
+			zap.String("transaction_uuid", transaction.UUID),
+			zap.String("provider", provider.Name()),
+			zap.Error(err),
+		)
+
+		refund.Status = model.RefundStatusFailed
+		if err := s.updateRefund(ctx, refund); err != nil {
+			return model.Refund{}, err
+		}
+		return model.Refund{}, model.ErrProviderUnavailable
+	}
+
+	refund.Status = model.RefundStatusSucceeded
+	refund.ProviderReference = reference
+	if err := s.updateRefund(ctx, refund); err != nil {
+		return model.Refund{}, err
+	}
+
+	logger.Info(ctx, "payment refunded",
+		zap.String("refund_uuid", refund.UUID),
+		zap.String("transaction_uuid", transaction.UUID),
+		zap.String("amount", refund.Amount.String()),
+	)
+
+	return *refund, nil
+}
+
+// validateRefund проверяет корректность запроса на возврат
+func validateRefund(req model.RefundRequest) error {
+	if _, err := uuid.Parse(req.TransactionUUID); err != nil {
+		return model.ErrInvalidTransactionUUID
+	}
+
+	if req.Amount.IsNegative() {
+		return model.ErrInvalidRefundAmount
+	}
+
+	return nil
+}
+
+// replayRefund возвращает результат возврата с тем же ключом идемпотентности
+func replayRefund(refund *model.Refund, req model.RefundRequest) (model.Refund, error) {
+	if refund.TransactionUUID != req.TransactionUUID || (!req.Amount.IsZero() && !refund.Amount.Equal(req.Amount)) {
+		return model.Refund{}, model.ErrIdempotencyKeyConflict
+	}
+
+	if refund.Status != model.RefundStatusSucceeded {
+		return model.Refund{}, model.ErrRefundInProgress
+	}
+
+	return *refund, nil
+}
+
+func (s *Service) updateRefund(ctx context.Context, refund *model.Refund) error {
+	refund.UpdatedAt = s.now()
+	return s.refundRepository.UpdateRefund(ctx, refund)
+}
@@ -0,0 +1,195 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+)
+
+const testTransactionUUID = "550e8400-e29b-41d4-a716-446655440020"
+
+// capturedTransaction возвращает проведенную транзакцию, авторизованную в фейковом провайдере
+func (s *ServiceSuite) capturedTransaction(amount int64) *model.Transaction {
+	authorization, err := s.provider.Authorize(context.Background(), model.AuthorizationRequest{
+		TransactionUUID: testTransactionUUID,
+		UserUUID:        testUserUUID,
+		PaymentMethod:   model.PaymentMethodCard,
+		Amount:          decimal.NewFromInt(amount),
+	})
+	s.Require().NoError(err)
+
+	return &model.Transaction{
+		UUID:              testTransactionUUID,
+		OrderUUID:         testOrderUUID,
+		UserUUID:          testUserUUID,
+		PaymentMethod:     model.PaymentMethodCard,
+		Amount:            decimal.NewFromInt(amount),
+		Status:            model.TransactionStatusCaptured,
+		ProviderReference: authorization.Reference,
+	}
+}
+
+func withRefundStatus(status model.RefundStatus) interface{} {
+	return mock.MatchedBy(func(refund *model.Refund) bool {
+		return refund.Status == status
+	})
+}
+
+func (s *ServiceSuite) TestRefundPayment_Partial() {
+	ctx := context.Background()
+	transaction := s.capturedTransaction(100)
+
+	s.transactionRepository.EXPECT().GetTransactionByUUID(ctx, testTransactionUUID).Return(transaction, nil).Once()
+	s.refundRepository.EXPECT().CreateRefund(ctx, mock.MatchedBy(func(refund *model.Refund) bool {
+		return refund.Status == model.RefundStatusPending && refund.Amount.Equal(decimal.NewFromInt(40)) && refund.OrderUUID == testOrderUUID
+	})).Return(nil).Once()
+	s.refundRepository.EXPECT().UpdateRefund(ctx, withRefundStatus(model.RefundStatusSucceeded)).Return(nil).Once()
+
+	refund, err := s.service.RefundPayment(ctx, model.RefundRequest{TransactionUUID: testTransactionUUID, Amount: decimal.NewFromInt(40)})
+
+	s.Require().NoError(err)
+	s.Equal(model.RefundStatusSucceeded, refund.Status)
+	s.True(decimal.NewFromInt(40).Equal(refund.Amount))
+	s.NotEmpty(refund.ProviderReference)
+	s.True(decimal.NewFromInt(40).Equal(s.provider.Refunded(transaction.ProviderReference)))
+}
+
+func (s *ServiceSuite) TestRefundPayment_FullRemainingAmount() {
+	ctx := context.Background()
+	transaction := s.capturedTransaction(100)
+
+	s.transactionRepository.EXPECT().GetTransactionByUUID(ctx, testTransactionUUID).Return(transaction, nil).Once()
+	s.refundRepository.EXPECT().GetRefundableAmount(ctx, testTransactionUUID).Return(decimal.NewFromInt(60), nil).Once()
+	s.refundRepository.EXPECT().CreateRefund(ctx, mock.MatchedBy(func(refund *model.Refund) bool {
+		return refund.Amount.Equal(decimal.NewFromInt(60))
+	})).Return(nil).Once()
+	s.refundRepository.EXPECT().UpdateRefund(ctx, withRefundStatus(model.RefundStatusSucceeded)).Return(nil).Once()
+
+	refund, err := s.service.RefundPayment(ctx, model.RefundRequest{TransactionUUID: testTransactionUUID})
+
+	s.Require().NoError(err)
+	s.True(decimal.NewFromInt(60).Equal(refund.Amount))
+}
+
+func (s *ServiceSuite) TestRefundPayment_NothingLeftToRefund() {
+	ctx := context.Background()
+	transaction := s.capturedTransaction(100)
+
+	s.transactionRepository.EXPECT().GetTransactionByUUID(ctx, testTransactionUUID).Return(transaction, nil).Once()
+	s.refundRepository.EXPECT().GetRefundableAmount(ctx, testTransactionUUID).Return(decimal.NewFromInt(0), nil).Once()
+
+	_, err := s.service.RefundPayment(ctx, model.RefundRequest{TransactionUUID: testTransactionUUID})
+
+	s.ErrorIs(err, model.ErrRefundAmountExceeded)
+}
+
+func (s *ServiceSuite) TestRefundPayment_NotCaptured() {
+	ctx := context.Background()
+	transaction := s.capturedTransaction(100)
+	transaction.Status = model.TransactionStatusDeclined
+
+	s.transactionRepository.EXPECT().GetTransactionByUUID(ctx, testTransactionUUID).Return(transaction, nil).Once()
+
+	_, err := s.service.RefundPayment(ctx, model.RefundRequest{TransactionUUID: testTransactionUUID, Amount: decimal.NewFromInt(10)})
+
+	s.ErrorIs(err, model.ErrTransactionNotRefundable)
+}
+
+func (s *ServiceSuite) TestRefundPayment_ProviderUnavailable() {
+	ctx := context.Background()
+	transaction := s.capturedTransaction(100)
+	s.provider.SetUnavailable(true)
+
+	s.transactionRepository.EXPECT().GetTransactionByUUID(ctx, testTransactionUUID).Return(transaction, nil).Once()
+	s.refundRepository.EXPECT().CreateRefund(ctx, withRefundStatus(model.RefundStatusPending)).Return(nil).Once()
+	s.refundRepository.EXPECT().UpdateRefund(ctx, withRefundStatus(model.RefundStatusFailed)).Return(nil).Once()
+
+	_, err := s.service.RefundPayment(ctx, model.RefundRequest{TransactionUUID: testTransactionUUID, Amount: decimal.NewFromInt(10)})
+
+	s.ErrorIs(err, model.ErrProviderUnavailable)
+}
+
+func (s *ServiceSuite) TestRefundPayment_IdempotencyKey() {
+	const key = "refund:" + testOrderUUID
+
+	succeeded := &model.Refund{
+		UUID:            "550e8400-e29b-41d4-a716-446655440021",
+		TransactionUUID: testTransactionUUID,
+		Amount:          decimal.NewFromInt(100),
+		Status:          model.RefundStatusSucceeded,
+		IdempotencyKey:  key,
+	}
+
+	tests := []struct {
+		name          string
+		previous      *model.Refund
+		req           model.RefundRequest
+		expectedError error
+	}{
+		{
+			name:     "Повтор полного возврата возвращает исходный возврат",
+			previous: succeeded,
+			req:      model.RefundRequest{TransactionUUID: testTransactionUUID, IdempotencyKey: key},
+		},
+		{
+			name:          "Ключ с другой суммой",
+			previous:      succeeded,
+			req:           model.RefundRequest{TransactionUUID: testTransactionUUID, Amount: decimal.NewFromInt(50), IdempotencyKey: key},
+			expectedError: model.ErrIdempotencyKeyConflict,
+		},
+		{
+			name: "Исходный возврат еще не завершен",
+			previous: &model.Refund{
+				TransactionUUID: testTransactionUUID,
+				Amount:          decimal.NewFromInt(100),
+				Status:          model.RefundStatusPending,
+			},
+			req:           model.RefundRequest{TransactionUUID: testTransactionUUID, IdempotencyKey: key},
+			expectedError: model.ErrRefundInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.refundRepository.EXPECT().GetRefundByIdempotencyKey(mock.Anything, key).Return(tt.previous, nil).Once()
+
+			refund, err := s.service.RefundPayment(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				s.ErrorIs(err, tt.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(tt.previous.UUID, refund.UUID)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRefundPayment_InvalidRequest() {
+	tests := []struct {
+		name          string
+		req           model.RefundRequest
+		expectedError error
+	}{
+		{
+			name:          "Некорректный UUID транзакции",
+			req:           model.RefundRequest{TransactionUUID: "invalid", Amount: decimal.NewFromInt(10)},
+			expectedError: model.ErrInvalidTransactionUUID,
+		},
+		{
+			name:          "Отрицательная сумма",
+			req:           model.RefundRequest{TransactionUUID: testTransactionUUID, Amount: decimal.NewFromInt(-1)},
+			expectedError: model.ErrInvalidRefundAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.RefundPayment(context.Background(), tt.req)
+			s.ErrorIs(err, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package payment
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+)
+
+// validatePay проверяет корректность запроса на оплату
+func validatePay(req model.Pay) error {
+	if _, err := uuid.Parse(req.OrderUuid); err != nil {
+		return model.ErrInvalidOrderUUID
+	}
+
+	if _, err := uuid.Parse(req.UserUuid); err != nil {
+		return model.ErrInvalidUserUUID
+	}
+
+	if !req.Amount.IsPositive() {
+		return model.ErrInvalidAmount
+	}
+
+	return nil
+}
+
+// checkRules применяет правила способа оплаты и возвращает причину отказа,
+// если платеж не может быть принят
+func (s *Service) checkRules(req model.Pay) model.DeclineReason {
+	if req.PaymentMethod == model.PaymentMethodInvestorMoney {
+		if _, ok := s.investorWhitelist[req.UserUuid]; !ok {
+			return model.DeclineReasonMethodNotAllowed
+		}
+	}
+
+	if maxAmount, ok := s.rules.MaxAmount[req.PaymentMethod]; ok && req.Amount.GreaterThan(maxAmount) {
+		return model.DeclineReasonLimitExceeded
+	}
+
+	return ""
+}
+
+// declineError возвращает бизнес-ошибку, соответствующую причине отказа
+func declineError(reason model.DeclineReason) error {
+	switch reason {
+	case model.DeclineReasonMethodNotAllowed:
+		return model.ErrPaymentMethodNotAllowed
+	case model.DeclineReasonInsufficientFunds:
+		return model.ErrInsufficientFunds
+	case model.DeclineReasonLimitExceeded:
+		return model.ErrLimitExceeded
+	case model.DeclineReasonFraudSuspected:
+		return model.ErrFraudSuspected
+	default:
+		return model.ErrPaymentDeclined
+	}
+}
@@ -1,7 +1,39 @@
 package payment
 
-type Service struct{}
+import (
+	"time"
 
-func NewService() *Service {
-	return &Service{}
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/provider"
+	"github.com/space-wanderer/microservices/payment/internal/repository"
+)
+
+type Service struct {
+	transactionRepository repository.TransactionRepository
+	refundRepository      repository.RefundRepository
+	providers             provider.Registry
+	rules                 model.PaymentRules
+	investorWhitelist     map[string]struct{}
+	now                   func() time.Time
+}
+
+func NewService(
+	transactionRepository repository.TransactionRepository,
+	refundRepository repository.RefundRepository,
+	providers provider.Registry,
+	rules model.PaymentRules,
+) *Service {
+	investorWhitelist := make(map[string]struct{}, len(rules.InvestorWhitelist))
+	for _, userUUID := range rules.InvestorWhitelist {
+		investorWhitelist[userUUID] = struct{}{}
+	}
+
+	return &Service{
+		transactionRepository: transactionRepository,
+		refundRepository:      refundRepository,
+		providers:             providers,
+		rules:                 rules,
+		investorWhitelist:     investorWhitelist,
+		now:                   func() time.Time { return time.Now().UTC() },
+	}
 }
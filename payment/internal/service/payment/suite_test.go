@@ -3,19 +3,57 @@ package payment
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/space-wanderer/microservices/payment/internal/model"
+	"github.com/space-wanderer/microservices/payment/internal/provider"
+	"github.com/space-wanderer/microservices/payment/internal/provider/fake"
+	"github.com/space-wanderer/microservices/payment/internal/repository/mocks"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+const (
+	testOrderUUID    = "550e8400-e29b-41d4-a716-446655440000"
+	testUserUUID     = "550e8400-e29b-41d4-a716-446655440001"
+	testInvestorUUID = "550e8400-e29b-41d4-a716-446655440002"
 )
 
 type ServiceSuite struct {
 	suite.Suite
-	service *Service
+	transactionRepository *mocks.TransactionRepository
+	refundRepository      *mocks.RefundRepository
+	provider              *fake.Provider
+	service               *Service
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.Require().NoError(logger.Init("error", true))
 }
 
 func (s *ServiceSuite) SetupTest() {
-	s.service = NewService()
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.refundRepository = mocks.NewRefundRepository(s.T())
+	s.provider = fake.New()
+	s.service = NewService(
+		s.transactionRepository,
+		s.refundRepository,
+		provider.Registry{
+			model.PaymentMethodCard:          s.provider,
+			model.PaymentMethodSBP:           s.provider,
+			model.PaymentMethodInvestorMoney: s.provider,
+		},
+		model.PaymentRules{
+			InvestorWhitelist: []string{testInvestorUUID},
+			MaxAmount:         map[model.PaymentMethod]decimal.Decimal{model.PaymentMethodSBP: decimal.NewFromInt(1000)},
+		},
+	)
 }
 
-func (s *ServiceSuite) TearDownTest() {}
+func (s *ServiceSuite) TearDownTest() {
+	s.transactionRepository.AssertExpectations(s.T())
+	s.refundRepository.AssertExpectations(s.T())
+}
 
 func TestServiceIntegration(t *testing.T) {
 	suite.Run(t, new(ServiceSuite))
@@ -7,5 +7,6 @@ import (
 )
 
 type PaymentService interface {
-	PayOrder(ctx context.Context, req model.Pay) (string, error)
+	PayOrder(ctx context.Context, req model.Pay) (model.Transaction, error)
+	RefundPayment(ctx context.Context, req model.RefundRequest) (model.Refund, error)
 }
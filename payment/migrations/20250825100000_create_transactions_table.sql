@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE transactions (
+    transaction_uuid VARCHAR(36) PRIMARY KEY,
+    order_uuid VARCHAR(36) NOT NULL,
+    user_uuid VARCHAR(36) NOT NULL,
+    payment_method VARCHAR(20) NOT NULL CHECK (payment_method IN ('CARD', 'SBP', 'CREDIT_CARD', 'INVESTOR_MONEY')),
+    amount DECIMAL(12,2) NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'AUTHORIZED', 'CAPTURED', 'DECLINED', 'FAILED')),
+    decline_reason VARCHAR(32),
+    provider VARCHAR(32) NOT NULL,
+    provider_reference VARCHAR(64), -- идентификатор операции у платежного провайдера
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- По заказу может быть не больше одной незавершенной или успешной транзакции,
+-- отклоненные и неудачные попытки остаются в истории
+CREATE UNIQUE INDEX idx_transactions_active_order ON transactions(order_uuid)
+    WHERE status IN ('PENDING', 'AUTHORIZED', 'CAPTURED');
+CREATE INDEX idx_transactions_user_uuid ON transactions(user_uuid);
+
+-- +goose Down
+DROP TABLE transactions;
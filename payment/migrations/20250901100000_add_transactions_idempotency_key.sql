@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN idempotency_key VARCHAR(255);
+
+-- Ключ принадлежит одной попытке оплаты, после неудачной попытки (FAILED) его можно повторить
+CREATE UNIQUE INDEX idx_transactions_idempotency_key ON transactions(idempotency_key)
+    WHERE idempotency_key IS NOT NULL AND status <> 'FAILED';
+
+-- +goose Down
+DROP INDEX idx_transactions_idempotency_key;
+ALTER TABLE transactions DROP COLUMN idempotency_key;
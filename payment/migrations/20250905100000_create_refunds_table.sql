@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE refunds (
+    refund_uuid VARCHAR(36) PRIMARY KEY,
+    transaction_uuid VARCHAR(36) NOT NULL REFERENCES transactions(transaction_uuid),
+    order_uuid VARCHAR(36) NOT NULL,
+    amount DECIMAL(12,2) NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    reason TEXT,
+    provider_reference VARCHAR(64), -- идентификатор возврата у платежного провайдера
+    idempotency_key VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_refunds_transaction_uuid ON refunds(transaction_uuid);
+-- Ключ принадлежит одной попытке возврата, после неудачной попытки (FAILED) его можно повторить
+CREATE UNIQUE INDEX idx_refunds_idempotency_key ON refunds(idempotency_key)
+    WHERE idempotency_key IS NOT NULL AND status <> 'FAILED';
+
+-- +goose Down
+DROP TABLE refunds;
@@ -0,0 +1,75 @@
+// dlq-replay переотправляет сообщения из DLQ-топика обратно на обработку.
+//
+//	go run ./platform/cmd/dlq-replay -brokers localhost:9092 -topic order.paid.assembly-group-order-paid.dlq
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/dlq"
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/producer"
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+func main() {
+	brokers := flag.String("brokers", "localhost:9092", "адреса Kafka через запятую")
+	topic := flag.String("topic", "", "DLQ-топик")
+	groupID := flag.String("group", "dlq-replay", "группа, в которой сохраняется прогресс replay")
+	limit := flag.Int("limit", 0, "максимум сообщений, 0 — все накопившиеся")
+	flag.Parse()
+
+	if *topic == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := logger.Init("info", false); err != nil {
+		panic(fmt.Sprintf("failed to init logger: %v", err))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if err := run(ctx, strings.Split(*brokers, ","), *topic, *groupID, *limit); err != nil {
+		logger.Error(ctx, "DLQ replay failed", zap.Error(err))
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, brokers []string, topic, groupID string, limit int) error {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	saramaConfig.Producer.Return.Successes = true
+
+	client, err := sarama.NewClient(brokers, saramaConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create kafka client: %w", err)
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	syncProducer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		return fmt.Errorf("failed to create kafka producer: %w", err)
+	}
+	defer func() {
+		_ = syncProducer.Close()
+	}()
+
+	replayer := dlq.NewReplayer(client, producer.NewTopicProducer(syncProducer, logger.Logger()), logger.Logger())
+
+	replayed, err := replayer.Replay(ctx, topic, groupID, limit)
+	logger.Info(ctx, "DLQ replay finished", zap.String("topic", topic), zap.Int("replayed", replayed))
+
+	return err
+}
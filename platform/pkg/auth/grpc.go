@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// authorizationHeader — ключ метаданных gRPC с токеном в формате "Bearer <token>"
+const authorizationHeader = "authorization"
+
+// UnaryServerInterceptor проверяет JWT из метаданных authorization и кладет идентификатор
+// пользователя из claim sub в контекст запроса; его возвращает logger.UserIDFromContext.
+// RPC из public (полные имена /package.Service/Method) вызываются без токена, например health-проверки
+func UnaryServerInterceptor(verifier *Verifier, public ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(public, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		token, ok := bearerToken(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "bearer token required")
+		}
+
+		claims, err := verifier.Verify(token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(logger.ContextWithUserID(ctx, claims.Subject), req)
+	}
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+const (
+	healthMethod = "/grpc.health.v1.Health/Check"
+	orderMethod  = "/order.v1.OrderService/GetOrder"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	set, err := StaticKey("shared-secret")
+	require.NoError(t, err)
+	interceptor := UnaryServerInterceptor(NewVerifier(set, testIssuer, testAudience), healthMethod)
+
+	validToken := sign(t, jwt.SigningMethodHS256, "", validClaims(), []byte("shared-secret"))
+	forgedToken := sign(t, jwt.SigningMethodHS256, "", validClaims(), []byte("other-secret"))
+
+	withAuthorization := func(values ...string) context.Context {
+		md := metadata.MD{}
+		for _, value := range values {
+			md.Append(authorizationHeader, value)
+		}
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		method       string
+		expectedCode codes.Code
+		expectedUser string
+	}{
+		{
+			name:         "Публичный метод без токена",
+			ctx:          context.Background(),
+			method:       healthMethod,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Публичный метод с недействительным токеном",
+			ctx:          withAuthorization("Bearer " + forgedToken),
+			method:       healthMethod,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Действительный токен",
+			ctx:          withAuthorization("Bearer " + validToken),
+			method:       orderMethod,
+			expectedCode: codes.OK,
+			expectedUser: testSubject,
+		},
+		{
+			name:         "Схема в нижнем регистре",
+			ctx:          withAuthorization("bearer " + validToken),
+			method:       orderMethod,
+			expectedCode: codes.OK,
+			expectedUser: testSubject,
+		},
+		{
+			name:         "Нет метаданных",
+			ctx:          context.Background(),
+			method:       orderMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Нет заголовка authorization",
+			ctx:          metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "1")),
+			method:       orderMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Токен без схемы",
+			ctx:          withAuthorization(validToken),
+			method:       orderMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Другая схема",
+			ctx:          withAuthorization("Basic " + validToken),
+			method:       orderMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Схема без токена",
+			ctx:          withAuthorization("Bearer "),
+			method:       orderMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Недействительный токен",
+			ctx:          withAuthorization("Bearer " + forgedToken),
+			method:       orderMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Проверяется только первое значение заголовка",
+			ctx:          withAuthorization("Bearer "+forgedToken, "Bearer "+validToken),
+			method:       orderMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handlerCtx context.Context
+			handler := func(ctx context.Context, _ any) (any, error) {
+				handlerCtx = ctx
+				return "response", nil
+			}
+
+			resp, err := interceptor(tt.ctx, "request", &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedCode, status.Code(err))
+				assert.Nil(t, resp)
+				assert.Nil(t, handlerCtx, "handler must not be called")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "response", resp)
+			require.NotNil(t, handlerCtx)
+
+			userID, ok := logger.UserIDFromContext(handlerCtx)
+			assert.Equal(t, tt.expectedUser != "", ok)
+			assert.Equal(t, tt.expectedUser, userID)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// KeySet — набор ключей проверки подписи, выбираемых по kid из заголовка токена
+type KeySet struct {
+	keys map[string]crypto.PublicKey
+	// single — ключ для токенов без kid, если в наборе ровно один ключ
+	single crypto.PublicKey
+}
+
+// jwk — ключ в формате JSON Web Key (RFC 7517)
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+// LoadJWKSFile читает набор ключей из JWKS-файла. Поддерживаются ключи RSA, EC, OKP (Ed25519) и oct (HMAC).
+// Ключи с use, отличным от sig, пропускаются.
+func LoadJWKSFile(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwks file: %w", err)
+	}
+
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse jwks file: %w", err)
+	}
+
+	set := &KeySet{keys: make(map[string]crypto.PublicKey, len(jwks.Keys))}
+	for _, key := range jwks.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		publicKey, parseErr := key.publicKey()
+		if parseErr != nil {
+			return nil, fmt.Errorf("failed to parse jwk %q: %w", key.Kid, parseErr)
+		}
+		set.keys[key.Kid] = publicKey
+		set.single = publicKey
+	}
+
+	if len(set.keys) == 0 {
+		return nil, errors.New("jwks file contains no signing keys")
+	}
+	if len(set.keys) > 1 {
+		set.single = nil
+	}
+
+	return set, nil
+}
+
+// StaticKey создает набор из одного ключа. PEM-блок разбирается как открытый ключ
+// (RSA, ECDSA или Ed25519), любое другое значение используется как общий секрет HMAC.
+func StaticKey(value string) (*KeySet, error) {
+	if value == "" {
+		return nil, errors.New("static key is empty")
+	}
+
+	var key crypto.PublicKey = []byte(value)
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse static public key: %w", err)
+		}
+		key = publicKey
+	}
+
+	return &KeySet{
+		keys:   map[string]crypto.PublicKey{"": key},
+		single: key,
+	}, nil
+}
+
+// keyfunc выбирает ключ проверки подписи по kid токена
+func (s *KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if s.single == nil {
+			return nil, errors.New("token has no kid")
+		}
+		return s.single, nil
+	}
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+
+	return key, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("rsa exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+
+	case "oct":
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return nil, err
+		}
+		return secret, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
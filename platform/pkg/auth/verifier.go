@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// leeway — допустимое расхождение часов между издателем токена и сервисом
+const leeway = 30 * time.Second
+
+// ErrInvalidToken — токен не прошёл проверку подписи или claims
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims — проверенные данные токена
+type Claims struct {
+	// Subject — идентификатор пользователя из claim sub
+	Subject string
+}
+
+// Verifier проверяет подпись, срок действия, издателя и аудиторию JWT
+type Verifier struct {
+	keys   *KeySet
+	parser *jwt.Parser
+}
+
+func NewVerifier(keys *KeySet, issuer, audience string) *Verifier {
+	return &Verifier{
+		keys: keys,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{
+				"RS256", "RS384", "RS512",
+				"PS256", "PS384", "PS512",
+				"ES256", "ES384", "ES512",
+				"EdDSA",
+				"HS256", "HS384", "HS512",
+			}),
+			jwt.WithIssuer(issuer),
+			jwt.WithAudience(audience),
+			jwt.WithExpirationRequired(),
+			jwt.WithLeeway(leeway),
+		),
+	}
+}
+
+// Verify проверяет токен и возвращает его claims.
+// Тип ключа должен соответствовать алгоритму токена, поэтому токен с alg=HS256
+// не пройдёт проверку открытым RSA-ключом, использованным как HMAC-секрет.
+func (v *Verifier) Verify(tokenString string) (*Claims, error) {
+	claims := &jwt.RegisteredClaims{}
+	if _, err := v.parser.ParseWithClaims(tokenString, claims, v.keys.keyfunc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing sub claim", ErrInvalidToken)
+	}
+
+	return &Claims{Subject: claims.Subject}, nil
+}
@@ -0,0 +1,267 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://auth.space-wanderer.local"
+	testAudience = "order"
+	testSubject  = "4d2f6f5e-2c43-4c4e-9a0b-3f1d7e1c2a10"
+)
+
+// testKeys — ключи, которыми тесты подписывают токены
+type testKeys struct {
+	rsa   *rsa.PrivateKey
+	ecdsa *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return testKeys{rsa: rsaKey, ecdsa: ecKey}
+}
+
+// writeJWKS сохраняет открытые ключи в JWKS-файл с kid "rsa-1" и "ec-1"
+func (k testKeys) writeJWKS(t *testing.T) string {
+	t.Helper()
+
+	encode := func(n *big.Int) string { return base64.RawURLEncoding.EncodeToString(n.Bytes()) }
+	jwks := map[string][]map[string]string{"keys": {
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": encode(k.rsa.N), "e": encode(big.NewInt(int64(k.rsa.E)))},
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": encode(k.ecdsa.X), "y": encode(k.ecdsa.Y)},
+		{"kty": "RSA", "kid": "enc-1", "use": "enc", "n": encode(k.rsa.N), "e": encode(big.NewInt(int64(k.rsa.E)))},
+	}}
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+// rsaPublicPEM возвращает открытый RSA-ключ в формате PEM, как его задают в статической конфигурации
+func (k testKeys) rsaPublicPEM(t *testing.T) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&k.rsa.PublicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func validClaims() jwt.RegisteredClaims {
+	now := time.Now()
+	return jwt.RegisteredClaims{
+		Issuer:    testIssuer,
+		Audience:  jwt.ClaimStrings{testAudience},
+		Subject:   testSubject,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, claims jwt.RegisteredClaims, key any) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestVerifier_JWKS(t *testing.T) {
+	keys := newTestKeys(t)
+	set, err := LoadJWKSFile(keys.writeJWKS(t))
+	require.NoError(t, err)
+	verifier := NewVerifier(set, testIssuer, testAudience)
+
+	withClaims := func(modify func(claims *jwt.RegisteredClaims)) jwt.RegisteredClaims {
+		claims := validClaims()
+		modify(&claims)
+		return claims
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		expectErr string
+	}{
+		{
+			name:  "RS256 по kid",
+			token: sign(t, jwt.SigningMethodRS256, "rsa-1", validClaims(), keys.rsa),
+		},
+		{
+			name:  "ES256 по kid",
+			token: sign(t, jwt.SigningMethodES256, "ec-1", validClaims(), keys.ecdsa),
+		},
+		{
+			name: "Истекший токен в пределах допуска часов",
+			token: sign(t, jwt.SigningMethodRS256, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-10 * time.Second))
+			}), keys.rsa),
+		},
+		{
+			name: "Истекший токен",
+			token: sign(t, jwt.SigningMethodRS256, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+			}), keys.rsa),
+			expectErr: "token is expired",
+		},
+		{
+			name: "Токен без срока действия",
+			token: sign(t, jwt.SigningMethodRS256, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = nil
+			}), keys.rsa),
+			expectErr: "exp claim is required",
+		},
+		{
+			name: "Чужой издатель",
+			token: sign(t, jwt.SigningMethodRS256, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) {
+				c.Issuer = "https://evil.example"
+			}), keys.rsa),
+			expectErr: "token has invalid issuer",
+		},
+		{
+			name: "Чужая аудитория",
+			token: sign(t, jwt.SigningMethodRS256, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) {
+				c.Audience = jwt.ClaimStrings{"payment"}
+			}), keys.rsa),
+			expectErr: "token has invalid audience",
+		},
+		{
+			name: "Нет claim sub",
+			token: sign(t, jwt.SigningMethodRS256, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) {
+				c.Subject = ""
+			}), keys.rsa),
+			expectErr: "missing sub claim",
+		},
+		{
+			name:      "Неизвестный kid",
+			token:     sign(t, jwt.SigningMethodRS256, "rsa-2", validClaims(), keys.rsa),
+			expectErr: `unknown kid "rsa-2"`,
+		},
+		{
+			name:      "Ключ с use=enc не используется для подписи",
+			token:     sign(t, jwt.SigningMethodRS256, "enc-1", validClaims(), keys.rsa),
+			expectErr: `unknown kid "enc-1"`,
+		},
+		{
+			name:      "Без kid при нескольких ключах",
+			token:     sign(t, jwt.SigningMethodRS256, "", validClaims(), keys.rsa),
+			expectErr: "token has no kid",
+		},
+		{
+			name:      "Алгоритм не соответствует типу ключа",
+			token:     sign(t, jwt.SigningMethodES256, "rsa-1", validClaims(), keys.ecdsa),
+			expectErr: "signature is invalid",
+		},
+		{
+			name:      "Алгоритм none",
+			token:     sign(t, jwt.SigningMethodNone, "rsa-1", validClaims(), jwt.UnsafeAllowNoneSignatureType),
+			expectErr: "signing method none is invalid",
+		},
+		{
+			name:      "Поврежденная подпись",
+			token:     sign(t, jwt.SigningMethodRS256, "rsa-1", validClaims(), keys.rsa) + "x",
+			expectErr: "signature is invalid",
+		},
+		{
+			name:      "Не JWT",
+			token:     "not-a-token",
+			expectErr: "token is malformed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(tt.token)
+
+			if tt.expectErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testSubject, claims.Subject)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrInvalidToken)
+			assert.Contains(t, err.Error(), tt.expectErr)
+			assert.Nil(t, claims)
+		})
+	}
+}
+
+func TestVerifier_StaticPublicKeyRejectsHMACConfusion(t *testing.T) {
+	keys := newTestKeys(t)
+	publicPEM := keys.rsaPublicPEM(t)
+	set, err := StaticKey(publicPEM)
+	require.NoError(t, err)
+	verifier := NewVerifier(set, testIssuer, testAudience)
+
+	// Токен, подписанный RSA-ключом, проходит проверку без kid
+	claims, err := verifier.Verify(sign(t, jwt.SigningMethodRS256, "", validClaims(), keys.rsa))
+	require.NoError(t, err)
+	assert.Equal(t, testSubject, claims.Subject)
+
+	// Открытый ключ известен всем, поэтому подпись HS256 этим ключом как секретом не принимается
+	forged := sign(t, jwt.SigningMethodHS256, "", validClaims(), []byte(publicPEM))
+	_, err = verifier.Verify(forged)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	assert.Contains(t, err.Error(), "key is of invalid type")
+}
+
+func TestVerifier_StaticSecret(t *testing.T) {
+	set, err := StaticKey("shared-secret")
+	require.NoError(t, err)
+	verifier := NewVerifier(set, testIssuer, testAudience)
+
+	claims, err := verifier.Verify(sign(t, jwt.SigningMethodHS256, "", validClaims(), []byte("shared-secret")))
+	require.NoError(t, err)
+	assert.Equal(t, testSubject, claims.Subject)
+
+	_, err = verifier.Verify(sign(t, jwt.SigningMethodHS256, "", validClaims(), []byte("other-secret")))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestLoadJWKSFile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "Некорректный JSON", content: "{"},
+		{name: "Нет ключей подписи", content: `{"keys":[{"kty":"oct","use":"enc","k":"c2VjcmV0"}]}`},
+		{name: "Неизвестный тип ключа", content: `{"keys":[{"kty":"XYZ","kid":"1"}]}`},
+		{name: "Неизвестная кривая", content: `{"keys":[{"kty":"EC","kid":"1","crv":"P-192","x":"AQ","y":"AQ"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "jwks.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			_, err := LoadJWKSFile(path)
+
+			assert.Error(t, err)
+		})
+	}
+}
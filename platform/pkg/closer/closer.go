@@ -27,6 +27,7 @@ type Closer struct {
 	once   sync.Once
 	done   chan struct{}
 	funcs  []func(context.Context) error
+	before []func(context.Context) error
 	logger Logger
 }
 
@@ -37,6 +38,11 @@ func AddNamed(name string, f func(context.Context) error) {
 	globalCloser.AddNamed(name, f)
 }
 
+// AddBeforeShutdown добавляет в глобальный closer функцию, которая выполняется до закрытия ресурсов
+func AddBeforeShutdown(name string, f func(context.Context) error) {
+	globalCloser.AddBeforeShutdown(name, f)
+}
+
 // Add добавляет функции закрытия в глобальный closer
 func Add(f ...func(context.Context) error) {
 	globalCloser.Add(f...)
@@ -104,7 +110,21 @@ func (c *Closer) handleSignals(signals ...os.Signal) {
 
 // AddNamed добавляет функцию закрытия с именем зависимости для логирования
 func (c *Closer) AddNamed(name string, f func(context.Context) error) {
-	c.Add(func(ctx context.Context) error {
+	c.Add(c.named(name, f))
+}
+
+// AddBeforeShutdown добавляет функцию, которая выполняется до параллельного закрытия ресурсов.
+// Такие функции выполняются последовательно в порядке добавления: например, health-статус
+// переключается в NOT_SERVING, чтобы балансировщик перестал слать запросы до остановки серверов.
+func (c *Closer) AddBeforeShutdown(name string, f func(context.Context) error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.before = append(c.before, c.named(name, f))
+}
+
+// named оборачивает функцию закрытия логированием с именем зависимости
+func (c *Closer) named(name string, f func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) error {
 		start := time.Now()
 		c.logger.Info(ctx, fmt.Sprintf("Закрытие ресурса %s запущено", name))
 
@@ -117,7 +137,7 @@ func (c *Closer) AddNamed(name string, f func(context.Context) error) {
 			c.logger.Info(ctx, fmt.Sprintf("✅ Ресурс %s закрыт за %s", name, duration))
 		}
 		return err
-	})
+	}
 }
 
 // Add добавляет одну или несколько функций закрытия
@@ -137,9 +157,17 @@ func (c *Closer) CloseAll(ctx context.Context) error {
 
 		c.mu.Lock()
 		funcs := c.funcs
+		before := c.before
 		c.funcs = nil // освободим память
+		c.before = nil
 		c.mu.Unlock()
 
+		for _, f := range before {
+			if err := f(ctx); err != nil && result == nil {
+				result = err
+			}
+		}
+
 		if len(funcs) == 0 {
 			c.logger.Info(ctx, "ℹ️ Нет функций для закрытия.")
 			return
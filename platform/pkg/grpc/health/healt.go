@@ -4,25 +4,65 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	platformHealth "github.com/space-wanderer/microservices/platform/pkg/health"
 )
 
+// Server отвечает на gRPC health-запросы статусами сервисов из реестра проверок
 type Server struct {
 	grpc_health_v1.UnimplementedHealthServer
+
+	registry *platformHealth.Registry
+}
+
+func NewServer(registry *platformHealth.Registry) *Server {
+	return &Server{registry: registry}
 }
 
 func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	st := s.registry.Status(req.GetService())
+	if st == platformHealth.StatusServiceUnknown {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+
 	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: toProto(st),
 	}, nil
 }
 
+// Watch отправляет текущий статус сервиса и затем каждое его изменение до закрытия стрима
 func (s *Server) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
-	return stream.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	})
+	updates, cancel := s.registry.Watch(req.GetService())
+	defer cancel()
+
+	for {
+		select {
+		case st := <-updates:
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: toProto(st)}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+func RegisterServer(s *grpc.Server, registry *platformHealth.Registry) {
+	grpc_health_v1.RegisterHealthServer(s, NewServer(registry))
 }
 
-func RegisterServer(s *grpc.Server) {
-	grpc_health_v1.RegisterHealthServer(s, &Server{})
+func toProto(st platformHealth.Status) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	switch st {
+	case platformHealth.StatusServing:
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	case platformHealth.StatusNotServing:
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	case platformHealth.StatusServiceUnknown:
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	default:
+		return grpc_health_v1.HealthCheckResponse_UNKNOWN
+	}
 }
@@ -0,0 +1,49 @@
+package mtls
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AllowList сопоставляет полное имя RPC (/package.Service/Method) с идентичностями клиентов,
+// которым разрешен вызов. RPC, отсутствующие в списке, доступны любому клиенту с валидным сертификатом.
+type AllowList map[string][]string
+
+// UnaryAllowListInterceptor отклоняет вызовы клиентов, не входящих в allow-list вызываемого RPC
+func UnaryAllowListInterceptor(allow AllowList) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		identity, ok := Identity(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "client certificate required")
+		}
+
+		if allowed, restricted := allow[info.FullMethod]; restricted && !slices.Contains(allowed, identity) {
+			return nil, status.Errorf(codes.PermissionDenied, "client %q is not allowed to call %s", identity, info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Identity возвращает идентичность клиента — CommonName проверенного клиентского сертификата
+func Identity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	identity := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+
+	return identity, identity != ""
+}
@@ -0,0 +1,124 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	reserveMethod = "/inventory.v1.InventoryService/ReserveParts"
+	getMethod     = "/inventory.v1.InventoryService/GetPart"
+)
+
+// plainAuthInfo — данные аутентификации соединения без TLS
+type plainAuthInfo struct{}
+
+func (plainAuthInfo) AuthType() string { return "insecure" }
+
+// peerContext возвращает контекст входящего вызова от клиента с данными аутентификации authInfo
+func peerContext(authInfo credentials.AuthInfo) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: authInfo})
+}
+
+// verifiedTLSInfo имитирует TLS-соединение с проверенным сертификатом клиента commonName
+func verifiedTLSInfo(commonName string) credentials.TLSInfo {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	return credentials.TLSInfo{State: tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{cert}},
+	}}
+}
+
+func TestUnaryAllowListInterceptor(t *testing.T) {
+	allow := AllowList{reserveMethod: {"order"}}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		method       string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Разрешенный клиент",
+			ctx:          peerContext(verifiedTLSInfo("order")),
+			method:       reserveMethod,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Клиент не из списка",
+			ctx:          peerContext(verifiedTLSInfo("assembly")),
+			method:       reserveMethod,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "RPC без ограничений",
+			ctx:          peerContext(verifiedTLSInfo("assembly")),
+			method:       getMethod,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Нет данных о клиенте",
+			ctx:          context.Background(),
+			method:       getMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Соединение без TLS",
+			ctx:          peerContext(plainAuthInfo{}),
+			method:       getMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Клиент без сертификата",
+			ctx:          peerContext(credentials.TLSInfo{}),
+			method:       getMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Сертификат без CommonName",
+			ctx:          peerContext(verifiedTLSInfo("")),
+			method:       getMethod,
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(context.Context, any) (any, error) {
+				called = true
+				return "response", nil
+			}
+
+			resp, err := UnaryAllowListInterceptor(allow)(tt.ctx, "request", &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, "response", resp)
+				assert.True(t, called)
+				return
+			}
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Nil(t, resp)
+			assert.False(t, called, "handler must not be called")
+		})
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	identity, ok := Identity(peerContext(verifiedTLSInfo("order")))
+
+	assert.True(t, ok)
+	assert.Equal(t, "order", identity)
+}
@@ -0,0 +1,175 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// certFiles — пути к сертификату, ключу и CA во временном каталоге теста
+type certFiles struct {
+	cert, key, ca string
+}
+
+func newCertFiles(t *testing.T) certFiles {
+	t.Helper()
+	dir := t.TempDir()
+	return certFiles{
+		cert: filepath.Join(dir, "tls.crt"),
+		key:  filepath.Join(dir, "tls.key"),
+		ca:   filepath.Join(dir, "ca.crt"),
+	}
+}
+
+// write выпускает CA и подписанный им сертификат commonName и записывает их в файлы.
+// Время изменения файлов задается явно, чтобы ротация не зависела от точности часов ФС
+func (f certFiles) write(t *testing.T, commonName string, modTime time.Time) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName + "-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	writePEM(t, f.cert, "CERTIFICATE", certDER, modTime)
+	writePEM(t, f.key, "EC PRIVATE KEY", keyDER, modTime)
+	writePEM(t, f.ca, "CERTIFICATE", caDER, modTime)
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+// currentCommonName возвращает CommonName сертификата, который сейчас отдает Reloader
+func currentCommonName(t *testing.T, r *Reloader) string {
+	t.Helper()
+	cert, _ := r.current()
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestReloader_RotatesFiles(t *testing.T) {
+	files := newCertFiles(t)
+	start := time.Now().Add(-time.Minute)
+	files.write(t, "order-v1", start)
+
+	reloader, err := NewReloader(files.cert, files.key, files.ca)
+	require.NoError(t, err)
+	assert.Equal(t, "order-v1", currentCommonName(t, reloader))
+
+	// Файлы не менялись
+	reloaded, err := reloader.reloadIfChanged()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	files.write(t, "order-v2", start.Add(time.Second))
+
+	reloaded, err = reloader.reloadIfChanged()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, "order-v2", currentCommonName(t, reloader))
+
+	cert, roots := reloader.current()
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	assert.NoError(t, err, "new certificate must be verified by the new CA")
+}
+
+func TestReloader_KeepsPreviousFilesOnError(t *testing.T) {
+	files := newCertFiles(t)
+	start := time.Now().Add(-time.Minute)
+	files.write(t, "order-v1", start)
+
+	reloader, err := NewReloader(files.cert, files.key, files.ca)
+	require.NoError(t, err)
+
+	// Ротация записала сертификат, но ключ от него поврежден
+	require.NoError(t, os.WriteFile(files.key, []byte("broken"), 0o600))
+	require.NoError(t, os.Chtimes(files.key, start.Add(time.Second), start.Add(time.Second)))
+
+	reloaded, err := reloader.reloadIfChanged()
+	assert.Error(t, err)
+	assert.False(t, reloaded)
+	assert.Equal(t, "order-v1", currentCommonName(t, reloader))
+}
+
+func TestReloader_RunPicksUpRotation(t *testing.T) {
+	logger.SetNopLogger()
+
+	files := newCertFiles(t)
+	start := time.Now().Add(-time.Minute)
+	files.write(t, "order-v1", start)
+
+	reloader, err := NewReloader(files.cert, files.key, files.ca)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		reloader.Run(ctx, 5*time.Millisecond)
+	}()
+
+	files.write(t, "order-v2", start.Add(time.Second))
+
+	assert.Eventually(t, func() bool {
+		return currentCommonName(t, reloader) == "order-v2"
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("reloader did not stop after context cancel")
+	}
+}
+
+func TestNewReloader_MissingFiles(t *testing.T) {
+	files := newCertFiles(t)
+
+	_, err := NewReloader(files.cert, files.key, files.ca)
+
+	assert.Error(t, err)
+}
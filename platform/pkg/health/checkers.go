@@ -0,0 +1,131 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/sarama"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// PgxPoolChecker проверяет доступность PostgreSQL через ping соединения из пула
+func PgxPoolChecker(pool *pgxpool.Pool) Checker {
+	return func(ctx context.Context) error {
+		return pool.Ping(ctx)
+	}
+}
+
+// MongoChecker проверяет доступность primary-узла MongoDB
+func MongoChecker(client *mongo.Client) Checker {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx, readpref.Primary())
+	}
+}
+
+// errKafkaCheckInProgress — предыдущая проверка Kafka не завершилась за таймаут и еще выполняется
+var errKafkaCheckInProgress = errors.New("previous kafka check is still running")
+
+// KafkaChecker проверяет доступность брокеров Kafka, запрашивая метаданные кластера.
+// Клиент создаётся при первой проверке, поэтому сервис стартует и при недоступной Kafka.
+type KafkaChecker struct {
+	brokers []string
+	refresh func() error
+
+	// running отмечает выполняющийся запрос метаданных, который может пережить таймаут проверки
+	running atomic.Bool
+
+	mu     sync.Mutex
+	client sarama.Client
+}
+
+func NewKafkaChecker(brokers []string) *KafkaChecker {
+	c := &KafkaChecker{brokers: brokers}
+	c.refresh = c.refreshMetadata
+	return c
+}
+
+// Check — Checker для регистрации в Registry. Пока запрос метаданных, переживший таймаут
+// прошлой проверки, не завершился, новый не запускается и Kafka считается недоступной
+func (c *KafkaChecker) Check(ctx context.Context) error {
+	if !c.running.CompareAndSwap(false, true) {
+		return errKafkaCheckInProgress
+	}
+
+	// sarama не принимает контекст, поэтому таймаут проверки соблюдается ожиданием в горутине
+	errCh := make(chan error, 1)
+	go func() {
+		defer c.running.Store(false)
+		errCh <- c.refresh()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close закрывает клиент Kafka, если он был создан
+func (c *KafkaChecker) Close(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == nil {
+		return nil
+	}
+
+	err := c.client.Close()
+	c.client = nil
+
+	return err
+}
+
+func (c *KafkaChecker) refreshMetadata() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == nil {
+		client, err := sarama.NewClient(c.brokers, sarama.NewConfig())
+		if err != nil {
+			return err
+		}
+		c.client = client
+	}
+
+	if err := c.client.RefreshMetadata(); err != nil {
+		return err
+	}
+
+	if len(c.client.Brokers()) == 0 {
+		return errors.New("no kafka brokers available")
+	}
+
+	return nil
+}
+
+// GRPCChecker проверяет готовность gRPC-сервиса через стандартный Health/Check.
+// Пустое имя сервиса означает общий статус сервера.
+func GRPCChecker(conn grpc.ClientConnInterface, service string) Checker {
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil {
+			return err
+		}
+
+		if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+			return fmt.Errorf("service %q is %s", service, resp.GetStatus())
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaChecker_SkipsWhileCheckIsRunning(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+
+	checker := NewKafkaChecker(nil)
+	checker.refresh = func() error {
+		calls.Add(1)
+		<-release
+		return nil
+	}
+
+	// Первая проверка не укладывается в таймаут, запрос метаданных продолжает выполняться
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, checker.Check(ctx), context.DeadlineExceeded)
+
+	// Следующие проверки не запускают новые запросы, пока не завершится первый
+	for range 3 {
+		assert.ErrorIs(t, checker.Check(context.Background()), errKafkaCheckInProgress)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+
+	close(release)
+	require.Eventually(t, func() bool { return !checker.running.Load() }, time.Second, time.Millisecond)
+
+	assert.NoError(t, checker.Check(context.Background()))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestKafkaChecker_ReturnsRefreshError(t *testing.T) {
+	refreshErr := errors.New("no kafka brokers available")
+
+	checker := NewKafkaChecker(nil)
+	checker.refresh = func() error { return refreshErr }
+
+	assert.ErrorIs(t, checker.Check(context.Background()), refreshErr)
+	assert.False(t, checker.running.Load())
+}
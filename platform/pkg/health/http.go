@@ -0,0 +1,58 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// LivenessPath — путь проверки живости процесса
+	LivenessPath = "/healthz"
+	// ReadinessPath — путь проверки готовности принимать запросы
+	ReadinessPath = "/readyz"
+)
+
+// readinessResponse — тело ответа /readyz
+type readinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// LivenessHandler отвечает 200, пока процесс способен обрабатывать HTTP-запросы.
+// Недоступность зависимостей не влияет на живость, чтобы оркестратор не перезапускал сервис зря.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+// ReadinessHandler отвечает 200, если OverallService в статусе SERVING, иначе 503.
+// В теле перечислены результаты всех проверок.
+func (r *Registry) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := r.Status(OverallService)
+
+		resp := readinessResponse{
+			Status: status.String(),
+			Checks: make(map[string]string),
+		}
+		for name, err := range r.Results() {
+			if err != nil {
+				resp.Checks[name] = err.Error()
+				continue
+			}
+			resp.Checks[name] = "ok"
+		}
+
+		code := http.StatusOK
+		if status != StatusServing {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
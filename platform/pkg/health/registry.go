@@ -0,0 +1,278 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// OverallService — имя сервиса, статус которого зависит от всех зарегистрированных проверок
+const OverallService = ""
+
+// Status — статус готовности сервиса
+type Status int
+
+const (
+	// StatusUnknown — проверки ещё не выполнялись
+	StatusUnknown Status = iota
+	// StatusServing — все зависимости сервиса доступны
+	StatusServing
+	// StatusNotServing — хотя бы одна зависимость недоступна или приложение останавливается
+	StatusNotServing
+	// StatusServiceUnknown — сервис не зарегистрирован в реестре
+	StatusServiceUnknown
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusServing:
+		return "SERVING"
+	case StatusNotServing:
+		return "NOT_SERVING"
+	case StatusServiceUnknown:
+		return "SERVICE_UNKNOWN"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Checker проверяет доступность зависимости и возвращает ошибку, если она недоступна
+type Checker func(ctx context.Context) error
+
+var (
+	errNotChecked   = errors.New("not checked yet")
+	errShuttingDown = errors.New("shutting down")
+)
+
+// Registry хранит именованные проверки зависимостей, периодически выполняет их
+// и вычисляет статусы сервисов. Статус сервиса — SERVING, только если все его проверки прошли.
+type Registry struct {
+	interval time.Duration
+	timeout  time.Duration
+
+	mu       sync.RWMutex
+	checkers map[string]Checker
+	results  map[string]error
+	services map[string][]string
+	statuses map[string]Status
+	watchers map[string]map[chan Status]struct{}
+	shutdown bool
+}
+
+// NewRegistry создаёт реестр, который выполняет проверки каждые interval,
+// ограничивая каждую проверку таймаутом timeout
+func NewRegistry(interval, timeout time.Duration) *Registry {
+	return &Registry{
+		interval: interval,
+		timeout:  timeout,
+		checkers: make(map[string]Checker),
+		results:  make(map[string]error),
+		services: map[string][]string{OverallService: nil},
+		statuses: map[string]Status{OverallService: StatusUnknown},
+		watchers: make(map[string]map[chan Status]struct{}),
+	}
+}
+
+// Register добавляет именованную проверку. OverallService зависит от всех проверок.
+func (r *Registry) Register(name string, checker Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checkers[name] = checker
+	r.results[name] = errNotChecked
+	r.services[OverallService] = append(r.services[OverallService], name)
+	r.updateStatusesLocked()
+}
+
+// RegisterService объявляет сервис (например, полное имя gRPC-сервиса),
+// статус которого зависит от перечисленных проверок
+func (r *Registry) RegisterService(service string, checkers ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.services[service] = checkers
+	if _, ok := r.statuses[service]; !ok {
+		r.statuses[service] = StatusUnknown
+	}
+	r.updateStatusesLocked()
+}
+
+// Run выполняет проверки сразу и затем каждые interval до отмены контекста
+func (r *Registry) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.CheckAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll параллельно выполняет все проверки и обновляет статусы сервисов
+func (r *Registry) CheckAll(ctx context.Context) {
+	r.mu.RLock()
+	checkers := make(map[string]Checker, len(r.checkers))
+	for name, checker := range r.checkers {
+		checkers[name] = checker
+	}
+	r.mu.RUnlock()
+
+	results := make(map[string]error, len(checkers))
+	var (
+		wg      sync.WaitGroup
+		resultM sync.Mutex
+	)
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, r.timeout)
+			defer cancel()
+			err := checker(checkCtx)
+
+			resultM.Lock()
+			results[name] = err
+			resultM.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, err := range results {
+		previous := r.results[name]
+		r.results[name] = err
+
+		switch {
+		case err != nil && previous == nil:
+			logger.Warn(ctx, "Health check failed", zap.String("check", name), zap.Error(err))
+		case err == nil && previous != nil && !errors.Is(previous, errNotChecked):
+			logger.Info(ctx, "Health check recovered", zap.String("check", name))
+		}
+	}
+	r.updateStatusesLocked()
+}
+
+// Shutdown переводит все сервисы в NOT_SERVING; последующие проверки статус уже не меняют
+func (r *Registry) Shutdown(context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.shutdown = true
+	r.updateStatusesLocked()
+
+	return nil
+}
+
+// Status возвращает текущий статус сервиса
+func (r *Registry) Status(service string) Status {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	status, ok := r.statuses[service]
+	if !ok {
+		return StatusServiceUnknown
+	}
+
+	return status
+}
+
+// Results возвращает результат последнего выполнения каждой проверки
+func (r *Registry) Results() map[string]error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	results := make(map[string]error, len(r.results))
+	for name, err := range r.results {
+		if r.shutdown {
+			err = errShuttingDown
+		}
+		results[name] = err
+	}
+
+	return results
+}
+
+// Watch подписывается на изменения статуса сервиса. В канал сразу приходит текущий статус,
+// а затем — каждое изменение; медленный подписчик получает только последний статус.
+// Возвращаемая функция отменяет подписку.
+func (r *Registry) Watch(service string) (<-chan Status, func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ch := make(chan Status, 1)
+	status, ok := r.statuses[service]
+	if !ok {
+		status = StatusServiceUnknown
+	}
+	ch <- status
+
+	if r.watchers[service] == nil {
+		r.watchers[service] = make(map[chan Status]struct{})
+	}
+	r.watchers[service][ch] = struct{}{}
+
+	return ch, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.watchers[service], ch)
+	}
+}
+
+// updateStatusesLocked пересчитывает статусы сервисов и уведомляет подписчиков об изменениях
+func (r *Registry) updateStatusesLocked() {
+	for service, checkers := range r.services {
+		status := r.computeStatusLocked(checkers)
+		if r.statuses[service] == status {
+			continue
+		}
+
+		r.statuses[service] = status
+		for ch := range r.watchers[service] {
+			notify(ch, status)
+		}
+	}
+}
+
+func (r *Registry) computeStatusLocked(checkers []string) Status {
+	if r.shutdown {
+		return StatusNotServing
+	}
+
+	status := StatusServing
+	for _, name := range checkers {
+		err, ok := r.results[name]
+		switch {
+		case !ok:
+			// Сервис зависит от незарегистрированной проверки
+			return StatusNotServing
+		case errors.Is(err, errNotChecked):
+			status = StatusUnknown
+		case err != nil:
+			return StatusNotServing
+		}
+	}
+
+	return status
+}
+
+// notify отправляет статус без блокировки, вытесняя непрочитанный предыдущий
+func notify(ch chan Status, status Status) {
+	select {
+	case <-ch:
+	default:
+	}
+	ch <- status
+}
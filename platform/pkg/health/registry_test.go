@@ -0,0 +1,159 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/space-wanderer/microservices/platform/pkg/logger"
+)
+
+// switchableChecker — проверка, результат которой задает тест
+type switchableChecker struct {
+	err error
+}
+
+func (c *switchableChecker) Check(context.Context) error {
+	return c.err
+}
+
+func newTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+	logger.SetNopLogger()
+	return NewRegistry(time.Hour, time.Second)
+}
+
+func receive(t *testing.T, ch <-chan Status) Status {
+	t.Helper()
+	select {
+	case status := <-ch:
+		return status
+	case <-time.After(time.Second):
+		t.Fatal("status was not delivered")
+		return StatusUnknown
+	}
+}
+
+func assertNoStatus(t *testing.T, ch <-chan Status) {
+	t.Helper()
+	select {
+	case status := <-ch:
+		t.Fatalf("unexpected status %s", status)
+	default:
+	}
+}
+
+func TestRegistry_WatchTransitions(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+	postgres := &switchableChecker{}
+	registry.Register("postgres", postgres.Check)
+	registry.RegisterService("order.v1.OrderService", "postgres")
+
+	updates, cancel := registry.Watch("order.v1.OrderService")
+	defer cancel()
+
+	// До первой проверки статус неизвестен
+	assert.Equal(t, StatusUnknown, receive(t, updates))
+
+	registry.CheckAll(ctx)
+	assert.Equal(t, StatusServing, receive(t, updates))
+
+	// Повтор того же результата не порождает уведомления
+	registry.CheckAll(ctx)
+	assertNoStatus(t, updates)
+
+	postgres.err = errors.New("connection refused")
+	registry.CheckAll(ctx)
+	assert.Equal(t, StatusNotServing, receive(t, updates))
+	assert.Equal(t, StatusNotServing, registry.Status(OverallService))
+
+	postgres.err = nil
+	registry.CheckAll(ctx)
+	assert.Equal(t, StatusServing, receive(t, updates))
+}
+
+func TestRegistry_ServiceDependsOnlyOnItsChecks(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+	postgres, kafka := &switchableChecker{}, &switchableChecker{err: errors.New("kafka is down")}
+	registry.Register("postgres", postgres.Check)
+	registry.Register("kafka", kafka.Check)
+	registry.RegisterService("grpc", "postgres")
+	registry.RegisterService("broken", "missing")
+
+	registry.CheckAll(ctx)
+
+	assert.Equal(t, StatusServing, registry.Status("grpc"))
+	assert.Equal(t, StatusNotServing, registry.Status(OverallService))
+	assert.Equal(t, StatusNotServing, registry.Status("broken"))
+	assert.Equal(t, StatusServiceUnknown, registry.Status("unknown"))
+
+	results := registry.Results()
+	assert.NoError(t, results["postgres"])
+	assert.EqualError(t, results["kafka"], "kafka is down")
+}
+
+func TestRegistry_CheckTimeout(t *testing.T) {
+	registry := NewRegistry(time.Hour, 10*time.Millisecond)
+	logger.SetNopLogger()
+	registry.Register("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	registry.CheckAll(context.Background())
+
+	assert.ErrorIs(t, registry.Results()["slow"], context.DeadlineExceeded)
+	assert.Equal(t, StatusNotServing, registry.Status(OverallService))
+}
+
+func TestRegistry_ShutdownForcesNotServing(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+	registry.Register("postgres", (&switchableChecker{}).Check)
+	registry.RegisterService("order.v1.OrderService", "postgres")
+	registry.CheckAll(ctx)
+
+	updates, cancel := registry.Watch("order.v1.OrderService")
+	defer cancel()
+	require.Equal(t, StatusServing, receive(t, updates))
+
+	require.NoError(t, registry.Shutdown(ctx))
+
+	assert.Equal(t, StatusNotServing, receive(t, updates))
+	assert.Equal(t, StatusNotServing, registry.Status(OverallService))
+	assert.ErrorIs(t, registry.Results()["postgres"], errShuttingDown)
+
+	// Успешные проверки после остановки статус не возвращают
+	registry.CheckAll(ctx)
+	assertNoStatus(t, updates)
+	assert.Equal(t, StatusNotServing, registry.Status("order.v1.OrderService"))
+}
+
+func TestRegistry_WatchCancel(t *testing.T) {
+	ctx := context.Background()
+	registry := newTestRegistry(t)
+	postgres := &switchableChecker{}
+	registry.Register("postgres", postgres.Check)
+
+	updates, cancel := registry.Watch(OverallService)
+	assert.Equal(t, StatusUnknown, receive(t, updates))
+	cancel()
+
+	registry.CheckAll(ctx)
+	assertNoStatus(t, updates)
+}
+
+func TestRegistry_WatchUnknownService(t *testing.T) {
+	registry := newTestRegistry(t)
+
+	updates, cancel := registry.Watch("unknown")
+	defer cancel()
+
+	assert.Equal(t, StatusServiceUnknown, receive(t, updates))
+}
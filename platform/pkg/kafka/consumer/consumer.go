@@ -18,6 +18,8 @@ type consumer struct {
 	topics      []string
 	logger      Logger
 	middlewares []Middleware
+	// workers — размер пула обработчиков; 1 — сообщения партиции обрабатываются по одному
+	workers int
 }
 
 // NewConsumer — создаёт новый consumer.
@@ -27,12 +29,29 @@ func NewConsumer(group sarama.ConsumerGroup, topics []string, logger Logger, mid
 		topics:      topics,
 		logger:      logger,
 		middlewares: middlewares,
+		workers:     1,
 	}
 }
 
+// NewPooledConsumer — создаёт consumer, который обрабатывает до workers сообщений одновременно.
+// Порядок сохраняется для сообщений с одним ключом внутри партиции, offset подтверждается
+// только до первого незавершенного сообщения.
+func NewPooledConsumer(group sarama.ConsumerGroup, topics []string, workers int, logger Logger, middlewares ...Middleware) *consumer {
+	c := NewConsumer(group, topics, logger, middlewares...)
+	if workers > 1 {
+		c.workers = workers
+	}
+	return c
+}
+
 // Consume запускает консьюмер для списка топиков.
 func (c *consumer) Consume(ctx context.Context, handler MessageHandler) error {
-	newGroupHandler := NewGroupHandler(handler, c.logger, c.middlewares...)
+	groupHandler := NewGroupHandler(handler, c.logger, c.middlewares...)
+
+	var newGroupHandler sarama.ConsumerGroupHandler = groupHandler
+	if c.workers > 1 {
+		newGroupHandler = newPoolGroupHandler(groupHandler, c.workers)
+	}
 
 	for {
 		if err := c.group.Consume(ctx, c.topics, newGroupHandler); err != nil {
@@ -2,9 +2,16 @@ package consumer
 
 import (
 	"context"
+	"time"
 
 	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
 )
 
 // MessageHandler — обработчик сообщений.
@@ -49,20 +56,28 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 				return nil
 			}
 
-			msg := Message{
-				Key:            message.Key,
-				Value:          message.Value,
-				Topic:          message.Topic,
-				Partition:      message.Partition,
-				Offset:         message.Offset,
-				Timestamp:      message.Timestamp,
-				BlockTimestamp: message.BlockTimestamp,
-				Headers:        extractHeaders(message.Headers),
-			}
+			msg := newMessage(message)
+
+			// high water mark — offset следующего сообщения, которое будет записано в партицию
+			metrics.SetKafkaConsumerLag(message.Topic, message.Partition, claim.HighWaterMarkOffset()-message.Offset-1)
 
-			if err := g.handler(session.Context(), msg); err != nil {
-				g.logger.Error(session.Context(), "Kafka handler error", zap.Error(err))
-				continue
+			if err := g.handle(session.Context(), msg); err != nil {
+				if session.Context().Err() != nil {
+					// Сессия завершается: неподтвержденное сообщение будет прочитано снова
+					return nil
+				}
+
+				g.logger.Error(session.Context(), "Kafka handler error, message will be redelivered",
+					zap.String("topic", message.Topic),
+					zap.Int32("partition", message.Partition),
+					zap.Int64("offset", message.Offset),
+					zap.Error(err),
+				)
+
+				// Неподтвержденное сообщение нельзя пропускать: завершаем сессию, и после
+				// перебалансировки чтение продолжится с последнего подтвержденного offset.
+				// Чтобы одно сообщение не блокировало партицию, используйте middleware Retry с DLQ
+				return err
 			}
 
 			session.MarkMessage(message, "")
@@ -74,6 +89,44 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 	}
 }
 
+// handle вызывает обработчик в span'е, продолжающем трассировку продюсера из заголовков сообщения
+func (g *groupHandler) handle(ctx context.Context, msg Message) error {
+	ctx = tracing.ExtractHeaders(ctx, msg.Headers)
+	ctx, span := tracing.Tracer().Start(ctx, msg.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination.name", msg.Topic),
+			attribute.Int64("messaging.kafka.partition", int64(msg.Partition)),
+			attribute.Int64("messaging.kafka.offset", msg.Offset),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	err := g.handler(ctx, msg)
+	metrics.ObserveKafkaConsume(msg.Topic, time.Since(start), err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func newMessage(message *sarama.ConsumerMessage) Message {
+	return Message{
+		Key:            message.Key,
+		Value:          message.Value,
+		Topic:          message.Topic,
+		Partition:      message.Partition,
+		Offset:         message.Offset,
+		Timestamp:      message.Timestamp,
+		BlockTimestamp: message.BlockTimestamp,
+		Headers:        extractHeaders(message.Headers),
+	}
+}
+
 func extractHeaders(headers []*sarama.RecordHeader) map[string][]byte {
 	result := make(map[string][]byte)
 	for _, h := range headers {
@@ -0,0 +1,234 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Заголовки, которые Retry добавляет к сообщению при переносе в топик повтора или DLQ.
+const (
+	// HeaderOriginalTopic, HeaderOriginalPartition и HeaderOriginalOffset указывают,
+	// откуда сообщение было прочитано в первый раз
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	// HeaderRetryStage — номер топика повтора, в который перенесено сообщение
+	HeaderRetryStage = "x-retry-stage"
+	// HeaderRetryNotBefore — время (RFC 3339), раньше которого повтор не обрабатывается
+	HeaderRetryNotBefore = "x-retry-not-before"
+	// HeaderReplayTopic — топик, в который сообщение из DLQ переотправляется при replay
+	HeaderReplayTopic = "x-replay-topic"
+	// HeaderAttempts, HeaderError и HeaderFailedAt описывают последнюю неудачную обработку
+	HeaderAttempts = "x-attempts"
+	HeaderError    = "x-error"
+	HeaderFailedAt = "x-failed-at"
+)
+
+// Republisher — отправка сообщения в произвольный топик с заголовками.
+type Republisher interface {
+	SendTo(ctx context.Context, topic string, key, value []byte, headers map[string][]byte) error
+}
+
+// RetryTopic — топик отложенного повтора.
+type RetryTopic struct {
+	Name  string
+	Delay time.Duration
+}
+
+// RetryPolicy — политика повторной обработки сообщений.
+type RetryPolicy struct {
+	// MaxAttempts — число попыток обработки в одном топике, включая первую
+	MaxAttempts int
+	// Backoff — пауза после первой неудачной попытки, дальше она удваивается до MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// RetryTopics — топики отложенных повторов по порядку. Сообщение, исчерпавшее попытки
+	// в последнем из них, переносится в DLQTopic
+	RetryTopics []RetryTopic
+	DLQTopic    string
+}
+
+// NewRetryPolicy — создаёт политику с топиками повторов <topic>.<groupID>.retry.<n>
+// и DLQ <topic>.<groupID>.dlq. Группа входит в имя, чтобы повторы одной группы
+// не читали другие группы того же топика.
+func NewRetryPolicy(topic, groupID string, maxAttempts int, backoff, maxBackoff time.Duration, delays []time.Duration) RetryPolicy {
+	prefix := topic + "." + groupID
+
+	retryTopics := make([]RetryTopic, 0, len(delays))
+	for i, delay := range delays {
+		retryTopics = append(retryTopics, RetryTopic{
+			Name:  fmt.Sprintf("%s.retry.%d", prefix, i+1),
+			Delay: delay,
+		})
+	}
+
+	return RetryPolicy{
+		MaxAttempts: maxAttempts,
+		Backoff:     backoff,
+		MaxBackoff:  maxBackoff,
+		RetryTopics: retryTopics,
+		DLQTopic:    prefix + ".dlq",
+	}
+}
+
+// Topics — топики повторов, которые консьюмер должен читать вместе с основным.
+func (p RetryPolicy) Topics() []string {
+	topics := make([]string, 0, len(p.RetryTopics))
+	for _, topic := range p.RetryTopics {
+		topics = append(topics, topic.Name)
+	}
+	return topics
+}
+
+// backoff — пауза после неудачной попытки attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// Retry — middleware повторной обработки. Неудачная обработка повторяется MaxAttempts раз
+// с паузой, затем сообщение переносится в следующий топик повтора, а после последнего — в DLQ.
+// Обработка считается успешной, только когда сообщение доставлено в топик повтора или DLQ,
+// иначе ошибка возвращается и сообщение не подтверждается.
+func Retry(policy RetryPolicy, republisher Republisher, logger Logger) Middleware {
+	return func(next MessageHandler) MessageHandler {
+		return func(ctx context.Context, msg Message) error {
+			if err := waitRetryDelay(ctx, msg); err != nil {
+				return err
+			}
+
+			attempt := 1
+			for {
+				err := next(ctx, msg)
+				if err == nil {
+					return nil
+				}
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				if attempt >= policy.MaxAttempts {
+					return forwardFailed(ctx, policy, republisher, logger, msg, attempt, err)
+				}
+
+				logger.Error(ctx, "Kafka handler failed, retrying",
+					zap.String("topic", msg.Topic),
+					zap.Int32("partition", msg.Partition),
+					zap.Int64("offset", msg.Offset),
+					zap.Int("attempt", attempt),
+					zap.Error(err),
+				)
+
+				if err := sleep(ctx, policy.backoff(attempt)); err != nil {
+					return err
+				}
+				attempt++
+			}
+		}
+	}
+}
+
+// forwardFailed переносит сообщение, исчерпавшее попытки, в следующий топик повтора или DLQ.
+func forwardFailed(ctx context.Context, policy RetryPolicy, republisher Republisher, logger Logger, msg Message, attempts int, handlerErr error) error {
+	now := time.Now()
+
+	headers := make(map[string][]byte, len(msg.Headers)+6)
+	for key, value := range msg.Headers {
+		headers[key] = value
+	}
+	if _, ok := headers[HeaderOriginalTopic]; !ok {
+		headers[HeaderOriginalTopic] = []byte(msg.Topic)
+		headers[HeaderOriginalPartition] = []byte(strconv.FormatInt(int64(msg.Partition), 10))
+		headers[HeaderOriginalOffset] = []byte(strconv.FormatInt(msg.Offset, 10))
+	}
+	headers[HeaderAttempts] = []byte(strconv.Itoa(attempts))
+	headers[HeaderError] = []byte(handlerErr.Error())
+	headers[HeaderFailedAt] = []byte(now.UTC().Format(time.RFC3339Nano))
+
+	target := policy.DLQTopic
+	stage := retryStage(msg)
+	if stage < len(policy.RetryTopics) {
+		retryTopic := policy.RetryTopics[stage]
+		target = retryTopic.Name
+		headers[HeaderRetryStage] = []byte(strconv.Itoa(stage + 1))
+		headers[HeaderRetryNotBefore] = []byte(now.Add(retryTopic.Delay).UTC().Format(time.RFC3339Nano))
+	} else {
+		delete(headers, HeaderRetryStage)
+		delete(headers, HeaderRetryNotBefore)
+		// Replay через первый топик повтора вернет сообщение только этой группе
+		if len(policy.RetryTopics) > 0 {
+			headers[HeaderReplayTopic] = []byte(policy.RetryTopics[0].Name)
+		}
+	}
+
+	if target == "" {
+		return handlerErr
+	}
+
+	if err := republisher.SendTo(ctx, target, msg.Key, msg.Value, headers); err != nil {
+		return fmt.Errorf("failed to forward message to %s: %w (handler error: %v)", target, err, handlerErr)
+	}
+
+	logger.Error(ctx, "Kafka message forwarded after failed processing",
+		zap.String("topic", msg.Topic),
+		zap.Int32("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+		zap.String("target_topic", target),
+		zap.Int("attempts", attempts),
+		zap.Error(handlerErr),
+	)
+
+	return nil
+}
+
+// retryStage — номер топика повтора, из которого прочитано сообщение, 0 для основного топика.
+func retryStage(msg Message) int {
+	stage, err := strconv.Atoi(string(msg.Headers[HeaderRetryStage]))
+	if err != nil || stage < 0 {
+		return 0
+	}
+	return stage
+}
+
+// waitRetryDelay откладывает обработку повтора до времени из заголовка HeaderRetryNotBefore.
+// Задержка у всех сообщений одного топика повтора одинакова, поэтому ожидание
+// не задерживает сообщения, готовые раньше.
+func waitRetryDelay(ctx context.Context, msg Message) error {
+	raw, ok := msg.Headers[HeaderRetryNotBefore]
+	if !ok {
+		return nil
+	}
+
+	notBefore, err := time.Parse(time.RFC3339Nano, string(raw))
+	if err != nil {
+		return nil
+	}
+
+	return sleep(ctx, time.Until(notBefore))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,292 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+
+type sentMessage struct {
+	topic   string
+	key     []byte
+	value   []byte
+	headers map[string][]byte
+}
+
+// fakeRepublisher запоминает отправленные сообщения вместо отправки в Kafka
+type fakeRepublisher struct {
+	mu   sync.Mutex
+	sent []sentMessage
+	err  error
+}
+
+func (r *fakeRepublisher) SendTo(_ context.Context, topic string, key, value []byte, headers map[string][]byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return r.err
+	}
+	r.sent = append(r.sent, sentMessage{topic: topic, key: key, value: value, headers: headers})
+	return nil
+}
+
+func testPolicy() RetryPolicy {
+	return NewRetryPolicy("orders", "billing", 2, time.Millisecond, 2*time.Millisecond,
+		[]time.Duration{time.Minute, time.Hour})
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	policy := testPolicy()
+
+	assert.Equal(t, []RetryTopic{
+		{Name: "orders.billing.retry.1", Delay: time.Minute},
+		{Name: "orders.billing.retry.2", Delay: time.Hour},
+	}, policy.RetryTopics)
+	assert.Equal(t, "orders.billing.dlq", policy.DLQTopic)
+	assert.Equal(t, []string{"orders.billing.retry.1", "orders.billing.retry.2"}, policy.Topics())
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	tests := []struct {
+		name       string
+		backoff    time.Duration
+		maxBackoff time.Duration
+		attempt    int
+		expected   time.Duration
+	}{
+		{name: "Первая попытка", backoff: 100 * time.Millisecond, maxBackoff: time.Second, attempt: 1, expected: 100 * time.Millisecond},
+		{name: "Удвоение", backoff: 100 * time.Millisecond, maxBackoff: time.Second, attempt: 3, expected: 400 * time.Millisecond},
+		{name: "Ограничение сверху", backoff: 100 * time.Millisecond, maxBackoff: time.Second, attempt: 5, expected: time.Second},
+		{name: "Большой номер попытки", backoff: 100 * time.Millisecond, maxBackoff: time.Second, attempt: 1000, expected: time.Second},
+		{name: "Пауза больше предела", backoff: 2 * time.Second, maxBackoff: time.Second, attempt: 1, expected: time.Second},
+		{name: "Без предела пауза не растет", backoff: 100 * time.Millisecond, attempt: 4, expected: 100 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := RetryPolicy{Backoff: tt.backoff, MaxBackoff: tt.maxBackoff}
+			assert.Equal(t, tt.expected, policy.backoff(tt.attempt))
+		})
+	}
+}
+
+func TestRetry_ForwardsFailedMessage(t *testing.T) {
+	originalHeaders := map[string][]byte{
+		HeaderOriginalTopic:     []byte("orders"),
+		HeaderOriginalPartition: []byte("3"),
+		HeaderOriginalOffset:    []byte("42"),
+	}
+
+	tests := []struct {
+		name            string
+		policy          RetryPolicy
+		msg             Message
+		expectedTopic   string
+		expectedStage   string
+		expectedDelay   time.Duration
+		expectedHeaders map[string][]byte
+	}{
+		{
+			name:          "Из основного топика в первый топик повтора",
+			policy:        testPolicy(),
+			msg:           Message{Topic: "orders", Partition: 3, Offset: 42, Headers: map[string][]byte{"trace": []byte("t1")}},
+			expectedTopic: "orders.billing.retry.1",
+			expectedStage: "1",
+			expectedDelay: time.Minute,
+			expectedHeaders: map[string][]byte{
+				"trace":                 []byte("t1"),
+				HeaderOriginalTopic:     []byte("orders"),
+				HeaderOriginalPartition: []byte("3"),
+				HeaderOriginalOffset:    []byte("42"),
+			},
+		},
+		{
+			name:   "Из первого топика повтора во второй",
+			policy: testPolicy(),
+			msg: Message{Topic: "orders.billing.retry.1", Partition: 0, Offset: 7, Headers: withHeaders(originalHeaders, map[string][]byte{
+				HeaderRetryStage: []byte("1"),
+			})},
+			expectedTopic:   "orders.billing.retry.2",
+			expectedStage:   "2",
+			expectedDelay:   time.Hour,
+			expectedHeaders: originalHeaders,
+		},
+		{
+			name:   "Из последнего топика повтора в DLQ",
+			policy: testPolicy(),
+			msg: Message{Topic: "orders.billing.retry.2", Partition: 0, Offset: 9, Headers: withHeaders(originalHeaders, map[string][]byte{
+				HeaderRetryStage:     []byte("2"),
+				HeaderRetryNotBefore: []byte(time.Now().Add(-time.Second).UTC().Format(time.RFC3339Nano)),
+			})},
+			expectedTopic: "orders.billing.dlq",
+			expectedHeaders: withHeaders(originalHeaders, map[string][]byte{
+				HeaderReplayTopic: []byte("orders.billing.retry.1"),
+			}),
+		},
+		{
+			name:          "Без топиков повтора сразу в DLQ",
+			policy:        NewRetryPolicy("orders", "billing", 1, time.Millisecond, time.Millisecond, nil),
+			msg:           Message{Topic: "orders", Partition: 3, Offset: 42},
+			expectedTopic: "orders.billing.dlq",
+			expectedHeaders: map[string][]byte{
+				HeaderOriginalTopic:     []byte("orders"),
+				HeaderOriginalPartition: []byte("3"),
+				HeaderOriginalOffset:    []byte("42"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			republisher := &fakeRepublisher{}
+			handlerErr := errors.New("handler failed")
+			calls := 0
+			handler := Retry(tt.policy, republisher, nopLogger{})(func(context.Context, Message) error {
+				calls++
+				return handlerErr
+			})
+
+			tt.msg.Key, tt.msg.Value = []byte("key"), []byte("value")
+			before := time.Now()
+
+			err := handler(context.Background(), tt.msg)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.policy.MaxAttempts, calls)
+			require.Len(t, republisher.sent, 1)
+
+			sent := republisher.sent[0]
+			assert.Equal(t, tt.expectedTopic, sent.topic)
+			assert.Equal(t, []byte("key"), sent.key)
+			assert.Equal(t, []byte("value"), sent.value)
+
+			for key, value := range tt.expectedHeaders {
+				assert.Equal(t, value, sent.headers[key], key)
+			}
+			assert.Equal(t, []byte("handler failed"), sent.headers[HeaderError])
+			assert.Equal(t, []byte(strconv.Itoa(tt.policy.MaxAttempts)), sent.headers[HeaderAttempts])
+			assert.Contains(t, sent.headers, HeaderFailedAt)
+
+			if tt.expectedStage == "" {
+				assert.NotContains(t, sent.headers, HeaderRetryStage)
+				assert.NotContains(t, sent.headers, HeaderRetryNotBefore)
+				return
+			}
+
+			assert.Equal(t, []byte(tt.expectedStage), sent.headers[HeaderRetryStage])
+			notBefore, err := time.Parse(time.RFC3339Nano, string(sent.headers[HeaderRetryNotBefore]))
+			require.NoError(t, err)
+			assert.WithinRange(t, notBefore, before.Add(tt.expectedDelay), time.Now().Add(tt.expectedDelay))
+		})
+	}
+}
+
+func TestRetry_SucceedsAfterRetry(t *testing.T) {
+	republisher := &fakeRepublisher{}
+	calls := 0
+	handler := Retry(testPolicy(), republisher, nopLogger{})(func(context.Context, Message) error {
+		calls++
+		if calls == 1 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+
+	err := handler(context.Background(), Message{Topic: "orders"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Empty(t, republisher.sent)
+}
+
+func TestRetry_ForwardErrorIsReturned(t *testing.T) {
+	republisher := &fakeRepublisher{err: errors.New("broker unavailable")}
+	handler := Retry(testPolicy(), republisher, nopLogger{})(func(context.Context, Message) error {
+		return errors.New("handler failed")
+	})
+
+	err := handler(context.Background(), Message{Topic: "orders"})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, republisher.err)
+}
+
+func TestRetry_WithoutDLQReturnsHandlerError(t *testing.T) {
+	handlerErr := errors.New("handler failed")
+	handler := Retry(RetryPolicy{MaxAttempts: 1}, &fakeRepublisher{}, nopLogger{})(func(context.Context, Message) error {
+		return handlerErr
+	})
+
+	assert.ErrorIs(t, handler(context.Background(), Message{Topic: "orders"}), handlerErr)
+}
+
+func TestRetry_WaitsUntilNotBefore(t *testing.T) {
+	tests := []struct {
+		name      string
+		notBefore string
+		minWait   time.Duration
+	}{
+		{name: "Время повтора не наступило", notBefore: time.Now().Add(50 * time.Millisecond).UTC().Format(time.RFC3339Nano), minWait: 40 * time.Millisecond},
+		{name: "Время повтора прошло", notBefore: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339Nano)},
+		{name: "Некорректный заголовок", notBefore: "tomorrow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handledAt time.Time
+			handler := Retry(testPolicy(), &fakeRepublisher{}, nopLogger{})(func(context.Context, Message) error {
+				handledAt = time.Now()
+				return nil
+			})
+
+			start := time.Now()
+			err := handler(context.Background(), Message{Headers: map[string][]byte{
+				HeaderRetryNotBefore: []byte(tt.notBefore),
+			}})
+
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, handledAt.Sub(start), tt.minWait)
+			assert.Less(t, handledAt.Sub(start), tt.minWait+time.Second)
+		})
+	}
+}
+
+func TestRetry_WaitStopsOnContextCancel(t *testing.T) {
+	handler := Retry(testPolicy(), &fakeRepublisher{}, nopLogger{})(func(context.Context, Message) error {
+		t.Error("handler must not be called before the retry time")
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := handler(ctx, Message{Headers: map[string][]byte{
+		HeaderRetryNotBefore: []byte(time.Now().Add(time.Hour).UTC().Format(time.RFC3339Nano)),
+	}})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func withHeaders(base, extra map[string][]byte) map[string][]byte {
+	headers := make(map[string][]byte, len(base)+len(extra))
+	for key, value := range base {
+		headers[key] = value
+	}
+	for key, value := range extra {
+		headers[key] = value
+	}
+	return headers
+}
@@ -0,0 +1,270 @@
+package consumer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+)
+
+// poolGroupHandler — groupHandler, обрабатывающий сообщения параллельно в пуле из workers обработчиков.
+// Сообщения с одним ключом внутри партиции обрабатываются строго по очереди, сообщения без ключа — независимо.
+// Offset подтверждается только до первого незавершенного сообщения, поэтому при падении
+// или перебалансировке ни одно необработанное сообщение не теряется
+type poolGroupHandler struct {
+	*groupHandler
+
+	workers int
+	// slots ограничивает число одновременно работающих обработчиков во всех партициях
+	slots chan struct{}
+}
+
+func newPoolGroupHandler(handler *groupHandler, workers int) *poolGroupHandler {
+	return &poolGroupHandler{
+		groupHandler: handler,
+		workers:      workers,
+		slots:        make(chan struct{}, workers),
+	}
+}
+
+func (g *poolGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	p := newPartitionPool(g, session, claim.Topic(), claim.Partition())
+
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				g.logger.Info(ctx, "Kafka message channel closed")
+				return p.stop()
+			}
+
+			// high water mark — offset следующего сообщения, которое будет записано в партицию
+			metrics.SetKafkaConsumerLag(message.Topic, message.Partition, claim.HighWaterMarkOffset()-message.Offset-1)
+
+			if !p.dispatch(ctx, message) {
+				return p.stop()
+			}
+		case <-p.failed:
+			return p.stop()
+		case <-ctx.Done():
+			g.logger.Info(ctx, "Kafka session context done")
+			return p.stop()
+		}
+	}
+}
+
+// partitionPool распределяет сообщения одной партиции по очередям ключей и подтверждает offset'ы
+type partitionPool struct {
+	handler   *poolGroupHandler
+	session   sarama.ConsumerGroupSession
+	topic     string
+	partition int32
+
+	// pending ограничивает число принятых, но не завершенных сообщений партиции
+	pending chan struct{}
+	wg      sync.WaitGroup
+
+	mu sync.Mutex
+	// lanes — очереди сообщений с ключом, который сейчас обрабатывается
+	lanes map[string][]*sarama.ConsumerMessage
+	// inFlight — offset'ы незавершенных сообщений в порядке чтения, done — завершенные из них
+	inFlight []int64
+	done     map[int64]struct{}
+
+	failOnce sync.Once
+	failed   chan struct{}
+	err      error
+}
+
+func newPartitionPool(handler *poolGroupHandler, session sarama.ConsumerGroupSession, topic string, partition int32) *partitionPool {
+	return &partitionPool{
+		handler:   handler,
+		session:   session,
+		topic:     topic,
+		partition: partition,
+		pending:   make(chan struct{}, handler.workers),
+		lanes:     make(map[string][]*sarama.ConsumerMessage),
+		done:      make(map[int64]struct{}),
+		failed:    make(chan struct{}),
+	}
+}
+
+// dispatch ставит сообщение в очередь его ключа. Возвращает false, если партиция
+// останавливается: завершается сессия или обработка сообщения не удалась
+func (p *partitionPool) dispatch(ctx context.Context, message *sarama.ConsumerMessage) bool {
+	select {
+	case p.pending <- struct{}{}:
+	case <-p.failed:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inFlight = append(p.inFlight, message.Offset)
+
+	if len(message.Key) == 0 {
+		p.startLane("", message)
+		return true
+	}
+
+	key := string(message.Key)
+	if queue, ok := p.lanes[key]; ok {
+		p.lanes[key] = append(queue, message)
+		return true
+	}
+
+	p.lanes[key] = nil
+	p.startLane(key, message)
+
+	return true
+}
+
+// startLane запускает обработку очереди ключа key, начиная с message; пустой ключ — сообщение без очереди
+func (p *partitionPool) startLane(key string, message *sarama.ConsumerMessage) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		for message != nil {
+			if !p.process(message) {
+				// Следующие сообщения ключа нельзя обработать раньше упавшего: они будут прочитаны снова
+				p.dropLane(key)
+				return
+			}
+			message = p.next(key)
+		}
+	}()
+}
+
+// process обрабатывает сообщение в свободном слоте пула и отмечает его завершение
+func (p *partitionPool) process(message *sarama.ConsumerMessage) bool {
+	ctx := p.session.Context()
+
+	// select ниже выбирает случай случайно, поэтому остановку проверяем заранее: иначе сообщение,
+	// прочитанное после сбоя предыдущего с тем же ключом, могло бы обработаться раньше него
+	if p.stopped() {
+		return false
+	}
+
+	select {
+	case p.handler.slots <- struct{}{}:
+	case <-p.failed:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+
+	err := p.handler.handle(ctx, newMessage(message))
+	<-p.handler.slots
+
+	if err != nil {
+		if ctx.Err() == nil {
+			p.handler.logger.Error(ctx, "Kafka handler error, message will be redelivered",
+				zap.String("topic", message.Topic),
+				zap.Int32("partition", message.Partition),
+				zap.Int64("offset", message.Offset),
+				zap.Error(err),
+			)
+			p.fail(err)
+		}
+		return false
+	}
+
+	p.complete(message.Offset)
+	<-p.pending
+
+	return true
+}
+
+// next возвращает следующее сообщение очереди ключа или закрывает очередь, если она пуста
+func (p *partitionPool) next(key string) *sarama.ConsumerMessage {
+	if key == "" {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	queue := p.lanes[key]
+	if len(queue) == 0 || p.stopped() {
+		delete(p.lanes, key)
+		return nil
+	}
+
+	p.lanes[key] = queue[1:]
+	return queue[0]
+}
+
+func (p *partitionPool) dropLane(key string) {
+	if key == "" {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.lanes, key)
+}
+
+// complete отмечает сообщение завершенным и подтверждает offset'ы всех завершенных сообщений,
+// прочитанных до первого незавершенного
+func (p *partitionPool) complete(offset int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.done[offset] = struct{}{}
+
+	marked := int64(-1)
+	for len(p.inFlight) > 0 {
+		first := p.inFlight[0]
+		if _, ok := p.done[first]; !ok {
+			break
+		}
+		delete(p.done, first)
+		p.inFlight = p.inFlight[1:]
+		marked = first
+	}
+
+	if marked >= 0 {
+		// Как и MarkMessage, подтверждаем offset следующего сообщения
+		p.session.MarkOffset(p.topic, p.partition, marked+1, "")
+	}
+}
+
+func (p *partitionPool) fail(err error) {
+	p.failOnce.Do(func() {
+		p.err = err
+		close(p.failed)
+	})
+}
+
+func (p *partitionPool) stopped() bool {
+	select {
+	case <-p.failed:
+		return true
+	case <-p.session.Context().Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// stop дожидается завершения начатых обработчиков. Ошибка обработки завершает сессию,
+// и после перебалансировки чтение продолжится с последнего подтвержденного offset
+func (p *partitionPool) stop() error {
+	p.wg.Wait()
+
+	select {
+	case <-p.failed:
+		return p.err
+	default:
+		return nil
+	}
+}
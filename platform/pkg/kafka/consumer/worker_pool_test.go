@@ -0,0 +1,288 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTopic     = "orders"
+	testPartition = int32(3)
+)
+
+// fakeSession запоминает подтвержденные offset'ы вместо коммита в Kafka
+type fakeSession struct {
+	ctx context.Context
+
+	mu     sync.Mutex
+	marked []int64
+}
+
+func newFakeSession(ctx context.Context) *fakeSession {
+	return &fakeSession{ctx: ctx}
+}
+
+func (s *fakeSession) Claims() map[string][]int32 { return nil }
+func (s *fakeSession) MemberID() string           { return "member" }
+func (s *fakeSession) GenerationID() int32        { return 1 }
+func (s *fakeSession) Commit()                    {}
+func (s *fakeSession) Context() context.Context   { return s.ctx }
+
+func (s *fakeSession) ResetOffset(string, int32, int64, string) {}
+
+func (s *fakeSession) MarkOffset(topic string, partition int32, offset int64, _ string) {
+	if topic != testTopic || partition != testPartition {
+		panic("offset marked for unexpected partition")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.marked = append(s.marked, offset)
+}
+
+func (s *fakeSession) MarkMessage(message *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(message.Topic, message.Partition, message.Offset+1, metadata)
+}
+
+func (s *fakeSession) markedOffsets() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int64(nil), s.marked...)
+}
+
+// lastMarked возвращает последний подтвержденный offset или -1
+func (s *fakeSession) lastMarked() int64 {
+	marked := s.markedOffsets()
+	if len(marked) == 0 {
+		return -1
+	}
+	return marked[len(marked)-1]
+}
+
+type fakeClaim struct {
+	messages chan *sarama.ConsumerMessage
+}
+
+func newFakeClaim() *fakeClaim {
+	return &fakeClaim{messages: make(chan *sarama.ConsumerMessage, 100)}
+}
+
+func (c *fakeClaim) Topic() string                            { return testTopic }
+func (c *fakeClaim) Partition() int32                         { return testPartition }
+func (c *fakeClaim) InitialOffset() int64                     { return 0 }
+func (c *fakeClaim) HighWaterMarkOffset() int64               { return 100 }
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+func (c *fakeClaim) send(offset int64, key string) {
+	message := &sarama.ConsumerMessage{Topic: testTopic, Partition: testPartition, Offset: offset}
+	if key != "" {
+		message.Key = []byte(key)
+	}
+	c.messages <- message
+}
+
+// handledLog запоминает порядок обработки сообщений
+type handledLog struct {
+	mu      sync.Mutex
+	offsets []int64
+	byKey   map[string][]int64
+}
+
+func newHandledLog() *handledLog {
+	return &handledLog{byKey: make(map[string][]int64)}
+}
+
+func (l *handledLog) add(msg Message) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.offsets = append(l.offsets, msg.Offset)
+	l.byKey[string(msg.Key)] = append(l.byKey[string(msg.Key)], msg.Offset)
+}
+
+func (l *handledLog) handled() []int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]int64(nil), l.offsets...)
+}
+
+func (l *handledLog) forKey(key string) []int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]int64(nil), l.byKey[key]...)
+}
+
+// consumeClaim запускает ConsumeClaim пула и возвращает канал с его результатом
+func consumeClaim(workers int, handler MessageHandler, session *fakeSession, claim *fakeClaim) <-chan error {
+	pool := newPoolGroupHandler(NewGroupHandler(handler, nopLogger{}), workers)
+
+	result := make(chan error, 1)
+	go func() {
+		result <- pool.ConsumeClaim(session, claim)
+	}()
+	return result
+}
+
+func waitResult(t *testing.T, result <-chan error) error {
+	t.Helper()
+
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("ConsumeClaim did not return")
+		return nil
+	}
+}
+
+func TestPoolGroupHandler_PreservesOrderWithinKey(t *testing.T) {
+	session := newFakeSession(context.Background())
+	claim := newFakeClaim()
+	log := newHandledLog()
+
+	handler := func(_ context.Context, msg Message) error {
+		// Ранние сообщения обрабатываются дольше поздних: без очередей ключей порядок бы нарушился
+		time.Sleep(time.Duration(20-msg.Offset) * time.Millisecond / 4)
+		log.add(msg)
+		return nil
+	}
+
+	keys := []string{"a", "b", "a", "c", "b", "a", "c", "a", "b", "c"}
+	for offset, key := range keys {
+		claim.send(int64(offset), key)
+	}
+	close(claim.messages)
+
+	err := waitResult(t, consumeClaim(4, handler, session, claim))
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0, 2, 5, 7}, log.forKey("a"))
+	assert.Equal(t, []int64{1, 4, 8}, log.forKey("b"))
+	assert.Equal(t, []int64{3, 6, 9}, log.forKey("c"))
+	assert.Equal(t, int64(len(keys)), session.lastMarked())
+}
+
+func TestPoolGroupHandler_DoesNotMarkPastUnfinishedMessage(t *testing.T) {
+	session := newFakeSession(context.Background())
+	claim := newFakeClaim()
+	log := newHandledLog()
+	release := make(chan struct{})
+
+	handler := func(_ context.Context, msg Message) error {
+		if msg.Offset == 0 {
+			<-release
+		}
+		log.add(msg)
+		return nil
+	}
+
+	result := consumeClaim(4, handler, session, claim)
+	claim.send(0, "a")
+	claim.send(1, "b")
+	claim.send(2, "")
+
+	// Сообщения после долгого завершились раньше него, но offset подтверждать нельзя
+	require.Eventually(t, func() bool { return len(log.handled()) == 2 }, time.Second, time.Millisecond)
+	assert.ElementsMatch(t, []int64{1, 2}, log.handled())
+	assert.Empty(t, session.markedOffsets())
+
+	// После завершения первого подтверждаются сразу все три
+	close(release)
+	require.Eventually(t, func() bool { return session.lastMarked() == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, []int64{3}, session.markedOffsets())
+
+	close(claim.messages)
+	require.NoError(t, waitResult(t, result))
+}
+
+func TestPoolGroupHandler_FailedLaneStopsPartition(t *testing.T) {
+	session := newFakeSession(context.Background())
+	claim := newFakeClaim()
+	log := newHandledLog()
+	release := make(chan struct{})
+	handlerErr := errors.New("handler failed")
+
+	handler := func(_ context.Context, msg Message) error {
+		log.add(msg)
+		if msg.Offset == 0 {
+			<-release
+			return handlerErr
+		}
+		return nil
+	}
+
+	result := consumeClaim(4, handler, session, claim)
+	claim.send(0, "a")
+	claim.send(1, "a")
+	claim.send(2, "b")
+
+	// Сообщение 1 прочитано раньше 2 и ждет в очереди ключа a, пока обрабатывается 0
+	require.Eventually(t, func() bool { return len(log.forKey("b")) == 1 }, time.Second, time.Millisecond)
+	close(release)
+
+	err := waitResult(t, result)
+
+	// Следующее сообщение упавшего ключа не обрабатывается, ни один offset не подтверждается:
+	// после перебалансировки партиция будет прочитана с сообщения 0
+	require.ErrorIs(t, err, handlerErr)
+	assert.Equal(t, []int64{0}, log.forKey("a"))
+	assert.Empty(t, session.markedOffsets())
+}
+
+func TestPartitionPool_SkipsMessagesAfterFailure(t *testing.T) {
+	session := newFakeSession(context.Background())
+	log := newHandledLog()
+	handler := func(_ context.Context, msg Message) error {
+		log.add(msg)
+		return nil
+	}
+
+	pool := newPoolGroupHandler(NewGroupHandler(handler, nopLogger{}), 1)
+	p := newPartitionPool(pool, session, testTopic, testPartition)
+	p.fail(errors.New("handler failed"))
+
+	// Сообщение, прочитанное после сбоя предыдущего с тем же ключом, не обрабатывается
+	processed := p.process(&sarama.ConsumerMessage{Topic: testTopic, Partition: testPartition, Offset: 1, Key: []byte("a")})
+
+	assert.False(t, processed)
+	assert.Empty(t, log.handled())
+	assert.Empty(t, session.markedOffsets())
+}
+
+func TestPoolGroupHandler_StopDrainsInFlightMessages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	session := newFakeSession(ctx)
+	claim := newFakeClaim()
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	handler := func(context.Context, Message) error {
+		close(started)
+		<-release
+		return nil
+	}
+
+	result := consumeClaim(2, handler, session, claim)
+	claim.send(0, "a")
+	<-started
+
+	// Сессия завершается, но ConsumeClaim ждет начатый обработчик
+	cancel()
+	select {
+	case <-result:
+		t.Fatal("ConsumeClaim returned before in-flight message finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, waitResult(t, result))
+	assert.Equal(t, []int64{1}, session.markedOffsets())
+}
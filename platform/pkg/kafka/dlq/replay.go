@@ -0,0 +1,195 @@
+package dlq
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
+)
+
+// failureHeaders — заголовки последней неудачной обработки, которые не переносятся при replay
+var failureHeaders = []string{
+	consumer.HeaderRetryStage,
+	consumer.HeaderRetryNotBefore,
+	consumer.HeaderReplayTopic,
+	consumer.HeaderAttempts,
+	consumer.HeaderError,
+	consumer.HeaderFailedAt,
+}
+
+type replayer struct {
+	client      sarama.Client
+	republisher consumer.Republisher
+	logger      consumer.Logger
+}
+
+// NewReplayer — создаёт replayer сообщений DLQ. Клиент должен читать
+// с начала топика: Consumer.Offsets.Initial = sarama.OffsetOldest.
+func NewReplayer(client sarama.Client, republisher consumer.Republisher, logger consumer.Logger) *replayer {
+	return &replayer{
+		client:      client,
+		republisher: republisher,
+		logger:      logger,
+	}
+}
+
+// Replay переотправляет сообщения, накопившиеся в DLQ к моменту запуска, в топик из заголовка
+// HeaderReplayTopic, а без него — в исходный топик. Прогресс сохраняется в группе groupID,
+// поэтому повторный запуск не отправит те же сообщения еще раз. limit ограничивает число
+// сообщений, 0 — без ограничения. Возвращает число переотправленных сообщений.
+func (r *replayer) Replay(ctx context.Context, dlqTopic, groupID string, limit int) (int, error) {
+	partitions, err := r.client.Partitions(dlqTopic)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get partitions of %s: %w", dlqTopic, err)
+	}
+
+	offsetManager, err := sarama.NewOffsetManagerFromClient(groupID, r.client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create offset manager: %w", err)
+	}
+	defer func() {
+		if closeErr := offsetManager.Close(); closeErr != nil {
+			r.logger.Error(ctx, "Failed to close offset manager", zap.Error(closeErr))
+		}
+	}()
+
+	partitionConsumer, err := sarama.NewConsumerFromClient(r.client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create consumer: %w", err)
+	}
+	defer func() {
+		if closeErr := partitionConsumer.Close(); closeErr != nil {
+			r.logger.Error(ctx, "Failed to close consumer", zap.Error(closeErr))
+		}
+	}()
+
+	replayed := 0
+	for _, partition := range partitions {
+		if limit > 0 && replayed >= limit {
+			break
+		}
+
+		remaining := 0
+		if limit > 0 {
+			remaining = limit - replayed
+		}
+
+		n, err := r.replayPartition(ctx, offsetManager, partitionConsumer, dlqTopic, partition, remaining)
+		replayed += n
+		if err != nil {
+			return replayed, err
+		}
+	}
+
+	offsetManager.Commit()
+
+	return replayed, nil
+}
+
+// replayPartition переотправляет не больше limit сообщений одной партиции (0 — без ограничения)
+// до offset, последнего на момент вызова.
+func (r *replayer) replayPartition(
+	ctx context.Context,
+	offsetManager sarama.OffsetManager,
+	partitionConsumer sarama.Consumer,
+	topic string,
+	partition int32,
+	limit int,
+) (int, error) {
+	oldest, err := r.client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, err
+	}
+	newest, err := r.client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, err
+	}
+
+	offsets, err := offsetManager.ManagePartition(topic, partition)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := offsets.Close(); closeErr != nil {
+			r.logger.Error(ctx, "Failed to close partition offset manager", zap.Error(closeErr))
+		}
+	}()
+
+	next, _ := offsets.NextOffset()
+	if next < oldest {
+		next = oldest
+	}
+	if next >= newest {
+		return 0, nil
+	}
+
+	claim, err := partitionConsumer.ConsumePartition(topic, partition, next)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := claim.Close(); closeErr != nil {
+			r.logger.Error(ctx, "Failed to close partition consumer", zap.Error(closeErr))
+		}
+	}()
+
+	replayed := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return replayed, ctx.Err()
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return replayed, nil
+			}
+
+			if err := r.replayMessage(ctx, message); err != nil {
+				return replayed, err
+			}
+			offsets.MarkOffset(message.Offset+1, "")
+			replayed++
+
+			if message.Offset+1 >= newest || (limit > 0 && replayed >= limit) {
+				return replayed, nil
+			}
+		}
+	}
+}
+
+// replayMessage отправляет сообщение DLQ обратно на обработку без заголовков последней ошибки.
+func (r *replayer) replayMessage(ctx context.Context, message *sarama.ConsumerMessage) error {
+	headers := make(map[string][]byte, len(message.Headers))
+	for _, header := range message.Headers {
+		if header != nil && header.Key != nil {
+			headers[string(header.Key)] = header.Value
+		}
+	}
+
+	target := string(headers[consumer.HeaderReplayTopic])
+	if target == "" {
+		target = string(headers[consumer.HeaderOriginalTopic])
+	}
+	if target == "" {
+		return fmt.Errorf("message %s/%d/%d has no %s header", message.Topic, message.Partition, message.Offset, consumer.HeaderOriginalTopic)
+	}
+
+	for _, key := range failureHeaders {
+		delete(headers, key)
+	}
+
+	if err := r.republisher.SendTo(ctx, target, message.Key, message.Value, headers); err != nil {
+		return fmt.Errorf("failed to replay message %s/%d/%d: %w", message.Topic, message.Partition, message.Offset, err)
+	}
+
+	r.logger.Info(ctx, "DLQ message replayed",
+		zap.String("topic", message.Topic),
+		zap.Int32("partition", message.Partition),
+		zap.Int64("offset", message.Offset),
+		zap.String("target_topic", target),
+	)
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package dlq
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+
+// fakeRepublisher запоминает последнее отправленное сообщение вместо отправки в Kafka
+type fakeRepublisher struct {
+	topic   string
+	key     []byte
+	value   []byte
+	headers map[string][]byte
+	calls   int
+	err     error
+}
+
+func (r *fakeRepublisher) SendTo(_ context.Context, topic string, key, value []byte, headers map[string][]byte) error {
+	r.calls++
+	if r.err != nil {
+		return r.err
+	}
+	r.topic, r.key, r.value, r.headers = topic, key, value, headers
+	return nil
+}
+
+func dlqMessage(headers map[string]string) *sarama.ConsumerMessage {
+	message := &sarama.ConsumerMessage{
+		Topic:     "orders.billing.dlq",
+		Partition: 1,
+		Offset:    5,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+	}
+	for key, value := range headers {
+		message.Headers = append(message.Headers, &sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+	}
+	// Заголовок без ключа не должен ломать replay
+	message.Headers = append(message.Headers, nil, &sarama.RecordHeader{})
+	return message
+}
+
+func TestReplayMessage(t *testing.T) {
+	failure := map[string]string{
+		consumer.HeaderRetryStage:     "2",
+		consumer.HeaderRetryNotBefore: "2025-10-01T10:00:00Z",
+		consumer.HeaderAttempts:       "3",
+		consumer.HeaderError:          "handler failed",
+		consumer.HeaderFailedAt:       "2025-10-01T10:00:00Z",
+	}
+
+	tests := []struct {
+		name            string
+		headers         map[string]string
+		expectedTopic   string
+		expectedHeaders map[string][]byte
+	}{
+		{
+			name: "Топик replay из заголовка",
+			headers: withFailure(failure, map[string]string{
+				consumer.HeaderReplayTopic:   "orders.billing.retry.1",
+				consumer.HeaderOriginalTopic: "orders",
+				"traceparent":                "00-abc-01",
+			}),
+			expectedTopic: "orders.billing.retry.1",
+			expectedHeaders: map[string][]byte{
+				consumer.HeaderOriginalTopic: []byte("orders"),
+				"traceparent":                []byte("00-abc-01"),
+			},
+		},
+		{
+			name: "Без топика replay - в исходный топик",
+			headers: withFailure(failure, map[string]string{
+				consumer.HeaderOriginalTopic:     "orders",
+				consumer.HeaderOriginalPartition: "3",
+				consumer.HeaderOriginalOffset:    "42",
+			}),
+			expectedTopic: "orders",
+			expectedHeaders: map[string][]byte{
+				consumer.HeaderOriginalTopic:     []byte("orders"),
+				consumer.HeaderOriginalPartition: []byte("3"),
+				consumer.HeaderOriginalOffset:    []byte("42"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			republisher := &fakeRepublisher{}
+			r := NewReplayer(nil, republisher, nopLogger{})
+
+			err := r.replayMessage(context.Background(), dlqMessage(tt.headers))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTopic, republisher.topic)
+			assert.Equal(t, []byte("key"), republisher.key)
+			assert.Equal(t, []byte("value"), republisher.value)
+			assert.Equal(t, tt.expectedHeaders, republisher.headers)
+		})
+	}
+}
+
+func TestReplayMessage_WithoutTarget(t *testing.T) {
+	republisher := &fakeRepublisher{}
+	r := NewReplayer(nil, republisher, nopLogger{})
+
+	err := r.replayMessage(context.Background(), dlqMessage(map[string]string{consumer.HeaderError: "handler failed"}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), consumer.HeaderOriginalTopic)
+	assert.Zero(t, republisher.calls)
+}
+
+func TestReplayMessage_SendError(t *testing.T) {
+	republisher := &fakeRepublisher{err: errors.New("broker unavailable")}
+	r := NewReplayer(nil, republisher, nopLogger{})
+
+	err := r.replayMessage(context.Background(), dlqMessage(map[string]string{consumer.HeaderOriginalTopic: "orders"}))
+
+	assert.ErrorIs(t, err, republisher.err)
+}
+
+func withFailure(failure, headers map[string]string) map[string]string {
+	merged := make(map[string]string, len(failure)+len(headers))
+	for key, value := range failure {
+		merged[key] = value
+	}
+	for key, value := range headers {
+		merged[key] = value
+	}
+	return merged
+}
@@ -13,3 +13,8 @@ type Consumer interface {
 type Producer interface {
 	Send(ctx context.Context, key, value []byte) error
 }
+
+// TopicProducer отправляет сообщение с заголовками в топик, заданный при отправке
+type TopicProducer interface {
+	SendTo(ctx context.Context, topic string, key, value []byte, headers map[string][]byte) error
+}
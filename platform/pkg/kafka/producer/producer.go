@@ -2,9 +2,13 @@ package producer
 
 import (
 	"context"
+	"time"
 
 	"github.com/IBM/sarama"
 	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/metrics"
+	"github.com/space-wanderer/microservices/platform/pkg/tracing"
 )
 
 type Logger interface {
@@ -27,11 +31,17 @@ func NewProducer(syncProducer sarama.SyncProducer, topic string, logger Logger)
 }
 
 func (p *producer) Send(ctx context.Context, key, value []byte) error {
+	headers := make(map[string][]byte)
+	tracing.InjectHeaders(ctx, headers)
+
+	start := time.Now()
 	partition, offset, err := p.syncProducer.SendMessage(&sarama.ProducerMessage{
-		Topic: p.topic,
-		Key:   sarama.ByteEncoder(key),
-		Value: sarama.ByteEncoder(value),
+		Topic:   p.topic,
+		Key:     sarama.ByteEncoder(key),
+		Value:   sarama.ByteEncoder(value),
+		Headers: toRecordHeaders(headers),
 	})
+	metrics.ObserveKafkaProduce(p.topic, time.Since(start), err)
 	if err != nil {
 		p.logger.Error(ctx, "Failed to send message", zap.Error(err))
 		return err
@@ -47,3 +57,59 @@ func (p *producer) Send(ctx context.Context, key, value []byte) error {
 
 	return nil
 }
+
+// topicProducer — producer, топик которого задаётся при каждой отправке.
+// Используется для переноса сообщений в топики повторов и DLQ.
+type topicProducer struct {
+	syncProducer sarama.SyncProducer
+	logger       Logger
+}
+
+func NewTopicProducer(syncProducer sarama.SyncProducer, logger Logger) *topicProducer {
+	return &topicProducer{
+		syncProducer: syncProducer,
+		logger:       logger,
+	}
+}
+
+func (p *topicProducer) SendTo(ctx context.Context, topic string, key, value []byte, headers map[string][]byte) error {
+	allHeaders := make(map[string][]byte, len(headers))
+	for headerKey, headerValue := range headers {
+		allHeaders[headerKey] = headerValue
+	}
+	tracing.InjectHeaders(ctx, allHeaders)
+
+	start := time.Now()
+	partition, offset, err := p.syncProducer.SendMessage(&sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(key),
+		Value:   sarama.ByteEncoder(value),
+		Headers: toRecordHeaders(allHeaders),
+	})
+	metrics.ObserveKafkaProduce(topic, time.Since(start), err)
+	if err != nil {
+		p.logger.Error(ctx, "Failed to send message", zap.String("topic", topic), zap.Error(err))
+		return err
+	}
+
+	p.logger.Info(ctx, "Message sent",
+		zap.String("topic", topic),
+		zap.Int32("partition", partition),
+		zap.Int64("offset", offset),
+		zap.String("key", string(key)),
+	)
+
+	return nil
+}
+
+func toRecordHeaders(headers map[string][]byte) []sarama.RecordHeader {
+	recordHeaders := make([]sarama.RecordHeader, 0, len(headers))
+	for headerKey, headerValue := range headers {
+		recordHeaders = append(recordHeaders, sarama.RecordHeader{
+			Key:   []byte(headerKey),
+			Value: headerValue,
+		})
+	}
+
+	return recordHeaders
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -14,6 +15,7 @@ type Key string
 
 const (
 	traceIDKey Key = "trace_id"
+	spanIDKey  Key = "span_id"
 	userIDKey  Key = "user_id"
 )
 
@@ -133,6 +135,17 @@ func WithContext(ctx context.Context) *logger {
 	}
 }
 
+// ContextWithUserID сохраняет в контексте идентификатор пользователя; он добавляется в логи полем user_id
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserIDFromContext возвращает идентификатор пользователя, сохранённый через ContextWithUserID
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDKey).(string)
+	return userID, ok && userID != ""
+}
+
 // Debug enrich-aware debug log
 func Debug(ctx context.Context, msg string, fields ...zap.Field) {
 	globalLogger.Debug(ctx, msg, fields...)
@@ -205,7 +218,13 @@ func parseLevel(levelStr string) zapcore.Level {
 func fieldsFromContext(ctx context.Context) []zap.Field {
 	fields := make([]zap.Field, 0)
 
-	if traceID, ok := ctx.Value(traceIDKey).(string); ok && traceID != "" {
+	// Активный span OpenTelemetry имеет приоритет над trace_id, положенным в контекст вручную
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		fields = append(fields,
+			zap.String(string(traceIDKey), spanCtx.TraceID().String()),
+			zap.String(string(spanIDKey), spanCtx.SpanID().String()),
+		)
+	} else if traceID, ok := ctx.Value(traceIDKey).(string); ok && traceID != "" {
 		fields = append(fields, zap.String(string(traceIDKey), traceID))
 	}
 
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcServerHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "grpc",
+		Subsystem: "server",
+		Name:      "handled_total",
+		Help:      "Количество обработанных gRPC-запросов по методам и кодам ответа",
+	}, []string{"method", "code"})
+
+	grpcServerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "grpc",
+		Subsystem: "server",
+		Name:      "handling_seconds",
+		Help:      "Длительность обработки gRPC-запросов",
+		Buckets:   durationBuckets,
+	}, []string{"method"})
+
+	grpcClientHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "grpc",
+		Subsystem: "client",
+		Name:      "handled_total",
+		Help:      "Количество исходящих gRPC-запросов по методам и кодам ответа",
+	}, []string{"method", "code"})
+
+	grpcClientDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "grpc",
+		Subsystem: "client",
+		Name:      "handling_seconds",
+		Help:      "Длительность исходящих gRPC-запросов",
+		Buckets:   durationBuckets,
+	}, []string{"method"})
+)
+
+// UnaryServerInterceptor считает входящие gRPC-запросы, их коды ответа и длительность
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		grpcServerDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+		grpcServerHandled.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+		return resp, err
+	}
+}
+
+// UnaryClientInterceptor считает исходящие gRPC-запросы, их коды ответа и длительность
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		grpcClientDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		grpcClientHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+
+		return err
+	}
+}
@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// unknownRoute — значение метки route для запросов, не попавших ни в один маршрут
+const unknownRoute = "unknown"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "http",
+		Subsystem: "server",
+		Name:      "requests_total",
+		Help:      "Количество обработанных HTTP-запросов по маршрутам и кодам ответа",
+	}, []string{"route", "method", "code"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "http",
+		Subsystem: "server",
+		Name:      "request_duration_seconds",
+		Help:      "Длительность обработки HTTP-запросов",
+		Buckets:   durationBuckets,
+	}, []string{"route", "method"})
+)
+
+// RouteFunc возвращает имя маршрута запроса для метки route.
+// Имя должно быть низкокардинальным: например, operationId из OpenAPI, а не путь с UUID.
+type RouteFunc func(r *http.Request) (string, bool)
+
+// HTTPMiddleware считает HTTP-запросы, их коды ответа и длительность по маршрутам
+func HTTPMiddleware(route RouteFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(recorder, r)
+
+			name, ok := route(r)
+			if !ok {
+				name = unknownRoute
+			}
+
+			httpDuration.WithLabelValues(name, r.Method).Observe(time.Since(start).Seconds())
+			httpRequests.WithLabelValues(name, r.Method, strconv.Itoa(recorder.status)).Inc()
+		})
+	}
+}
+
+// statusRecorder запоминает код ответа, записанный обработчиком
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	kafkaProduced = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kafka",
+		Subsystem: "producer",
+		Name:      "messages_total",
+		Help:      "Количество отправленных в Kafka сообщений по топикам",
+	}, []string{"topic"})
+
+	kafkaProduceErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kafka",
+		Subsystem: "producer",
+		Name:      "errors_total",
+		Help:      "Количество ошибок отправки сообщений в Kafka по топикам",
+	}, []string{"topic"})
+
+	kafkaProduceDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "kafka",
+		Subsystem: "producer",
+		Name:      "send_duration_seconds",
+		Help:      "Длительность синхронной отправки сообщения в Kafka",
+		Buckets:   durationBuckets,
+	}, []string{"topic"})
+
+	kafkaConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kafka",
+		Subsystem: "consumer",
+		Name:      "messages_total",
+		Help:      "Количество обработанных сообщений Kafka по топикам",
+	}, []string{"topic"})
+
+	kafkaConsumeErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kafka",
+		Subsystem: "consumer",
+		Name:      "errors_total",
+		Help:      "Количество ошибок обработчика сообщений Kafka по топикам",
+	}, []string{"topic"})
+
+	kafkaHandlerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "kafka",
+		Subsystem: "consumer",
+		Name:      "handler_duration_seconds",
+		Help:      "Длительность обработки сообщения Kafka",
+		Buckets:   durationBuckets,
+	}, []string{"topic"})
+
+	kafkaConsumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kafka",
+		Subsystem: "consumer",
+		Name:      "lag_messages",
+		Help:      "Количество сообщений партиции, ещё не прочитанных consumer group",
+	}, []string{"topic", "partition"})
+)
+
+// ObserveKafkaProduce учитывает попытку отправки сообщения в топик
+func ObserveKafkaProduce(topic string, duration time.Duration, err error) {
+	kafkaProduceDuration.WithLabelValues(topic).Observe(duration.Seconds())
+	if err != nil {
+		kafkaProduceErrors.WithLabelValues(topic).Inc()
+		return
+	}
+	kafkaProduced.WithLabelValues(topic).Inc()
+}
+
+// ObserveKafkaConsume учитывает обработку сообщения из топика
+func ObserveKafkaConsume(topic string, duration time.Duration, err error) {
+	kafkaHandlerDuration.WithLabelValues(topic).Observe(duration.Seconds())
+	kafkaConsumed.WithLabelValues(topic).Inc()
+	if err != nil {
+		kafkaConsumeErrors.WithLabelValues(topic).Inc()
+	}
+}
+
+// SetKafkaConsumerLag запоминает отставание consumer group в партиции:
+// разницу между high water mark и следующим offset для чтения
+func SetKafkaConsumerLag(topic string, partition int32, lag int64) {
+	kafkaConsumerLag.WithLabelValues(topic, strconv.Itoa(int(partition))).Set(float64(max(lag, 0)))
+}
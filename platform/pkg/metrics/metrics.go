@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Path — путь, по которому сервисы отдают метрики
+const Path = "/metrics"
+
+// durationBuckets — границы гистограмм длительности запросов и обработки сообщений, в секундах
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Handler отдаёт метрики глобального реестра Prometheus в текстовом формате
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// NewServer создаёт HTTP-сервер, отдающий метрики по пути Path
+func NewServer(address string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler())
+
+	return &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+// register регистрирует коллектор в глобальном реестре.
+// Повторная регистрация того же коллектора не считается ошибкой.
+func register(collector prometheus.Collector) error {
+	if err := prometheus.Register(collector); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
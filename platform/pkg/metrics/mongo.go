@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+var (
+	mongoConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "mongo",
+		Subsystem: "pool",
+		Name:      "connections",
+		Help:      "Количество открытых соединений в пуле MongoDB",
+	})
+
+	mongoConnectionsInUse = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "mongo",
+		Subsystem: "pool",
+		Name:      "connections_in_use",
+		Help:      "Количество соединений MongoDB, выданных из пула",
+	})
+
+	mongoCheckoutFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mongo",
+		Subsystem: "pool",
+		Name:      "checkout_failures_total",
+		Help:      "Количество неудачных получений соединения MongoDB по причинам",
+	}, []string{"reason"})
+
+	mongoCommands = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mongo",
+		Subsystem: "client",
+		Name:      "commands_total",
+		Help:      "Количество выполненных команд MongoDB",
+	}, []string{"command", "status"})
+
+	mongoCommandDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "mongo",
+		Subsystem: "client",
+		Name:      "command_duration_seconds",
+		Help:      "Длительность выполнения команд MongoDB",
+		Buckets:   durationBuckets,
+	}, []string{"command"})
+)
+
+// MongoPoolMonitor возвращает монитор пула соединений MongoDB для options.Client().SetPoolMonitor
+func MongoPoolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			switch e.Type {
+			case event.ConnectionCreated:
+				mongoConnections.Inc()
+			case event.ConnectionClosed:
+				mongoConnections.Dec()
+			case event.GetSucceeded:
+				mongoConnectionsInUse.Inc()
+			case event.ConnectionReturned:
+				mongoConnectionsInUse.Dec()
+			case event.GetFailed:
+				mongoCheckoutFailures.WithLabelValues(e.Reason).Inc()
+			}
+		},
+	}
+}
+
+// MongoCommandMonitor возвращает монитор команд MongoDB для options.Client().SetMonitor
+func MongoCommandMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			observeMongoCommand(e.CommandName, "success", e.Duration)
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			observeMongoCommand(e.CommandName, "failure", e.Duration)
+		},
+	}
+}
+
+func observeMongoCommand(command, status string, duration time.Duration) {
+	mongoCommands.WithLabelValues(command, status).Inc()
+	mongoCommandDuration.WithLabelValues(command).Observe(duration.Seconds())
+}
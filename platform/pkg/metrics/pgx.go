@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// pgxPoolCollector снимает статистику пула соединений pgx при каждом сборе метрик
+type pgxPoolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+}
+
+// RegisterPgxPool регистрирует метрики пула соединений PostgreSQL
+func RegisterPgxPool(pool *pgxpool.Pool) error {
+	return register(newPgxPoolCollector(pool))
+}
+
+func newPgxPoolCollector(pool *pgxpool.Pool) *pgxPoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("pgxpool", "", name), help, nil, nil)
+	}
+
+	return &pgxPoolCollector{
+		pool:                 pool,
+		acquiredConns:        desc("acquired_conns", "Количество соединений, выданных из пула"),
+		idleConns:            desc("idle_conns", "Количество простаивающих соединений в пуле"),
+		totalConns:           desc("total_conns", "Общее количество соединений в пуле"),
+		maxConns:             desc("max_conns", "Максимальный размер пула"),
+		acquireCount:         desc("acquire_total", "Количество успешных получений соединения из пула"),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Суммарное время ожидания соединения из пула"),
+		emptyAcquireCount:    desc("empty_acquire_total", "Количество получений соединения, которым пришлось ждать свободного соединения"),
+		canceledAcquireCount: desc("canceled_acquire_total", "Количество получений соединения, отменённых контекстом"),
+	}
+}
+
+func (c *pgxPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquireCount
+	ch <- c.canceledAcquireCount
+}
+
+func (c *pgxPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+}
@@ -0,0 +1,17 @@
+package http
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// TracePropagation извлекает W3C-контекст трассировки (traceparent, baggage) из заголовков
+// запроса. Серверный span создаёт ogen-обработчик, и он становится дочерним для вызывающей стороны.
+func TracePropagation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,68 @@
+package kafka
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
+)
+
+// InboxStore хранит идентификаторы событий, уже обработанных консьюмером.
+type InboxStore interface {
+	IsProcessed(ctx context.Context, consumerName, eventID string) (bool, error)
+	MarkProcessed(ctx context.Context, consumerName, eventID string) error
+}
+
+// EventIDFunc извлекает идентификатор события из сообщения. Пустой идентификатор
+// отключает дедупликацию для сообщения.
+type EventIDFunc func(msg consumer.Message) string
+
+// Inbox пропускает события, которые консьюмер consumerName уже обработал. Событие отмечается
+// обработанным только после успешного выполнения обработчика, поэтому при ошибке
+// повторная доставка обработает его снова.
+//
+// Проверка, обработка и отметка не атомарны, и дедупликация работает по принципу at-least-once:
+// событие обработается повторно, если сервис остановился или отметка не сохранилась после
+// успешной обработки, а также если дубликат доставлен, пока первая копия еще обрабатывается.
+// Inbox отсекает повторные доставки, но обработчик все равно должен быть идемпотентным,
+// например проверять текущее состояние заказа перед переходом.
+func Inbox(consumerName string, store InboxStore, eventID EventIDFunc, logger Logger) consumer.Middleware {
+	return func(next consumer.MessageHandler) consumer.MessageHandler {
+		return func(ctx context.Context, msg consumer.Message) error {
+			id := eventID(msg)
+			if id == "" {
+				return next(ctx, msg)
+			}
+
+			processed, err := store.IsProcessed(ctx, consumerName, id)
+			if err != nil {
+				return err
+			}
+			if processed {
+				logger.Info(ctx, "Kafka event already processed, skipping",
+					zap.String("consumer", consumerName),
+					zap.String("event_id", id),
+					zap.String("topic", msg.Topic),
+					zap.Int64("offset", msg.Offset),
+				)
+				return nil
+			}
+
+			if err := next(ctx, msg); err != nil {
+				return err
+			}
+
+			// Событие уже обработано: ошибка отметки не должна запускать обработку повторно
+			if err := store.MarkProcessed(ctx, consumerName, id); err != nil {
+				logger.Error(ctx, "Failed to mark Kafka event as processed",
+					zap.String("consumer", consumerName),
+					zap.String("event_id", id),
+					zap.Error(err),
+				)
+			}
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// InboxCleaner удаляет отметки о давно обработанных событиях
+type InboxCleaner interface {
+	DeleteProcessedBefore(ctx context.Context, processedBefore time.Time, limit int) (int, error)
+}
+
+// RunInboxCleanup раз в interval удаляет отметки старше retention пачками по batchSize, пока не отменен ctx.
+// retention должен быть больше срока, в течение которого событие может быть доставлено повторно
+// (срока хранения сообщений в топиках): иначе повтор старого события обработается заново
+func RunInboxCleanup(ctx context.Context, cleaner InboxCleaner, retention, interval time.Duration, batchSize int, logger Logger) error {
+	logger.Info(ctx, "Starting Kafka inbox cleanup",
+		zap.Duration("retention", retention),
+		zap.Duration("cleanup_interval", interval),
+		zap.Int("batch_size", batchSize))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		cleanupInboxOnce(ctx, cleaner, retention, batchSize, logger)
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "🛑 Kafka inbox cleanup stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func cleanupInboxOnce(ctx context.Context, cleaner InboxCleaner, retention time.Duration, batchSize int, logger Logger) {
+	processedBefore := time.Now().Add(-retention)
+
+	// Удаляем пачки подряд, пока устаревшие отметки не закончатся
+	for ctx.Err() == nil {
+		deleted, err := cleaner.DeleteProcessedBefore(ctx, processedBefore, batchSize)
+		if err != nil {
+			logger.Error(ctx, "❌ Failed to delete processed Kafka events", zap.Error(err))
+			return
+		}
+		if deleted < batchSize {
+			return
+		}
+	}
+}
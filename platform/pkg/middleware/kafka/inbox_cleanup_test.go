@@ -0,0 +1,87 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInboxCleaner возвращает заданные результаты удаления по очереди
+type fakeInboxCleaner struct {
+	results []int
+	err     error
+	calls   []time.Time
+}
+
+func (c *fakeInboxCleaner) DeleteProcessedBefore(_ context.Context, processedBefore time.Time, _ int) (int, error) {
+	c.calls = append(c.calls, processedBefore)
+	if c.err != nil {
+		return 0, c.err
+	}
+	if len(c.results) == 0 {
+		return 0, nil
+	}
+	deleted := c.results[0]
+	c.results = c.results[1:]
+	return deleted, nil
+}
+
+func TestCleanupInboxOnce(t *testing.T) {
+	tests := []struct {
+		name          string
+		cleaner       *fakeInboxCleaner
+		expectedCalls int
+	}{
+		{
+			name:          "Неполная пачка завершает проход",
+			cleaner:       &fakeInboxCleaner{results: []int{3}},
+			expectedCalls: 1,
+		},
+		{
+			name:          "Полные пачки удаляются подряд",
+			cleaner:       &fakeInboxCleaner{results: []int{10, 10, 0}},
+			expectedCalls: 3,
+		},
+		{
+			name:          "Ошибка удаления завершает проход",
+			cleaner:       &fakeInboxCleaner{err: errors.New("database unavailable")},
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := time.Now().Add(-time.Hour)
+
+			cleanupInboxOnce(context.Background(), tt.cleaner, time.Hour, 10, nopLogger{})
+
+			require.Len(t, tt.cleaner.calls, tt.expectedCalls)
+			// Удаляются только отметки старше retention
+			assert.False(t, tt.cleaner.calls[0].Before(before))
+			assert.True(t, tt.cleaner.calls[0].Before(time.Now().Add(-59*time.Minute)))
+		})
+	}
+}
+
+func TestRunInboxCleanup_StopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() {
+		done <- RunInboxCleanup(ctx, &fakeInboxCleaner{}, time.Hour, time.Millisecond, 10, nopLogger{})
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("cleanup did not stop after context cancel")
+	}
+}
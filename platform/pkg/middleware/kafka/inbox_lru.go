@@ -0,0 +1,60 @@
+package kafka
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// lruInbox — InboxStore в памяти, помнящий последние capacity событий.
+// Подходит для тестов и сервисов без собственной БД: после перезапуска
+// или вытеснения событие может быть обработано повторно.
+type lruInbox struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+func NewLRUInbox(capacity int) *lruInbox {
+	return &lruInbox{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element, capacity),
+	}
+}
+
+func (s *lruInbox) IsProcessed(_ context.Context, consumerName, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.items[inboxKey(consumerName, eventID)]
+	if ok {
+		s.order.MoveToFront(element)
+	}
+	return ok, nil
+}
+
+func (s *lruInbox) MarkProcessed(_ context.Context, consumerName, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := inboxKey(consumerName, eventID)
+	if element, ok := s.items[key]; ok {
+		s.order.MoveToFront(element)
+		return nil
+	}
+
+	s.items[key] = s.order.PushFront(key)
+	for s.capacity > 0 && s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(string))
+	}
+
+	return nil
+}
+
+func inboxKey(consumerName, eventID string) string {
+	return consumerName + "/" + eventID
+}
@@ -0,0 +1,30 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUInbox_EvictsOldest(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUInbox(2)
+
+	require.NoError(t, store.MarkProcessed(ctx, testConsumer, "first"))
+	require.NoError(t, store.MarkProcessed(ctx, testConsumer, "second"))
+
+	// Обращение продлевает жизнь события, поэтому вытесняется second
+	processed, err := store.IsProcessed(ctx, testConsumer, "first")
+	require.NoError(t, err)
+	require.True(t, processed)
+
+	require.NoError(t, store.MarkProcessed(ctx, testConsumer, "third"))
+
+	for eventID, expected := range map[string]bool{"first": true, "second": false, "third": true} {
+		processed, err := store.IsProcessed(ctx, testConsumer, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, expected, processed, eventID)
+	}
+}
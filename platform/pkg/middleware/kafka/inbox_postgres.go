@@ -0,0 +1,65 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresInbox — InboxStore в таблице processed_events базы сервиса:
+//
+//	CREATE TABLE processed_events (
+//	    consumer VARCHAR(128) NOT NULL,
+//	    event_uuid VARCHAR(36) NOT NULL,
+//	    processed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+//	    PRIMARY KEY (consumer, event_uuid)
+//	);
+//	CREATE INDEX idx_processed_events_processed_at ON processed_events(processed_at);
+//
+// Таблица создается миграцией сервиса. Отметки хранятся, пока их не удалит RunInboxCleanup.
+type PostgresInbox struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresInbox(db *pgxpool.Pool) *PostgresInbox {
+	return &PostgresInbox{db: db}
+}
+
+// IsProcessed проверяет, обработал ли consumerName событие eventID
+func (s *PostgresInbox) IsProcessed(ctx context.Context, consumerName, eventID string) (bool, error) {
+	var processed bool
+	err := s.db.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM processed_events WHERE consumer = $1 AND event_uuid = $2)
+	`, consumerName, eventID).Scan(&processed)
+	return processed, err
+}
+
+// MarkProcessed отмечает событие eventID обработанным consumerName
+func (s *PostgresInbox) MarkProcessed(ctx context.Context, consumerName, eventID string) error {
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO processed_events (consumer, event_uuid)
+		VALUES ($1, $2)
+		ON CONFLICT (consumer, event_uuid) DO NOTHING
+	`, consumerName, eventID)
+	return err
+}
+
+// DeleteProcessedBefore удаляет не больше limit отметок, сделанных раньше processedBefore,
+// и возвращает количество удаленных
+func (s *PostgresInbox) DeleteProcessedBefore(ctx context.Context, processedBefore time.Time, limit int) (int, error) {
+	tag, err := s.db.Exec(ctx, `
+		DELETE FROM processed_events
+		WHERE (consumer, event_uuid) IN (
+			SELECT consumer, event_uuid
+			FROM processed_events
+			WHERE processed_at < $1
+			ORDER BY processed_at
+			LIMIT $2
+		)
+	`, processedBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,157 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/space-wanderer/microservices/platform/pkg/kafka/consumer"
+)
+
+const (
+	testConsumer = "order-group"
+	testEventID  = "550e8400-e29b-41d4-a716-446655440000"
+)
+
+// nopLogger отбрасывает записи middleware
+type nopLogger struct{}
+
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+
+// fakeInboxStore хранит отметки в памяти и возвращает заданные ошибки
+type fakeInboxStore struct {
+	processed map[string]bool
+	checkErr  error
+	markErr   error
+	marked    []string
+}
+
+func (s *fakeInboxStore) IsProcessed(_ context.Context, consumerName, eventID string) (bool, error) {
+	if s.checkErr != nil {
+		return false, s.checkErr
+	}
+	return s.processed[inboxKey(consumerName, eventID)], nil
+}
+
+func (s *fakeInboxStore) MarkProcessed(_ context.Context, consumerName, eventID string) error {
+	if s.markErr != nil {
+		return s.markErr
+	}
+	s.marked = append(s.marked, inboxKey(consumerName, eventID))
+	return nil
+}
+
+func headerEventID(msg consumer.Message) string {
+	return string(msg.Headers["event_uuid"])
+}
+
+func eventMessage(eventID string) consumer.Message {
+	return consumer.Message{Topic: "ship.assembled", Headers: map[string][]byte{"event_uuid": []byte(eventID)}}
+}
+
+func TestInbox(t *testing.T) {
+	handlerErr := errors.New("handler failed")
+	storeErr := errors.New("database unavailable")
+
+	tests := []struct {
+		name          string
+		store         *fakeInboxStore
+		eventID       string
+		handlerErr    error
+		expectedErr   error
+		expectHandled bool
+		expectMarked  []string
+	}{
+		{
+			name:          "Новое событие обрабатывается и отмечается",
+			store:         &fakeInboxStore{},
+			eventID:       testEventID,
+			expectHandled: true,
+			expectMarked:  []string{inboxKey(testConsumer, testEventID)},
+		},
+		{
+			name:          "Дубликат пропускается",
+			store:         &fakeInboxStore{processed: map[string]bool{inboxKey(testConsumer, testEventID): true}},
+			eventID:       testEventID,
+			expectHandled: false,
+		},
+		{
+			name:          "Событие другого консьюмера не считается дубликатом",
+			store:         &fakeInboxStore{processed: map[string]bool{inboxKey("notification-group", testEventID): true}},
+			eventID:       testEventID,
+			expectHandled: true,
+			expectMarked:  []string{inboxKey(testConsumer, testEventID)},
+		},
+		{
+			name:          "Ошибка обработчика не отмечает событие",
+			store:         &fakeInboxStore{},
+			eventID:       testEventID,
+			handlerErr:    handlerErr,
+			expectedErr:   handlerErr,
+			expectHandled: true,
+		},
+		{
+			name:          "Ошибка проверки возвращается без обработки",
+			store:         &fakeInboxStore{checkErr: storeErr},
+			eventID:       testEventID,
+			expectedErr:   storeErr,
+			expectHandled: false,
+		},
+		{
+			name:          "Ошибка отметки после обработки не вызывает повтор",
+			store:         &fakeInboxStore{markErr: storeErr},
+			eventID:       testEventID,
+			expectHandled: true,
+		},
+		{
+			name:          "Сообщение без идентификатора обрабатывается без дедупликации",
+			store:         &fakeInboxStore{checkErr: storeErr},
+			eventID:       "",
+			expectHandled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled := false
+			handler := Inbox(testConsumer, tt.store, headerEventID, nopLogger{})(func(context.Context, consumer.Message) error {
+				handled = true
+				return tt.handlerErr
+			})
+
+			err := handler(context.Background(), eventMessage(tt.eventID))
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectHandled, handled)
+			assert.Equal(t, tt.expectMarked, tt.store.marked)
+		})
+	}
+}
+
+func TestInbox_RedeliveryAfterHandlerErrorIsProcessed(t *testing.T) {
+	store := NewLRUInbox(10)
+	calls := 0
+	handler := Inbox(testConsumer, store, headerEventID, nopLogger{})(func(context.Context, consumer.Message) error {
+		calls++
+		if calls == 1 {
+			return errors.New("handler failed")
+		}
+		return nil
+	})
+
+	require.Error(t, handler(context.Background(), eventMessage(testEventID)))
+	require.NoError(t, handler(context.Background(), eventMessage(testEventID)))
+	require.NoError(t, handler(context.Background(), eventMessage(testEventID)))
+
+	// Первая доставка упала, вторая обработала событие, третья отброшена как дубликат
+	assert.Equal(t, 2, calls)
+}
@@ -10,6 +10,7 @@ import (
 
 type Logger interface {
 	Info(ctx context.Context, msg string, fields ...zap.Field)
+	Error(ctx context.Context, msg string, fields ...zap.Field)
 }
 
 func Logging(logger Logger) consumer.Middleware {
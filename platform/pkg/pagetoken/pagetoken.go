@@ -0,0 +1,83 @@
+package pagetoken
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidToken — токен поврежден или выдан для запроса с другими параметрами
+var ErrInvalidToken = errors.New("invalid page token")
+
+// envelope — содержимое непрозрачного токена страницы. Кроме ключа последней записи
+// токен хранит отпечаток параметров запроса, чтобы продолжение с другим фильтром
+// или сортировкой отклонялось, а не возвращало мусор
+type envelope struct {
+	Query  string          `json:"q"`
+	Cursor json.RawMessage `json:"k"`
+}
+
+// Encode строит токен страницы keyset-пагинации. query — параметры запроса, от которых
+// зависит порядок выдачи (фильтр и сортировка), cursor — ключ последней записи страницы
+func Encode(query, cursor any) (string, error) {
+	fingerprint, err := queryFingerprint(query)
+	if err != nil {
+		return "", err
+	}
+
+	rawCursor, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page cursor: %w", err)
+	}
+
+	data, err := json.Marshal(envelope{Query: fingerprint, Cursor: rawCursor})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode разбирает токен raw, выданный Encode для тех же параметров запроса query,
+// и записывает ключ последней записи в cursor. Поврежденный токен или токен
+// от другого запроса отклоняется с ErrInvalidToken
+func Decode(raw string, query, cursor any) error {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	var token envelope
+	if err := json.Unmarshal(data, &token); err != nil || len(token.Cursor) == 0 {
+		return ErrInvalidToken
+	}
+
+	fingerprint, err := queryFingerprint(query)
+	if err != nil {
+		return err
+	}
+
+	if token.Query != fingerprint {
+		return ErrInvalidToken
+	}
+
+	if err := json.Unmarshal(token.Cursor, cursor); err != nil {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
+
+// queryFingerprint возвращает короткий отпечаток параметров запроса для сверки с токеном
+func queryFingerprint(query any) (string, error) {
+	data, err := json.Marshal(query)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page query: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
@@ -0,0 +1,57 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testQuery struct {
+	Category string `json:"category"`
+	OrderBy  string `json:"order_by"`
+}
+
+type testCursor struct {
+	UUID      string `json:"u"`
+	CreatedAt int64  `json:"c"`
+}
+
+func TestEncodeDecode(t *testing.T) {
+	query := testQuery{Category: "engine", OrderBy: "created_at"}
+	cursor := testCursor{UUID: "550e8400-e29b-41d4-a716-446655440000", CreatedAt: 1760000000000000000}
+
+	token, err := Encode(query, cursor)
+	require.NoError(t, err)
+
+	var decoded testCursor
+	require.NoError(t, Decode(token, query, &decoded))
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecode_InvalidToken(t *testing.T) {
+	query := testQuery{Category: "engine", OrderBy: "created_at"}
+
+	token, err := Encode(query, testCursor{UUID: "550e8400-e29b-41d4-a716-446655440000"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		raw   string
+		query testQuery
+	}{
+		{name: "Не base64", raw: "not a token!", query: query},
+		{name: "Не JSON", raw: base64.RawURLEncoding.EncodeToString([]byte("garbage")), query: query},
+		{name: "Без ключа записи", raw: base64.RawURLEncoding.EncodeToString([]byte(`{"q":"x"}`)), query: query},
+		{name: "Другой фильтр", raw: token, query: testQuery{Category: "wing", OrderBy: "created_at"}},
+		{name: "Другая сортировка", raw: token, query: testQuery{Category: "engine", OrderBy: "price"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cursor testCursor
+			assert.ErrorIs(t, Decode(tt.raw, tt.query, &cursor), ErrInvalidToken)
+		})
+	}
+}
@@ -33,6 +33,7 @@ type Config struct {
 	Dockerfile    string
 	Port          string
 	Env           map[string]string
+	Files         []testcontainers.ContainerFile
 	Networks      []string
 	LogOutput     io.Writer
 	StartupWait   wait.Strategy
@@ -70,6 +71,7 @@ func NewContainer(ctx context.Context, opts ...Option) (*Container, error) {
 		},
 		Networks:           cfg.Networks,
 		Env:                cfg.Env,
+		Files:              cfg.Files,
 		WaitingFor:         cfg.StartupWait,
 		ExposedPorts:       []string{cfg.Port + "/tcp"},
 		HostConfigModifier: DefaultHostConfig(),
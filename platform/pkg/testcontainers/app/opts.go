@@ -3,6 +3,7 @@ package app
 import (
 	"io"
 
+	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -41,6 +42,17 @@ func WithEnv(env map[string]string) Option {
 	}
 }
 
+// WithFile копирует файл с хоста в контейнер перед запуском
+func WithFile(hostPath, containerPath string) Option {
+	return func(c *Config) {
+		c.Files = append(c.Files, testcontainers.ContainerFile{
+			HostFilePath:      hostPath,
+			ContainerFilePath: containerPath,
+			FileMode:          0o644,
+		})
+	}
+}
+
 func WithLogOutput(out io.Writer) Option {
 	return func(c *Config) {
 		c.LogOutput = out
@@ -0,0 +1,154 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const validity = 24 * time.Hour
+
+// Pair — пути к сертификату и ключу в PEM
+type Pair struct {
+	CertFile string
+	KeyFile  string
+}
+
+// Bundle — одноразовый набор для mTLS: CA, сертификат сервера и клиентские сертификаты по идентичностям
+type Bundle struct {
+	CAFile  string
+	Server  Pair
+	Clients map[string]Pair
+}
+
+// Generate выпускает CA, серверный сертификат на hosts и клиентские сертификаты с CommonName из clients
+// и складывает их в dir. Файлы доступны на чтение всем, чтобы их мог прочитать процесс в контейнере.
+func Generate(dir string, hosts []string, clients ...string) (*Bundle, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate CA key")
+	}
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          serial(),
+		Subject:               pkix.Name{CommonName: "testcontainers-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "create CA certificate")
+	}
+
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse CA certificate")
+	}
+
+	bundle := &Bundle{
+		CAFile:  filepath.Join(dir, "ca.pem"),
+		Clients: make(map[string]Pair, len(clients)),
+	}
+	if err = writePEM(bundle.CAFile, "CERTIFICATE", caDER); err != nil {
+		return nil, err
+	}
+
+	serverTemplate := leafTemplate("server", x509.ExtKeyUsageServerAuth)
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			serverTemplate.IPAddresses = append(serverTemplate.IPAddresses, ip)
+		} else {
+			serverTemplate.DNSNames = append(serverTemplate.DNSNames, host)
+		}
+	}
+
+	bundle.Server, err = issue(dir, "server", serverTemplate, caCert, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, client := range clients {
+		pair, issueErr := issue(dir, client, leafTemplate(client, x509.ExtKeyUsageClientAuth), caCert, caKey)
+		if issueErr != nil {
+			return nil, issueErr
+		}
+		bundle.Clients[client] = pair
+	}
+
+	return bundle, nil
+}
+
+func leafTemplate(commonName string, usage x509.ExtKeyUsage) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber: serial(),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+}
+
+func issue(dir, name string, template, ca *x509.Certificate, caKey *ecdsa.PrivateKey) (Pair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return Pair{}, errors.Wrapf(err, "generate %s key", name)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return Pair{}, errors.Wrapf(err, "create %s certificate", name)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return Pair{}, errors.Wrapf(err, "marshal %s key", name)
+	}
+
+	pair := Pair{
+		CertFile: filepath.Join(dir, name+".pem"),
+		KeyFile:  filepath.Join(dir, name+"-key.pem"),
+	}
+	if err = writePEM(pair.CertFile, "CERTIFICATE", der); err != nil {
+		return Pair{}, err
+	}
+	if err = writePEM(pair.KeyFile, "PRIVATE KEY", keyDER); err != nil {
+		return Pair{}, err
+	}
+
+	return pair, nil
+}
+
+func writePEM(path, blockType string, der []byte) error {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+
+	//nolint:gosec // сертификаты одноразовые и должны читаться пользователем контейнера
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return errors.Wrapf(err, "write %s", path)
+	}
+
+	return nil
+}
+
+func serial() *big.Int {
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	if err != nil {
+		return big.NewInt(time.Now().UnixNano())
+	}
+
+	return n
+}
@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName — имя инструментации для span'ов, создаваемых пакетами platform и shared
+const InstrumentationName = "github.com/space-wanderer/microservices"
+
+// Init настраивает глобальный TracerProvider с экспортом span'ов по OTLP/gRPC
+// и W3C-пропагатор (traceparent, baggage). Возвращает функцию, которая
+// дописывает буфер span'ов и останавливает экспортёр.
+func Init(ctx context.Context, serviceName, collectorEndpoint string) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(collectorEndpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
+
+// Tracer возвращает tracer глобального провайдера.
+// До вызова Init это no-op tracer, поэтому инструментированный код работает и без трейсинга.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// InjectHeaders записывает контекст трассировки из ctx в заголовки сообщения
+func InjectHeaders(ctx context.Context, headers map[string][]byte) {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	for key, value := range carrier {
+		headers[key] = []byte(value)
+	}
+}
+
+// ExtractHeaders восстанавливает контекст трассировки из заголовков сообщения
+func ExtractHeaders(ctx context.Context, headers map[string][]byte) context.Context {
+	carrier := make(propagation.MapCarrier, len(headers))
+	for key, value := range headers {
+		carrier[key] = string(value)
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+// Carrier возвращает контекст трассировки из ctx в виде W3C-заголовков.
+// Используется, чтобы сохранить трассировку вместе с отложенной работой (например, в outbox).
+func Carrier(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	return carrier
+}
+
+// ContextWithCarrier восстанавливает контекст трассировки, сохранённый через Carrier
+func ContextWithCarrier(ctx context.Context, carrier map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
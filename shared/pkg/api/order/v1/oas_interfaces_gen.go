@@ -13,6 +13,14 @@ type GetOrderByUuidRes interface {
 	getOrderByUuidRes()
 }
 
+type GetOrderHistoryRes interface {
+	getOrderHistoryRes()
+}
+
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
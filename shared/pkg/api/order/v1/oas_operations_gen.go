@@ -9,5 +9,7 @@ const (
 	CancelOrderByUuidOperation OperationName = "CancelOrderByUuid"
 	CreateOrderOperation       OperationName = "CreateOrder"
 	GetOrderByUuidOperation    OperationName = "GetOrderByUuid"
+	GetOrderHistoryOperation   OperationName = "GetOrderHistory"
+	ListOrdersOperation        OperationName = "ListOrders"
 	PayOrderOperation          OperationName = "PayOrder"
 )
@@ -81,6 +81,7 @@ func (s *BadGatewayError) SetTimestamp(val time.Time) {
 func (*BadGatewayError) cancelOrderByUuidRes() {}
 func (*BadGatewayError) createOrderRes()       {}
 func (*BadGatewayError) getOrderByUuidRes()    {}
+func (*BadGatewayError) getOrderHistoryRes()   {}
 func (*BadGatewayError) payOrderRes()          {}
 
 // Ref: #/components/schemas/bad_request_error
@@ -112,6 +113,33 @@ func (s *BadRequestError) SetMessage(val string) {
 }
 
 func (*BadRequestError) createOrderRes() {}
+func (*BadRequestError) listOrdersRes()  {}
+func (*BadRequestError) payOrderRes()    {}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
 
 // CancelOrderByUuidNoContent is response for CancelOrderByUuid operation.
 type CancelOrderByUuidNoContent struct{}
@@ -147,18 +175,24 @@ func (s *ConflictError) SetMessage(val string) {
 }
 
 func (*ConflictError) cancelOrderByUuidRes() {}
+func (*ConflictError) createOrderRes()       {}
+func (*ConflictError) payOrderRes()          {}
 
 // Ref: #/components/schemas/create_order_request
 type CreateOrderRequest struct {
-	// UUID пользователя.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей/товаров для заказа.
+	// Позиции заказа с количеством. Повторяющиеся UUID
+	// деталей объединяются в одну позицию.
+	Items []OrderItemRequest `json:"items"`
+	// Устаревший способ задать состав заказа - каждый UUID
+	// считается одной деталью. Используйте items.
+	//
+	// Deprecated: schema marks this property as deprecated.
 	PartUuids []uuid.UUID `json:"part_uuids"`
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
-	return s.UserUUID
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []OrderItemRequest {
+	return s.Items
 }
 
 // GetPartUuids returns the value of PartUuids.
@@ -166,9 +200,9 @@ func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
-	s.UserUUID = val
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []OrderItemRequest) {
+	s.Items = val
 }
 
 // SetPartUuids sets the value of PartUuids.
@@ -180,8 +214,8 @@ func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 type CreateOrderResponse struct {
 	// UUID заказа.
 	OrderUUID uuid.UUID `json:"order_uuid"`
-	// Общая стоимость заказа.
-	TotalPrice float32 `json:"total_price"`
+	// Общая стоимость заказа, точная до копеек (DECIMAL(10,2)).
+	TotalPrice float64 `json:"total_price"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -190,7 +224,7 @@ func (s *CreateOrderResponse) GetOrderUUID() uuid.UUID {
 }
 
 // GetTotalPrice returns the value of TotalPrice.
-func (s *CreateOrderResponse) GetTotalPrice() float32 {
+func (s *CreateOrderResponse) GetTotalPrice() float64 {
 	return s.TotalPrice
 }
 
@@ -200,7 +234,7 @@ func (s *CreateOrderResponse) SetOrderUUID(val uuid.UUID) {
 }
 
 // SetTotalPrice sets the value of TotalPrice.
-func (s *CreateOrderResponse) SetTotalPrice(val float32) {
+func (s *CreateOrderResponse) SetTotalPrice(val float64) {
 	s.TotalPrice = val
 }
 
@@ -237,6 +271,8 @@ func (s *ForbiddenError) SetMessage(val string) {
 func (*ForbiddenError) cancelOrderByUuidRes() {}
 func (*ForbiddenError) createOrderRes()       {}
 func (*ForbiddenError) getOrderByUuidRes()    {}
+func (*ForbiddenError) getOrderHistoryRes()   {}
+func (*ForbiddenError) listOrdersRes()        {}
 func (*ForbiddenError) payOrderRes()          {}
 
 // Ref: #/components/schemas/generic_error
@@ -340,6 +376,37 @@ func (s *InternalServerError) SetMessage(val string) {
 
 func (*InternalServerError) createOrderRes() {}
 
+// Ref: #/components/schemas/list_orders_response
+type ListOrdersResponse struct {
+	// Заказы текущей страницы.
+	Orders []OrderDto `json:"orders"`
+	// Токен следующей страницы, отсутствует на последней
+	// странице.
+	NextPageToken OptString `json:"next_page_token"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *ListOrdersResponse) GetOrders() []OrderDto {
+	return s.Orders
+}
+
+// GetNextPageToken returns the value of NextPageToken.
+func (s *ListOrdersResponse) GetNextPageToken() OptString {
+	return s.NextPageToken
+}
+
+// SetOrders sets the value of Orders.
+func (s *ListOrdersResponse) SetOrders(val []OrderDto) {
+	s.Orders = val
+}
+
+// SetNextPageToken sets the value of NextPageToken.
+func (s *ListOrdersResponse) SetNextPageToken(val OptString) {
+	s.NextPageToken = val
+}
+
+func (*ListOrdersResponse) listOrdersRes() {}
+
 // Ref: #/components/schemas/not_found_error
 type NotFoundError struct {
 	// HTTP-код ошибки.
@@ -370,8 +437,147 @@ func (s *NotFoundError) SetMessage(val string) {
 
 func (*NotFoundError) cancelOrderByUuidRes() {}
 func (*NotFoundError) getOrderByUuidRes()    {}
+func (*NotFoundError) getOrderHistoryRes()   {}
 func (*NotFoundError) payOrderRes()          {}
 
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
 	return OptInt{
@@ -418,6 +624,144 @@ func (o OptInt) Or(d int) int {
 	return d
 }
 
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrderStatus returns new OptOrderStatus with value set to v.
+func NewOptOrderStatus(v OrderStatus) OptOrderStatus {
+	return OptOrderStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderStatus is optional OrderStatus.
+type OptOrderStatus struct {
+	Value OrderStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOrderStatus was set.
+func (o OptOrderStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderStatus) Reset() {
+	var v OrderStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderStatus) SetTo(v OrderStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderStatus) Get() (v OrderStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderStatus) Or(d OrderStatus) OrderStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrdersOrderBy returns new OptOrdersOrderBy with value set to v.
+func NewOptOrdersOrderBy(v OrdersOrderBy) OptOrdersOrderBy {
+	return OptOrdersOrderBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrdersOrderBy is optional OrdersOrderBy.
+type OptOrdersOrderBy struct {
+	Value OrdersOrderBy
+	Set   bool
+}
+
+// IsSet returns true if OptOrdersOrderBy was set.
+func (o OptOrdersOrderBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrdersOrderBy) Reset() {
+	var v OrdersOrderBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrdersOrderBy) SetTo(v OrdersOrderBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrdersOrderBy) Get() (v OrdersOrderBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrdersOrderBy) Or(d OrdersOrderBy) OrdersOrderBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptString returns new OptString with value set to v.
 func NewOptString(v string) OptString {
 	return OptString{
@@ -516,14 +860,20 @@ type OrderDto struct {
 	OrderUUID uuid.UUID `json:"order_uuid"`
 	// Уникальный идентификатор пользователя.
 	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей/товаров в заказе.
+	// Список UUID деталей в заказе без повторов, количество
+	// деталей - в items.
 	PartUuids []uuid.UUID `json:"part_uuids"`
-	// Общая стоимость заказа.
-	TotalPrice float32 `json:"total_price"`
+	// Позиции заказа с количеством и ценой на момент
+	// оформления. Заполняются при получении заказа по UUID.
+	Items []OrderItemDto `json:"items"`
+	// Общая стоимость заказа, точная до копеек (DECIMAL(10,2)).
+	TotalPrice float64 `json:"total_price"`
 	// Уникальный идентификатор транзакции.
 	TransactionUUID OptUUID       `json:"transaction_uuid"`
 	PaymentMethod   PaymentMethod `json:"payment_method"`
 	Status          OrderStatus   `json:"status"`
+	// Дата создания заказа.
+	CreatedAt OptDateTime `json:"created_at"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -541,8 +891,13 @@ func (s *OrderDto) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetItems returns the value of Items.
+func (s *OrderDto) GetItems() []OrderItemDto {
+	return s.Items
+}
+
 // GetTotalPrice returns the value of TotalPrice.
-func (s *OrderDto) GetTotalPrice() float32 {
+func (s *OrderDto) GetTotalPrice() float64 {
 	return s.TotalPrice
 }
 
@@ -561,6 +916,11 @@ func (s *OrderDto) GetStatus() OrderStatus {
 	return s.Status
 }
 
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderDto) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *OrderDto) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
@@ -576,8 +936,13 @@ func (s *OrderDto) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetItems sets the value of Items.
+func (s *OrderDto) SetItems(val []OrderItemDto) {
+	s.Items = val
+}
+
 // SetTotalPrice sets the value of TotalPrice.
-func (s *OrderDto) SetTotalPrice(val float32) {
+func (s *OrderDto) SetTotalPrice(val float64) {
 	s.TotalPrice = val
 }
 
@@ -596,6 +961,123 @@ func (s *OrderDto) SetStatus(val OrderStatus) {
 	s.Status = val
 }
 
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderDto) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/order_history_response
+type OrderHistoryResponse struct {
+	// Уникальный идентификатор заказа.
+	OrderUUID uuid.UUID `json:"order_uuid"`
+	// Смены статусов заказа от самой ранней к последней.
+	History []OrderStatusChangeDto `json:"history"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *OrderHistoryResponse) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetHistory returns the value of History.
+func (s *OrderHistoryResponse) GetHistory() []OrderStatusChangeDto {
+	return s.History
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *OrderHistoryResponse) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetHistory sets the value of History.
+func (s *OrderHistoryResponse) SetHistory(val []OrderStatusChangeDto) {
+	s.History = val
+}
+
+func (*OrderHistoryResponse) getOrderHistoryRes() {}
+
+// Ref: #/components/schemas/order_item_dto
+type OrderItemDto struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей в позиции.
+	Quantity int64 `json:"quantity"`
+	// Цена одной детали на момент оформления заказа.
+	// Отсутствует у позиций, перенесенных из заказов без
+	// снимка цены.
+	UnitPrice OptFloat64 `json:"unit_price"`
+	// Название детали на момент оформления заказа.
+	PartName string `json:"part_name"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItemDto) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItemDto) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderItemDto) GetUnitPrice() OptFloat64 {
+	return s.UnitPrice
+}
+
+// GetPartName returns the value of PartName.
+func (s *OrderItemDto) GetPartName() string {
+	return s.PartName
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItemDto) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItemDto) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderItemDto) SetUnitPrice(val OptFloat64) {
+	s.UnitPrice = val
+}
+
+// SetPartName sets the value of PartName.
+func (s *OrderItemDto) SetPartName(val string) {
+	s.PartName = val
+}
+
+// Ref: #/components/schemas/order_item_request
+type OrderItemRequest struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей в позиции.
+	Quantity int64 `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItemRequest) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItemRequest) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItemRequest) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItemRequest) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
 // Статус заказа.
 // Ref: #/components/schemas/order_status
 type OrderStatus string
@@ -605,6 +1087,10 @@ const (
 	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+	OrderStatusREFUNDPENDING  OrderStatus = "REFUND_PENDING"
+	OrderStatusREFUNDED       OrderStatus = "REFUNDED"
+	OrderStatusEXPIRED        OrderStatus = "EXPIRED"
+	OrderStatusASSEMBLYFAILED OrderStatus = "ASSEMBLY_FAILED"
 )
 
 // AllValues returns all OrderStatus values.
@@ -614,6 +1100,10 @@ func (OrderStatus) AllValues() []OrderStatus {
 		OrderStatusPENDINGPAYMENT,
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
+		OrderStatusREFUNDPENDING,
+		OrderStatusREFUNDED,
+		OrderStatusEXPIRED,
+		OrderStatusASSEMBLYFAILED,
 	}
 }
 
@@ -628,6 +1118,14 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case OrderStatusCANCELLED:
 		return []byte(s), nil
+	case OrderStatusREFUNDPENDING:
+		return []byte(s), nil
+	case OrderStatusREFUNDED:
+		return []byte(s), nil
+	case OrderStatusEXPIRED:
+		return []byte(s), nil
+	case OrderStatusASSEMBLYFAILED:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -648,6 +1146,123 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusCANCELLED:
 		*s = OrderStatusCANCELLED
 		return nil
+	case OrderStatusREFUNDPENDING:
+		*s = OrderStatusREFUNDPENDING
+		return nil
+	case OrderStatusREFUNDED:
+		*s = OrderStatusREFUNDED
+		return nil
+	case OrderStatusEXPIRED:
+		*s = OrderStatusEXPIRED
+		return nil
+	case OrderStatusASSEMBLYFAILED:
+		*s = OrderStatusASSEMBLYFAILED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/order_status_change_dto
+type OrderStatusChangeDto struct {
+	FromStatus OptOrderStatus `json:"from_status"`
+	ToStatus   OrderStatus    `json:"to_status"`
+	// Инициатор смены статуса - user:<uuid>, assembly или system.
+	Actor string `json:"actor"`
+	// Причина смены статуса.
+	Reason string `json:"reason"`
+	// Время смены статуса.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *OrderStatusChangeDto) GetFromStatus() OptOrderStatus {
+	return s.FromStatus
+}
+
+// GetToStatus returns the value of ToStatus.
+func (s *OrderStatusChangeDto) GetToStatus() OrderStatus {
+	return s.ToStatus
+}
+
+// GetActor returns the value of Actor.
+func (s *OrderStatusChangeDto) GetActor() string {
+	return s.Actor
+}
+
+// GetReason returns the value of Reason.
+func (s *OrderStatusChangeDto) GetReason() string {
+	return s.Reason
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderStatusChangeDto) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *OrderStatusChangeDto) SetFromStatus(val OptOrderStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *OrderStatusChangeDto) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
+
+// SetActor sets the value of Actor.
+func (s *OrderStatusChangeDto) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OrderStatusChangeDto) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderStatusChangeDto) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Поле сортировки списка заказов.
+// Ref: #/components/schemas/orders_order_by
+type OrdersOrderBy string
+
+const (
+	OrdersOrderByCREATEDAT  OrdersOrderBy = "CREATED_AT"
+	OrdersOrderByTOTALPRICE OrdersOrderBy = "TOTAL_PRICE"
+)
+
+// AllValues returns all OrdersOrderBy values.
+func (OrdersOrderBy) AllValues() []OrdersOrderBy {
+	return []OrdersOrderBy{
+		OrdersOrderByCREATEDAT,
+		OrdersOrderByTOTALPRICE,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrdersOrderBy) MarshalText() ([]byte, error) {
+	switch s {
+	case OrdersOrderByCREATEDAT:
+		return []byte(s), nil
+	case OrdersOrderByTOTALPRICE:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrdersOrderBy) UnmarshalText(data []byte) error {
+	switch OrdersOrderBy(data) {
+	case OrdersOrderByCREATEDAT:
+		*s = OrdersOrderByCREATEDAT
+		return nil
+	case OrdersOrderByTOTALPRICE:
+		*s = OrdersOrderByTOTALPRICE
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -750,6 +1365,36 @@ func (s *PaymentMethod) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/payment_required_error
+type PaymentRequiredError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *PaymentRequiredError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *PaymentRequiredError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *PaymentRequiredError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *PaymentRequiredError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*PaymentRequiredError) payOrderRes() {}
+
 // Ref: #/components/schemas/rate_limit_error
 type RateLimitError struct {
 	// HTTP-код ошибки.
@@ -781,6 +1426,8 @@ func (s *RateLimitError) SetMessage(val string) {
 func (*RateLimitError) cancelOrderByUuidRes() {}
 func (*RateLimitError) createOrderRes()       {}
 func (*RateLimitError) getOrderByUuidRes()    {}
+func (*RateLimitError) getOrderHistoryRes()   {}
+func (*RateLimitError) listOrdersRes()        {}
 func (*RateLimitError) payOrderRes()          {}
 
 // Ref: #/components/schemas/service_unavailable_error
@@ -850,6 +1497,7 @@ func (s *ServiceUnavailableError) SetTimestamp(val time.Time) {
 func (*ServiceUnavailableError) cancelOrderByUuidRes() {}
 func (*ServiceUnavailableError) createOrderRes()       {}
 func (*ServiceUnavailableError) getOrderByUuidRes()    {}
+func (*ServiceUnavailableError) getOrderHistoryRes()   {}
 func (*ServiceUnavailableError) payOrderRes()          {}
 
 // Ref: #/components/schemas/unauthorized_error
@@ -883,6 +1531,8 @@ func (s *UnauthorizedError) SetMessage(val string) {
 func (*UnauthorizedError) cancelOrderByUuidRes() {}
 func (*UnauthorizedError) createOrderRes()       {}
 func (*UnauthorizedError) getOrderByUuidRes()    {}
+func (*UnauthorizedError) getOrderHistoryRes()   {}
+func (*UnauthorizedError) listOrdersRes()        {}
 func (*UnauthorizedError) payOrderRes()          {}
 
 // Ref: #/components/schemas/validation_error
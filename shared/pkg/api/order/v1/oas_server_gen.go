@@ -10,7 +10,12 @@ import (
 type Handler interface {
 	// CancelOrderByUuid implements cancelOrderByUuid operation.
 	//
-	// Отменяет существующий заказ.
+	// Отменяет существующий заказ. Неоплаченный заказ
+	// отменяется с возвратом деталей на склад,
+	// оплаченный, но еще не собранный заказ - с полным
+	// возвратом средств (статус REFUNDED).
+	// Если возврат не удался, заказ остается в статусе
+	// REFUND_PENDING и отмену можно повторить.
 	//
 	// POST /api/v1/orders/{order_uuid}/cancel
 	CancelOrderByUuid(ctx context.Context, params CancelOrderByUuidParams) (CancelOrderByUuidRes, error)
@@ -19,13 +24,31 @@ type Handler interface {
 	// Создает новый заказ.
 	//
 	// POST /api/v1/orders
-	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (CreateOrderRes, error)
 	// GetOrderByUuid implements getOrderByUuid operation.
 	//
 	// Получает заказ по его UUID.
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrderByUuid(ctx context.Context, params GetOrderByUuidParams) (GetOrderByUuidRes, error)
+	// GetOrderHistory implements getOrderHistory operation.
+	//
+	// Возвращает смены статусов заказа в хронологическом
+	// порядке с инициатором и причиной.
+	//
+	// GET /api/v1/orders/{order_uuid}/history
+	GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (GetOrderHistoryRes, error)
+	// ListOrders implements listOrders operation.
+	//
+	// Возвращает заказы текущего пользователя,
+	// отфильтрованные по статусу, способу оплаты
+	// и дате создания. Выдача разбита на страницы: чтобы
+	// получить следующую,
+	// передайте next_page_token из ответа вместе с теми же
+	// фильтрами и сортировкой.
+	//
+	// GET /api/v1/orders
+	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
 	// PayOrder implements payOrder operation.
 	//
 	// Оплачивает существующий заказ.
@@ -41,18 +64,20 @@ type Handler interface {
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
@@ -15,7 +15,12 @@ var _ Handler = UnimplementedHandler{}
 
 // CancelOrderByUuid implements cancelOrderByUuid operation.
 //
-// Отменяет существующий заказ.
+// Отменяет существующий заказ. Неоплаченный заказ
+// отменяется с возвратом деталей на склад,
+// оплаченный, но еще не собранный заказ - с полным
+// возвратом средств (статус REFUNDED).
+// Если возврат не удался, заказ остается в статусе
+// REFUND_PENDING и отмену можно повторить.
 //
 // POST /api/v1/orders/{order_uuid}/cancel
 func (UnimplementedHandler) CancelOrderByUuid(ctx context.Context, params CancelOrderByUuidParams) (r CancelOrderByUuidRes, _ error) {
@@ -27,7 +32,7 @@ func (UnimplementedHandler) CancelOrderByUuid(ctx context.Context, params Cancel
 // Создает новый заказ.
 //
 // POST /api/v1/orders
-func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest) (r CreateOrderRes, _ error) {
+func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (r CreateOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -40,6 +45,30 @@ func (UnimplementedHandler) GetOrderByUuid(ctx context.Context, params GetOrderB
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderHistory implements getOrderHistory operation.
+//
+// Возвращает смены статусов заказа в хронологическом
+// порядке с инициатором и причиной.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (r GetOrderHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListOrders implements listOrders operation.
+//
+// Возвращает заказы текущего пользователя,
+// отфильтрованные по статусу, способу оплаты
+// и дате создания. Выдача разбита на страницы: чтобы
+// получить следующую,
+// передайте next_page_token из ответа вместе с теми же
+// фильтрами и сортировкой.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements payOrder operation.
 //
 // Оплачивает существующий заказ.
@@ -13,6 +13,11 @@ type ErrorCode int64
 const (
 	NotFoundErrCode ErrorCode = iota
 	InvalidArgumentErrCode
+	FailedPreconditionErrCode
+	PermissionDeniedErrCode
+	PaymentDeclinedErrCode
+	UnavailableErrCode
+	UnauthenticatedErrCode
 )
 
 // businessError represents a structured business error
@@ -51,6 +56,44 @@ func NewInvalidArgumentError(err error) *businessError {
 	}
 }
 
+func NewFailedPreconditionError(err error) *businessError {
+	return &businessError{
+		code: FailedPreconditionErrCode,
+		err:  err,
+	}
+}
+
+func NewPermissionDeniedError(err error) *businessError {
+	return &businessError{
+		code: PermissionDeniedErrCode,
+		err:  err,
+	}
+}
+
+// NewPaymentDeclinedError creates an error for a payment declined by the rules or the provider
+func NewPaymentDeclinedError(err error) *businessError {
+	return &businessError{
+		code: PaymentDeclinedErrCode,
+		err:  err,
+	}
+}
+
+// NewUnavailableError creates an error for a temporarily unavailable dependency, the call may be retried
+func NewUnavailableError(err error) *businessError {
+	return &businessError{
+		code: UnavailableErrCode,
+		err:  err,
+	}
+}
+
+// NewUnauthenticatedError creates an error for a caller without valid credentials
+func NewUnauthenticatedError(err error) *businessError {
+	return &businessError{
+		code: UnauthenticatedErrCode,
+		err:  err,
+	}
+}
+
 // GetBusinessError returns businessError if err is a business error, nil otherwise
 func GetBusinessError(err error) *businessError {
 	var businessErr *businessError
@@ -67,6 +110,14 @@ func errorCodeToGRPCCode(code ErrorCode) codes.Code {
 		return codes.NotFound
 	case InvalidArgumentErrCode:
 		return codes.InvalidArgument
+	case FailedPreconditionErrCode, PaymentDeclinedErrCode:
+		return codes.FailedPrecondition
+	case PermissionDeniedErrCode:
+		return codes.PermissionDenied
+	case UnavailableErrCode:
+		return codes.Unavailable
+	case UnauthenticatedErrCode:
+		return codes.Unauthenticated
 	default:
 		return codes.Unknown
 	}
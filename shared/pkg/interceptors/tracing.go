@@ -0,0 +1,119 @@
+package interceptors
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const tracerName = "github.com/space-wanderer/microservices/shared/pkg/interceptors"
+
+// metadataCarrier адаптирует gRPC metadata к propagation.TextMapCarrier
+type metadataCarrier metadata.MD
+
+var _ propagation.TextMapCarrier = metadataCarrier{}
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// UnaryTracingServerInterceptor извлекает W3C-контекст трассировки из входящих metadata
+// и оборачивает обработку RPC в серверный span
+func UnaryTracingServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if ok {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+		}
+
+		ctx, span := otel.Tracer(tracerName).Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "grpc"),
+				attribute.String("rpc.method", info.FullMethod),
+			),
+		)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		setSpanStatus(span, err)
+
+		return resp, err
+	}
+}
+
+// UnaryTracingClientInterceptor оборачивает исходящий RPC в клиентский span
+// и передаёт W3C-контекст трассировки в исходящих metadata
+func UnaryTracingClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx, span := otel.Tracer(tracerName).Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "grpc"),
+				attribute.String("rpc.method", method),
+				attribute.String("server.address", cc.Target()),
+			),
+		)
+		defer span.End()
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+		ctx = metadata.NewOutgoingContext(ctx, md)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		setSpanStatus(span, err)
+
+		return err
+	}
+}
+
+// setSpanStatus записывает в span gRPC-код завершения и ошибку
+func setSpanStatus(span trace.Span, err error) {
+	st, _ := status.FromError(err)
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", st.Code().String()))
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, st.Message())
+	}
+}
@@ -0,0 +1,637 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: assembly/v1/assembly.proto
+
+package assembly_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AssemblyStatus - статус сборки
+type AssemblyStatus int32
+
+const (
+	AssemblyStatus_ASSEMBLY_STATUS_UNSPECIFIED AssemblyStatus = 0 // Неизвестный статус
+	AssemblyStatus_ASSEMBLY_STATUS_IN_PROGRESS AssemblyStatus = 1 // Сборка идет или ожидает продолжения после перезапуска
+	AssemblyStatus_ASSEMBLY_STATUS_COMPLETED   AssemblyStatus = 2 // Корабль собран
+	AssemblyStatus_ASSEMBLY_STATUS_FAILED      AssemblyStatus = 3 // Сборка не удалась
+)
+
+// Enum value maps for AssemblyStatus.
+var (
+	AssemblyStatus_name = map[int32]string{
+		0: "ASSEMBLY_STATUS_UNSPECIFIED",
+		1: "ASSEMBLY_STATUS_IN_PROGRESS",
+		2: "ASSEMBLY_STATUS_COMPLETED",
+		3: "ASSEMBLY_STATUS_FAILED",
+	}
+	AssemblyStatus_value = map[string]int32{
+		"ASSEMBLY_STATUS_UNSPECIFIED": 0,
+		"ASSEMBLY_STATUS_IN_PROGRESS": 1,
+		"ASSEMBLY_STATUS_COMPLETED":   2,
+		"ASSEMBLY_STATUS_FAILED":      3,
+	}
+)
+
+func (x AssemblyStatus) Enum() *AssemblyStatus {
+	p := new(AssemblyStatus)
+	*p = x
+	return p
+}
+
+func (x AssemblyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssemblyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_assembly_v1_assembly_proto_enumTypes[0].Descriptor()
+}
+
+func (AssemblyStatus) Type() protoreflect.EnumType {
+	return &file_assembly_v1_assembly_proto_enumTypes[0]
+}
+
+func (x AssemblyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssemblyStatus.Descriptor instead.
+func (AssemblyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{0}
+}
+
+// GetAssemblyStatusRequest - запрос состояния сборки по UUID заказа
+type GetAssemblyStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssemblyStatusRequest) Reset() {
+	*x = GetAssemblyStatusRequest{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssemblyStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssemblyStatusRequest) ProtoMessage() {}
+
+func (x *GetAssemblyStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssemblyStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetAssemblyStatusRequest) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAssemblyStatusRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+// GetAssemblyStatusResponse - ответ с состоянием сборки
+type GetAssemblyStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assembly      *Assembly              `protobuf:"bytes,1,opt,name=assembly,proto3" json:"assembly,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssemblyStatusResponse) Reset() {
+	*x = GetAssemblyStatusResponse{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssemblyStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssemblyStatusResponse) ProtoMessage() {}
+
+func (x *GetAssemblyStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssemblyStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetAssemblyStatusResponse) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAssemblyStatusResponse) GetAssembly() *Assembly {
+	if x != nil {
+		return x.Assembly
+	}
+	return nil
+}
+
+// ListAssembliesRequest - запрос на получение списка сборок.
+// Продолжение выдачи запрашивается с next_page_token из предыдущего ответа и тем же filter
+type ListAssembliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *AssembliesFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Размер страницы. 0 — значение по умолчанию (50), максимум 500
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Непрозрачный токен следующей страницы. Пусто — первая страница
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssembliesRequest) Reset() {
+	*x = ListAssembliesRequest{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssembliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssembliesRequest) ProtoMessage() {}
+
+func (x *ListAssembliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssembliesRequest.ProtoReflect.Descriptor instead.
+func (*ListAssembliesRequest) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAssembliesRequest) GetFilter() *AssembliesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListAssembliesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAssembliesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListAssembliesResponse - ответ со списком сборок
+type ListAssembliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assemblies    []*Assembly            `protobuf:"bytes,1,rep,name=assemblies,proto3" json:"assemblies,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Токен следующей страницы. Пусто — страниц больше нет
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssembliesResponse) Reset() {
+	*x = ListAssembliesResponse{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssembliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssembliesResponse) ProtoMessage() {}
+
+func (x *ListAssembliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssembliesResponse.ProtoReflect.Descriptor instead.
+func (*ListAssembliesResponse) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAssembliesResponse) GetAssemblies() []*Assembly {
+	if x != nil {
+		return x.Assemblies
+	}
+	return nil
+}
+
+func (x *ListAssembliesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AssembliesFilter - фильтр списка сборок. Пустые поля не ограничивают выдачу
+type AssembliesFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []AssemblyStatus       `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=assembly.v1.AssemblyStatus" json:"statuses,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssembliesFilter) Reset() {
+	*x = AssembliesFilter{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssembliesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssembliesFilter) ProtoMessage() {}
+
+func (x *AssembliesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssembliesFilter.ProtoReflect.Descriptor instead.
+func (*AssembliesFilter) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AssembliesFilter) GetStatuses() []AssemblyStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *AssembliesFilter) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// Assembly - сборка корабля по заказу
+type Assembly struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid       string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Status          AssemblyStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=assembly.v1.AssemblyStatus" json:"status,omitempty"`
+	Stages          []*AssemblyStage       `protobuf:"bytes,4,rep,name=stages,proto3" json:"stages,omitempty"`                                           // План сборки в порядке выполнения
+	CompletedStages int32                  `protobuf:"varint,5,opt,name=completed_stages,json=completedStages,proto3" json:"completed_stages,omitempty"` // Число завершенных этапов
+	ElapsedMs       int64                  `protobuf:"varint,6,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`                   // Время, затраченное на завершенные этапы
+	FailedStage     string                 `protobuf:"bytes,7,opt,name=failed_stage,json=failedStage,proto3" json:"failed_stage,omitempty"`              // Этап, на котором сборка прервалась
+	FailureReason   string                 `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`        // Причина сбоя
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // Время завершения, не задано для незавершенной сборки
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Assembly) Reset() {
+	*x = Assembly{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assembly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assembly) ProtoMessage() {}
+
+func (x *Assembly) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assembly.ProtoReflect.Descriptor instead.
+func (*Assembly) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Assembly) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Assembly) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Assembly) GetStatus() AssemblyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AssemblyStatus_ASSEMBLY_STATUS_UNSPECIFIED
+}
+
+func (x *Assembly) GetStages() []*AssemblyStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *Assembly) GetCompletedStages() int32 {
+	if x != nil {
+		return x.CompletedStages
+	}
+	return 0
+}
+
+func (x *Assembly) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+func (x *Assembly) GetFailedStage() string {
+	if x != nil {
+		return x.FailedStage
+	}
+	return ""
+}
+
+func (x *Assembly) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Assembly) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Assembly) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Assembly) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// AssemblyStage - этап плана сборки
+type AssemblyStage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stage         string                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`                              // HULL, ENGINES, WINGS, PORTHOLES или FUEL
+	DurationMs    int64                  `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // Плановая длительность этапа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssemblyStage) Reset() {
+	*x = AssemblyStage{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssemblyStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssemblyStage) ProtoMessage() {}
+
+func (x *AssemblyStage) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssemblyStage.ProtoReflect.Descriptor instead.
+func (*AssemblyStage) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssemblyStage) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *AssemblyStage) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+var File_assembly_v1_assembly_proto protoreflect.FileDescriptor
+
+const file_assembly_v1_assembly_proto_rawDesc = "" +
+	"\n" +
+	"\x1aassembly/v1/assembly.proto\x12\vassembly.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"9\n" +
+	"\x18GetAssemblyStatusRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\"N\n" +
+	"\x19GetAssemblyStatusResponse\x121\n" +
+	"\bassembly\x18\x01 \x01(\v2\x15.assembly.v1.AssemblyR\bassembly\"\x8a\x01\n" +
+	"\x15ListAssembliesRequest\x125\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.assembly.v1.AssembliesFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"w\n" +
+	"\x16ListAssembliesResponse\x125\n" +
+	"\n" +
+	"assemblies\x18\x01 \x03(\v2\x15.assembly.v1.AssemblyR\n" +
+	"assemblies\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
+	"\x10AssembliesFilter\x127\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x1b.assembly.v1.AssemblyStatusR\bstatuses\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\"\xf6\x03\n" +
+	"\bAssembly\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.assembly.v1.AssemblyStatusR\x06status\x122\n" +
+	"\x06stages\x18\x04 \x03(\v2\x1a.assembly.v1.AssemblyStageR\x06stages\x12)\n" +
+	"\x10completed_stages\x18\x05 \x01(\x05R\x0fcompletedStages\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\x06 \x01(\x03R\telapsedMs\x12!\n" +
+	"\ffailed_stage\x18\a \x01(\tR\vfailedStage\x12%\n" +
+	"\x0efailure_reason\x18\b \x01(\tR\rfailureReason\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vfinished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"F\n" +
+	"\rAssemblyStage\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\tR\x05stage\x12\x1f\n" +
+	"\vduration_ms\x18\x02 \x01(\x03R\n" +
+	"durationMs*\x8d\x01\n" +
+	"\x0eAssemblyStatus\x12\x1f\n" +
+	"\x1bASSEMBLY_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bASSEMBLY_STATUS_IN_PROGRESS\x10\x01\x12\x1d\n" +
+	"\x19ASSEMBLY_STATUS_COMPLETED\x10\x02\x12\x1a\n" +
+	"\x16ASSEMBLY_STATUS_FAILED\x10\x032\xd0\x01\n" +
+	"\x0fAssemblyService\x12b\n" +
+	"\x11GetAssemblyStatus\x12%.assembly.v1.GetAssemblyStatusRequest\x1a&.assembly.v1.GetAssemblyStatusResponse\x12Y\n" +
+	"\x0eListAssemblies\x12\".assembly.v1.ListAssembliesRequest\x1a#.assembly.v1.ListAssembliesResponseBRZPgithub.com/space-wanderer/microservices/shared/pkg/proto/assembly/v1;assembly_v1b\x06proto3"
+
+var (
+	file_assembly_v1_assembly_proto_rawDescOnce sync.Once
+	file_assembly_v1_assembly_proto_rawDescData []byte
+)
+
+func file_assembly_v1_assembly_proto_rawDescGZIP() []byte {
+	file_assembly_v1_assembly_proto_rawDescOnce.Do(func() {
+		file_assembly_v1_assembly_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_assembly_v1_assembly_proto_rawDesc), len(file_assembly_v1_assembly_proto_rawDesc)))
+	})
+	return file_assembly_v1_assembly_proto_rawDescData
+}
+
+var file_assembly_v1_assembly_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_assembly_v1_assembly_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_assembly_v1_assembly_proto_goTypes = []any{
+	(AssemblyStatus)(0),               // 0: assembly.v1.AssemblyStatus
+	(*GetAssemblyStatusRequest)(nil),  // 1: assembly.v1.GetAssemblyStatusRequest
+	(*GetAssemblyStatusResponse)(nil), // 2: assembly.v1.GetAssemblyStatusResponse
+	(*ListAssembliesRequest)(nil),     // 3: assembly.v1.ListAssembliesRequest
+	(*ListAssembliesResponse)(nil),    // 4: assembly.v1.ListAssembliesResponse
+	(*AssembliesFilter)(nil),          // 5: assembly.v1.AssembliesFilter
+	(*Assembly)(nil),                  // 6: assembly.v1.Assembly
+	(*AssemblyStage)(nil),             // 7: assembly.v1.AssemblyStage
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+}
+var file_assembly_v1_assembly_proto_depIdxs = []int32{
+	6,  // 0: assembly.v1.GetAssemblyStatusResponse.assembly:type_name -> assembly.v1.Assembly
+	5,  // 1: assembly.v1.ListAssembliesRequest.filter:type_name -> assembly.v1.AssembliesFilter
+	6,  // 2: assembly.v1.ListAssembliesResponse.assemblies:type_name -> assembly.v1.Assembly
+	0,  // 3: assembly.v1.AssembliesFilter.statuses:type_name -> assembly.v1.AssemblyStatus
+	0,  // 4: assembly.v1.Assembly.status:type_name -> assembly.v1.AssemblyStatus
+	7,  // 5: assembly.v1.Assembly.stages:type_name -> assembly.v1.AssemblyStage
+	8,  // 6: assembly.v1.Assembly.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 7: assembly.v1.Assembly.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 8: assembly.v1.Assembly.finished_at:type_name -> google.protobuf.Timestamp
+	1,  // 9: assembly.v1.AssemblyService.GetAssemblyStatus:input_type -> assembly.v1.GetAssemblyStatusRequest
+	3,  // 10: assembly.v1.AssemblyService.ListAssemblies:input_type -> assembly.v1.ListAssembliesRequest
+	2,  // 11: assembly.v1.AssemblyService.GetAssemblyStatus:output_type -> assembly.v1.GetAssemblyStatusResponse
+	4,  // 12: assembly.v1.AssemblyService.ListAssemblies:output_type -> assembly.v1.ListAssembliesResponse
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_assembly_v1_assembly_proto_init() }
+func file_assembly_v1_assembly_proto_init() {
+	if File_assembly_v1_assembly_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_assembly_v1_assembly_proto_rawDesc), len(file_assembly_v1_assembly_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_assembly_v1_assembly_proto_goTypes,
+		DependencyIndexes: file_assembly_v1_assembly_proto_depIdxs,
+		EnumInfos:         file_assembly_v1_assembly_proto_enumTypes,
+		MessageInfos:      file_assembly_v1_assembly_proto_msgTypes,
+	}.Build()
+	File_assembly_v1_assembly_proto = out.File
+	file_assembly_v1_assembly_proto_goTypes = nil
+	file_assembly_v1_assembly_proto_depIdxs = nil
+}
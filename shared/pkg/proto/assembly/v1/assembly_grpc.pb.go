@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: assembly/v1/assembly.proto
+
+package assembly_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AssemblyService_GetAssemblyStatus_FullMethodName = "/assembly.v1.AssemblyService/GetAssemblyStatus"
+	AssemblyService_ListAssemblies_FullMethodName    = "/assembly.v1.AssemblyService/ListAssemblies"
+)
+
+// AssemblyServiceClient is the client API for AssemblyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AssemblyServiceClient interface {
+	// GetAssemblyStatus - получить состояние сборки корабля по заказу
+	GetAssemblyStatus(ctx context.Context, in *GetAssemblyStatusRequest, opts ...grpc.CallOption) (*GetAssemblyStatusResponse, error)
+	// ListAssemblies - получить список сборок с фильтрацией, от новых к старым
+	ListAssemblies(ctx context.Context, in *ListAssembliesRequest, opts ...grpc.CallOption) (*ListAssembliesResponse, error)
+}
+
+type assemblyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssemblyServiceClient(cc grpc.ClientConnInterface) AssemblyServiceClient {
+	return &assemblyServiceClient{cc}
+}
+
+func (c *assemblyServiceClient) GetAssemblyStatus(ctx context.Context, in *GetAssemblyStatusRequest, opts ...grpc.CallOption) (*GetAssemblyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssemblyStatusResponse)
+	err := c.cc.Invoke(ctx, AssemblyService_GetAssemblyStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assemblyServiceClient) ListAssemblies(ctx context.Context, in *ListAssembliesRequest, opts ...grpc.CallOption) (*ListAssembliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssembliesResponse)
+	err := c.cc.Invoke(ctx, AssemblyService_ListAssemblies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssemblyServiceServer is the server API for AssemblyService service.
+// All implementations must embed UnimplementedAssemblyServiceServer
+// for forward compatibility.
+type AssemblyServiceServer interface {
+	// GetAssemblyStatus - получить состояние сборки корабля по заказу
+	GetAssemblyStatus(context.Context, *GetAssemblyStatusRequest) (*GetAssemblyStatusResponse, error)
+	// ListAssemblies - получить список сборок с фильтрацией, от новых к старым
+	ListAssemblies(context.Context, *ListAssembliesRequest) (*ListAssembliesResponse, error)
+	mustEmbedUnimplementedAssemblyServiceServer()
+}
+
+// UnimplementedAssemblyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssemblyServiceServer struct{}
+
+func (UnimplementedAssemblyServiceServer) GetAssemblyStatus(context.Context, *GetAssemblyStatusRequest) (*GetAssemblyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssemblyStatus not implemented")
+}
+func (UnimplementedAssemblyServiceServer) ListAssemblies(context.Context, *ListAssembliesRequest) (*ListAssembliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssemblies not implemented")
+}
+func (UnimplementedAssemblyServiceServer) mustEmbedUnimplementedAssemblyServiceServer() {}
+func (UnimplementedAssemblyServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeAssemblyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssemblyServiceServer will
+// result in compilation errors.
+type UnsafeAssemblyServiceServer interface {
+	mustEmbedUnimplementedAssemblyServiceServer()
+}
+
+func RegisterAssemblyServiceServer(s grpc.ServiceRegistrar, srv AssemblyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAssemblyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssemblyService_ServiceDesc, srv)
+}
+
+func _AssemblyService_GetAssemblyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssemblyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssemblyServiceServer).GetAssemblyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssemblyService_GetAssemblyStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssemblyServiceServer).GetAssemblyStatus(ctx, req.(*GetAssemblyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssemblyService_ListAssemblies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssembliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssemblyServiceServer).ListAssemblies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssemblyService_ListAssemblies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssemblyServiceServer).ListAssemblies(ctx, req.(*ListAssembliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssemblyService_ServiceDesc is the grpc.ServiceDesc for AssemblyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssemblyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "assembly.v1.AssemblyService",
+	HandlerType: (*AssemblyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAssemblyStatus",
+			Handler:    _AssemblyService_GetAssemblyStatus_Handler,
+		},
+		{
+			MethodName: "ListAssemblies",
+			Handler:    _AssemblyService_ListAssemblies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "assembly/v1/assembly.proto",
+}
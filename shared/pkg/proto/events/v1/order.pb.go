@@ -29,6 +29,7 @@ type OrderPaidEvent struct {
 	UserUuid        string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
 	PaymentMethod   string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
 	TransactionUuid string                 `protobuf:"bytes,5,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Items           []*OrderItem           `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"` // Детали заказа, из которых собирается корабль
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -98,6 +99,66 @@ func (x *OrderPaidEvent) GetTransactionUuid() string {
 	return ""
 }
 
+func (x *OrderPaidEvent) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// OrderItem - деталь заказа и ее количество
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_events_v1_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 // ShipAssembledEvent - событие сборки корабля
 type ShipAssembledEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -111,7 +172,7 @@ type ShipAssembledEvent struct {
 
 func (x *ShipAssembledEvent) Reset() {
 	*x = ShipAssembledEvent{}
-	mi := &file_events_v1_order_proto_msgTypes[1]
+	mi := &file_events_v1_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -123,7 +184,7 @@ func (x *ShipAssembledEvent) String() string {
 func (*ShipAssembledEvent) ProtoMessage() {}
 
 func (x *ShipAssembledEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_v1_order_proto_msgTypes[1]
+	mi := &file_events_v1_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -136,7 +197,7 @@ func (x *ShipAssembledEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShipAssembledEvent.ProtoReflect.Descriptor instead.
 func (*ShipAssembledEvent) Descriptor() ([]byte, []int) {
-	return file_events_v1_order_proto_rawDescGZIP(), []int{1}
+	return file_events_v1_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ShipAssembledEvent) GetEventUuid() string {
@@ -167,11 +228,343 @@ func (x *ShipAssembledEvent) GetBuildTimeSec() int64 {
 	return 0
 }
 
+// AssemblyProgressEvent - событие завершения этапа сборки корабля
+type AssemblyProgressEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid       string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Stage           string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`                                               // Название завершенного этапа: HULL, ENGINES, WINGS, PORTHOLES, FUEL
+	StageNumber     int32                  `protobuf:"varint,5,opt,name=stage_number,json=stageNumber,proto3" json:"stage_number,omitempty"`               // Номер этапа, начиная с 1
+	StageCount      int32                  `protobuf:"varint,6,opt,name=stage_count,json=stageCount,proto3" json:"stage_count,omitempty"`                  // Всего этапов в плане сборки
+	StageDurationMs int64                  `protobuf:"varint,7,opt,name=stage_duration_ms,json=stageDurationMs,proto3" json:"stage_duration_ms,omitempty"` // Длительность этапа
+	ElapsedMs       int64                  `protobuf:"varint,8,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`                     // Время от начала сборки
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssemblyProgressEvent) Reset() {
+	*x = AssemblyProgressEvent{}
+	mi := &file_events_v1_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssemblyProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssemblyProgressEvent) ProtoMessage() {}
+
+func (x *AssemblyProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssemblyProgressEvent.ProtoReflect.Descriptor instead.
+func (*AssemblyProgressEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AssemblyProgressEvent) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *AssemblyProgressEvent) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *AssemblyProgressEvent) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AssemblyProgressEvent) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *AssemblyProgressEvent) GetStageNumber() int32 {
+	if x != nil {
+		return x.StageNumber
+	}
+	return 0
+}
+
+func (x *AssemblyProgressEvent) GetStageCount() int32 {
+	if x != nil {
+		return x.StageCount
+	}
+	return 0
+}
+
+func (x *AssemblyProgressEvent) GetStageDurationMs() int64 {
+	if x != nil {
+		return x.StageDurationMs
+	}
+	return 0
+}
+
+func (x *AssemblyProgressEvent) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+// ShipAssemblyFailedEvent - событие неудачной сборки корабля
+type ShipAssemblyFailedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Stage         string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`   // Этап, на котором сборка прервалась
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // Причина сбоя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipAssemblyFailedEvent) Reset() {
+	*x = ShipAssemblyFailedEvent{}
+	mi := &file_events_v1_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipAssemblyFailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipAssemblyFailedEvent) ProtoMessage() {}
+
+func (x *ShipAssemblyFailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipAssemblyFailedEvent.ProtoReflect.Descriptor instead.
+func (*ShipAssemblyFailedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShipAssemblyFailedEvent) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailedEvent) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailedEvent) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailedEvent) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *ShipAssemblyFailedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// OrderRefundedEvent - событие возврата средств за отмененный заказ
+type OrderRefundedEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid       string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,4,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID исходной транзакции оплаты
+	RefundUuid      string                 `protobuf:"bytes,5,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	AmountMinor     int64                  `protobuf:"varint,7,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"` // Возвращенная сумма в копейках
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderRefundedEvent) Reset() {
+	*x = OrderRefundedEvent{}
+	mi := &file_events_v1_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderRefundedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderRefundedEvent) ProtoMessage() {}
+
+func (x *OrderRefundedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderRefundedEvent.ProtoReflect.Descriptor instead.
+func (*OrderRefundedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderRefundedEvent) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderRefundedEvent) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderRefundedEvent) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderRefundedEvent) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *OrderRefundedEvent) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+func (x *OrderRefundedEvent) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+// OrderExpiredEvent - событие истечения срока оплаты заказа
+type OrderExpiredEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid        string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid        string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid         string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentWindowSec int64                  `protobuf:"varint,4,opt,name=payment_window_sec,json=paymentWindowSec,proto3" json:"payment_window_sec,omitempty"` // Срок оплаты, по истечении которого заказ снят
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrderExpiredEvent) Reset() {
+	*x = OrderExpiredEvent{}
+	mi := &file_events_v1_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderExpiredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderExpiredEvent) ProtoMessage() {}
+
+func (x *OrderExpiredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderExpiredEvent.ProtoReflect.Descriptor instead.
+func (*OrderExpiredEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderExpiredEvent) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderExpiredEvent) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderExpiredEvent) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderExpiredEvent) GetPaymentWindowSec() int64 {
+	if x != nil {
+		return x.PaymentWindowSec
+	}
+	return 0
+}
+
 var File_events_v1_order_proto protoreflect.FileDescriptor
 
 const file_events_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x15events/v1/order.proto\x12\tevents.v1\"\xbd\x01\n" +
+	"\x15events/v1/order.proto\x12\tevents.v1\"\xe9\x01\n" +
 	"\x0eOrderPaidEvent\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
@@ -179,14 +572,56 @@ const file_events_v1_order_proto_rawDesc = "" +
 	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12%\n" +
 	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12)\n" +
-	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\"\x95\x01\n" +
+	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\x12*\n" +
+	"\x05items\x18\x06 \x03(\v2\x14.events.v1.OrderItemR\x05items\"D\n" +
+	"\tOrderItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\x95\x01\n" +
 	"\x12ShipAssembledEvent\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
 	"\n" +
 	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12$\n" +
-	"\x0ebuild_time_sec\x18\x04 \x01(\x03R\fbuildTimeSecBNZLgithub.com/space-wanderer/microservices/shared/pkg/proto/events/v1;events_v1b\x06proto3"
+	"\x0ebuild_time_sec\x18\x04 \x01(\x03R\fbuildTimeSec\"\x97\x02\n" +
+	"\x15AssemblyProgressEvent\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\tR\x05stage\x12!\n" +
+	"\fstage_number\x18\x05 \x01(\x05R\vstageNumber\x12\x1f\n" +
+	"\vstage_count\x18\x06 \x01(\x05R\n" +
+	"stageCount\x12*\n" +
+	"\x11stage_duration_ms\x18\a \x01(\x03R\x0fstageDurationMs\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\b \x01(\x03R\telapsedMs\"\xa2\x01\n" +
+	"\x17ShipAssemblyFailedEvent\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\tR\x05stage\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xde\x01\n" +
+	"\x12OrderRefundedEvent\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x04 \x01(\tR\x0ftransactionUuid\x12\x1f\n" +
+	"\vrefund_uuid\x18\x05 \x01(\tR\n" +
+	"refundUuid\x12!\n" +
+	"\famount_minor\x18\a \x01(\x03R\vamountMinor\"\x9c\x01\n" +
+	"\x11OrderExpiredEvent\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12,\n" +
+	"\x12payment_window_sec\x18\x04 \x01(\x03R\x10paymentWindowSecBNZLgithub.com/space-wanderer/microservices/shared/pkg/proto/events/v1;events_v1b\x06proto3"
 
 var (
 	file_events_v1_order_proto_rawDescOnce sync.Once
@@ -200,17 +635,23 @@ func file_events_v1_order_proto_rawDescGZIP() []byte {
 	return file_events_v1_order_proto_rawDescData
 }
 
-var file_events_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_events_v1_order_proto_goTypes = []any{
-	(*OrderPaidEvent)(nil),     // 0: events.v1.OrderPaidEvent
-	(*ShipAssembledEvent)(nil), // 1: events.v1.ShipAssembledEvent
+	(*OrderPaidEvent)(nil),          // 0: events.v1.OrderPaidEvent
+	(*OrderItem)(nil),               // 1: events.v1.OrderItem
+	(*ShipAssembledEvent)(nil),      // 2: events.v1.ShipAssembledEvent
+	(*AssemblyProgressEvent)(nil),   // 3: events.v1.AssemblyProgressEvent
+	(*ShipAssemblyFailedEvent)(nil), // 4: events.v1.ShipAssemblyFailedEvent
+	(*OrderRefundedEvent)(nil),      // 5: events.v1.OrderRefundedEvent
+	(*OrderExpiredEvent)(nil),       // 6: events.v1.OrderExpiredEvent
 }
 var file_events_v1_order_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: events.v1.OrderPaidEvent.items:type_name -> events.v1.OrderItem
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_events_v1_order_proto_init() }
@@ -224,7 +665,7 @@ func file_events_v1_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_order_proto_rawDesc), len(file_events_v1_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,112 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PartsOrderBy - поле сортировки списка деталей
+type PartsOrderBy int32
+
+const (
+	PartsOrderBy_PARTS_ORDER_BY_UNSPECIFIED PartsOrderBy = 0 // По умолчанию — по дате создания
+	PartsOrderBy_PARTS_ORDER_BY_PRICE       PartsOrderBy = 1 // По цене
+	PartsOrderBy_PARTS_ORDER_BY_NAME        PartsOrderBy = 2 // По названию
+	PartsOrderBy_PARTS_ORDER_BY_CREATED_AT  PartsOrderBy = 3 // По дате создания
+)
+
+// Enum value maps for PartsOrderBy.
+var (
+	PartsOrderBy_name = map[int32]string{
+		0: "PARTS_ORDER_BY_UNSPECIFIED",
+		1: "PARTS_ORDER_BY_PRICE",
+		2: "PARTS_ORDER_BY_NAME",
+		3: "PARTS_ORDER_BY_CREATED_AT",
+	}
+	PartsOrderBy_value = map[string]int32{
+		"PARTS_ORDER_BY_UNSPECIFIED": 0,
+		"PARTS_ORDER_BY_PRICE":       1,
+		"PARTS_ORDER_BY_NAME":        2,
+		"PARTS_ORDER_BY_CREATED_AT":  3,
+	}
+)
+
+func (x PartsOrderBy) Enum() *PartsOrderBy {
+	p := new(PartsOrderBy)
+	*p = x
+	return p
+}
+
+func (x PartsOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartsOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (PartsOrderBy) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x PartsOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartsOrderBy.Descriptor instead.
+func (PartsOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// ReservationStatus - статус резерва
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0 // Неизвестный статус
+	ReservationStatus_RESERVATION_STATUS_RESERVED    ReservationStatus = 1 // Детали зарезервированы
+	ReservationStatus_RESERVATION_STATUS_COMMITTED   ReservationStatus = 2 // Резерв подтвержден оплатой
+	ReservationStatus_RESERVATION_STATUS_RELEASED    ReservationStatus = 3 // Резерв снят, детали возвращены на склад
+)
+
+// Enum value maps for ReservationStatus.
+var (
+	ReservationStatus_name = map[int32]string{
+		0: "RESERVATION_STATUS_UNSPECIFIED",
+		1: "RESERVATION_STATUS_RESERVED",
+		2: "RESERVATION_STATUS_COMMITTED",
+		3: "RESERVATION_STATUS_RELEASED",
+	}
+	ReservationStatus_value = map[string]int32{
+		"RESERVATION_STATUS_UNSPECIFIED": 0,
+		"RESERVATION_STATUS_RESERVED":    1,
+		"RESERVATION_STATUS_COMMITTED":   2,
+		"RESERVATION_STATUS_RELEASED":    3,
+	}
+)
+
+func (x ReservationStatus) Enum() *ReservationStatus {
+	p := new(ReservationStatus)
+	*p = x
+	return p
+}
+
+func (x ReservationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (ReservationStatus) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x ReservationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationStatus.Descriptor instead.
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
 // Category - Категории
 type Category int32
 
@@ -62,11 +168,11 @@ func (x Category) String() string {
 }
 
 func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
 }
 
 func (Category) Type() protoreflect.EnumType {
-	return &file_inventory_v1_inventory_proto_enumTypes[0]
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
 }
 
 func (x Category) Number() protoreflect.EnumNumber {
@@ -75,7 +181,119 @@ func (x Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Category.Descriptor instead.
 func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// TagsMatch - режим сравнения тегов
+type TagsMatch int32
+
+const (
+	TagsMatch_TAGS_MATCH_UNSPECIFIED TagsMatch = 0 // По умолчанию — любой из тегов
+	TagsMatch_TAGS_MATCH_ANY         TagsMatch = 1 // Деталь содержит хотя бы один из тегов
+	TagsMatch_TAGS_MATCH_ALL         TagsMatch = 2 // Деталь содержит все теги
+)
+
+// Enum value maps for TagsMatch.
+var (
+	TagsMatch_name = map[int32]string{
+		0: "TAGS_MATCH_UNSPECIFIED",
+		1: "TAGS_MATCH_ANY",
+		2: "TAGS_MATCH_ALL",
+	}
+	TagsMatch_value = map[string]int32{
+		"TAGS_MATCH_UNSPECIFIED": 0,
+		"TAGS_MATCH_ANY":         1,
+		"TAGS_MATCH_ALL":         2,
+	}
+)
+
+func (x TagsMatch) Enum() *TagsMatch {
+	p := new(TagsMatch)
+	*p = x
+	return p
+}
+
+func (x TagsMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagsMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (TagsMatch) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[3]
+}
+
+func (x TagsMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagsMatch.Descriptor instead.
+func (TagsMatch) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+// ComparisonOperator - оператор сравнения
+type ComparisonOperator int32
+
+const (
+	ComparisonOperator_COMPARISON_OPERATOR_UNSPECIFIED ComparisonOperator = 0 // Не указан — условие отклоняется
+	ComparisonOperator_COMPARISON_OPERATOR_EQ          ComparisonOperator = 1 // Равно
+	ComparisonOperator_COMPARISON_OPERATOR_NE          ComparisonOperator = 2 // Не равно (в том числе ключ отсутствует)
+	ComparisonOperator_COMPARISON_OPERATOR_GT          ComparisonOperator = 3 // Больше
+	ComparisonOperator_COMPARISON_OPERATOR_GTE         ComparisonOperator = 4 // Больше или равно
+	ComparisonOperator_COMPARISON_OPERATOR_LT          ComparisonOperator = 5 // Меньше
+	ComparisonOperator_COMPARISON_OPERATOR_LTE         ComparisonOperator = 6 // Меньше или равно
+)
+
+// Enum value maps for ComparisonOperator.
+var (
+	ComparisonOperator_name = map[int32]string{
+		0: "COMPARISON_OPERATOR_UNSPECIFIED",
+		1: "COMPARISON_OPERATOR_EQ",
+		2: "COMPARISON_OPERATOR_NE",
+		3: "COMPARISON_OPERATOR_GT",
+		4: "COMPARISON_OPERATOR_GTE",
+		5: "COMPARISON_OPERATOR_LT",
+		6: "COMPARISON_OPERATOR_LTE",
+	}
+	ComparisonOperator_value = map[string]int32{
+		"COMPARISON_OPERATOR_UNSPECIFIED": 0,
+		"COMPARISON_OPERATOR_EQ":          1,
+		"COMPARISON_OPERATOR_NE":          2,
+		"COMPARISON_OPERATOR_GT":          3,
+		"COMPARISON_OPERATOR_GTE":         4,
+		"COMPARISON_OPERATOR_LT":          5,
+		"COMPARISON_OPERATOR_LTE":         6,
+	}
+)
+
+func (x ComparisonOperator) Enum() *ComparisonOperator {
+	p := new(ComparisonOperator)
+	*p = x
+	return p
+}
+
+func (x ComparisonOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComparisonOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (ComparisonOperator) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[4]
+}
+
+func (x ComparisonOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComparisonOperator.Descriptor instead.
+func (ComparisonOperator) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
 }
 
 // GetPartRequest - получение детали по UUID
@@ -168,10 +386,16 @@ func (x *GetPartResponse) GetPart() *Part {
 	return nil
 }
 
-// ListPartsRequest - запрос на получение списка деталей
+// ListPartsRequest - запрос на получение списка деталей.
+// Продолжение выдачи запрашивается с next_page_token из предыдущего ответа
+// и теми же filter, order_by и descending
 type ListPartsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                             // Размер страницы. 0 — значение по умолчанию (50), максимум 500
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                           // Непрозрачный токен следующей страницы. Пусто — первая страница
+	OrderBy       PartsOrderBy           `protobuf:"varint,4,opt,name=order_by,json=orderBy,proto3,enum=inventory.v1.PartsOrderBy" json:"order_by,omitempty"` // Поле сортировки. Не указано — по дате создания
+	Descending    bool                   `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`                                         // Сортировка по убыванию
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -213,10 +437,39 @@ func (x *ListPartsRequest) GetFilter() *PartsFilter {
 	return nil
 }
 
+func (x *ListPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetOrderBy() PartsOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return PartsOrderBy_PARTS_ORDER_BY_UNSPECIFIED
+}
+
+func (x *ListPartsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
 // ListPartsResponse - ответ со списком деталей
 type ListPartsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Токен следующей страницы. Пусто — страниц больше нет
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -232,10 +485,794 @@ func (x *ListPartsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsResponse) ProtoMessage() {}
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CreatePartRequest - запрос на создание детали
+type CreatePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *PartInfo              `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePartRequest) GetInfo() *PartInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// CreatePartResponse - ответ с созданной деталью
+type CreatePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// UpdatePartRequest - запрос на обновление детали.
+// version должна совпадать с текущей версией детали, иначе обновление отклоняется
+type UpdatePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Info          *PartInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdatePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdatePartRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdatePartRequest) GetInfo() *PartInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// UpdatePartResponse - ответ с обновленной деталью
+type UpdatePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// DeletePartRequest - запрос на удаление детали.
+// version должна совпадать с текущей версией детали, иначе удаление отклоняется
+type DeletePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartRequest) Reset() {
+	*x = DeletePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartRequest) ProtoMessage() {}
+
+func (x *DeletePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeletePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DeletePartRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// DeletePartResponse - ответ на удаление детали
+type DeletePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartResponse) Reset() {
+	*x = DeletePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartResponse) ProtoMessage() {}
+
+func (x *DeletePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+// PartInfo - редактируемые поля детали
+type PartInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	StockQuantity int64                  `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Category      Category               `protobuf:"varint,5,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	Dimensions    *Dimensions            `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	Manufacturer  *Manufacturer          `protobuf:"bytes,7,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]*Value      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartInfo) Reset() {
+	*x = PartInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartInfo) ProtoMessage() {}
+
+func (x *PartInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartInfo.ProtoReflect.Descriptor instead.
+func (*PartInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PartInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PartInfo) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PartInfo) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *PartInfo) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *PartInfo) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartInfo) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *PartInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartInfo) GetMetadata() map[string]*Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// ReservePartsRequest - запрос на резервирование деталей под заказ
+type ReservePartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservePartsRequest) Reset() {
+	*x = ReservePartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePartsRequest) ProtoMessage() {}
+
+func (x *ReservePartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePartsRequest.ProtoReflect.Descriptor instead.
+func (*ReservePartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReservePartsRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *ReservePartsRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ReservePartsResponse - ответ с созданным резервом
+type ReservePartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservePartsResponse) Reset() {
+	*x = ReservePartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePartsResponse) ProtoMessage() {}
+
+func (x *ReservePartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePartsResponse.ProtoReflect.Descriptor instead.
+func (*ReservePartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReservePartsResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// CommitReservationRequest - запрос на подтверждение резерва
+type CommitReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CommitReservationRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+// CommitReservationResponse - ответ с подтвержденным резервом
+type CommitReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationResponse) Reset() {
+	*x = CommitReservationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationResponse) ProtoMessage() {}
+
+func (x *CommitReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationResponse.ProtoReflect.Descriptor instead.
+func (*CommitReservationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CommitReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// ReleaseReservationRequest - запрос на снятие резерва
+type ReleaseReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReleaseReservationRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+// ReleaseReservationResponse - ответ со снятым резервом
+type ReleaseReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReleaseReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// ReservationItem - позиция резерва
+type ReservationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"` // UUID детали
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                // Количество
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationItem) ProtoMessage() {}
+
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReservationItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Reservation - резерв деталей под заказ
+type Reservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Status        ReservationStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=inventory.v1.ReservationStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,14 +1283,42 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
+func (x *Reservation) GetOrderUuid() string {
 	if x != nil {
-		return x.Parts
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Reservation) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Reservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
@@ -273,13 +1338,14 @@ type Part struct {
 	Metadata      map[string]*Value      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"` // Версия для оптимистичной блокировки, растет при каждом изменении
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Part) Reset() {
 	*x = Part{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +1357,7 @@ func (x *Part) String() string {
 func (*Part) ProtoMessage() {}
 
 func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +1370,7 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Part.ProtoReflect.Descriptor instead.
 func (*Part) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Part) GetUuid() string {
@@ -391,6 +1457,13 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Dimensions - размеры и вес деталией
 type Dimensions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -404,7 +1477,7 @@ type Dimensions struct {
 
 func (x *Dimensions) Reset() {
 	*x = Dimensions{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -416,7 +1489,7 @@ func (x *Dimensions) String() string {
 func (*Dimensions) ProtoMessage() {}
 
 func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,7 +1502,7 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
 func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Dimensions) GetLength() float64 {
@@ -472,7 +1545,7 @@ type Manufacturer struct {
 
 func (x *Manufacturer) Reset() {
 	*x = Manufacturer{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -484,7 +1557,7 @@ func (x *Manufacturer) String() string {
 func (*Manufacturer) ProtoMessage() {}
 
 func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +1570,7 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
 func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Manufacturer) GetName() string {
@@ -537,7 +1610,7 @@ type Value struct {
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -549,7 +1622,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +1635,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Value) GetValue() isValue_Value {
@@ -644,13 +1717,19 @@ type PartsFilter struct {
 	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`                 // Список категорий. Пусто — не фильтруем по категории
 	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"` // Список стран производителей. Пусто — не фильтруем по стране
 	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                                                // Список тегов. Пусто — не фильтруем по тегам
+	Price                 *DoubleRange           `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`                                                              // Диапазон цены. Не задан — не фильтруем по цене
+	StockQuantity         *Int64Range            `protobuf:"bytes,7,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`                         // Диапазон остатка на складе
+	Dimensions            *DimensionsFilter      `protobuf:"bytes,8,opt,name=dimensions,proto3" json:"dimensions,omitempty"`                                                    // Диапазоны размеров и веса
+	TagsMatch             TagsMatch              `protobuf:"varint,9,opt,name=tags_match,json=tagsMatch,proto3,enum=inventory.v1.TagsMatch" json:"tags_match,omitempty"`        // Режим сравнения тегов. Не указан — любой из тегов
+	Metadata              []*MetadataPredicate   `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`                                                       // Условия на метаданные, должны выполняться все
+	Text                  string                 `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`                                                               // Полнотекстовый поиск по названию и описанию
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *PartsFilter) Reset() {
 	*x = PartsFilter{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +1741,7 @@ func (x *PartsFilter) String() string {
 func (*PartsFilter) ProtoMessage() {}
 
 func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +1754,7 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
 func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PartsFilter) GetUuids() []string {
@@ -713,6 +1792,288 @@ func (x *PartsFilter) GetTags() []string {
 	return nil
 }
 
+func (x *PartsFilter) GetPrice() *DoubleRange {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetStockQuantity() *Int64Range {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetDimensions() *DimensionsFilter {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetTagsMatch() TagsMatch {
+	if x != nil {
+		return x.TagsMatch
+	}
+	return TagsMatch_TAGS_MATCH_UNSPECIFIED
+}
+
+func (x *PartsFilter) GetMetadata() []*MetadataPredicate {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// DoubleRange - диапазон дробных значений, границы включаются.
+// Не заданная граница не ограничивает диапазон
+type DoubleRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleRange) Reset() {
+	*x = DoubleRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRange) ProtoMessage() {}
+
+func (x *DoubleRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRange.ProtoReflect.Descriptor instead.
+func (*DoubleRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DoubleRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *DoubleRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Int64Range - диапазон целых значений, границы включаются.
+// Не заданная граница не ограничивает диапазон
+type Int64Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *int64                 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *int64                 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64Range) Reset() {
+	*x = Int64Range{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Range) ProtoMessage() {}
+
+func (x *Int64Range) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64Range.ProtoReflect.Descriptor instead.
+func (*Int64Range) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Int64Range) GetMin() int64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Int64Range) GetMax() int64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// DimensionsFilter - диапазоны размеров и веса детали
+type DimensionsFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        *DoubleRange           `protobuf:"bytes,1,opt,name=length,proto3" json:"length,omitempty"` // Длина в см
+	Width         *DoubleRange           `protobuf:"bytes,2,opt,name=width,proto3" json:"width,omitempty"`   // Ширина в см
+	Height        *DoubleRange           `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"` // Высота в см
+	Weight        *DoubleRange           `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"` // Вес в кг
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DimensionsFilter) Reset() {
+	*x = DimensionsFilter{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DimensionsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DimensionsFilter) ProtoMessage() {}
+
+func (x *DimensionsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DimensionsFilter.ProtoReflect.Descriptor instead.
+func (*DimensionsFilter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DimensionsFilter) GetLength() *DoubleRange {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *DimensionsFilter) GetWidth() *DoubleRange {
+	if x != nil {
+		return x.Width
+	}
+	return nil
+}
+
+func (x *DimensionsFilter) GetHeight() *DoubleRange {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *DimensionsFilter) GetWeight() *DoubleRange {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+// MetadataPredicate - условие на значение метаданных по ключу.
+// Числовые значения сравниваются независимо от того, хранятся они как int64 или double;
+// для логических значений допустимы только EQ и NE
+type MetadataPredicate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator      ComparisonOperator     `protobuf:"varint,2,opt,name=operator,proto3,enum=inventory.v1.ComparisonOperator" json:"operator,omitempty"`
+	Value         *Value                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataPredicate) Reset() {
+	*x = MetadataPredicate{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataPredicate) ProtoMessage() {}
+
+func (x *MetadataPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataPredicate.ProtoReflect.Descriptor instead.
+func (*MetadataPredicate) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MetadataPredicate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataPredicate) GetOperator() ComparisonOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return ComparisonOperator_COMPARISON_OPERATOR_UNSPECIFIED
+}
+
+func (x *MetadataPredicate) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_v1_inventory_proto_rawDesc = "" +
@@ -721,11 +2082,76 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x0eGetPartRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xd8\x01\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x125\n" +
+	"\border_by\x18\x04 \x01(\x0e2\x1a.inventory.v1.PartsOrderByR\aorderBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x05 \x01(\bR\n" +
+	"descending\"e\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xd5\x04\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"?\n" +
+	"\x11CreatePartRequest\x12*\n" +
+	"\x04info\x18\x01 \x01(\v2\x16.inventory.v1.PartInfoR\x04info\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"m\n" +
+	"\x11UpdatePartRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12*\n" +
+	"\x04info\x18\x03 \x01(\v2\x16.inventory.v1.PartInfoR\x04info\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"A\n" +
+	"\x11DeletePartRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\x14\n" +
+	"\x12DeletePartResponse\"\xd3\x03\n" +
+	"\bPartInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12%\n" +
+	"\x0estock_quantity\x18\x04 \x01(\x03R\rstockQuantity\x122\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x128\n" +
+	"\n" +
+	"dimensions\x18\x06 \x01(\v2\x18.inventory.v1.DimensionsR\n" +
+	"dimensions\x12>\n" +
+	"\fmanufacturer\x18\a \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12@\n" +
+	"\bmetadata\x18\t \x03(\v2$.inventory.v1.PartInfo.MetadataEntryR\bmetadata\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"i\n" +
+	"\x13ReservePartsRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x123\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.inventory.v1.ReservationItemR\x05items\"S\n" +
+	"\x14ReservePartsResponse\x12;\n" +
+	"\vreservation\x18\x01 \x01(\v2\x19.inventory.v1.ReservationR\vreservation\"9\n" +
+	"\x18CommitReservationRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\"X\n" +
+	"\x19CommitReservationResponse\x12;\n" +
+	"\vreservation\x18\x01 \x01(\v2\x19.inventory.v1.ReservationR\vreservation\":\n" +
+	"\x19ReleaseReservationRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\"Y\n" +
+	"\x1aReleaseReservationResponse\x12;\n" +
+	"\vreservation\x18\x01 \x01(\v2\x19.inventory.v1.ReservationR\vreservation\"J\n" +
+	"\x0fReservationItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\x90\x02\n" +
+	"\vReservation\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x123\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.inventory.v1.ReservationItemR\x05items\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.inventory.v1.ReservationStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xef\x04\n" +
 	"\x04Part\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -743,7 +2169,8 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
 	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"j\n" +
@@ -764,7 +2191,7 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\a\n" +
-	"\x05value\"\xbc\x01\n" +
+	"\x05value\"\xf7\x03\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -772,16 +2199,77 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags*v\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12/\n" +
+	"\x05price\x18\x06 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05price\x12?\n" +
+	"\x0estock_quantity\x18\a \x01(\v2\x18.inventory.v1.Int64RangeR\rstockQuantity\x12>\n" +
+	"\n" +
+	"dimensions\x18\b \x01(\v2\x1e.inventory.v1.DimensionsFilterR\n" +
+	"dimensions\x126\n" +
+	"\n" +
+	"tags_match\x18\t \x01(\x0e2\x17.inventory.v1.TagsMatchR\ttagsMatch\x12;\n" +
+	"\bmetadata\x18\n" +
+	" \x03(\v2\x1f.inventory.v1.MetadataPredicateR\bmetadata\x12\x12\n" +
+	"\x04text\x18\v \x01(\tR\x04text\"K\n" +
+	"\vDoubleRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"J\n" +
+	"\n" +
+	"Int64Range\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x03H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x03H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xdc\x01\n" +
+	"\x10DimensionsFilter\x121\n" +
+	"\x06length\x18\x01 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06length\x12/\n" +
+	"\x05width\x18\x02 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05width\x121\n" +
+	"\x06height\x18\x03 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06height\x121\n" +
+	"\x06weight\x18\x04 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06weight\"\x8e\x01\n" +
+	"\x11MetadataPredicate\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12<\n" +
+	"\boperator\x18\x02 \x01(\x0e2 .inventory.v1.ComparisonOperatorR\boperator\x12)\n" +
+	"\x05value\x18\x03 \x01(\v2\x13.inventory.v1.ValueR\x05value*\x80\x01\n" +
+	"\fPartsOrderBy\x12\x1e\n" +
+	"\x1aPARTS_ORDER_BY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PARTS_ORDER_BY_PRICE\x10\x01\x12\x17\n" +
+	"\x13PARTS_ORDER_BY_NAME\x10\x02\x12\x1d\n" +
+	"\x19PARTS_ORDER_BY_CREATED_AT\x10\x03*\x9b\x01\n" +
+	"\x11ReservationStatus\x12\"\n" +
+	"\x1eRESERVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RESERVED\x10\x01\x12 \n" +
+	"\x1cRESERVATION_STATUS_COMMITTED\x10\x02\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RELEASED\x10\x03*v\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
+	"\rCATEGORY_WING\x10\x04*O\n" +
+	"\tTagsMatch\x12\x1a\n" +
+	"\x16TAGS_MATCH_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eTAGS_MATCH_ANY\x10\x01\x12\x12\n" +
+	"\x0eTAGS_MATCH_ALL\x10\x02*\xe3\x01\n" +
+	"\x12ComparisonOperator\x12#\n" +
+	"\x1fCOMPARISON_OPERATOR_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16COMPARISON_OPERATOR_EQ\x10\x01\x12\x1a\n" +
+	"\x16COMPARISON_OPERATOR_NE\x10\x02\x12\x1a\n" +
+	"\x16COMPARISON_OPERATOR_GT\x10\x03\x12\x1b\n" +
+	"\x17COMPARISON_OPERATOR_GTE\x10\x04\x12\x1a\n" +
+	"\x16COMPARISON_OPERATOR_LT\x10\x05\x12\x1b\n" +
+	"\x17COMPARISON_OPERATOR_LTE\x10\x062\xc1\x05\n" +
 	"\x10InventoryService\x12F\n" +
 	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseBTZRgithub.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\x12O\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\x12O\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\x12O\n" +
+	"\n" +
+	"DeletePart\x12\x1f.inventory.v1.DeletePartRequest\x1a .inventory.v1.DeletePartResponse\x12U\n" +
+	"\fReserveParts\x12!.inventory.v1.ReservePartsRequest\x1a\".inventory.v1.ReservePartsResponse\x12d\n" +
+	"\x11CommitReservation\x12&.inventory.v1.CommitReservationRequest\x1a'.inventory.v1.CommitReservationResponse\x12g\n" +
+	"\x12ReleaseReservation\x12'.inventory.v1.ReleaseReservationRequest\x1a(.inventory.v1.ReleaseReservationResponseBTZRgithub.com/space-wanderer/microservices/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -795,43 +2283,108 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*Part)(nil),                  // 5: inventory.v1.Part
-	(*Dimensions)(nil),            // 6: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 7: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 8: inventory.v1.Value
-	(*PartsFilter)(nil),           // 9: inventory.v1.PartsFilter
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(PartsOrderBy)(0),                  // 0: inventory.v1.PartsOrderBy
+	(ReservationStatus)(0),             // 1: inventory.v1.ReservationStatus
+	(Category)(0),                      // 2: inventory.v1.Category
+	(TagsMatch)(0),                     // 3: inventory.v1.TagsMatch
+	(ComparisonOperator)(0),            // 4: inventory.v1.ComparisonOperator
+	(*GetPartRequest)(nil),             // 5: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),            // 6: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),           // 7: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),          // 8: inventory.v1.ListPartsResponse
+	(*CreatePartRequest)(nil),          // 9: inventory.v1.CreatePartRequest
+	(*CreatePartResponse)(nil),         // 10: inventory.v1.CreatePartResponse
+	(*UpdatePartRequest)(nil),          // 11: inventory.v1.UpdatePartRequest
+	(*UpdatePartResponse)(nil),         // 12: inventory.v1.UpdatePartResponse
+	(*DeletePartRequest)(nil),          // 13: inventory.v1.DeletePartRequest
+	(*DeletePartResponse)(nil),         // 14: inventory.v1.DeletePartResponse
+	(*PartInfo)(nil),                   // 15: inventory.v1.PartInfo
+	(*ReservePartsRequest)(nil),        // 16: inventory.v1.ReservePartsRequest
+	(*ReservePartsResponse)(nil),       // 17: inventory.v1.ReservePartsResponse
+	(*CommitReservationRequest)(nil),   // 18: inventory.v1.CommitReservationRequest
+	(*CommitReservationResponse)(nil),  // 19: inventory.v1.CommitReservationResponse
+	(*ReleaseReservationRequest)(nil),  // 20: inventory.v1.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil), // 21: inventory.v1.ReleaseReservationResponse
+	(*ReservationItem)(nil),            // 22: inventory.v1.ReservationItem
+	(*Reservation)(nil),                // 23: inventory.v1.Reservation
+	(*Part)(nil),                       // 24: inventory.v1.Part
+	(*Dimensions)(nil),                 // 25: inventory.v1.Dimensions
+	(*Manufacturer)(nil),               // 26: inventory.v1.Manufacturer
+	(*Value)(nil),                      // 27: inventory.v1.Value
+	(*PartsFilter)(nil),                // 28: inventory.v1.PartsFilter
+	(*DoubleRange)(nil),                // 29: inventory.v1.DoubleRange
+	(*Int64Range)(nil),                 // 30: inventory.v1.Int64Range
+	(*DimensionsFilter)(nil),           // 31: inventory.v1.DimensionsFilter
+	(*MetadataPredicate)(nil),          // 32: inventory.v1.MetadataPredicate
+	nil,                                // 33: inventory.v1.PartInfo.MetadataEntry
+	nil,                                // 34: inventory.v1.Part.MetadataEntry
+	(*timestamppb.Timestamp)(nil),      // 35: google.protobuf.Timestamp
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	5,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	9,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	5,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	6,  // 4: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	7,  // 5: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 6: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 7: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 8: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	8,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	24, // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	28, // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	0,  // 2: inventory.v1.ListPartsRequest.order_by:type_name -> inventory.v1.PartsOrderBy
+	24, // 3: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	15, // 4: inventory.v1.CreatePartRequest.info:type_name -> inventory.v1.PartInfo
+	24, // 5: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	15, // 6: inventory.v1.UpdatePartRequest.info:type_name -> inventory.v1.PartInfo
+	24, // 7: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	2,  // 8: inventory.v1.PartInfo.category:type_name -> inventory.v1.Category
+	25, // 9: inventory.v1.PartInfo.dimensions:type_name -> inventory.v1.Dimensions
+	26, // 10: inventory.v1.PartInfo.manufacturer:type_name -> inventory.v1.Manufacturer
+	33, // 11: inventory.v1.PartInfo.metadata:type_name -> inventory.v1.PartInfo.MetadataEntry
+	22, // 12: inventory.v1.ReservePartsRequest.items:type_name -> inventory.v1.ReservationItem
+	23, // 13: inventory.v1.ReservePartsResponse.reservation:type_name -> inventory.v1.Reservation
+	23, // 14: inventory.v1.CommitReservationResponse.reservation:type_name -> inventory.v1.Reservation
+	23, // 15: inventory.v1.ReleaseReservationResponse.reservation:type_name -> inventory.v1.Reservation
+	22, // 16: inventory.v1.Reservation.items:type_name -> inventory.v1.ReservationItem
+	1,  // 17: inventory.v1.Reservation.status:type_name -> inventory.v1.ReservationStatus
+	35, // 18: inventory.v1.Reservation.created_at:type_name -> google.protobuf.Timestamp
+	35, // 19: inventory.v1.Reservation.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 20: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	25, // 21: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	26, // 22: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	34, // 23: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	35, // 24: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	35, // 25: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 26: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	29, // 27: inventory.v1.PartsFilter.price:type_name -> inventory.v1.DoubleRange
+	30, // 28: inventory.v1.PartsFilter.stock_quantity:type_name -> inventory.v1.Int64Range
+	31, // 29: inventory.v1.PartsFilter.dimensions:type_name -> inventory.v1.DimensionsFilter
+	3,  // 30: inventory.v1.PartsFilter.tags_match:type_name -> inventory.v1.TagsMatch
+	32, // 31: inventory.v1.PartsFilter.metadata:type_name -> inventory.v1.MetadataPredicate
+	29, // 32: inventory.v1.DimensionsFilter.length:type_name -> inventory.v1.DoubleRange
+	29, // 33: inventory.v1.DimensionsFilter.width:type_name -> inventory.v1.DoubleRange
+	29, // 34: inventory.v1.DimensionsFilter.height:type_name -> inventory.v1.DoubleRange
+	29, // 35: inventory.v1.DimensionsFilter.weight:type_name -> inventory.v1.DoubleRange
+	4,  // 36: inventory.v1.MetadataPredicate.operator:type_name -> inventory.v1.ComparisonOperator
+	27, // 37: inventory.v1.MetadataPredicate.value:type_name -> inventory.v1.Value
+	27, // 38: inventory.v1.PartInfo.MetadataEntry.value:type_name -> inventory.v1.Value
+	27, // 39: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	5,  // 40: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	7,  // 41: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	9,  // 42: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	11, // 43: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	13, // 44: inventory.v1.InventoryService.DeletePart:input_type -> inventory.v1.DeletePartRequest
+	16, // 45: inventory.v1.InventoryService.ReserveParts:input_type -> inventory.v1.ReservePartsRequest
+	18, // 46: inventory.v1.InventoryService.CommitReservation:input_type -> inventory.v1.CommitReservationRequest
+	20, // 47: inventory.v1.InventoryService.ReleaseReservation:input_type -> inventory.v1.ReleaseReservationRequest
+	6,  // 48: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	8,  // 49: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	10, // 50: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	12, // 51: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	14, // 52: inventory.v1.InventoryService.DeletePart:output_type -> inventory.v1.DeletePartResponse
+	17, // 53: inventory.v1.InventoryService.ReserveParts:output_type -> inventory.v1.ReservePartsResponse
+	19, // 54: inventory.v1.InventoryService.CommitReservation:output_type -> inventory.v1.CommitReservationResponse
+	21, // 55: inventory.v1.InventoryService.ReleaseReservation:output_type -> inventory.v1.ReleaseReservationResponse
+	48, // [48:56] is the sub-list for method output_type
+	40, // [40:48] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -839,19 +2392,21 @@ func file_inventory_v1_inventory_proto_init() {
 	if File_inventory_v1_inventory_proto != nil {
 		return
 	}
-	file_inventory_v1_inventory_proto_msgTypes[7].OneofWrappers = []any{
+	file_inventory_v1_inventory_proto_msgTypes[22].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
 		(*Value_BoolValue)(nil),
 	}
+	file_inventory_v1_inventory_proto_msgTypes[24].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      5,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetPart_FullMethodName   = "/inventory.v1.InventoryService/GetPart"
-	InventoryService_ListParts_FullMethodName = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_GetPart_FullMethodName            = "/inventory.v1.InventoryService/GetPart"
+	InventoryService_ListParts_FullMethodName          = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_CreatePart_FullMethodName         = "/inventory.v1.InventoryService/CreatePart"
+	InventoryService_UpdatePart_FullMethodName         = "/inventory.v1.InventoryService/UpdatePart"
+	InventoryService_DeletePart_FullMethodName         = "/inventory.v1.InventoryService/DeletePart"
+	InventoryService_ReserveParts_FullMethodName       = "/inventory.v1.InventoryService/ReserveParts"
+	InventoryService_CommitReservation_FullMethodName  = "/inventory.v1.InventoryService/CommitReservation"
+	InventoryService_ReleaseReservation_FullMethodName = "/inventory.v1.InventoryService/ReleaseReservation"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -31,6 +37,19 @@ type InventoryServiceClient interface {
 	GetPart(ctx context.Context, in *GetPartRequest, opts ...grpc.CallOption) (*GetPartResponse, error)
 	// ListParts - получить список деталей с возможностью фильтрации
 	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	// CreatePart - добавить деталь в каталог
+	CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error)
+	// UpdatePart - обновить деталь с проверкой версии
+	UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error)
+	// DeletePart - мягко удалить деталь из каталога
+	DeletePart(ctx context.Context, in *DeletePartRequest, opts ...grpc.CallOption) (*DeletePartResponse, error)
+	// ReserveParts - зарезервировать детали под заказ
+	ReserveParts(ctx context.Context, in *ReservePartsRequest, opts ...grpc.CallOption) (*ReservePartsResponse, error)
+	// CommitReservation - подтвердить резерв после оплаты заказа
+	CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error)
+	// ReleaseReservation - вернуть на склад детали резерва, в том числе подтвержденного:
+	// так возвращаются детали оплаченного заказа, отмененного с возвратом средств
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -61,6 +80,66 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 	return out, nil
 }
 
+func (c *inventoryServiceClient) CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeletePart(ctx context.Context, in *DeletePartRequest, opts ...grpc.CallOption) (*DeletePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeletePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReserveParts(ctx context.Context, in *ReservePartsRequest, opts ...grpc.CallOption) (*ReservePartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReservePartsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReserveParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitReservationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseReservationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -69,6 +148,19 @@ type InventoryServiceServer interface {
 	GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error)
 	// ListParts - получить список деталей с возможностью фильтрации
 	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	// CreatePart - добавить деталь в каталог
+	CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error)
+	// UpdatePart - обновить деталь с проверкой версии
+	UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error)
+	// DeletePart - мягко удалить деталь из каталога
+	DeletePart(context.Context, *DeletePartRequest) (*DeletePartResponse, error)
+	// ReserveParts - зарезервировать детали под заказ
+	ReserveParts(context.Context, *ReservePartsRequest) (*ReservePartsResponse, error)
+	// CommitReservation - подтвердить резерв после оплаты заказа
+	CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error)
+	// ReleaseReservation - вернуть на склад детали резерва, в том числе подтвержденного:
+	// так возвращаются детали оплаченного заказа, отмененного с возвратом средств
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -85,6 +177,24 @@ func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequ
 func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListParts not implemented")
 }
+func (UnimplementedInventoryServiceServer) CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeletePart(context.Context, *DeletePartRequest) (*DeletePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReserveParts(context.Context, *ReservePartsRequest) (*ReservePartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -142,6 +252,114 @@ func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_CreatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePart(ctx, req.(*CreatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, req.(*UpdatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeletePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeletePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeletePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeletePart(ctx, req.(*DeletePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReserveParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservePartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReserveParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReserveParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReserveParts(ctx, req.(*ReservePartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CommitReservation(ctx, req.(*CommitReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +375,30 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListParts",
 			Handler:    _InventoryService_ListParts_Handler,
 		},
+		{
+			MethodName: "CreatePart",
+			Handler:    _InventoryService_CreatePart_Handler,
+		},
+		{
+			MethodName: "UpdatePart",
+			Handler:    _InventoryService_UpdatePart_Handler,
+		},
+		{
+			MethodName: "DeletePart",
+			Handler:    _InventoryService_DeletePart_Handler,
+		},
+		{
+			MethodName: "ReserveParts",
+			Handler:    _InventoryService_ReserveParts_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _InventoryService_CommitReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _InventoryService_ReleaseReservation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
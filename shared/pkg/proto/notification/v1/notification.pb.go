@@ -0,0 +1,1384 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification/v1/notification.proto
+
+package notification_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Channel - канал доставки уведомлений
+type Channel int32
+
+const (
+	Channel_CHANNEL_UNSPECIFIED Channel = 0 // Неизвестный канал
+	Channel_CHANNEL_TELEGRAM    Channel = 1 // Сообщение в Telegram-чат
+	Channel_CHANNEL_EMAIL       Channel = 2 // Письмо по SMTP
+	Channel_CHANNEL_WEBHOOK     Channel = 3 // Подписанный POST-запрос на URL пользователя
+)
+
+// Enum value maps for Channel.
+var (
+	Channel_name = map[int32]string{
+		0: "CHANNEL_UNSPECIFIED",
+		1: "CHANNEL_TELEGRAM",
+		2: "CHANNEL_EMAIL",
+		3: "CHANNEL_WEBHOOK",
+	}
+	Channel_value = map[string]int32{
+		"CHANNEL_UNSPECIFIED": 0,
+		"CHANNEL_TELEGRAM":    1,
+		"CHANNEL_EMAIL":       2,
+		"CHANNEL_WEBHOOK":     3,
+	}
+)
+
+func (x Channel) Enum() *Channel {
+	p := new(Channel)
+	*p = x
+	return p
+}
+
+func (x Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (Channel) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[0]
+}
+
+func (x Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Channel.Descriptor instead.
+func (Channel) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+// EventType - событие, о котором отправляется уведомление
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED    EventType = 0 // Неизвестное событие
+	EventType_EVENT_TYPE_ORDER_PAID     EventType = 1 // Заказ оплачен
+	EventType_EVENT_TYPE_SHIP_ASSEMBLED EventType = 2 // Корабль собран
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_ORDER_PAID",
+		2: "EVENT_TYPE_SHIP_ASSEMBLED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":    0,
+		"EVENT_TYPE_ORDER_PAID":     1,
+		"EVENT_TYPE_SHIP_ASSEMBLED": 2,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[1]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+// CreateTelegramLinkTokenRequest - запрос токена привязки Telegram-чата
+type CreateTelegramLinkTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTelegramLinkTokenRequest) Reset() {
+	*x = CreateTelegramLinkTokenRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTelegramLinkTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTelegramLinkTokenRequest) ProtoMessage() {}
+
+func (x *CreateTelegramLinkTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTelegramLinkTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateTelegramLinkTokenRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+// CreateTelegramLinkTokenResponse - токен привязки Telegram-чата
+type CreateTelegramLinkTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                          // Токен для команды /start
+	Link          string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`                            // Ссылка на бота, которая сразу отправляет /start <token>
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // После этого времени токен не принимается
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTelegramLinkTokenResponse) Reset() {
+	*x = CreateTelegramLinkTokenResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTelegramLinkTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTelegramLinkTokenResponse) ProtoMessage() {}
+
+func (x *CreateTelegramLinkTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTelegramLinkTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateTelegramLinkTokenResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTelegramLinkTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateTelegramLinkTokenResponse) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *CreateTelegramLinkTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateEmailSubscriptionRequest - запрос на подписку адреса электронной почты
+type CreateEmailSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmailSubscriptionRequest) Reset() {
+	*x = CreateEmailSubscriptionRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmailSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateEmailSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmailSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateEmailSubscriptionRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// CreateEmailSubscriptionResponse - письмо с токеном подтверждения отправлено
+type CreateEmailSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // После этого времени токен не принимается
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmailSubscriptionResponse) Reset() {
+	*x = CreateEmailSubscriptionResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmailSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateEmailSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmailSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateEmailSubscriptionResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// ConfirmEmailSubscriptionRequest - запрос на подтверждение адреса
+type ConfirmEmailSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Токен из письма
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailSubscriptionRequest) Reset() {
+	*x = ConfirmEmailSubscriptionRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailSubscriptionRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConfirmEmailSubscriptionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// ConfirmEmailSubscriptionResponse - адрес подписан на уведомления
+type ConfirmEmailSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailSubscriptionResponse) Reset() {
+	*x = ConfirmEmailSubscriptionResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailSubscriptionResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfirmEmailSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// CreateWebhookSubscriptionRequest - запрос на подписку URL
+type CreateWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // Адрес http или https, на который отправляются POST-запросы с JSON
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// CreateWebhookSubscriptionResponse - URL подписан на уведомления
+type CreateWebhookSubscriptionResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Subscription *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Секрет подписи запросов. Заголовок X-Notification-Signature содержит
+	// sha256=<hex HMAC-SHA256 от "<X-Notification-Timestamp>.<тело запроса>">
+	SigningSecret string `protobuf:"bytes,2,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+// ListWebhookDeliveryLogsRequest - запрос журнала доставки webhook-подписки
+type ListWebhookDeliveryLogsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionUuid string                 `protobuf:"bytes,1,opt,name=subscription_uuid,json=subscriptionUuid,proto3" json:"subscription_uuid,omitempty"`
+	PageSize         int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Сколько последних записей вернуть. 0 — значение по умолчанию (50), максимум 500
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveryLogsRequest) Reset() {
+	*x = ListWebhookDeliveryLogsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveryLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveryLogsRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveryLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveryLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveryLogsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListWebhookDeliveryLogsRequest) GetSubscriptionUuid() string {
+	if x != nil {
+		return x.SubscriptionUuid
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveryLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListWebhookDeliveryLogsResponse - журнал доставки webhook-подписки
+type ListWebhookDeliveryLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*WebhookDeliveryLog  `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveryLogsResponse) Reset() {
+	*x = ListWebhookDeliveryLogsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveryLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveryLogsResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveryLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveryLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveryLogsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListWebhookDeliveryLogsResponse) GetLogs() []*WebhookDeliveryLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// WebhookDeliveryLog - попытка доставки уведомления на webhook
+type WebhookDeliveryLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LogUuid       string                 `protobuf:"bytes,1,opt,name=log_uuid,json=logUuid,proto3" json:"log_uuid,omitempty"`
+	MessageUuid   string                 `protobuf:"bytes,2,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"` // Идентификатор уведомления из заголовка X-Notification-Delivery, общий для всех попыток
+	EventType     EventType              `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=notification.v1.EventType" json:"event_type,omitempty"`
+	Attempt       int32                  `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`                         // Номер попытки внутри одной отправки
+	StatusCode    int32                  `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // HTTP-статус ответа, 0 — ответ не получен
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                              // Причина неудачи, пусто — доставлено
+	DurationMs    int64                  `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryLog) Reset() {
+	*x = WebhookDeliveryLog{}
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryLog) ProtoMessage() {}
+
+func (x *WebhookDeliveryLog) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryLog.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryLog) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WebhookDeliveryLog) GetLogUuid() string {
+	if x != nil {
+		return x.LogUuid
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryLog) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryLog) GetEventType() EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDeliveryLog) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryLog) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryLog) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryLog) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryLog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetNotificationSettingsRequest - запрос настроек уведомлений
+type GetNotificationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationSettingsRequest) Reset() {
+	*x = GetNotificationSettingsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettingsRequest) ProtoMessage() {}
+
+func (x *GetNotificationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+// GetNotificationSettingsResponse - настройки уведомлений пользователя
+type GetNotificationSettingsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions  []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	DisabledEvents []EventType            `protobuf:"varint,2,rep,packed,name=disabled_events,json=disabledEvents,proto3,enum=notification.v1.EventType" json:"disabled_events,omitempty"` // События, о которых пользователь не получает уведомления
+	QuietHours     *QuietHours            `protobuf:"bytes,3,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`                                                    // Не задано — тихие часы отключены
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetNotificationSettingsResponse) Reset() {
+	*x = GetNotificationSettingsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettingsResponse) ProtoMessage() {}
+
+func (x *GetNotificationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetNotificationSettingsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *GetNotificationSettingsResponse) GetDisabledEvents() []EventType {
+	if x != nil {
+		return x.DisabledEvents
+	}
+	return nil
+}
+
+func (x *GetNotificationSettingsResponse) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+// DeleteSubscriptionRequest - запрос на удаление подписки
+type DeleteSubscriptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionUuid string                 `protobuf:"bytes,1,opt,name=subscription_uuid,json=subscriptionUuid,proto3" json:"subscription_uuid,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteSubscriptionRequest) GetSubscriptionUuid() string {
+	if x != nil {
+		return x.SubscriptionUuid
+	}
+	return ""
+}
+
+// DeleteSubscriptionResponse - ответ на удаление подписки
+type DeleteSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionResponse) Reset() {
+	*x = DeleteSubscriptionResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+// SetEventEnabledRequest - запрос на включение или отключение уведомлений о событии
+type SetEventEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     EventType              `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=notification.v1.EventType" json:"event_type,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventEnabledRequest) Reset() {
+	*x = SetEventEnabledRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventEnabledRequest) ProtoMessage() {}
+
+func (x *SetEventEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetEventEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetEventEnabledRequest) GetEventType() EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *SetEventEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SetEventEnabledResponse - ответ на изменение уведомлений о событии
+type SetEventEnabledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventEnabledResponse) Reset() {
+	*x = SetEventEnabledResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventEnabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventEnabledResponse) ProtoMessage() {}
+
+func (x *SetEventEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetEventEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{16}
+}
+
+// SetQuietHoursRequest - запрос на изменение тихих часов
+type SetQuietHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuietHours    *QuietHours            `protobuf:"bytes,1,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"` // Не задано — тихие часы отключаются
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuietHoursRequest) Reset() {
+	*x = SetQuietHoursRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuietHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuietHoursRequest) ProtoMessage() {}
+
+func (x *SetQuietHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuietHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetQuietHoursRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetQuietHoursRequest) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+// SetQuietHoursResponse - ответ на изменение тихих часов
+type SetQuietHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuietHoursResponse) Reset() {
+	*x = SetQuietHoursResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuietHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuietHoursResponse) ProtoMessage() {}
+
+func (x *SetQuietHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuietHoursResponse.ProtoReflect.Descriptor instead.
+func (*SetQuietHoursResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{18}
+}
+
+// Subscription - получатель уведомлений пользователя
+type Subscription struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionUuid string                 `protobuf:"bytes,1,opt,name=subscription_uuid,json=subscriptionUuid,proto3" json:"subscription_uuid,omitempty"`
+	Channel          Channel                `protobuf:"varint,2,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	Recipient        string                 `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"` // Адрес в канале: идентификатор Telegram-чата, адрес почты или URL webhook
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Subscription) GetSubscriptionUuid() string {
+	if x != nil {
+		return x.SubscriptionUuid
+	}
+	return ""
+}
+
+func (x *Subscription) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *Subscription) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// QuietHours - ежедневный интервал, в который уведомления откладываются до его окончания.
+// Интервал может переходить через полночь, например с 23:00 до 08:00
+type QuietHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartMinute   int32                  `protobuf:"varint,1,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"` // Начало интервала в минутах от полуночи, 0-1439
+	EndMinute     int32                  `protobuf:"varint,2,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`       // Конец интервала в минутах от полуночи, 0-1439
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`           // Часовой пояс IANA, например Europe/Moscow
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QuietHours) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *QuietHours) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+func (x *QuietHours) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+var File_notification_v1_notification_proto protoreflect.FileDescriptor
+
+const file_notification_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	"\"notification/v1/notification.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\" \n" +
+	"\x1eCreateTelegramLinkTokenRequest\"\x86\x01\n" +
+	"\x1fCreateTelegramLinkTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"6\n" +
+	"\x1eCreateEmailSubscriptionRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\\\n" +
+	"\x1fCreateEmailSubscriptionResponse\x129\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"7\n" +
+	"\x1fConfirmEmailSubscriptionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"e\n" +
+	" ConfirmEmailSubscriptionResponse\x12A\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1d.notification.v1.SubscriptionR\fsubscription\"4\n" +
+	" CreateWebhookSubscriptionRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\x8d\x01\n" +
+	"!CreateWebhookSubscriptionResponse\x12A\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1d.notification.v1.SubscriptionR\fsubscription\x12%\n" +
+	"\x0esigning_secret\x18\x02 \x01(\tR\rsigningSecret\"j\n" +
+	"\x1eListWebhookDeliveryLogsRequest\x12+\n" +
+	"\x11subscription_uuid\x18\x01 \x01(\tR\x10subscriptionUuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"Z\n" +
+	"\x1fListWebhookDeliveryLogsResponse\x127\n" +
+	"\x04logs\x18\x01 \x03(\v2#.notification.v1.WebhookDeliveryLogR\x04logs\"\xba\x02\n" +
+	"\x12WebhookDeliveryLog\x12\x19\n" +
+	"\blog_uuid\x18\x01 \x01(\tR\alogUuid\x12!\n" +
+	"\fmessage_uuid\x18\x02 \x01(\tR\vmessageUuid\x129\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\x0e2\x1a.notification.v1.EventTypeR\teventType\x12\x18\n" +
+	"\aattempt\x18\x04 \x01(\x05R\aattempt\x12\x1f\n" +
+	"\vstatus_code\x18\x05 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\a \x01(\x03R\n" +
+	"durationMs\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\" \n" +
+	"\x1eGetNotificationSettingsRequest\"\xe9\x01\n" +
+	"\x1fGetNotificationSettingsResponse\x12C\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1d.notification.v1.SubscriptionR\rsubscriptions\x12C\n" +
+	"\x0fdisabled_events\x18\x02 \x03(\x0e2\x1a.notification.v1.EventTypeR\x0edisabledEvents\x12<\n" +
+	"\vquiet_hours\x18\x03 \x01(\v2\x1b.notification.v1.QuietHoursR\n" +
+	"quietHours\"H\n" +
+	"\x19DeleteSubscriptionRequest\x12+\n" +
+	"\x11subscription_uuid\x18\x01 \x01(\tR\x10subscriptionUuid\"\x1c\n" +
+	"\x1aDeleteSubscriptionResponse\"m\n" +
+	"\x16SetEventEnabledRequest\x129\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\x0e2\x1a.notification.v1.EventTypeR\teventType\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"\x19\n" +
+	"\x17SetEventEnabledResponse\"T\n" +
+	"\x14SetQuietHoursRequest\x12<\n" +
+	"\vquiet_hours\x18\x01 \x01(\v2\x1b.notification.v1.QuietHoursR\n" +
+	"quietHours\"\x17\n" +
+	"\x15SetQuietHoursResponse\"\xc8\x01\n" +
+	"\fSubscription\x12+\n" +
+	"\x11subscription_uuid\x18\x01 \x01(\tR\x10subscriptionUuid\x122\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1c\n" +
+	"\trecipient\x18\x03 \x01(\tR\trecipient\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"k\n" +
+	"\n" +
+	"QuietHours\x12!\n" +
+	"\fstart_minute\x18\x01 \x01(\x05R\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x02 \x01(\x05R\tendMinute\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone*`\n" +
+	"\aChannel\x12\x17\n" +
+	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10CHANNEL_TELEGRAM\x10\x01\x12\x11\n" +
+	"\rCHANNEL_EMAIL\x10\x02\x12\x13\n" +
+	"\x0fCHANNEL_WEBHOOK\x10\x03*a\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15EVENT_TYPE_ORDER_PAID\x10\x01\x12\x1d\n" +
+	"\x19EVENT_TYPE_SHIP_ASSEMBLED\x10\x022\xc8\b\n" +
+	"\x13NotificationService\x12|\n" +
+	"\x17CreateTelegramLinkToken\x12/.notification.v1.CreateTelegramLinkTokenRequest\x1a0.notification.v1.CreateTelegramLinkTokenResponse\x12|\n" +
+	"\x17CreateEmailSubscription\x12/.notification.v1.CreateEmailSubscriptionRequest\x1a0.notification.v1.CreateEmailSubscriptionResponse\x12\x7f\n" +
+	"\x18ConfirmEmailSubscription\x120.notification.v1.ConfirmEmailSubscriptionRequest\x1a1.notification.v1.ConfirmEmailSubscriptionResponse\x12\x82\x01\n" +
+	"\x19CreateWebhookSubscription\x121.notification.v1.CreateWebhookSubscriptionRequest\x1a2.notification.v1.CreateWebhookSubscriptionResponse\x12|\n" +
+	"\x17ListWebhookDeliveryLogs\x12/.notification.v1.ListWebhookDeliveryLogsRequest\x1a0.notification.v1.ListWebhookDeliveryLogsResponse\x12|\n" +
+	"\x17GetNotificationSettings\x12/.notification.v1.GetNotificationSettingsRequest\x1a0.notification.v1.GetNotificationSettingsResponse\x12m\n" +
+	"\x12DeleteSubscription\x12*.notification.v1.DeleteSubscriptionRequest\x1a+.notification.v1.DeleteSubscriptionResponse\x12d\n" +
+	"\x0fSetEventEnabled\x12'.notification.v1.SetEventEnabledRequest\x1a(.notification.v1.SetEventEnabledResponse\x12^\n" +
+	"\rSetQuietHours\x12%.notification.v1.SetQuietHoursRequest\x1a&.notification.v1.SetQuietHoursResponseBZZXgithub.com/space-wanderer/microservices/shared/pkg/proto/notification/v1;notification_v1b\x06proto3"
+
+var (
+	file_notification_v1_notification_proto_rawDescOnce sync.Once
+	file_notification_v1_notification_proto_rawDescData []byte
+)
+
+func file_notification_v1_notification_proto_rawDescGZIP() []byte {
+	file_notification_v1_notification_proto_rawDescOnce.Do(func() {
+		file_notification_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)))
+	})
+	return file_notification_v1_notification_proto_rawDescData
+}
+
+var file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_notification_v1_notification_proto_goTypes = []any{
+	(Channel)(0),                              // 0: notification.v1.Channel
+	(EventType)(0),                            // 1: notification.v1.EventType
+	(*CreateTelegramLinkTokenRequest)(nil),    // 2: notification.v1.CreateTelegramLinkTokenRequest
+	(*CreateTelegramLinkTokenResponse)(nil),   // 3: notification.v1.CreateTelegramLinkTokenResponse
+	(*CreateEmailSubscriptionRequest)(nil),    // 4: notification.v1.CreateEmailSubscriptionRequest
+	(*CreateEmailSubscriptionResponse)(nil),   // 5: notification.v1.CreateEmailSubscriptionResponse
+	(*ConfirmEmailSubscriptionRequest)(nil),   // 6: notification.v1.ConfirmEmailSubscriptionRequest
+	(*ConfirmEmailSubscriptionResponse)(nil),  // 7: notification.v1.ConfirmEmailSubscriptionResponse
+	(*CreateWebhookSubscriptionRequest)(nil),  // 8: notification.v1.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil), // 9: notification.v1.CreateWebhookSubscriptionResponse
+	(*ListWebhookDeliveryLogsRequest)(nil),    // 10: notification.v1.ListWebhookDeliveryLogsRequest
+	(*ListWebhookDeliveryLogsResponse)(nil),   // 11: notification.v1.ListWebhookDeliveryLogsResponse
+	(*WebhookDeliveryLog)(nil),                // 12: notification.v1.WebhookDeliveryLog
+	(*GetNotificationSettingsRequest)(nil),    // 13: notification.v1.GetNotificationSettingsRequest
+	(*GetNotificationSettingsResponse)(nil),   // 14: notification.v1.GetNotificationSettingsResponse
+	(*DeleteSubscriptionRequest)(nil),         // 15: notification.v1.DeleteSubscriptionRequest
+	(*DeleteSubscriptionResponse)(nil),        // 16: notification.v1.DeleteSubscriptionResponse
+	(*SetEventEnabledRequest)(nil),            // 17: notification.v1.SetEventEnabledRequest
+	(*SetEventEnabledResponse)(nil),           // 18: notification.v1.SetEventEnabledResponse
+	(*SetQuietHoursRequest)(nil),              // 19: notification.v1.SetQuietHoursRequest
+	(*SetQuietHoursResponse)(nil),             // 20: notification.v1.SetQuietHoursResponse
+	(*Subscription)(nil),                      // 21: notification.v1.Subscription
+	(*QuietHours)(nil),                        // 22: notification.v1.QuietHours
+	(*timestamppb.Timestamp)(nil),             // 23: google.protobuf.Timestamp
+}
+var file_notification_v1_notification_proto_depIdxs = []int32{
+	23, // 0: notification.v1.CreateTelegramLinkTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	23, // 1: notification.v1.CreateEmailSubscriptionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 2: notification.v1.ConfirmEmailSubscriptionResponse.subscription:type_name -> notification.v1.Subscription
+	21, // 3: notification.v1.CreateWebhookSubscriptionResponse.subscription:type_name -> notification.v1.Subscription
+	12, // 4: notification.v1.ListWebhookDeliveryLogsResponse.logs:type_name -> notification.v1.WebhookDeliveryLog
+	1,  // 5: notification.v1.WebhookDeliveryLog.event_type:type_name -> notification.v1.EventType
+	23, // 6: notification.v1.WebhookDeliveryLog.created_at:type_name -> google.protobuf.Timestamp
+	21, // 7: notification.v1.GetNotificationSettingsResponse.subscriptions:type_name -> notification.v1.Subscription
+	1,  // 8: notification.v1.GetNotificationSettingsResponse.disabled_events:type_name -> notification.v1.EventType
+	22, // 9: notification.v1.GetNotificationSettingsResponse.quiet_hours:type_name -> notification.v1.QuietHours
+	1,  // 10: notification.v1.SetEventEnabledRequest.event_type:type_name -> notification.v1.EventType
+	22, // 11: notification.v1.SetQuietHoursRequest.quiet_hours:type_name -> notification.v1.QuietHours
+	0,  // 12: notification.v1.Subscription.channel:type_name -> notification.v1.Channel
+	23, // 13: notification.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 14: notification.v1.NotificationService.CreateTelegramLinkToken:input_type -> notification.v1.CreateTelegramLinkTokenRequest
+	4,  // 15: notification.v1.NotificationService.CreateEmailSubscription:input_type -> notification.v1.CreateEmailSubscriptionRequest
+	6,  // 16: notification.v1.NotificationService.ConfirmEmailSubscription:input_type -> notification.v1.ConfirmEmailSubscriptionRequest
+	8,  // 17: notification.v1.NotificationService.CreateWebhookSubscription:input_type -> notification.v1.CreateWebhookSubscriptionRequest
+	10, // 18: notification.v1.NotificationService.ListWebhookDeliveryLogs:input_type -> notification.v1.ListWebhookDeliveryLogsRequest
+	13, // 19: notification.v1.NotificationService.GetNotificationSettings:input_type -> notification.v1.GetNotificationSettingsRequest
+	15, // 20: notification.v1.NotificationService.DeleteSubscription:input_type -> notification.v1.DeleteSubscriptionRequest
+	17, // 21: notification.v1.NotificationService.SetEventEnabled:input_type -> notification.v1.SetEventEnabledRequest
+	19, // 22: notification.v1.NotificationService.SetQuietHours:input_type -> notification.v1.SetQuietHoursRequest
+	3,  // 23: notification.v1.NotificationService.CreateTelegramLinkToken:output_type -> notification.v1.CreateTelegramLinkTokenResponse
+	5,  // 24: notification.v1.NotificationService.CreateEmailSubscription:output_type -> notification.v1.CreateEmailSubscriptionResponse
+	7,  // 25: notification.v1.NotificationService.ConfirmEmailSubscription:output_type -> notification.v1.ConfirmEmailSubscriptionResponse
+	9,  // 26: notification.v1.NotificationService.CreateWebhookSubscription:output_type -> notification.v1.CreateWebhookSubscriptionResponse
+	11, // 27: notification.v1.NotificationService.ListWebhookDeliveryLogs:output_type -> notification.v1.ListWebhookDeliveryLogsResponse
+	14, // 28: notification.v1.NotificationService.GetNotificationSettings:output_type -> notification.v1.GetNotificationSettingsResponse
+	16, // 29: notification.v1.NotificationService.DeleteSubscription:output_type -> notification.v1.DeleteSubscriptionResponse
+	18, // 30: notification.v1.NotificationService.SetEventEnabled:output_type -> notification.v1.SetEventEnabledResponse
+	20, // 31: notification.v1.NotificationService.SetQuietHours:output_type -> notification.v1.SetQuietHoursResponse
+	23, // [23:32] is the sub-list for method output_type
+	14, // [14:23] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_notification_proto_init() }
+func file_notification_v1_notification_proto_init() {
+	if File_notification_v1_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_notification_proto_goTypes,
+		DependencyIndexes: file_notification_v1_notification_proto_depIdxs,
+		EnumInfos:         file_notification_v1_notification_proto_enumTypes,
+		MessageInfos:      file_notification_v1_notification_proto_msgTypes,
+	}.Build()
+	File_notification_v1_notification_proto = out.File
+	file_notification_v1_notification_proto_goTypes = nil
+	file_notification_v1_notification_proto_depIdxs = nil
+}
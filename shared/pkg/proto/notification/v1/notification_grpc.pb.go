@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification/v1/notification.proto
+
+package notification_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NotificationService_CreateTelegramLinkToken_FullMethodName   = "/notification.v1.NotificationService/CreateTelegramLinkToken"
+	NotificationService_CreateEmailSubscription_FullMethodName   = "/notification.v1.NotificationService/CreateEmailSubscription"
+	NotificationService_ConfirmEmailSubscription_FullMethodName  = "/notification.v1.NotificationService/ConfirmEmailSubscription"
+	NotificationService_CreateWebhookSubscription_FullMethodName = "/notification.v1.NotificationService/CreateWebhookSubscription"
+	NotificationService_ListWebhookDeliveryLogs_FullMethodName   = "/notification.v1.NotificationService/ListWebhookDeliveryLogs"
+	NotificationService_GetNotificationSettings_FullMethodName   = "/notification.v1.NotificationService/GetNotificationSettings"
+	NotificationService_DeleteSubscription_FullMethodName        = "/notification.v1.NotificationService/DeleteSubscription"
+	NotificationService_SetEventEnabled_FullMethodName           = "/notification.v1.NotificationService/SetEventEnabled"
+	NotificationService_SetQuietHours_FullMethodName             = "/notification.v1.NotificationService/SetQuietHours"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationService - настройки уведомлений текущего пользователя.
+// Пользователь определяется по JWT из метаданных authorization: Bearer <token>
+type NotificationServiceClient interface {
+	// CreateTelegramLinkToken - получить одноразовый токен для привязки Telegram-чата.
+	// Чат привязывается, когда пользователь отправляет боту /start <token>
+	CreateTelegramLinkToken(ctx context.Context, in *CreateTelegramLinkTokenRequest, opts ...grpc.CallOption) (*CreateTelegramLinkTokenResponse, error)
+	// CreateEmailSubscription - отправить на адрес письмо с токеном подтверждения.
+	// Адрес подписывается на уведомления после ConfirmEmailSubscription с этим токеном
+	CreateEmailSubscription(ctx context.Context, in *CreateEmailSubscriptionRequest, opts ...grpc.CallOption) (*CreateEmailSubscriptionResponse, error)
+	// ConfirmEmailSubscription - подтвердить адрес токеном из письма
+	ConfirmEmailSubscription(ctx context.Context, in *ConfirmEmailSubscriptionRequest, opts ...grpc.CallOption) (*ConfirmEmailSubscriptionResponse, error)
+	// CreateWebhookSubscription - подписать URL на уведомления. Запросы к URL подписываются
+	// HMAC-SHA256 секретом из ответа; секрет возвращается только один раз
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	// ListWebhookDeliveryLogs - получить журнал попыток доставки webhook-подписки, от новых к старым
+	ListWebhookDeliveryLogs(ctx context.Context, in *ListWebhookDeliveryLogsRequest, opts ...grpc.CallOption) (*ListWebhookDeliveryLogsResponse, error)
+	// GetNotificationSettings - получить подписки, отключенные события и тихие часы
+	GetNotificationSettings(ctx context.Context, in *GetNotificationSettingsRequest, opts ...grpc.CallOption) (*GetNotificationSettingsResponse, error)
+	// DeleteSubscription - отвязать получателя уведомлений
+	DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*DeleteSubscriptionResponse, error)
+	// SetEventEnabled - включить или отключить уведомления о событии
+	SetEventEnabled(ctx context.Context, in *SetEventEnabledRequest, opts ...grpc.CallOption) (*SetEventEnabledResponse, error)
+	// SetQuietHours - задать тихие часы. Пустое значение отключает их
+	SetQuietHours(ctx context.Context, in *SetQuietHoursRequest, opts ...grpc.CallOption) (*SetQuietHoursResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) CreateTelegramLinkToken(ctx context.Context, in *CreateTelegramLinkTokenRequest, opts ...grpc.CallOption) (*CreateTelegramLinkTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTelegramLinkTokenResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CreateTelegramLinkToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CreateEmailSubscription(ctx context.Context, in *CreateEmailSubscriptionRequest, opts ...grpc.CallOption) (*CreateEmailSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEmailSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CreateEmailSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ConfirmEmailSubscription(ctx context.Context, in *ConfirmEmailSubscriptionRequest, opts ...grpc.CallOption) (*ConfirmEmailSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmailSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ConfirmEmailSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListWebhookDeliveryLogs(ctx context.Context, in *ListWebhookDeliveryLogsRequest, opts ...grpc.CallOption) (*ListWebhookDeliveryLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveryLogsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListWebhookDeliveryLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetNotificationSettings(ctx context.Context, in *GetNotificationSettingsRequest, opts ...grpc.CallOption) (*GetNotificationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationSettingsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetNotificationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*DeleteSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SetEventEnabled(ctx context.Context, in *SetEventEnabledRequest, opts ...grpc.CallOption) (*SetEventEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEventEnabledResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SetEventEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SetQuietHours(ctx context.Context, in *SetQuietHoursRequest, opts ...grpc.CallOption) (*SetQuietHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQuietHoursResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SetQuietHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// NotificationService - настройки уведомлений текущего пользователя.
+// Пользователь определяется по JWT из метаданных authorization: Bearer <token>
+type NotificationServiceServer interface {
+	// CreateTelegramLinkToken - получить одноразовый токен для привязки Telegram-чата.
+	// Чат привязывается, когда пользователь отправляет боту /start <token>
+	CreateTelegramLinkToken(context.Context, *CreateTelegramLinkTokenRequest) (*CreateTelegramLinkTokenResponse, error)
+	// CreateEmailSubscription - отправить на адрес письмо с токеном подтверждения.
+	// Адрес подписывается на уведомления после ConfirmEmailSubscription с этим токеном
+	CreateEmailSubscription(context.Context, *CreateEmailSubscriptionRequest) (*CreateEmailSubscriptionResponse, error)
+	// ConfirmEmailSubscription - подтвердить адрес токеном из письма
+	ConfirmEmailSubscription(context.Context, *ConfirmEmailSubscriptionRequest) (*ConfirmEmailSubscriptionResponse, error)
+	// CreateWebhookSubscription - подписать URL на уведомления. Запросы к URL подписываются
+	// HMAC-SHA256 секретом из ответа; секрет возвращается только один раз
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	// ListWebhookDeliveryLogs - получить журнал попыток доставки webhook-подписки, от новых к старым
+	ListWebhookDeliveryLogs(context.Context, *ListWebhookDeliveryLogsRequest) (*ListWebhookDeliveryLogsResponse, error)
+	// GetNotificationSettings - получить подписки, отключенные события и тихие часы
+	GetNotificationSettings(context.Context, *GetNotificationSettingsRequest) (*GetNotificationSettingsResponse, error)
+	// DeleteSubscription - отвязать получателя уведомлений
+	DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*DeleteSubscriptionResponse, error)
+	// SetEventEnabled - включить или отключить уведомления о событии
+	SetEventEnabled(context.Context, *SetEventEnabledRequest) (*SetEventEnabledResponse, error)
+	// SetQuietHours - задать тихие часы. Пустое значение отключает их
+	SetQuietHours(context.Context, *SetQuietHoursRequest) (*SetQuietHoursResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) CreateTelegramLinkToken(context.Context, *CreateTelegramLinkTokenRequest) (*CreateTelegramLinkTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTelegramLinkToken not implemented")
+}
+func (UnimplementedNotificationServiceServer) CreateEmailSubscription(context.Context, *CreateEmailSubscriptionRequest) (*CreateEmailSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmailSubscription not implemented")
+}
+func (UnimplementedNotificationServiceServer) ConfirmEmailSubscription(context.Context, *ConfirmEmailSubscriptionRequest) (*ConfirmEmailSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailSubscription not implemented")
+}
+func (UnimplementedNotificationServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListWebhookDeliveryLogs(context.Context, *ListWebhookDeliveryLogsRequest) (*ListWebhookDeliveryLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveryLogs not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetNotificationSettings(context.Context, *GetNotificationSettingsRequest) (*GetNotificationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSettings not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*DeleteSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+func (UnimplementedNotificationServiceServer) SetEventEnabled(context.Context, *SetEventEnabledRequest) (*SetEventEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventEnabled not implemented")
+}
+func (UnimplementedNotificationServiceServer) SetQuietHours(context.Context, *SetQuietHoursRequest) (*SetQuietHoursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuietHours not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_CreateTelegramLinkToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTelegramLinkTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateTelegramLinkToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateTelegramLinkToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateTelegramLinkToken(ctx, req.(*CreateTelegramLinkTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CreateEmailSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmailSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateEmailSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateEmailSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateEmailSubscription(ctx, req.(*CreateEmailSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ConfirmEmailSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ConfirmEmailSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ConfirmEmailSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ConfirmEmailSubscription(ctx, req.(*ConfirmEmailSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListWebhookDeliveryLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveryLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListWebhookDeliveryLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListWebhookDeliveryLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListWebhookDeliveryLogs(ctx, req.(*ListWebhookDeliveryLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetNotificationSettings(ctx, req.(*GetNotificationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteSubscription(ctx, req.(*DeleteSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SetEventEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SetEventEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SetEventEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SetEventEnabled(ctx, req.(*SetEventEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SetQuietHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuietHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SetQuietHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SetQuietHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SetQuietHours(ctx, req.(*SetQuietHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTelegramLinkToken",
+			Handler:    _NotificationService_CreateTelegramLinkToken_Handler,
+		},
+		{
+			MethodName: "CreateEmailSubscription",
+			Handler:    _NotificationService_CreateEmailSubscription_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailSubscription",
+			Handler:    _NotificationService_ConfirmEmailSubscription_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _NotificationService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveryLogs",
+			Handler:    _NotificationService_ListWebhookDeliveryLogs_Handler,
+		},
+		{
+			MethodName: "GetNotificationSettings",
+			Handler:    _NotificationService_GetNotificationSettings_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _NotificationService_DeleteSubscription_Handler,
+		},
+		{
+			MethodName: "SetEventEnabled",
+			Handler:    _NotificationService_SetEventEnabled_Handler,
+		},
+		{
+			MethodName: "SetQuietHours",
+			Handler:    _NotificationService_SetQuietHours_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notification/v1/notification.proto",
+}
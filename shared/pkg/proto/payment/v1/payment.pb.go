@@ -21,6 +21,118 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RefundStatus - статус возврата средств
+type RefundStatus int32
+
+const (
+	RefundStatus_REFUND_STATUS_UNSPECIFIED RefundStatus = 0 // Неизвестный статус
+	RefundStatus_REFUND_STATUS_PENDING     RefundStatus = 1 // Создан, провайдер еще не ответил
+	RefundStatus_REFUND_STATUS_SUCCEEDED   RefundStatus = 2 // Средства возвращены
+	RefundStatus_REFUND_STATUS_FAILED      RefundStatus = 3 // Провайдер недоступен или вернул ошибку
+)
+
+// Enum value maps for RefundStatus.
+var (
+	RefundStatus_name = map[int32]string{
+		0: "REFUND_STATUS_UNSPECIFIED",
+		1: "REFUND_STATUS_PENDING",
+		2: "REFUND_STATUS_SUCCEEDED",
+		3: "REFUND_STATUS_FAILED",
+	}
+	RefundStatus_value = map[string]int32{
+		"REFUND_STATUS_UNSPECIFIED": 0,
+		"REFUND_STATUS_PENDING":     1,
+		"REFUND_STATUS_SUCCEEDED":   2,
+		"REFUND_STATUS_FAILED":      3,
+	}
+)
+
+func (x RefundStatus) Enum() *RefundStatus {
+	p := new(RefundStatus)
+	*p = x
+	return p
+}
+
+func (x RefundStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[0].Descriptor()
+}
+
+func (RefundStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[0]
+}
+
+func (x RefundStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundStatus.Descriptor instead.
+func (RefundStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+// TransactionStatus - статус платежной транзакции
+type TransactionStatus int32
+
+const (
+	TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED TransactionStatus = 0 // Неизвестный статус
+	TransactionStatus_TRANSACTION_STATUS_PENDING     TransactionStatus = 1 // Создана, провайдер еще не ответил
+	TransactionStatus_TRANSACTION_STATUS_AUTHORIZED  TransactionStatus = 2 // Средства заблокированы провайдером
+	TransactionStatus_TRANSACTION_STATUS_CAPTURED    TransactionStatus = 3 // Средства списаны
+	TransactionStatus_TRANSACTION_STATUS_DECLINED    TransactionStatus = 4 // Платеж отклонен
+	TransactionStatus_TRANSACTION_STATUS_FAILED      TransactionStatus = 5 // Провайдер недоступен или вернул ошибку
+)
+
+// Enum value maps for TransactionStatus.
+var (
+	TransactionStatus_name = map[int32]string{
+		0: "TRANSACTION_STATUS_UNSPECIFIED",
+		1: "TRANSACTION_STATUS_PENDING",
+		2: "TRANSACTION_STATUS_AUTHORIZED",
+		3: "TRANSACTION_STATUS_CAPTURED",
+		4: "TRANSACTION_STATUS_DECLINED",
+		5: "TRANSACTION_STATUS_FAILED",
+	}
+	TransactionStatus_value = map[string]int32{
+		"TRANSACTION_STATUS_UNSPECIFIED": 0,
+		"TRANSACTION_STATUS_PENDING":     1,
+		"TRANSACTION_STATUS_AUTHORIZED":  2,
+		"TRANSACTION_STATUS_CAPTURED":    3,
+		"TRANSACTION_STATUS_DECLINED":    4,
+		"TRANSACTION_STATUS_FAILED":      5,
+	}
+)
+
+func (x TransactionStatus) Enum() *TransactionStatus {
+	p := new(TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (TransactionStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[1]
+}
+
+func (x TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatus.Descriptor instead.
+func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
 // PaymentMethod - способ оплаты
 type PaymentMethod int32
 
@@ -61,11 +173,11 @@ func (x PaymentMethod) String() string {
 }
 
 func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_payment_v1_payment_proto_enumTypes[0].Descriptor()
+	return file_payment_v1_payment_proto_enumTypes[2].Descriptor()
 }
 
 func (PaymentMethod) Type() protoreflect.EnumType {
-	return &file_payment_v1_payment_proto_enumTypes[0]
+	return &file_payment_v1_payment_proto_enumTypes[2]
 }
 
 func (x PaymentMethod) Number() protoreflect.EnumNumber {
@@ -74,17 +186,19 @@ func (x PaymentMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PaymentMethod.Descriptor instead.
 func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
 }
 
 // PayOrderRequest - запрос на оплату заказа
 type PayOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                                            // UUID заказа
-	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                                               // UUID пользователя, который инициирует оплату
-	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"` // Выбранный способ оплаты
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid      string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`                                            // UUID заказа
+	UserUuid       string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`                                               // UUID пользователя, который инициирует оплату
+	PaymentMethod  PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"` // Выбранный способ оплаты
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`                             // Ключ идемпотентности, повтор с тем же ключом не списывает средства повторно
+	AmountMinor    int64                  `protobuf:"varint,6,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`                                     // Сумма к оплате в копейках
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PayOrderRequest) Reset() {
@@ -138,10 +252,26 @@ func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
 }
 
-// PayOrderResponse - ответ на оплату заказа
+func (x *PayOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+// PayOrderResponse - ответ на оплату заказа.
+// Отклоненный платеж возвращается ошибкой FAILED_PRECONDITION с причиной отказа
 type PayOrderResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID транзакции оплаты
+	Status          TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`       // Статус транзакции
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -183,27 +313,199 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
+func (x *PayOrderResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+// RefundPaymentRequest - запрос на возврат средств
+type RefundPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID исходной транзакции оплаты
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                          // Причина возврата
+	IdempotencyKey  string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`    // Ключ идемпотентности, повтор с тем же ключом не возвращает средства повторно
+	AmountMinor     int64                  `protobuf:"varint,5,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`            // Сумма возврата в копейках, 0 - вернуть весь невозвращенный остаток
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefundPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+// RefundPaymentResponse - ответ на возврат средств
+type RefundPaymentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RefundUuid      string                 `protobuf:"bytes,1,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`                // UUID возврата
+	TransactionUuid string                 `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"` // UUID исходной транзакции оплаты
+	Status          RefundStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=payment.v1.RefundStatus" json:"status,omitempty"`            // Статус возврата
+	AmountMinor     int64                  `protobuf:"varint,5,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`            // Возвращенная сумма в копейках
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefundPaymentResponse) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentResponse) GetStatus() RefundStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RefundStatus_REFUND_STATUS_UNSPECIFIED
+}
+
+func (x *RefundPaymentResponse) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
 var File_payment_v1_payment_proto protoreflect.FileDescriptor
 
 const file_payment_v1_payment_proto_rawDesc = "" +
 	"\n" +
 	"\x18payment/v1/payment.proto\x12\n" +
-	"payment.v1\"\x8f\x01\n" +
+	"payment.v1\"\xdb\x01\n" +
 	"\x0fPayOrderRequest\x12\x1d\n" +
 	"\n" +
 	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\"=\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12!\n" +
+	"\famount_minor\x18\x06 \x01(\x03R\vamountMinor\"t\n" +
 	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*\xa3\x01\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\"\xa5\x01\n" +
+	"\x14RefundPaymentRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12!\n" +
+	"\famount_minor\x18\x05 \x01(\x03R\vamountMinor\"\xb8\x01\n" +
+	"\x15RefundPaymentResponse\x12\x1f\n" +
+	"\vrefund_uuid\x18\x01 \x01(\tR\n" +
+	"refundUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.payment.v1.RefundStatusR\x06status\x12!\n" +
+	"\famount_minor\x18\x05 \x01(\x03R\vamountMinor*\x7f\n" +
+	"\fRefundStatus\x12\x1d\n" +
+	"\x19REFUND_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15REFUND_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17REFUND_STATUS_SUCCEEDED\x10\x02\x12\x18\n" +
+	"\x14REFUND_STATUS_FAILED\x10\x03*\xdb\x01\n" +
+	"\x11TransactionStatus\x12\"\n" +
+	"\x1eTRANSACTION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_PENDING\x10\x01\x12!\n" +
+	"\x1dTRANSACTION_STATUS_AUTHORIZED\x10\x02\x12\x1f\n" +
+	"\x1bTRANSACTION_STATUS_CAPTURED\x10\x03\x12\x1f\n" +
+	"\x1bTRANSACTION_STATUS_DECLINED\x10\x04\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_FAILED\x10\x05*\xa3\x01\n" +
 	"\rPaymentMethod\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
 	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042W\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042\xad\x01\n" +
 	"\x0ePaymentService\x12E\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponseBPZNgithub.com/space-wanderer/microservices/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\x12T\n" +
+	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a!.payment.v1.RefundPaymentResponseBPZNgithub.com/space-wanderer/microservices/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
 
 var (
 	file_payment_v1_payment_proto_rawDescOnce sync.Once
@@ -217,22 +519,30 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 	return file_payment_v1_payment_proto_rawDescData
 }
 
-var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_payment_v1_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-	(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-	(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+	(RefundStatus)(0),             // 0: payment.v1.RefundStatus
+	(TransactionStatus)(0),        // 1: payment.v1.TransactionStatus
+	(PaymentMethod)(0),            // 2: payment.v1.PaymentMethod
+	(*PayOrderRequest)(nil),       // 3: payment.v1.PayOrderRequest
+	(*PayOrderResponse)(nil),      // 4: payment.v1.PayOrderResponse
+	(*RefundPaymentRequest)(nil),  // 5: payment.v1.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil), // 6: payment.v1.RefundPaymentResponse
 }
 var file_payment_v1_payment_proto_depIdxs = []int32{
-	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	1, // 1: payment.v1.PayOrderResponse.status:type_name -> payment.v1.TransactionStatus
+	0, // 2: payment.v1.RefundPaymentResponse.status:type_name -> payment.v1.RefundStatus
+	3, // 3: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
+	5, // 4: payment.v1.PaymentService.RefundPayment:input_type -> payment.v1.RefundPaymentRequest
+	4, // 5: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	6, // 6: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundPaymentResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_payment_proto_init() }
@@ -245,8 +555,8 @@ func file_payment_v1_payment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},